@@ -0,0 +1,84 @@
+package accessrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// Approve approves the pending access request, granting the requesting user
+// access to the target until the end of the access window.
+func (c *Client) Approve(ctx context.Context, accessRequestId string, version uint32, opt ...Option) (*AccessRequestUpdateResult, error) {
+	return c.decide(ctx, "Approve", "approve", accessRequestId, version, opt...)
+}
+
+// Deny denies the pending access request.
+func (c *Client) Deny(ctx context.Context, accessRequestId string, version uint32, opt ...Option) (*AccessRequestUpdateResult, error) {
+	return c.decide(ctx, "Deny", "deny", accessRequestId, version, opt...)
+}
+
+func (c *Client) decide(ctx context.Context, name, verb, accessRequestId string, version uint32, opt ...Option) (*AccessRequestUpdateResult, error) {
+	if accessRequestId == "" {
+		return nil, fmt.Errorf("empty accessRequestId value passed into %s request", name)
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if version == 0 {
+		if !opts.withAutomaticVersioning {
+			return nil, fmt.Errorf("zero version number passed into %s request", name)
+		}
+		existingRequest, existingErr := c.Read(ctx, accessRequestId, opt...)
+		if existingErr != nil {
+			if api.AsServerError(existingErr) != nil {
+				return nil, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+			}
+			return nil, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+		}
+		if existingRequest == nil {
+			return nil, errors.New("nil resource response found when performing initial check-and-set read")
+		}
+		if existingRequest.Item == nil {
+			return nil, errors.New("nil resource found when performing initial check-and-set read")
+		}
+		version = existingRequest.Item.Version
+	}
+
+	opts.postMap["version"] = version
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("access-requests/%s:%s", accessRequestId, verb), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	target := new(AccessRequestUpdateResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,137 @@
+package accessrequests
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// Option is a func that sets optional attributes for a call. This does not need
+// to be used directly, but instead option arguments are built from the
+// functions in this package. WithX options set a value to that given in the
+// argument; DefaultX options indicate that the value should be set to its
+// default. When an API call is made options are processed in ther order they
+// appear in the function call, so for a given argument X, a succession of WithX
+// or DefaultX calls will result in the last call taking effect.
+type Option func(*options)
+
+type options struct {
+	postMap                 map[string]interface{}
+	queryMap                map[string]string
+	withAutomaticVersioning bool
+	withSkipCurlOutput      bool
+	withFilter              string
+	withPageSize            uint32
+	withListToken           string
+	withRecursive           bool
+}
+
+func getDefaultOptions() options {
+	return options{
+		postMap:  make(map[string]interface{}),
+		queryMap: make(map[string]string),
+	}
+}
+
+func getOpts(opt ...Option) (options, []api.Option) {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	var apiOpts []api.Option
+	if opts.withSkipCurlOutput {
+		apiOpts = append(apiOpts, api.WithSkipCurlOutput(true))
+	}
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
+	if opts.withRecursive {
+		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
+	}
+	return opts, apiOpts
+}
+
+// If set, and if the version is zero during an update, the API will perform a
+// fetch to get the current version of the resource and populate it during the
+// update call. This is convenient but opens up the possibility for subtle
+// order-of-modification issues, so use carefully.
+func WithAutomaticVersioning(enable bool) Option {
+	return func(o *options) {
+		o.withAutomaticVersioning = enable
+	}
+}
+
+// WithSkipCurlOutput tells the API to not use the current call for cURL output.
+// Useful for when we need to look up versions.
+func WithSkipCurlOutput(skip bool) Option {
+	return func(o *options) {
+		o.withSkipCurlOutput = true
+	}
+}
+
+// WithFilter tells the API to filter the items returned using the provided
+// filter term.  The filter should be in a format supported by
+// hashicorp/go-bexpr.
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
+// WithRecursive tells the API to use recursion for listing operations on this
+// resource
+func WithRecursive(recurse bool) Option {
+	return func(o *options) {
+		o.withRecursive = true
+	}
+}
+
+func WithDurationSeconds(inDurationSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["duration_seconds"] = inDurationSeconds
+	}
+}
+
+func DefaultDurationSeconds() Option {
+	return func(o *options) {
+		o.postMap["duration_seconds"] = nil
+	}
+}
+
+func WithReason(inReason string) Option {
+	return func(o *options) {
+		o.postMap["reason"] = inReason
+	}
+}
+
+func DefaultReason() Option {
+	return func(o *options) {
+		o.postMap["reason"] = nil
+	}
+}
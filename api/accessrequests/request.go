@@ -0,0 +1,54 @@
+package accessrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Request requests access for the authenticated user to the target for the
+// duration given with WithDurationSeconds. The access request is pending
+// until approved or denied by another user.
+func (c *Client) Request(ctx context.Context, targetId string, opt ...Option) (*AccessRequestCreateResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into Request request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["target_id"] = targetId
+
+	req, err := c.client.NewRequest(ctx, "POST", "access-requests", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Request request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Request call: %w", err)
+	}
+
+	target := new(AccessRequestCreateResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Request response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
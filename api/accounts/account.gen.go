@@ -61,8 +61,9 @@ func (n AccountDeleteResult) GetResponse() *api.Response {
 }
 
 type AccountListResult struct {
-	Items    []*Account
-	response *api.Response
+	Items     []*Account
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n AccountListResult) GetItems() interface{} {
@@ -321,3 +322,96 @@ func (c *Client) List(ctx context.Context, authMethodId string, opt ...Option) (
 	target.response = resp
 	return target, nil
 }
+
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, authMethodId string, opt ...Option) (*AccountListResult, error) {
+	var items []*Account
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, authMethodId, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &AccountListResult{Items: items, response: resp}, nil
+}
+
+// AccountListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type AccountListIterator struct {
+	ctx          context.Context
+	client       *Client
+	authMethodId string
+	opt          []Option
+	items        []*Account
+	item         *Account
+	token        string
+	started      bool
+	err          error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, authMethodId string, opt ...Option) *AccountListIterator {
+	return &AccountListIterator{
+		ctx:          ctx,
+		client:       c,
+		authMethodId: authMethodId,
+		opt:          opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *AccountListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.authMethodId, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *AccountListIterator) Item() *Account {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *AccountListIterator) Err() error {
+	return i.err
+}
@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/boundary/api"
@@ -21,6 +22,8 @@ type options struct {
 	withAutomaticVersioning bool
 	withSkipCurlOutput      bool
 	withFilter              string
+	withPageSize            uint32
+	withListToken           string
 }
 
 func getDefaultOptions() options {
@@ -44,6 +47,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withFilter != "" {
 		opts.queryMap["filter"] = opts.withFilter
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	return opts, apiOpts
 }
 
@@ -74,6 +83,23 @@ func WithFilter(filter string) Option {
 	}
 }
 
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -122,6 +148,30 @@ func DefaultOidcAccountIssuer() Option {
 	}
 }
 
+func WithLdapAccountLoginName(inLoginName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = inLoginName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAccountLoginName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAccountLoginName(inLoginName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
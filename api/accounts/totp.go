@@ -0,0 +1,162 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// TotpEnrollment is a TOTP secret enrolled for a password account along with
+// its recovery codes.
+type TotpEnrollment struct {
+	Item          *Account `json:"item,omitempty"`
+	TotpUri       string   `json:"totp_uri,omitempty"`
+	TotpSecret    string   `json:"totp_secret,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+type TotpEnrollmentResult struct {
+	Item     *TotpEnrollment
+	response *api.Response
+}
+
+func (n TotpEnrollmentResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n TotpEnrollmentResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// WithTotpCode provides the current TOTP code of the account to EnrollTotp
+// and RemoveTotp, which require it, or a recovery code, once the account has
+// a confirmed TOTP enrollment.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.postMap["totp_code"] = code
+	}
+}
+
+// WithRecoveryCode provides a recovery code of the account to EnrollTotp and
+// RemoveTotp instead of a TOTP code.
+func WithRecoveryCode(code string) Option {
+	return func(o *options) {
+		o.postMap["recovery_code"] = code
+	}
+}
+
+// EnrollTotp generates a new TOTP secret and new recovery codes for the
+// account. The secret must be confirmed with ConfirmTotp before it is
+// required to authenticate the account, and replaces any previous enrollment
+// once confirmed. The secret and the recovery codes cannot be retrieved
+// again.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*TotpEnrollmentResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(TotpEnrollmentResult)
+	target.Item = new(TotpEnrollment)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ConfirmTotp confirms the TOTP enrollment of the account with a TOTP code
+// generated from the enrolled secret. Once confirmed, a TOTP code or a
+// recovery code is required to authenticate the account.
+func (c *Client) ConfirmTotp(ctx context.Context, accountId, totpCode string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into ConfirmTotp request")
+	}
+	if totpCode == "" {
+		return nil, fmt.Errorf("empty totpCode value passed into ConfirmTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in ConfirmTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"totp_code": totpCode,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:confirm-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ConfirmTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ConfirmTotp call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ConfirmTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveTotp removes the TOTP secret and the recovery codes of the account.
+func (c *Client) RemoveTotp(ctx context.Context, accountId string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into RemoveTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RemoveTotp request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:remove-totp", accountId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveTotp call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,41 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+)
+
+// Unlock clears the failed authentication attempts of the account, unlocking
+// it if it was locked by too many failed attempts.
+func (c *Client) Unlock(ctx context.Context, accountId string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into Unlock request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in Unlock request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:unlock", accountId), map[string]interface{}{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Unlock request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Unlock call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Unlock response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
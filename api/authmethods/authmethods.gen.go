@@ -24,6 +24,8 @@ type AuthMethod struct {
 	Type                        string                 `json:"type,omitempty"`
 	Attributes                  map[string]interface{} `json:"attributes,omitempty"`
 	IsPrimary                   bool                   `json:"is_primary,omitempty"`
+	AuthTokenTimeToLive         uint32                 `json:"auth_token_time_to_live,omitempty"`
+	AuthTokenTimeToStale        uint32                 `json:"auth_token_time_to_stale,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
 
@@ -62,8 +64,9 @@ func (n AuthMethodDeleteResult) GetResponse() *api.Response {
 }
 
 type AuthMethodListResult struct {
-	Items    []*AuthMethod
-	response *api.Response
+	Items     []*AuthMethod
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n AuthMethodListResult) GetItems() interface{} {
@@ -327,3 +330,96 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Auth
 	target.response = resp
 	return target, nil
 }
+
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, scopeId string, opt ...Option) (*AuthMethodListResult, error) {
+	var items []*AuthMethod
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, scopeId, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &AuthMethodListResult{Items: items, response: resp}, nil
+}
+
+// AuthMethodListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type AuthMethodListIterator struct {
+	ctx     context.Context
+	client  *Client
+	scopeId string
+	opt     []Option
+	items   []*AuthMethod
+	item    *AuthMethod
+	token   string
+	started bool
+	err     error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *AuthMethodListIterator {
+	return &AuthMethodListIterator{
+		ctx:     ctx,
+		client:  c,
+		scopeId: scopeId,
+		opt:     opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *AuthMethodListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.scopeId, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *AuthMethodListIterator) Item() *AuthMethod {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *AuthMethodListIterator) Err() error {
+	return i.err
+}
@@ -22,6 +22,8 @@ type options struct {
 	withAutomaticVersioning bool
 	withSkipCurlOutput      bool
 	withFilter              string
+	withPageSize            uint32
+	withListToken           string
 	withRecursive           bool
 }
 
@@ -46,6 +48,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withFilter != "" {
 		opts.queryMap["filter"] = opts.withFilter
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
@@ -79,6 +87,23 @@ func WithFilter(filter string) Option {
 	}
 }
 
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
 // WithRecursive tells the API to use recursion for listing operations on this
 // resource
 func WithRecursive(recurse bool) Option {
@@ -135,6 +160,30 @@ func DefaultOidcAuthMethodAllowedAudiences() Option {
 	}
 }
 
+func WithLdapAuthMethodAnonGroupSearch(inAnonGroupSearch bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = inAnonGroupSearch
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodAnonGroupSearch() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodApiUrlPrefix(inApiUrlPrefix string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -171,6 +220,102 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithAuthTokenTimeToLive(inAuthTokenTimeToLive uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live"] = inAuthTokenTimeToLive
+	}
+}
+
+func DefaultAuthTokenTimeToLive() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live"] = nil
+	}
+}
+
+func WithAuthTokenTimeToStale(inAuthTokenTimeToStale uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale"] = inAuthTokenTimeToStale
+	}
+}
+
+func DefaultAuthTokenTimeToStale() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale"] = nil
+	}
+}
+
+func WithLdapAuthMethodBindDn(inBindDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = inBindDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodBindPassword(inBindPassword string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = inBindPassword
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindPassword() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodCertificates(inCertificates []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = inCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodClaimsScopes(inClaimsScopes []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -279,6 +424,54 @@ func DefaultOidcAuthMethodDisableDiscoveredConfigValidation() Option {
 	}
 }
 
+func WithPasswordAuthMethodDisallowedPasswords(inDisallowedPasswords []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["disallowed_passwords"] = inDisallowedPasswords
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodDisallowedPasswords() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["disallowed_passwords"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodDiscoverDn(inDiscoverDn bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["discover_dn"] = inDiscoverDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodDiscoverDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["discover_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodDryRun(inDryRun bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -303,158 +496,638 @@ func DefaultOidcAuthMethodDryRun() Option {
 	}
 }
 
-func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
+func WithPasswordAuthMethodFailedAttemptDelay(inFailedAttemptDelay uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["idp_ca_certs"] = inIdpCaCerts
+		val["failed_attempt_delay"] = inFailedAttemptDelay
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodIdpCaCerts() Option {
+func DefaultPasswordAuthMethodFailedAttemptDelay() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["idp_ca_certs"] = nil
+		val["failed_attempt_delay"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodIssuer(inIssuer string) Option {
+func WithLdapAuthMethodGroupAttr(inGroupAttr string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["issuer"] = inIssuer
+		val["group_attr"] = inGroupAttr
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodIssuer() Option {
+func DefaultLdapAuthMethodGroupAttr() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["issuer"] = nil
+		val["group_attr"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
+func WithLdapAuthMethodGroupDn(inGroupDn string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["max_age"] = inMaxAge
+		val["group_dn"] = inGroupDn
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodMaxAge() Option {
+func DefaultLdapAuthMethodGroupDn() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["max_age"] = nil
+		val["group_dn"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
+func WithLdapAuthMethodGroupFilter(inGroupFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_login_name_length"] = inMinLoginNameLength
+		val["group_filter"] = inGroupFilter
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultPasswordAuthMethodMinLoginNameLength() Option {
+func DefaultLdapAuthMethodGroupFilter() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_login_name_length"] = nil
+		val["group_filter"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithPasswordAuthMethodMinPasswordLength(inMinPasswordLength uint32) Option {
+func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_password_length"] = inMinPasswordLength
+		val["idp_ca_certs"] = inIdpCaCerts
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultPasswordAuthMethodMinPasswordLength() Option {
+func DefaultOidcAuthMethodIdpCaCerts() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_password_length"] = nil
+		val["idp_ca_certs"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithName(inName string) Option {
+func WithLdapAuthMethodInsecureTls(inInsecureTls bool) Option {
 	return func(o *options) {
-		o.postMap["name"] = inName
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = inInsecureTls
+		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultName() Option {
+func DefaultLdapAuthMethodInsecureTls() Option {
 	return func(o *options) {
-		o.postMap["name"] = nil
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = nil
+		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+func WithOidcAuthMethodIssuer(inIssuer string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["signing_algorithms"] = inSigningAlgorithms
+		val["issuer"] = inIssuer
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodSigningAlgorithms() Option {
+func DefaultOidcAuthMethodIssuer() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["signing_algorithms"] = nil
+		val["issuer"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodLockoutDuration(inLockoutDuration uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration"] = inLockoutDuration
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutDuration() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_age"] = inMaxAge
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodMaxAge() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_age"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMaxFailedAttempts(inMaxFailedAttempts uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_failed_attempts"] = inMaxFailedAttempts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMaxFailedAttempts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_failed_attempts"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMaxPasswordAgeDays(inMaxPasswordAgeDays uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_days"] = inMaxPasswordAgeDays
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMaxPasswordAgeDays() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_days"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_login_name_length"] = inMinLoginNameLength
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinLoginNameLength() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_login_name_length"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMinPasswordLength(inMinPasswordLength uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_password_length"] = inMinPasswordLength
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinPasswordLength() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_password_length"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithName(inName string) Option {
+	return func(o *options) {
+		o.postMap["name"] = inName
+	}
+}
+
+func DefaultName() Option {
+	return func(o *options) {
+		o.postMap["name"] = nil
+	}
+}
+
+func WithPasswordAuthMethodPasswordHistoryCount(inPasswordHistoryCount uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = inPasswordHistoryCount
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodPasswordHistoryCount() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireDigit(inRequireDigit bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_digit"] = inRequireDigit
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireDigit() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_digit"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireLowercase(inRequireLowercase bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_lowercase"] = inRequireLowercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireLowercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_lowercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireMfa(inRequireMfa bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_mfa"] = inRequireMfa
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireMfa() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_mfa"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireSymbol(inRequireSymbol bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_symbol"] = inRequireSymbol
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireSymbol() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_symbol"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireUppercase(inRequireUppercase bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_uppercase"] = inRequireUppercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireUppercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_uppercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = inSigningAlgorithms
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodSigningAlgorithms() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodStartTls(inStartTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = inStartTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodStartTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = inUpnDomain
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUpnDomain() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUrls(inUrls []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = inUrls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUrls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserAttr(inUserAttr string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = inUserAttr
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserAttr() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserDn(inUserDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = inUserDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserFilter(inUserFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = inUserFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = nil
 		o.postMap["attributes"] = val
 	}
 }
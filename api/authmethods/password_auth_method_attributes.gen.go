@@ -2,6 +2,17 @@
 package authmethods
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength   uint32   `json:"min_login_name_length,omitempty"`
+	MinPasswordLength    uint32   `json:"min_password_length,omitempty"`
+	MaxFailedAttempts    uint32   `json:"max_failed_attempts,omitempty"`
+	LockoutDuration      uint32   `json:"lockout_duration,omitempty"`
+	FailedAttemptDelay   uint32   `json:"failed_attempt_delay,omitempty"`
+	RequireLowercase     bool     `json:"require_lowercase,omitempty"`
+	RequireUppercase     bool     `json:"require_uppercase,omitempty"`
+	RequireDigit         bool     `json:"require_digit,omitempty"`
+	RequireSymbol        bool     `json:"require_symbol,omitempty"`
+	DisallowedPasswords  []string `json:"disallowed_passwords,omitempty"`
+	PasswordHistoryCount uint32   `json:"password_history_count,omitempty"`
+	MaxPasswordAgeDays   uint32   `json:"max_password_age_days,omitempty"`
+	RequireMfa           bool     `json:"require_mfa,omitempty"`
 }
@@ -60,8 +60,9 @@ func (n CredentialLibraryDeleteResult) GetResponse() *api.Response {
 }
 
 type CredentialLibraryListResult struct {
-	Items    []*CredentialLibrary
-	response *api.Response
+	Items     []*CredentialLibrary
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n CredentialLibraryListResult) GetItems() interface{} {
@@ -320,3 +321,96 @@ func (c *Client) List(ctx context.Context, credentialStoreId string, opt ...Opti
 	target.response = resp
 	return target, nil
 }
+
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, credentialStoreId string, opt ...Option) (*CredentialLibraryListResult, error) {
+	var items []*CredentialLibrary
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, credentialStoreId, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &CredentialLibraryListResult{Items: items, response: resp}, nil
+}
+
+// CredentialLibraryListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type CredentialLibraryListIterator struct {
+	ctx               context.Context
+	client            *Client
+	credentialStoreId string
+	opt               []Option
+	items             []*CredentialLibrary
+	item              *CredentialLibrary
+	token             string
+	started           bool
+	err               error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, credentialStoreId string, opt ...Option) *CredentialLibraryListIterator {
+	return &CredentialLibraryListIterator{
+		ctx:               ctx,
+		client:            c,
+		credentialStoreId: credentialStoreId,
+		opt:               opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *CredentialLibraryListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.credentialStoreId, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *CredentialLibraryListIterator) Item() *CredentialLibrary {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *CredentialLibraryListIterator) Err() error {
+	return i.err
+}
@@ -65,8 +65,9 @@ func (n HostCatalogDeleteResult) GetResponse() *api.Response {
 }
 
 type HostCatalogListResult struct {
-	Items    []*HostCatalog
-	response *api.Response
+	Items     []*HostCatalog
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n HostCatalogListResult) GetItems() interface{} {
@@ -330,3 +331,96 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Host
 	target.response = resp
 	return target, nil
 }
+
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, scopeId string, opt ...Option) (*HostCatalogListResult, error) {
+	var items []*HostCatalog
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, scopeId, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &HostCatalogListResult{Items: items, response: resp}, nil
+}
+
+// HostCatalogListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type HostCatalogListIterator struct {
+	ctx     context.Context
+	client  *Client
+	scopeId string
+	opt     []Option
+	items   []*HostCatalog
+	item    *HostCatalog
+	token   string
+	started bool
+	err     error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *HostCatalogListIterator {
+	return &HostCatalogListIterator{
+		ctx:     ctx,
+		client:  c,
+		scopeId: scopeId,
+		opt:     opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *HostCatalogListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.scopeId, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *HostCatalogListIterator) Item() *HostCatalog {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *HostCatalogListIterator) Err() error {
+	return i.err
+}
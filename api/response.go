@@ -79,3 +79,46 @@ func (r *Response) Decode(inStruct interface{}) (*Error, error) {
 
 	return nil, nil
 }
+
+// MergeListResponses combines the responses of the List calls that fetched the
+// pages of a listing into a single response whose items are the items of all
+// pages, in order. The returned response wraps the HTTP response of the last
+// page and carries no list token.
+func MergeListResponses(resps []*Response) (*Response, error) {
+	if len(resps) == 0 {
+		return nil, fmt.Errorf("no responses to merge")
+	}
+	last := resps[len(resps)-1]
+	merged := &Response{
+		resp: last.resp,
+		Map:  make(map[string]interface{}, len(last.Map)),
+	}
+	for k, v := range last.Map {
+		merged.Map[k] = v
+	}
+	delete(merged.Map, "list_token")
+
+	var items []interface{}
+	for _, r := range resps {
+		if r == nil || r.Map == nil {
+			continue
+		}
+		switch page := r.Map["items"].(type) {
+		case nil:
+		case []interface{}:
+			items = append(items, page...)
+		default:
+			return nil, fmt.Errorf("unexpected type %T for items in list response", page)
+		}
+	}
+	if items != nil {
+		merged.Map["items"] = items
+	}
+
+	b, err := json.Marshal(merged.Map)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding merged list response: %w", err)
+	}
+	merged.Body = bytes.NewBuffer(b)
+	return merged, nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeListResponses(t *testing.T) {
+	page := func(t *testing.T, body string) *Response {
+		t.Helper()
+		r := &Response{resp: &http.Response{StatusCode: http.StatusOK}, Body: bytes.NewBufferString(body)}
+		require.NoError(t, json.Unmarshal([]byte(body), &r.Map))
+		return r
+	}
+
+	_, err := MergeListResponses(nil)
+	assert.Error(t, err)
+
+	resp, err := MergeListResponses([]*Response{
+		page(t, `{"items":[{"id":"a"},{"id":"b"}],"list_token":"t1"}`),
+		page(t, `{}`),
+		page(t, `{"items":[{"id":"c"}]}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.JSONEq(t, `{"items":[{"id":"a"},{"id":"b"},{"id":"c"}]}`, resp.Body.String())
+	assert.NotContains(t, resp.Map, "list_token")
+}
@@ -2,7 +2,12 @@
 package roles
 
 type GrantJson struct {
-	Id      string   `json:"id,omitempty"`
-	Type    string   `json:"type,omitempty"`
-	Actions []string `json:"actions,omitempty"`
+	Id       string   `json:"id,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Actions  []string `json:"actions,omitempty"`
+	Effect   string   `json:"effect,omitempty"`
+	ClientIp []string `json:"client_ip,omitempty"`
+	Days     []string `json:"days,omitempty"`
+	Hours    string   `json:"hours,omitempty"`
+	Timezone string   `json:"timezone,omitempty"`
 }
@@ -0,0 +1,85 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type PermissionsExplanation struct {
+	UserId            string            `json:"user_id,omitempty"`
+	Scope             *ScopeInfo        `json:"scope,omitempty"`
+	ResourceId        string            `json:"resource_id,omitempty"`
+	ResourceType      string            `json:"resource_type,omitempty"`
+	PinId             string            `json:"pin_id,omitempty"`
+	Action            string            `json:"action,omitempty"`
+	Authorized        *bool             `json:"authorized,omitempty"`
+	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
+	Grants            []*ExplainedGrant `json:"grants,omitempty"`
+	DenialReasons     []string          `json:"denial_reasons,omitempty"`
+}
+
+type ExplainedGrant struct {
+	RoleId            string   `json:"role_id,omitempty"`
+	Grant             string   `json:"grant,omitempty"`
+	AuthorizedActions []string `json:"authorized_actions,omitempty"`
+}
+
+type PermissionsExplanationResult struct {
+	Item     *PermissionsExplanation
+	response *api.Response
+}
+
+func (n PermissionsExplanationResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n PermissionsExplanationResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ExplainPermissions evaluates the grants of the user against a resource, or a
+// collection of resources, within the scope. The resource is set with the
+// WithResourceId or WithResourceType options, along with WithPinId for
+// resources within a parent resource. WithAction requests an authorization
+// decision for the action and WithClientIp sets the client IP grant conditions
+// are evaluated against. Without a resource, all the grants of the user within
+// the scope are returned.
+func (c *Client) ExplainPermissions(ctx context.Context, scopeId, userId string, opt ...Option) (*PermissionsExplanationResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ExplainPermissions request")
+	}
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into ExplainPermissions request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["user_id"] = userId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:explain-permissions", scopeId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ExplainPermissions request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ExplainPermissions call: %w", err)
+	}
+
+	target := new(PermissionsExplanationResult)
+	target.Item = new(PermissionsExplanation)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ExplainPermissions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,134 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type Key struct {
+	Id          string        `json:"id,omitempty"`
+	Scope       *ScopeInfo    `json:"scope,omitempty"`
+	Purpose     string        `json:"purpose,omitempty"`
+	CreatedTime time.Time     `json:"created_time,omitempty"`
+	Type        string        `json:"type,omitempty"`
+	Versions    []*KeyVersion `json:"versions,omitempty"`
+}
+
+type KeyVersion struct {
+	Id          string    `json:"id,omitempty"`
+	Version     uint32    `json:"version,omitempty"`
+	CreatedTime time.Time `json:"created_time,omitempty"`
+	State       string    `json:"state,omitempty"`
+}
+
+type KeyListResult struct {
+	Items    []*Key
+	response *api.Response
+}
+
+func (n KeyListResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n KeyListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type KeyResult struct {
+	response *api.Response
+}
+
+// GetItem will always be nil for KeyResult
+func (n KeyResult) GetItem() interface{} {
+	return nil
+}
+
+func (n KeyResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListKeys lists the root key and the data encryption keys of the scope,
+// along with their versions.
+func (c *Client) ListKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListKeys request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("scopes/%s:list-keys", scopeId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListKeys request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListKeys call: %w", err)
+	}
+
+	target := new(KeyListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListKeys response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RotateKeys creates a new version of the root key and of each data
+// encryption key of the scope.
+func (c *Client) RotateKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into RotateKeys request")
+	}
+	return c.keysAction(ctx, "RotateKeys", fmt.Sprintf("scopes/%s:rotate-keys", scopeId), map[string]interface{}{}, opt...)
+}
+
+// DestroyKeyVersion destroys a previous version of a key of the scope. A key
+// version can only be destroyed once no data is encrypted with it anymore.
+func (c *Client) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, opt ...Option) (*KeyResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into DestroyKeyVersion request")
+	}
+	if keyVersionId == "" {
+		return nil, fmt.Errorf("empty keyVersionId value passed into DestroyKeyVersion request")
+	}
+	return c.keysAction(ctx, "DestroyKeyVersion", fmt.Sprintf("scopes/%s:destroy-key-version", scopeId), map[string]interface{}{"key_version_id": keyVersionId}, opt...)
+}
+
+func (c *Client) keysAction(ctx context.Context, name, path string, body map[string]interface{}, opt ...Option) (*KeyResult, error) {
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", path, body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &KeyResult{response: resp}, nil
+}
@@ -23,6 +23,8 @@ type options struct {
 	withAutomaticVersioning bool
 	withSkipCurlOutput      bool
 	withFilter              string
+	withPageSize            uint32
+	withListToken           string
 	withRecursive           bool
 }
 
@@ -47,6 +49,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withFilter != "" {
 		opts.queryMap["filter"] = opts.withFilter
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
@@ -80,6 +88,23 @@ func WithFilter(filter string) Option {
 	}
 }
 
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
 // WithRecursive tells the API to use recursion for listing operations on this
 // resource
 func WithRecursive(recurse bool) Option {
@@ -88,6 +113,42 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithAction(inAction string) Option {
+	return func(o *options) {
+		o.postMap["action"] = inAction
+	}
+}
+
+func WithAuthTokenTimeToLive(inAuthTokenTimeToLive uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live"] = inAuthTokenTimeToLive
+	}
+}
+
+func DefaultAuthTokenTimeToLive() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live"] = nil
+	}
+}
+
+func WithAuthTokenTimeToStale(inAuthTokenTimeToStale uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale"] = inAuthTokenTimeToStale
+	}
+}
+
+func DefaultAuthTokenTimeToStale() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale"] = nil
+	}
+}
+
+func WithClientIp(inClientIp string) Option {
+	return func(o *options) {
+		o.postMap["client_ip"] = inClientIp
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -112,6 +173,12 @@ func DefaultName() Option {
 	}
 }
 
+func WithPinId(inPinId string) Option {
+	return func(o *options) {
+		o.postMap["pin_id"] = inPinId
+	}
+}
+
 func WithPrimaryAuthMethodId(inPrimaryAuthMethodId string) Option {
 	return func(o *options) {
 		o.postMap["primary_auth_method_id"] = inPrimaryAuthMethodId
@@ -124,6 +191,18 @@ func DefaultPrimaryAuthMethodId() Option {
 	}
 }
 
+func WithResourceId(inResourceId string) Option {
+	return func(o *options) {
+		o.postMap["resource_id"] = inResourceId
+	}
+}
+
+func WithResourceType(inResourceType string) Option {
+	return func(o *options) {
+		o.postMap["resource_type"] = inResourceType
+	}
+}
+
 func WithSkipAdminRoleCreation(inSkipAdminRoleCreation bool) Option {
 	return func(o *options) {
 		o.queryMap["skip_admin_role_creation"] = fmt.Sprintf("%v", inSkipAdminRoleCreation)
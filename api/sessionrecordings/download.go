@@ -0,0 +1,67 @@
+package sessionrecordings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// SessionRecordingDownloadResult is the result of downloading the content of
+// a session recording.
+type SessionRecordingDownloadResult struct {
+	// Content is the recording in asciicast v2 format.
+	Content  []byte `json:"content,omitempty"`
+	response *api.Response
+}
+
+func (n SessionRecordingDownloadResult) GetItem() interface{} {
+	return n.Content
+}
+
+func (n SessionRecordingDownloadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Download returns the content of the session recording with the given id.
+func (c *Client) Download(ctx context.Context, id string, opt ...Option) (*SessionRecordingDownloadResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into Download request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("session-recordings/%s:download", id), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Download request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Download call: %w", err)
+	}
+
+	target := new(SessionRecordingDownloadResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Download response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
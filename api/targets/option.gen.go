@@ -22,6 +22,8 @@ type options struct {
 	withAutomaticVersioning bool
 	withSkipCurlOutput      bool
 	withFilter              string
+	withPageSize            uint32
+	withListToken           string
 	withRecursive           bool
 }
 
@@ -46,6 +48,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withFilter != "" {
 		opts.queryMap["filter"] = opts.withFilter
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
@@ -79,6 +87,23 @@ func WithFilter(filter string) Option {
 	}
 }
 
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
 // WithRecursive tells the API to use recursion for listing operations on this
 // resource
 func WithRecursive(recurse bool) Option {
@@ -123,6 +148,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithTcpTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -177,6 +226,30 @@ func WithHostId(inHostId string) Option {
 	}
 }
 
+func WithTcpTargetInjectionProtocol(inInjectionProtocol string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["injection_protocol"] = inInjectionProtocol
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetInjectionProtocol() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["injection_protocol"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -213,6 +286,18 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithSessionMaxExtensionSeconds(inSessionMaxExtensionSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["session_max_extension_seconds"] = inSessionMaxExtensionSeconds
+	}
+}
+
+func DefaultSessionMaxExtensionSeconds() Option {
+	return func(o *options) {
+		o.postMap["session_max_extension_seconds"] = nil
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -225,6 +310,54 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithSshTargetSshHostKeys(inSshHostKeys string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_host_keys"] = inSshHostKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetSshHostKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_host_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetSshHostKeys(inSshHostKeys string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_host_keys"] = inSshHostKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetSshHostKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_host_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
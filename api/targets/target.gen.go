@@ -29,6 +29,7 @@ type Target struct {
 	SessionMaxSeconds               uint32                 `json:"session_max_seconds,omitempty"`
 	SessionConnectionLimit          int32                  `json:"session_connection_limit,omitempty"`
 	WorkerFilter                    string                 `json:"worker_filter,omitempty"`
+	SessionMaxExtensionSeconds      uint32                 `json:"session_max_extension_seconds,omitempty"`
 	ApplicationCredentialLibraryIds []string               `json:"application_credential_library_ids,omitempty"`
 	ApplicationCredentialLibraries  []*CredentialLibrary   `json:"application_credential_libraries,omitempty"`
 	ApplicationCredentialSourceIds  []string               `json:"application_credential_source_ids,omitempty"`
@@ -73,8 +74,9 @@ func (n TargetDeleteResult) GetResponse() *api.Response {
 }
 
 type TargetListResult struct {
-	Items    []*Target
-	response *api.Response
+	Items     []*Target
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n TargetListResult) GetItems() interface{} {
@@ -339,6 +341,99 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Targ
 	return target, nil
 }
 
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, scopeId string, opt ...Option) (*TargetListResult, error) {
+	var items []*Target
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, scopeId, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &TargetListResult{Items: items, response: resp}, nil
+}
+
+// TargetListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type TargetListIterator struct {
+	ctx     context.Context
+	client  *Client
+	scopeId string
+	opt     []Option
+	items   []*Target
+	item    *Target
+	token   string
+	started bool
+	err     error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *TargetListIterator {
+	return &TargetListIterator{
+		ctx:     ctx,
+		client:  c,
+		scopeId: scopeId,
+		opt:     opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *TargetListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.scopeId, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *TargetListIterator) Item() *Target {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *TargetListIterator) Err() error {
+	return i.err
+}
+
 func (c *Client) AddCredentialLibraries(ctx context.Context, id string, version uint32, opt ...Option) (*TargetUpdateResult, error) {
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into AddCredentialLibraries request")
@@ -2,5 +2,7 @@
 package targets
 
 type TcpTargetAttributes struct {
-	DefaultPort uint32 `json:"default_port,omitempty"`
+	DefaultPort       uint32 `json:"default_port,omitempty"`
+	InjectionProtocol string `json:"injection_protocol,omitempty"`
+	SshHostKeys       string `json:"ssh_host_keys,omitempty"`
 }
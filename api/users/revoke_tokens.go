@@ -0,0 +1,70 @@
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// RevokedTokens is a user along with the ids of its revoked auth tokens and
+// of the sessions canceled because their auth token was revoked.
+type RevokedTokens struct {
+	Item               *User    `json:"item,omitempty"`
+	AuthTokenIds       []string `json:"auth_token_ids,omitempty"`
+	CanceledSessionIds []string `json:"canceled_session_ids,omitempty"`
+}
+
+type RevokeTokensResult struct {
+	Item     *RevokedTokens
+	response *api.Response
+}
+
+func (n RevokeTokensResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n RevokeTokensResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// RevokeTokens deletes all the auth tokens of the user and cancels the
+// pending or active sessions created with them. If accountId is not empty,
+// only the auth tokens issued for that account of the user are revoked.
+func (c *Client) RevokeTokens(ctx context.Context, userId, accountId string, opt ...Option) (*RevokeTokensResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into RevokeTokens request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RevokeTokens request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{}
+	if accountId != "" {
+		reqBody["account_id"] = accountId
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("users/%s:revoke-tokens", userId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeTokens request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeTokens call: %w", err)
+	}
+
+	target := new(RevokeTokensResult)
+	target.Item = new(RevokedTokens)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeTokens response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	// Enable ssh target support.
+	_ "github.com/hashicorp/boundary/internal/target/ssh"
 	// Enable tcp target support.
 	_ "github.com/hashicorp/boundary/internal/target/tcp"
 )
@@ -67,4 +67,25 @@ const (
 	DnsNamesField                        = "dns_names"
 	EgressCredentialSourceIdsField       = "egress_credential_source_ids"
 	EgressCredentialSourcesField         = "egress_credential_sources"
+	SessionIdField                       = "session_id"
+	ConnectionIdField                    = "connection_id"
+	WorkerIdField                        = "worker_id"
+	BytesField                           = "bytes"
+	StartTimeField                       = "start_time"
+	EndTimeField                         = "end_time"
+	AddressField                         = "address"
+	ConfigTagsField                      = "config_tags"
+	ApiTagsField                         = "api_tags"
+	CanonicalTagsField                   = "canonical_tags"
+	LastStatusTimeField                  = "last_status_time"
+	MaxExpirationTimeField               = "max_expiration_time"
+	SessionMaxExtensionSecondsField      = "session_max_extension_seconds"
+	ConnectionsField                     = "connections"
+	ReasonField                          = "reason"
+	DurationSecondsField                 = "duration_seconds"
+	ApproverIdField                      = "approver_id"
+	RoleIdField                          = "role_id"
+	ValidUntilField                      = "valid_until"
+	AuthTokenTimeToLiveField             = "auth_token_time_to_live"
+	AuthTokenTimeToStaleField            = "auth_token_time_to_stale"
 )
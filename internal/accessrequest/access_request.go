@@ -0,0 +1,159 @@
+package accessrequest
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultAccessRequestTableName = "access_request"
+
+	// MaxDurationSeconds is the longest time window which can be requested.
+	MaxDurationSeconds = 7 * 24 * 60 * 60
+)
+
+// AccessRequest is a request of a user for access to a target for a time
+// window of DurationSeconds, which starts when the request is approved.
+type AccessRequest struct {
+	// PublicId is used to access the access request via an API
+	PublicId string `json:"public_id,omitempty" gorm:"primary_key"`
+	// ScopeId of the target
+	ScopeId string `json:"scope_id,omitempty" gorm:"default:null"`
+	// TargetId the user is requesting access to
+	TargetId string `json:"target_id,omitempty" gorm:"default:null"`
+	// UserId of the user requesting access
+	UserId string `json:"user_id,omitempty" gorm:"default:null"`
+	// Reason is the justification given by the user
+	Reason string `json:"reason,omitempty" gorm:"default:null"`
+	// DurationSeconds is the length of the access window
+	DurationSeconds uint32 `json:"duration_seconds,omitempty" gorm:"default:null"`
+	// Status of the access request
+	Status string `json:"status,omitempty" gorm:"default:null"`
+	// ApproverId is the user who approved or denied the access request
+	ApproverId string `json:"approver_id,omitempty" gorm:"default:null"`
+	// RoleId is the role granting access to the target while the access
+	// request is approved
+	RoleId string `json:"role_id,omitempty" gorm:"default:null"`
+	// ValidUntil is the end of the access window of an approved request
+	ValidUntil *timestamp.Timestamp `json:"valid_until,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// UpdateTime from the RDBMS
+	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	// Version of the access request
+	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+
+	tableName string `gorm:"-"`
+}
+
+func (r *AccessRequest) GetPublicId() string {
+	return r.PublicId
+}
+
+var _ db.VetForWriter = (*AccessRequest)(nil)
+
+// NewAccessRequest creates a new in memory access request of userId for
+// access to targetId in scopeId for durationSeconds. WithReason is the only
+// supported option.
+func NewAccessRequest(scopeId, targetId, userId string, durationSeconds uint32, opt ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.NewAccessRequest"
+	opts := getOpts(opt...)
+	r := AccessRequest{
+		ScopeId:         scopeId,
+		TargetId:        targetId,
+		UserId:          userId,
+		DurationSeconds: durationSeconds,
+		Reason:          opts.withReason,
+	}
+	if err := r.validateNewAccessRequest(); err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
+	return &r, nil
+}
+
+// AllocAccessRequest will allocate an AccessRequest.
+func AllocAccessRequest() AccessRequest {
+	return AccessRequest{}
+}
+
+// Clone creates a clone of the AccessRequest.
+func (r *AccessRequest) Clone() interface{} {
+	clone := &AccessRequest{
+		PublicId:        r.PublicId,
+		ScopeId:         r.ScopeId,
+		TargetId:        r.TargetId,
+		UserId:          r.UserId,
+		Reason:          r.Reason,
+		DurationSeconds: r.DurationSeconds,
+		Status:          r.Status,
+		ApproverId:      r.ApproverId,
+		RoleId:          r.RoleId,
+		Version:         r.Version,
+	}
+	if r.ValidUntil != nil {
+		clone.ValidUntil = proto.Clone(r.ValidUntil).(*timestamp.Timestamp)
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = proto.Clone(r.CreateTime).(*timestamp.Timestamp)
+	}
+	if r.UpdateTime != nil {
+		clone.UpdateTime = proto.Clone(r.UpdateTime).(*timestamp.Timestamp)
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the access
+// request before it's written.
+func (r *AccessRequest) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "accessrequest.(AccessRequest).VetForWrite"
+	if r.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if opType == db.CreateOp {
+		if err := r.validateNewAccessRequest(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *AccessRequest) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultAccessRequestTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *AccessRequest) SetTableName(n string) {
+	r.tableName = n
+}
+
+// validateNewAccessRequest checks everything but the access request's
+// PublicId.
+func (r *AccessRequest) validateNewAccessRequest() error {
+	const op = "accessrequest.(AccessRequest).validateNewAccessRequest"
+	if r.ScopeId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing scope id")
+	}
+	if r.TargetId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing target id")
+	}
+	if r.UserId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
+	}
+	if r.DurationSeconds == 0 {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing duration")
+	}
+	if r.DurationSeconds > MaxDurationSeconds {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "duration is longer than the maximum duration")
+	}
+	return nil
+}
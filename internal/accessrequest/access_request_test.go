@@ -0,0 +1,102 @@
+package accessrequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccessRequest(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		scopeId         string
+		targetId        string
+		userId          string
+		durationSeconds uint32
+		opt             []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *AccessRequest
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			args: args{
+				scopeId:         "p_1234567890",
+				targetId:        "ttcp_1234567890",
+				userId:          "u_1234567890",
+				durationSeconds: 3600,
+				opt:             []Option{WithReason("incident 42")},
+			},
+			want: &AccessRequest{
+				ScopeId:         "p_1234567890",
+				TargetId:        "ttcp_1234567890",
+				UserId:          "u_1234567890",
+				DurationSeconds: 3600,
+				Reason:          "incident 42",
+			},
+		},
+		{
+			name: "missing-scope-id",
+			args: args{
+				targetId:        "ttcp_1234567890",
+				userId:          "u_1234567890",
+				durationSeconds: 3600,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing-target-id",
+			args: args{
+				scopeId:         "p_1234567890",
+				userId:          "u_1234567890",
+				durationSeconds: 3600,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing-user-id",
+			args: args{
+				scopeId:         "p_1234567890",
+				targetId:        "ttcp_1234567890",
+				durationSeconds: 3600,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing-duration",
+			args: args{
+				scopeId:  "p_1234567890",
+				targetId: "ttcp_1234567890",
+				userId:   "u_1234567890",
+			},
+			wantErr: true,
+		},
+		{
+			name: "duration-too-long",
+			args: args{
+				scopeId:         "p_1234567890",
+				targetId:        "ttcp_1234567890",
+				userId:          "u_1234567890",
+				durationSeconds: MaxDurationSeconds + 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAccessRequest(tt.args.scopeId, tt.args.targetId, tt.args.userId, tt.args.durationSeconds, tt.args.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
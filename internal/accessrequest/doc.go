@@ -0,0 +1,6 @@
+// Package accessrequest provides just-in-time access to targets. A user
+// requests access to a target for a bounded time window and a user in the
+// target's project approves or denies the request. Approving a request
+// creates a role granting the requesting user access to the target, which is
+// deleted once the window ends.
+package accessrequest
@@ -0,0 +1,30 @@
+package accessrequest
+
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+const (
+	// AccessRequestPrefix for access request PK ids
+	AccessRequestPrefix = "ar"
+)
+
+func newAccessRequestId() (string, error) {
+	const op = "accessrequest.newAccessRequestId"
+	id, err := db.NewPublicId(AccessRequestPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
+
+func newRoleId() (string, error) {
+	const op = "accessrequest.newRoleId"
+	id, err := db.NewPublicId(iam.RolePrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
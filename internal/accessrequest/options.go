@@ -0,0 +1,76 @@
+package accessrequest
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withOrderByCreateTime  db.OrderBy
+	withScopeIds           []string
+	withUserId             string
+	withReason             string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are returned. If
+// WithLimit == 0, then default limits are used for results.
+func WithLimit(limit int) Option {
+	return func(o *options) {
+		o.withLimit = limit
+	}
+}
+
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(orderBy db.OrderBy) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = orderBy
+	}
+}
+
+// WithScopeIds allows specifying a scope ID criteria for the function.
+func WithScopeIds(scopeIds []string) Option {
+	return func(o *options) {
+		o.withScopeIds = scopeIds
+	}
+}
+
+// WithUserId allows specifying a user ID criteria for the function.
+func WithUserId(userId string) Option {
+	return func(o *options) {
+		o.withUserId = userId
+	}
+}
+
+// WithReason provides an optional justification for an access request.
+func WithReason(reason string) Option {
+	return func(o *options) {
+		o.withReason = reason
+	}
+}
@@ -0,0 +1,48 @@
+package accessrequest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithLimit(-1))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = -1
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOrderByCreateTime", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithOrderByCreateTime(db.AscendingOrderBy))
+		testOpts := getDefaultOptions()
+		testOpts.withOrderByCreateTime = db.AscendingOrderBy
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithScopeIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScopeIds([]string{"o_1234", "p_1234"}))
+		testOpts := getDefaultOptions()
+		testOpts.withScopeIds = []string{"o_1234", "p_1234"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUserId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserId("u_1234"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserId = "u_1234"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithReason", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithReason("incident 42"))
+		testOpts := getDefaultOptions()
+		testOpts.withReason = "incident 42"
+		assert.Equal(opts, testOpts)
+	})
+}
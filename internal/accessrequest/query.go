@@ -0,0 +1,30 @@
+package accessrequest
+
+const (
+	approveAccessRequestQuery = `
+update access_request
+   set status      = 'approved',
+       approver_id = @approver_id,
+       role_id     = @role_id,
+       valid_until = now() + make_interval(secs => duration_seconds)
+ where public_id = @public_id
+   and version   = @version
+   and status    = 'pending';
+`
+
+	denyAccessRequestQuery = `
+update access_request
+   set status      = 'denied',
+       approver_id = @approver_id
+ where public_id = @public_id
+   and version   = @version
+   and status    = 'pending';
+`
+
+	expireAccessRequestQuery = `
+update access_request
+   set status = 'expired'
+ where public_id = @public_id
+   and status    = 'approved';
+`
+)
@@ -0,0 +1,366 @@
+package accessrequest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// CreateAccessRequest inserts into the repository a new pending access
+// request and returns it. No options are currently supported.
+func (r *Repository) CreateAccessRequest(ctx context.Context, newRequest *AccessRequest, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).CreateAccessRequest"
+	if newRequest == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request")
+	}
+	if newRequest.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id is not empty")
+	}
+	if err := newRequest.validateNewAccessRequest(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	id, err := newAccessRequestId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ar := newRequest.Clone().(*AccessRequest)
+	ar.PublicId = id
+	ar.Status = StatusPending.String()
+	ar.ApproverId = ""
+	ar.RoleId = ""
+	ar.ValidUntil = nil
+
+	var returnedRequest *AccessRequest
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedRequest = ar.Clone().(*AccessRequest)
+			if err := w.Create(ctx, returnedRequest); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for target %s", newRequest.TargetId)))
+	}
+	return returnedRequest, nil
+}
+
+// LookupAccessRequest will look up an access request in the repository. If
+// the access request is not found, it will return nil, nil. No options are
+// currently supported.
+func (r *Repository) LookupAccessRequest(ctx context.Context, requestId string, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).LookupAccessRequest"
+	if requestId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request id")
+	}
+	ar := AllocAccessRequest()
+	ar.PublicId = requestId
+	if err := r.reader.LookupById(ctx, &ar); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+	}
+	return &ar, nil
+}
+
+// ListAccessRequests will list access requests. Supports the WithLimit,
+// WithOrderByCreateTime, WithStartPageAfterItem, WithScopeIds and WithUserId
+// options.
+func (r *Repository) ListAccessRequests(ctx context.Context, opt ...Option) ([]*AccessRequest, error) {
+	const op = "accessrequest.(Repository).ListAccessRequests"
+	opts := getOpts(opt...)
+	if len(opts.withScopeIds) == 0 && opts.withUserId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids or user id")
+	}
+	var where string
+	var args []interface{}
+	switch {
+	case len(opts.withScopeIds) > 0 && opts.withUserId != "":
+		where, args = "scope_id in (?) and user_id = ?", []interface{}{opts.withScopeIds, opts.withUserId}
+	case len(opts.withScopeIds) > 0:
+		where, args = "scope_id in (?)", []interface{}{opts.withScopeIds}
+	default:
+		where, args = "user_id = ?", []interface{}{opts.withUserId}
+	}
+	var requests []*AccessRequest
+	if err := r.list(ctx, &requests, where, args, opt...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return requests, nil
+}
+
+// ApproveAccessRequest approves the pending access request on behalf of
+// approverId, who must not be the user who made the request. Approving the
+// request creates a role in the target's scope granting the requesting user
+// the read and authorize-session actions on the target until the access
+// window ends, and writes the role to the oplog. The role is deleted once the
+// access window ends by ExpireAccessRequests. No options are currently
+// supported.
+func (r *Repository) ApproveAccessRequest(ctx context.Context, requestId, approverId string, version uint32, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).ApproveAccessRequest"
+	if requestId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request id")
+	}
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+	if version == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	roleId, err := newRoleId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	ar := AllocAccessRequest()
+	ar.PublicId = requestId
+	if err := r.reader.LookupById(ctx, &ar); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			if err := read.LookupById(ctx, &ar); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+			}
+			if err := checkDecision(ctx, &ar, approverId, version, op); err != nil {
+				return err
+			}
+
+			role, err := iam.NewRole(ar.ScopeId,
+				iam.WithName(fmt.Sprintf("access request %s", ar.PublicId)),
+				iam.WithDescription(fmt.Sprintf("Access to target %s granted by access request %s", ar.TargetId, ar.PublicId)),
+				iam.WithGrantScopeId(ar.ScopeId))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			role.PublicId = roleId
+			roleTicket, err := w.GetTicket(role)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			msgs := make([]*oplog.Message, 0, 3)
+			var roleOplogMsg oplog.Message
+			if err := w.Create(ctx, role, db.NewOplogMsg(&roleOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create role"))
+			}
+			msgs = append(msgs, &roleOplogMsg)
+
+			// The role must exist before the request can reference it, and
+			// the request must be approved before the end of its access
+			// window, which bounds the principal, is known.
+			rowsUpdated, err := w.Exec(ctx, approveAccessRequestQuery, []interface{}{
+				sql.Named("approver_id", approverId),
+				sql.Named("role_id", roleId),
+				sql.Named("public_id", requestId),
+				sql.Named("version", version),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to approve access request"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d would have been updated", rowsUpdated))
+			}
+			if err := read.LookupById(ctx, &ar); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+			}
+
+			grant, err := iam.NewRoleGrant(roleId, fmt.Sprintf("id=%s;actions=read,authorize-session", ar.TargetId))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			var grantOplogMsg oplog.Message
+			if err := w.Create(ctx, grant, db.NewOplogMsg(&grantOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create role grant"))
+			}
+			msgs = append(msgs, &grantOplogMsg)
+			principal, err := iam.NewUserRole(roleId, ar.UserId, iam.WithValidUntil(ar.ValidUntil.AsTime()))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			var principalOplogMsg oplog.Message
+			if err := w.Create(ctx, principal, db.NewOplogMsg(&principalOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create role principal"))
+			}
+			msgs = append(msgs, &principalOplogMsg)
+
+			metadata := oplog.Metadata{
+				"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+				"scope-id":           []string{ar.ScopeId},
+				"scope-type":         []string{scopeType(ar.ScopeId)},
+				"resource-public-id": []string{roleId},
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &ar, nil
+}
+
+// DenyAccessRequest denies the pending access request on behalf of
+// approverId, who must not be the user who made the request. No options are
+// currently supported.
+func (r *Repository) DenyAccessRequest(ctx context.Context, requestId, approverId string, version uint32, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).DenyAccessRequest"
+	if requestId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request id")
+	}
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+	if version == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	ar := AllocAccessRequest()
+	ar.PublicId = requestId
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			if err := read.LookupById(ctx, &ar); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+			}
+			if err := checkDecision(ctx, &ar, approverId, version, op); err != nil {
+				return err
+			}
+			rowsUpdated, err := w.Exec(ctx, denyAccessRequestQuery, []interface{}{
+				sql.Named("approver_id", approverId),
+				sql.Named("public_id", requestId),
+				sql.Named("version", version),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to deny access request"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d would have been updated", rowsUpdated))
+			}
+			if err := read.LookupById(ctx, &ar); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", requestId)))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &ar, nil
+}
+
+// ExpireAccessRequests expires the approved access requests whose access
+// window has ended and deletes the roles created when they were approved,
+// writing their deletion to the oplog. It returns the expired access requests,
+// with the ids of their deleted roles.
+func (r *Repository) ExpireAccessRequests(ctx context.Context) ([]*AccessRequest, error) {
+	const op = "accessrequest.(Repository).ExpireAccessRequests"
+	var expired []*AccessRequest
+	oplogWrappers := make(map[string]wrapping.Wrapper)
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			expired = nil
+			var requests []*AccessRequest
+			if err := read.SearchWhere(ctx, &requests, "status = ? and valid_until <= current_timestamp", []interface{}{StatusApproved.String()}, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			for _, ar := range requests {
+				if ar.RoleId != "" {
+					oplogWrapper, ok := oplogWrappers[ar.ScopeId]
+					if !ok {
+						var err error
+						oplogWrapper, err = r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+						if err != nil {
+							return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+						}
+						oplogWrappers[ar.ScopeId] = oplogWrapper
+					}
+					role, err := iam.NewRole(ar.ScopeId)
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					role.PublicId = ar.RoleId
+					metadata := oplog.Metadata{
+						"op-type":            []string{oplog.OpType_OP_TYPE_DELETE.String()},
+						"scope-id":           []string{ar.ScopeId},
+						"scope-type":         []string{scopeType(ar.ScopeId)},
+						"resource-public-id": []string{ar.RoleId},
+					}
+					rowsDeleted, err := w.Delete(ctx, role, db.WithOplog(oplogWrapper, metadata))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete role %s", ar.RoleId)))
+					}
+					if rowsDeleted > 1 {
+						return errors.New(ctx, errors.MultipleRecords, op, "more than 1 role would have been deleted")
+					}
+				}
+				rowsUpdated, err := w.Exec(ctx, expireAccessRequestQuery, []interface{}{sql.Named("public_id", ar.PublicId)})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to expire access request %s", ar.PublicId)))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d would have been updated for %s", rowsUpdated, ar.PublicId))
+				}
+				ar.Status = StatusExpired.String()
+				expired = append(expired, ar)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return expired, nil
+}
+
+// scopeType returns the type of the scope with id scopeId, which is either an
+// org or a project.
+func scopeType(scopeId string) string {
+	if strings.HasPrefix(scopeId, scope.Project.Prefix()+"_") {
+		return scope.Project.String()
+	}
+	return scope.Org.String()
+}
+
+// checkDecision checks that the access request can be approved or denied by
+// approverId.
+func checkDecision(ctx context.Context, ar *AccessRequest, approverId string, version uint32, op errors.Op) error {
+	switch {
+	case ar.Version != version:
+		return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("access request version %d doesn't match %d", ar.Version, version))
+	case ar.Status != StatusPending.String():
+		return errors.New(ctx, errors.InvalidAccessRequestState, op, fmt.Sprintf("access request %s is %s", ar.PublicId, ar.Status))
+	case ar.UserId == approverId:
+		return errors.New(ctx, errors.Forbidden, op, "users can't approve or deny their own access requests")
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package accessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	user := iam.TestUser(t, iamRepo, org.PublicId)
+
+	tests := []struct {
+		name        string
+		request     func() *AccessRequest
+		wantIsError errors.Code
+	}{
+		{
+			name:        "nil-request",
+			request:     func() *AccessRequest { return nil },
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			request: func() *AccessRequest {
+				ar, err := NewAccessRequest(prj.PublicId, tar.PublicId, user.PublicId, 60)
+				require.NoError(t, err)
+				ar.PublicId = "ar_1234567890"
+				return ar
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "unknown-target",
+			request: func() *AccessRequest {
+				ar, err := NewAccessRequest(prj.PublicId, "ttcp_1234567890", user.PublicId, 60)
+				require.NoError(t, err)
+				return ar
+			},
+			wantIsError: errors.NotSpecificIntegrity,
+		},
+		{
+			name: "valid",
+			request: func() *AccessRequest {
+				ar, err := NewAccessRequest(prj.PublicId, tar.PublicId, user.PublicId, 60, WithReason("incident 42"))
+				require.NoError(t, err)
+				return ar
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateAccessRequest(context.Background(), tt.request())
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(StatusPending.String(), got.Status)
+			assert.Equal("incident 42", got.Reason)
+
+			found, err := repo.LookupAccessRequest(context.Background(), got.PublicId)
+			require.NoError(err)
+			assert.Equal(got.PublicId, found.PublicId)
+			assert.Equal(uint32(60), found.DurationSeconds)
+		})
+	}
+}
+
+func TestRepository_ListAccessRequests(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	alice := iam.TestUser(t, iamRepo, org.PublicId)
+	bob := iam.TestUser(t, iamRepo, org.PublicId)
+	TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, alice.PublicId)
+	TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, alice.PublicId)
+	TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, bob.PublicId)
+
+	tests := []struct {
+		name        string
+		opt         []Option
+		want        int
+		wantIsError errors.Code
+	}{
+		{
+			name:        "no-options",
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "scope",
+			opt:  []Option{WithScopeIds([]string{prj.PublicId})},
+			want: 3,
+		},
+		{
+			name: "user",
+			opt:  []Option{WithUserId(alice.PublicId)},
+			want: 2,
+		},
+		{
+			name: "scope-and-user",
+			opt:  []Option{WithScopeIds([]string{prj.PublicId}), WithUserId(bob.PublicId)},
+			want: 1,
+		},
+		{
+			name: "other-scope",
+			opt:  []Option{WithScopeIds([]string{org.PublicId})},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ListAccessRequests(context.Background(), tt.opt...)
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.Len(got, tt.want)
+		})
+	}
+}
+
+func TestRepository_ApproveAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	requester := iam.TestUser(t, iamRepo, org.PublicId)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+		got, err := repo.ApproveAccessRequest(context.Background(), ar.PublicId, approver.PublicId, ar.Version)
+		require.NoError(err)
+		assert.Equal(StatusApproved.String(), got.Status)
+		assert.Equal(approver.PublicId, got.ApproverId)
+		assert.NotEmpty(got.RoleId)
+		require.NotNil(got.ValidUntil)
+		assert.Greater(got.Version, ar.Version)
+
+		role, principals, grants, err := iamRepo.LookupRole(context.Background(), got.RoleId)
+		require.NoError(err)
+		assert.Equal(prj.PublicId, role.ScopeId)
+		require.Len(principals, 1)
+		assert.Equal(requester.PublicId, principals[0].PrincipalId)
+		require.NotNil(principals[0].GetValidUntil())
+		assert.True(got.ValidUntil.AsTime().Equal(principals[0].GetValidUntil().AsTime()))
+		require.Len(grants, 1)
+		assert.Equal("id="+tar.PublicId+";actions=read,authorize-session", grants[0].RawGrant)
+		assert.NoError(db.TestVerifyOplog(t, rw, got.RoleId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+		_, err = repo.ApproveAccessRequest(context.Background(), ar.PublicId, approver.PublicId, got.Version)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidAccessRequestState), err))
+	})
+	t.Run("own-request", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+		_, err := repo.ApproveAccessRequest(context.Background(), ar.PublicId, requester.PublicId, ar.Version)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("version-mismatch", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+		_, err := repo.ApproveAccessRequest(context.Background(), ar.PublicId, approver.PublicId, ar.Version+1)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.VersionMismatch), err))
+	})
+	t.Run("not-found", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.ApproveAccessRequest(context.Background(), "ar_1234567890", approver.PublicId, 1)
+		require.Error(err)
+		assert.True(errors.IsNotFoundError(err))
+	})
+}
+
+func TestRepository_DenyAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	requester := iam.TestUser(t, iamRepo, org.PublicId)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+
+	assert, require := assert.New(t), require.New(t)
+	ar := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+	_, err = repo.DenyAccessRequest(context.Background(), ar.PublicId, requester.PublicId, ar.Version)
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.Forbidden), err))
+
+	got, err := repo.DenyAccessRequest(context.Background(), ar.PublicId, approver.PublicId, ar.Version)
+	require.NoError(err)
+	assert.Equal(StatusDenied.String(), got.Status)
+	assert.Equal(approver.PublicId, got.ApproverId)
+	assert.Empty(got.RoleId)
+	assert.Nil(got.ValidUntil)
+
+	_, err = repo.ApproveAccessRequest(context.Background(), ar.PublicId, approver.PublicId, got.Version)
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidAccessRequestState), err))
+}
+
+func TestRepository_ExpireAccessRequests(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	requester := iam.TestUser(t, iamRepo, org.PublicId)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	ended := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+	ended, err = repo.ApproveAccessRequest(ctx, ended.PublicId, approver.PublicId, ended.Version)
+	require.NoError(err)
+	active := TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+	active, err = repo.ApproveAccessRequest(ctx, active.PublicId, approver.PublicId, active.Version)
+	require.NoError(err)
+
+	_, err = rw.Exec(ctx, "update access_request set valid_until = now() - interval '1 minute' where public_id = ?", []interface{}{ended.PublicId})
+	require.NoError(err)
+
+	expired, err := repo.ExpireAccessRequests(ctx)
+	require.NoError(err)
+	require.Len(expired, 1)
+	assert.Equal(ended.PublicId, expired[0].PublicId)
+	assert.Equal(ended.RoleId, expired[0].RoleId)
+	assert.Equal(StatusExpired.String(), expired[0].Status)
+
+	role, _, _, err := iamRepo.LookupRole(ctx, ended.RoleId)
+	require.NoError(err)
+	assert.Nil(role)
+	assert.NoError(db.TestVerifyOplog(t, rw, ended.RoleId, db.WithOperation(oplog.OpType_OP_TYPE_DELETE), db.WithCreateNotBefore(10*time.Second)))
+	role, _, _, err = iamRepo.LookupRole(ctx, active.RoleId)
+	require.NoError(err)
+	assert.NotNil(role)
+
+	got, err := repo.LookupAccessRequest(ctx, ended.PublicId)
+	require.NoError(err)
+	assert.Equal(StatusExpired.String(), got.Status)
+	assert.Empty(got.RoleId)
+
+	expired, err = repo.ExpireAccessRequests(ctx)
+	require.NoError(err)
+	assert.Empty(expired)
+}
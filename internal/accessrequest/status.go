@@ -0,0 +1,27 @@
+package accessrequest
+
+// Status of an access request
+type Status string
+
+const (
+	// StatusPending is the status of a new access request, which is waiting
+	// to be approved or denied.
+	StatusPending Status = "pending"
+
+	// StatusApproved is the status of an access request which was approved.
+	// The requesting user has access to the target until the request's
+	// ValidUntil time.
+	StatusApproved Status = "approved"
+
+	// StatusDenied is the status of an access request which was denied.
+	StatusDenied Status = "denied"
+
+	// StatusExpired is the status of an approved access request whose time
+	// window has ended. The access it granted has been revoked.
+	StatusExpired Status = "expired"
+)
+
+// String representation of the status
+func (s Status) String() string {
+	return string(s)
+}
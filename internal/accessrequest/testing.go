@@ -0,0 +1,26 @@
+package accessrequest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccessRequest creates a pending test access request of userId for
+// access to targetId in scopeId in the repository.
+func TestAccessRequest(t *testing.T, conn *db.DB, scopeId, targetId, userId string, opt ...Option) *AccessRequest {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ar, err := NewAccessRequest(scopeId, targetId, userId, 3600, opt...)
+	require.NoError(err)
+	id, err := newAccessRequestId()
+	require.NoError(err)
+	ar.PublicId = id
+	ar.Status = StatusPending.String()
+	err = rw.Create(context.Background(), ar)
+	require.NoError(err)
+	return ar
+}
@@ -4,10 +4,12 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/boundary/internal/gen/controller/api"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
@@ -17,9 +19,11 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessionrecordings"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -38,6 +42,7 @@ type fieldInfo struct {
 	Name              string
 	ProtoName         string
 	FieldType         string
+	JsonString        bool
 	GenerateSdkOption bool
 	SubtypeName       string
 	Query             bool
@@ -169,6 +174,36 @@ var inputStructs = []*structInfo{
 				FieldType: "bool",
 				Query:     true,
 			},
+			{
+				Name:        "ResourceId",
+				ProtoName:   "resource_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ResourceType",
+				ProtoName:   "resource_type",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PinId",
+				ProtoName:   "pin_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Action",
+				ProtoName:   "action",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ClientIp",
+				ProtoName:   "client_ip",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
 		},
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -267,6 +302,20 @@ var inputStructs = []*structInfo{
 				VarName:   "grantStrings",
 			},
 		},
+		extraFields: []fieldInfo{
+			{
+				Name:        "ValidFrom",
+				ProtoName:   "valid_from",
+				FieldType:   "time.Time",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ValidUntil",
+				ProtoName:   "valid_until",
+				FieldType:   "time.Time",
+				SkipDefault: true,
+			},
+		},
 		pluralResourceName:  "roles",
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -283,6 +332,11 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/oidc_auth_method_attributes.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.LdapAuthMethodAttributes{},
+		outFile:     "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName: "LdapAuthMethod",
+	},
 	{
 		inProto:     &authmethods.OidcAuthMethodAuthenticateStartResponse{},
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
@@ -316,6 +370,11 @@ var inputStructs = []*structInfo{
 		outFile:     "accounts/oidc_account_attributes.gen.go",
 		subtypeName: "OidcAccount",
 	},
+	{
+		inProto:     &accounts.LdapAccountAttributes{},
+		outFile:     "accounts/ldap_account_attributes.gen.go",
+		subtypeName: "LdapAccount",
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -344,6 +403,17 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:     &managedgroups.LdapManagedGroupAttributes{},
+		outFile:     "managedgroups/ldap_managed_group_attributes.gen.go",
+		subtypeName: "LdapManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Filter",
+				SkipDefault: true,
+			},
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -496,6 +566,64 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &credentials.UsernamePasswordAttributes{},
+		outFile:     "credentials/username_password_attributes.gen.go",
+		subtypeName: "UsernamePasswordCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Password",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto:     &credentials.SshPrivateKeyAttributes{},
+		outFile:     "credentials/ssh_private_key_attributes.gen.go",
+		subtypeName: "SshPrivateKeyCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PrivateKey",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto:     &credentials.JsonAttributes{},
+		outFile:     "credentials/json_attributes.gen.go",
+		subtypeName: "JsonCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Object",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto: &credentials.Credential{},
+		outFile: "credentials/credential.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			createTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "credentials",
+		parentTypeName:      "credential-store",
+		typeOnCreate:        true,
+		versionEnabled:      true,
+		createResponseTypes: true,
+	},
 	// Host related resources
 	{
 		inProto: &hostcatalogs.HostCatalog{},
@@ -617,6 +745,11 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/tcp_target_attributes.gen.go",
 		subtypeName: "TcpTarget",
 	},
+	{
+		inProto:     &targets.SshTargetAttributes{},
+		outFile:     "targets/ssh_target_attributes.gen.go",
+		subtypeName: "SshTarget",
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
@@ -689,6 +822,10 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.WorkerInfo{},
 		outFile: "sessions/workers.gen.go",
 	},
+	{
+		inProto: &sessions.Connection{},
+		outFile: "sessions/connection.gen.go",
+	},
 	{
 		inProto: &sessions.Session{},
 		outFile: "sessions/session.gen.go",
@@ -702,4 +839,49 @@ var inputStructs = []*structInfo{
 		fieldFilter:         []string{"private_key"},
 		recursiveListing:    true,
 	},
+	{
+		inProto: &sessionrecordings.SessionRecording{},
+		outFile: "sessionrecordings/session_recording.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "session-recordings",
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
+	{
+		inProto: &accessrequests.AccessRequest{},
+		outFile: "accessrequests/access_request.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "access-requests",
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
+	{
+		inProto: &workers.Worker{},
+		outFile: "workers/worker.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		sliceSubtypes: map[string]sliceSubtypeInfo{
+			"WorkerTags": {
+				SliceType: "map[string][]string",
+				VarName:   "apiTags",
+			},
+		},
+		pluralResourceName:  "workers",
+		versionEnabled:      true,
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
 }
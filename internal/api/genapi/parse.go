@@ -66,13 +66,20 @@ func parsePBs() {
 					name = fmt.Sprintf("%s.%s", pkg, name)
 				}
 				switch name {
-				case "v1.AuthorizedCollectionActionsEntry":
+				case "v1.AuthorizedCollectionActionsEntry",
+					"v1.ConfigTagsEntry",
+					"v1.ApiTagsEntry",
+					"v1.CanonicalTagsEntry":
 					fi.FieldType = "map[string][]string"
 				default:
 					fi.FieldType = sliceText + ptr + name
 				}
 			case protoreflect.BytesKind:
 				fi.FieldType = "[]byte"
+			case protoreflect.Int64Kind, protoreflect.Uint64Kind:
+				// 64-bit integers are encoded as JSON strings by the controller
+				fi.FieldType = sliceText + k.String()
+				fi.JsonString = sliceText == ""
 			default:
 				fi.FieldType = sliceText + k.String()
 			}
@@ -111,13 +111,15 @@ func fillTemplates() {
 		// We want to generate options per-package, not per-struct, so we
 		// collate them all here for writing later. The map argument of the
 		// package map is to prevent duplicates since we may have multiple e.g.
-		// Name or Description fields.
+		// Name or Description fields. Subtype fields are keyed by their
+		// subtype as well so that subtypes sharing a field name each get an
+		// option.
 		if !in.skipOptions {
 			pkgOptionMap := map[string]fieldInfo{}
 			for _, val := range input.Fields {
 				if val.GenerateSdkOption {
 					val.SubtypeName = in.subtypeName
-					pkgOptionMap[val.Name] = val
+					pkgOptionMap[in.subtypeName+val.Name] = val
 				}
 			}
 			optionMap := optionsMap[input.Package]
@@ -146,9 +148,12 @@ func fillTemplates() {
 				inOpts := optionsMap[input.Package]
 				if inOpts != nil {
 					if override.SkipDefault {
-						fieldInfo := inOpts[override.Name]
-						fieldInfo.SkipDefault = true
-						inOpts[override.Name] = fieldInfo
+						for k, fieldInfo := range inOpts {
+							if fieldInfo.Name == override.Name {
+								fieldInfo.SkipDefault = true
+								inOpts[k] = fieldInfo
+							}
+						}
 					}
 				}
 			}
@@ -173,16 +178,16 @@ func fillTemplates() {
 	for pkg, options := range optionsMap {
 		outBuf := new(bytes.Buffer)
 
-		var fieldNames []string
-		for _, v := range options {
-			fieldNames = append(fieldNames, v.Name)
-		}
-		sort.Strings(fieldNames)
-
 		var fields []fieldInfo
-		for _, v := range fieldNames {
-			fields = append(fields, options[v])
+		for _, v := range options {
+			fields = append(fields, v)
 		}
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].Name != fields[j].Name {
+				return fields[i].Name < fields[j].Name
+			}
+			return fields[i].SubtypeName < fields[j].SubtypeName
+		})
 
 		input := templateInput{
 			Package:          pkg,
@@ -256,6 +261,99 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 	target.response = resp
 	return target, nil
 }
+
+// ListAll performs List calls until all pages of the listing have been
+// fetched. If a list token is passed in via WithListToken, the listing
+// continues after it. The response of the returned result holds the items of
+// all pages.
+func (c *Client) ListAll(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) (*{{ .Name }}ListResult, error) {
+	var items []*{{ .Name }}
+	var resps []*api.Response
+	var token string
+	for {
+		pageOpt := opt
+		if token != "" {
+			pageOpt = append(opt[:len(opt):len(opt)], WithListToken(token))
+		}
+		page, err := c.List(ctx, {{ .CollectionFunctionArg }}, pageOpt...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		resps = append(resps, page.response)
+		if page.ListToken == "" {
+			break
+		}
+		token = page.ListToken
+	}
+
+	resp, err := api.MergeListResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("error merging List responses: %w", err)
+	}
+	return &{{ .Name }}ListResult{Items: items, response: resp}, nil
+}
+
+// {{ .Name }}ListIterator iterates over the items of a listing, fetching
+// pages as they are needed.
+type {{ .Name }}ListIterator struct {
+	ctx context.Context
+	client *Client
+	{{ .CollectionFunctionArg }} string
+	opt []Option
+	items []*{{ .Name }}
+	item *{{ .Name }}
+	token string
+	started bool
+	err error
+}
+
+// ListIterator returns an iterator over the items of a listing. The number of
+// items fetched per List call can be set using WithPageSize.
+func (c *Client) ListIterator(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) *{{ .Name }}ListIterator {
+	return &{{ .Name }}ListIterator{
+		ctx: ctx,
+		client: c,
+		{{ .CollectionFunctionArg }}: {{ .CollectionFunctionArg }},
+		opt: opt,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or when a List call
+// failed, in which case Err returns the error.
+func (i *{{ .Name }}ListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.started && i.token == "") {
+			i.item = nil
+			return false
+		}
+		opt := i.opt
+		if i.token != "" {
+			opt = append(opt[:len(opt):len(opt)], WithListToken(i.token))
+		}
+		page, err := i.client.List(i.ctx, i.{{ .CollectionFunctionArg }}, opt...)
+		i.started = true
+		if err != nil {
+			i.err = err
+			i.item = nil
+			return false
+		}
+		i.items, i.token = page.Items, page.ListToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *{{ .Name }}ListIterator) Item() *{{ .Name }} {
+	return i.item
+}
+
+// Err returns the error of the List call that stopped the iteration, if any.
+func (i *{{ .Name }}ListIterator) Err() error {
+	return i.err
+}
 `))
 
 var readTemplate = template.Must(template.New("").Parse(`
@@ -576,7 +674,7 @@ import (
 )
 
 type {{ .Name }} struct { {{ range .Fields }}
-{{ .Name }}  {{ .FieldType }} `, "`json:\"{{ .ProtoName }},omitempty\"`", `{{ end }}
+{{ .Name }}  {{ .FieldType }} `, "`json:\"{{ .ProtoName }},omitempty{{ if .JsonString }},string{{ end }}\"`", `{{ end }}
 {{ if .CreateResponseTypes }}
 	response *api.Response
 {{ else if ( eq .Name "Error" ) }}
@@ -616,6 +714,7 @@ func (n {{ .Name }}DeleteResult) GetResponse() *api.Response {
 
 type {{ .Name }}ListResult struct {
 	Items []*{{ .Name }}
+	ListToken string `, "`json:\"list_token,omitempty\"`", `
 	response *api.Response
 }
 
@@ -673,6 +772,8 @@ type options struct {
 	withAutomaticVersioning bool
 	withSkipCurlOutput bool
 	withFilter string
+	withPageSize uint32
+	withListToken string
 	{{ if .RecursiveListing }} withRecursive bool {{ end }}
 }
 
@@ -696,6 +797,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	}
 	if opts.withFilter != "" {
 		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
 	}{{ if .RecursiveListing }}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
@@ -729,6 +836,23 @@ func WithFilter(filter string) Option {
 		o.withFilter = strings.TrimSpace(filter)
 	}
 }
+
+// WithPageSize tells the API to return at most the given number of items from
+// a list call. If more items are available, the list result carries a token
+// that can be passed to WithListToken to fetch the next page.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithListToken tells the API to continue a list call after the last item
+// returned by the call that returned the token.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
 {{ if .RecursiveListing }}
 // WithRecursive tells the API to use recursion for listing operations on this
 // resource
@@ -0,0 +1,275 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_ldap_method"
+
+// AuthMethod contains an LDAP auth method configuration. It is owned by a
+// scope. AuthMethods can have Accounts, ManagedGroups, Urls and
+// Certificates.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+//
+// Urls are the LDAP URLs of the servers to connect to, in the order they are
+// tried. They must use the ldap or ldaps scheme and at least one is required.
+//
+// The user of an authentication is found with one of these, in order of
+// precedence:
+//
+// * with a search under the UserDn, using the UserFilter, when a bind
+// credential is set or DiscoverDn is set, in which case the search binds
+// anonymously.
+//
+// * with a bind as [login name]@[UpnDomain] when UpnDomain is set.
+//
+// * by binding as [UserAttr]=[login name],[UserDn].
+//
+// The groups of the user are found with a search under the GroupDn, using the
+// GroupFilter, when GroupDn is set. The values of the GroupAttr of the
+// entries found are the user's groups.
+//
+// Supports the options of WithName, WithDescription, WithStartTls,
+// WithInsecureTls, WithDiscoverDn, WithAnonGroupSearch, WithUpnDomain,
+// WithUserDn, WithUserAttr, WithUserFilter, WithGroupDn, WithGroupAttr,
+// WithGroupFilter, WithBindCredential and WithCertificates and all other
+// options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, urls []*url.URL, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.NewAuthMethod"
+	opts := getOpts(opt...)
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:         scopeId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			StartTls:        opts.withStartTls,
+			InsecureTls:     opts.withInsecureTls,
+			DiscoverDn:      opts.withDiscoverDn,
+			AnonGroupSearch: opts.withAnonGroupSearch,
+			UpnDomain:       opts.withUpnDomain,
+			UserDn:          opts.withUserDn,
+			UserAttr:        opts.withUserAttr,
+			UserFilter:      opts.withUserFilter,
+			GroupDn:         opts.withGroupDn,
+			GroupAttr:       opts.withGroupAttr,
+			GroupFilter:     opts.withGroupFilter,
+			BindDn:          opts.withBindDn,
+			BindPassword:    opts.withBindPassword,
+		},
+	}
+	if len(urls) > 0 {
+		a.Urls = make([]string, 0, len(urls))
+		for _, u := range urls {
+			if u == nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "missing url")
+			}
+			a.Urls = append(a.Urls, u.String())
+		}
+	}
+	if len(opts.withCertificates) > 0 {
+		pem, err := EncodeCertificates(ctx, opts.withCertificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.Certificates = pem
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod. On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if len(a.Urls) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing urls")
+	}
+	for _, u := range a.Urls {
+		if err := validateUrl(u); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("not a valid url: %s", u), errors.WithWrap(err))
+		}
+	}
+	if a.BindPassword != "" && a.BindDn == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "bind password requires a bind dn")
+	}
+	if a.UserFilter != "" {
+		if _, err := template.New("user_filter").Parse(a.UserFilter); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "not a valid user filter template", errors.WithWrap(err))
+		}
+	}
+	if a.GroupFilter != "" {
+		if _, err := template.New("group_filter").Parse(a.GroupFilter); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "not a valid group filter template", errors.WithWrap(err))
+		}
+	}
+	for _, c := range a.Certificates {
+		if _, err := ParseCertificates(ctx, c); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	return nil
+}
+
+// validateUrl returns an error when u isn't an ldap or ldaps url with a host.
+func validateUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "ldap", "ldaps":
+	default:
+		return fmt.Errorf("scheme %q is neither ldap nor ldaps", parsed.Scheme)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("missing host")
+	}
+	return nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
+
+// encrypt the bind password before writing the auth method to the db. Auth
+// methods without a bind password are left unchanged.
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).encrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if a.BindPassword == "" {
+		a.CtBindPassword = nil
+		a.BindPasswordHmac = ""
+		a.KeyId = ""
+		return nil
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	a.KeyId = cipher.KeyID()
+	if err := a.hmacBindPassword(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// decrypt the bind password of the auth method after reading it from the
+// db.
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).decrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if len(a.CtBindPassword) == 0 {
+		return nil
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// hmacBindPassword before writing it to the db
+func (a *AuthMethod) hmacBindPassword(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).hmacBindPassword"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, []byte(a.BindPassword), cipher, []byte(a.PublicId), nil, crypto.WithBase64Encoding())
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	a.BindPasswordHmac = hm
+	return nil
+}
+
+type convertedValues struct {
+	Urls  []interface{}
+	Certs []interface{}
+}
+
+// convertValueObjects converts the embedded value objects. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues, error) {
+	const op = "ldap.(AuthMethod).convertValueObjects"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	urls := make([]interface{}, 0, len(a.Urls))
+	for priority, u := range a.Urls {
+		obj, err := NewUrl(ctx, a.PublicId, uint32(priority+1), u)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		urls = append(urls, obj)
+	}
+	certs := make([]interface{}, 0, len(a.Certificates))
+	for _, c := range a.Certificates {
+		obj, err := NewCertificate(ctx, a.PublicId, c)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		certs = append(certs, obj)
+	}
+	return &convertedValues{
+		Urls:  urls,
+		Certs: certs,
+	}, nil
+}
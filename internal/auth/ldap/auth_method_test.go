@@ -0,0 +1,106 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		scopeId      string
+		urls         []string
+		opt          []Option
+		want         func(*AuthMethod)
+		wantErrMatch *errors.Template
+	}{
+		{
+			name:    "valid",
+			scopeId: "o_1234567890",
+			urls:    []string{"ldaps://ldap1.example.com", "ldap://ldap2.example.com:389"},
+			opt: []Option{
+				WithName("name"),
+				WithDescription("description"),
+				WithStartTls(),
+				WithUserDn("ou=people,dc=example,dc=com"),
+				WithUserAttr("uid"),
+				WithGroupDn("ou=groups,dc=example,dc=com"),
+				WithGroupFilter("(member={{.UserDN}})"),
+				WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+			},
+			want: func(am *AuthMethod) {
+				assert.Equal(t, "o_1234567890", am.ScopeId)
+				assert.Equal(t, []string{"ldaps://ldap1.example.com", "ldap://ldap2.example.com:389"}, am.Urls)
+				assert.Equal(t, "name", am.Name)
+				assert.Equal(t, "description", am.Description)
+				assert.True(t, am.StartTls)
+				assert.Equal(t, "uid", am.UserAttr)
+				assert.Equal(t, "(member={{.UserDN}})", am.GroupFilter)
+				assert.Equal(t, "cn=admin,dc=example,dc=com", am.BindDn)
+				assert.Equal(t, "admin-password", am.BindPassword)
+			},
+		},
+		{
+			name:         "missing-scope-id",
+			urls:         []string{"ldaps://ldap.example.com"},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "missing-urls",
+			scopeId:      "o_1234567890",
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "http-url",
+			scopeId:      "o_1234567890",
+			urls:         []string{"https://ldap.example.com"},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "url-without-host",
+			scopeId:      "o_1234567890",
+			urls:         []string{"ldap:///dc=example,dc=com"},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "bind-password-without-bind-dn",
+			scopeId:      "o_1234567890",
+			urls:         []string{"ldaps://ldap.example.com"},
+			opt:          []Option{WithBindCredential("", "admin-password")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-user-filter",
+			scopeId:      "o_1234567890",
+			urls:         []string{"ldaps://ldap.example.com"},
+			opt:          []Option{WithUserFilter("(uid={{.Username)")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-group-filter",
+			scopeId:      "o_1234567890",
+			urls:         []string{"ldaps://ldap.example.com"},
+			opt:          []Option{WithGroupFilter("(member={{.UserDN)")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tt.scopeId, TestConvertToUrls(t, tt.urls...), tt.opt...)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			tt.want(got)
+		})
+	}
+}
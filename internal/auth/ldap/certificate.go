@@ -0,0 +1,129 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultCertificateTableName defines the default table name for a certificate
+const defaultCertificateTableName = "auth_ldap_certificate"
+
+// Certificate defines a certificate to use as part of a trust root when
+// connecting to the auth method's LDAP servers. It is assigned to an LDAP
+// AuthMethod and updates/deletes to that AuthMethod are cascaded to its
+// Certificates. Certificates are value objects of an AuthMethod, therefore
+// there's no need for oplog metadata, since only the AuthMethod will have
+// metadata because it's the root aggregate.
+type Certificate struct {
+	*store.Certificate
+	tableName string
+}
+
+// NewCertificate creates a new in memory certificate assigned to an LDAP auth
+// method.
+func NewCertificate(ctx context.Context, authMethodId string, certificatePem string) (*Certificate, error) {
+	const op = "ldap.NewCertificate"
+	c := &Certificate{
+		Certificate: &store.Certificate{
+			LdapMethodId: authMethodId,
+			Cert:         certificatePem,
+		},
+	}
+	if err := c.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return c, nil
+}
+
+// validate the Certificate and on success return nil
+func (c *Certificate) validate(ctx context.Context, caller errors.Op) error {
+	if c.LdapMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing ldap auth method id")
+	}
+	if _, err := ParseCertificates(ctx, c.Cert); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// AllocCertificate makes an empty one in memory
+func AllocCertificate() Certificate {
+	return Certificate{
+		Certificate: &store.Certificate{},
+	}
+}
+
+// Clone a Certificate
+func (c *Certificate) Clone() *Certificate {
+	cp := proto.Clone(c.Certificate)
+	return &Certificate{
+		Certificate: cp.(*store.Certificate),
+	}
+}
+
+// TableName returns the table name.
+func (c *Certificate) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return defaultCertificateTableName
+}
+
+// SetTableName sets the table name.
+func (c *Certificate) SetTableName(n string) {
+	c.tableName = n
+}
+
+// EncodeCertificates will encode a number of x509 certificates to PEMs.
+func EncodeCertificates(ctx context.Context, certs ...*x509.Certificate) ([]string, error) {
+	const op = "ldap.EncodeCertificates"
+	if len(certs) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no certs provided")
+	}
+	pems := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		if cert == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "nil cert")
+		}
+		var buffer bytes.Buffer
+		err := pem.Encode(&buffer, &pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert.Raw,
+		})
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to encode cert: "+err.Error(), errors.WithWrap(err))
+		}
+		pems = append(pems, buffer.String())
+	}
+	return pems, nil
+}
+
+// ParseCertificates will parse a number of certificates PEMs to x509s.
+func ParseCertificates(ctx context.Context, pems ...string) ([]*x509.Certificate, error) {
+	const op = "ldap.ParseCertificates"
+	if len(pems) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no PEMs provided")
+	}
+	certs := make([]*x509.Certificate, 0, len(pems))
+	for _, p := range pems {
+		if p == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "empty certificate PEM")
+		}
+		block, _ := pem.Decode([]byte(p))
+		if block == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate PEM")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate: "+err.Error(), errors.WithWrap(err))
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
@@ -0,0 +1,348 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// DefaultUserAttr is the attribute matched with the login name when the
+	// auth method doesn't set one.
+	DefaultUserAttr = "cn"
+
+	// DefaultGroupAttr is the attribute of the group entries used as the
+	// group names when the auth method doesn't set one.
+	DefaultGroupAttr = "cn"
+
+	// DefaultUserFilter is the filter used to search for the user when the
+	// auth method doesn't set one.
+	DefaultUserFilter = "({{.UserAttr}}={{.Username}})"
+
+	// DefaultGroupFilter is the filter used to search for the groups of the
+	// user when the auth method doesn't set one. It matches the posix groups
+	// and the groups of names and unique names.
+	DefaultGroupFilter = "(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))"
+
+	// dialTimeout bounds the time spent connecting to each of the servers.
+	dialTimeout = 10 * time.Second
+)
+
+// authResult is the entry of the user authenticated by an ldap server along
+// with the names of its groups.
+type authResult struct {
+	dn       string
+	fullName string
+	email    string
+	groups   []string
+}
+
+// client authenticates users against the ldap servers of an auth method.
+type client struct {
+	am   *AuthMethod
+	conn *ldap.Conn
+}
+
+// newClient returns a client connected to the first of the auth method's
+// servers which accepts a connection. Its connection must be closed when it's
+// no longer needed.
+func newClient(ctx context.Context, am *AuthMethod) (*client, error) {
+	const op = "ldap.newClient"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if len(am.Urls) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls")
+	}
+	tlsConfig, err := am.tlsConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var errs []string
+	for _, u := range am.Urls {
+		conn, err := dial(u, am.StartTls, tlsConfig)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", u, err))
+			continue
+		}
+		return &client{am: am, conn: conn}, nil
+	}
+	return nil, errors.New(ctx, errors.Unavailable, op, fmt.Sprintf("unable to connect to any ldap server: %s", strings.Join(errs, "; ")))
+}
+
+// dial connects to the server at u. ldaps urls are connected to with TLS and
+// ldap urls are upgraded to TLS with StartTLS when startTls is set.
+func dial(u string, startTls bool, tlsConfig *tls.Config) (*ldap.Conn, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	cfg := tlsConfig.Clone()
+	cfg.ServerName = parsed.Hostname()
+
+	conn, err := ldap.DialURL(u, ldap.DialWithTLSDialer(cfg, &net.Dialer{Timeout: dialTimeout}))
+	if err != nil {
+		return nil, err
+	}
+	if startTls && strings.EqualFold(parsed.Scheme, "ldap") {
+		if err := conn.StartTLS(cfg); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// tlsConfig returns the TLS configuration of the connections to the auth
+// method's servers. The auth method's certificates, when it has some, are
+// trusted instead of the system's.
+func (am *AuthMethod) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	const op = "ldap.(AuthMethod).tlsConfig"
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: am.InsecureTls,
+	}
+	if len(am.Certificates) > 0 {
+		certs, err := ParseCertificates(ctx, am.Certificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		pool := x509.NewCertPool()
+		for _, c := range certs {
+			pool.AddCert(c)
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// close the client's connection.
+func (c *client) close() {
+	c.conn.Close()
+}
+
+// authenticate the user with loginName and password. It returns nil when the
+// user isn't found or the password doesn't match.
+func (c *client) authenticate(ctx context.Context, loginName, password string) (*authResult, error) {
+	const op = "ldap.(client).authenticate"
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	// An empty password would be an unauthenticated bind, which servers
+	// accept whatever the user.
+	if password == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+
+	dn, err := c.bindUser(ctx, loginName, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if dn == "" {
+		return nil, nil
+	}
+
+	res, err := c.conn.Search(ldap.NewSearchRequest(dn, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 0, 0, false,
+		"(objectClass=*)", []string{"displayName", "cn", "mail"}, nil))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to read the user entry", errors.WithWrap(err))
+	}
+	result := &authResult{dn: dn}
+	if len(res.Entries) == 1 {
+		e := res.Entries[0]
+		result.fullName = e.GetAttributeValue("displayName")
+		if result.fullName == "" {
+			result.fullName = e.GetAttributeValue("cn")
+		}
+		result.email = e.GetAttributeValue("mail")
+	}
+
+	if c.am.GroupDn != "" {
+		groups, err := c.groups(ctx, loginName, dn)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		result.groups = groups
+	}
+	return result, nil
+}
+
+// bindUser binds as the user with loginName and password and returns its dn.
+// It returns an empty dn when the user isn't found or the password doesn't
+// match.
+func (c *client) bindUser(ctx context.Context, loginName, password string) (string, error) {
+	const op = "ldap.(client).bindUser"
+	attr := c.am.UserAttr
+	if attr == "" {
+		attr = DefaultUserAttr
+	}
+	switch {
+	case c.am.BindDn != "" || c.am.DiscoverDn:
+		if err := c.serviceBind(); err != nil {
+			return "", errors.New(ctx, errors.Unknown, op, "unable to bind to search for the user", errors.WithWrap(err))
+		}
+		filter, err := renderFilter(c.am.UserFilter, DefaultUserFilter, map[string]string{
+			"UserAttr": attr,
+			"Username": ldap.EscapeFilter(loginName),
+		})
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		dn, err := c.searchUserDn(ctx, c.am.UserDn, filter)
+		if err != nil || dn == "" {
+			return "", err
+		}
+		if ok, err := c.bind(ctx, dn, password); err != nil || !ok {
+			return "", err
+		}
+		return dn, nil
+
+	case c.am.UpnDomain != "":
+		// Directories supporting user principal names rarely allow anonymous
+		// searches, so the user binds before searching for its own dn.
+		upn := fmt.Sprintf("%s@%s", loginName, c.am.UpnDomain)
+		if ok, err := c.bind(ctx, upn, password); err != nil || !ok {
+			return "", err
+		}
+		return c.searchUserDn(ctx, c.am.UserDn, fmt.Sprintf("(userPrincipalName=%s)", ldap.EscapeFilter(upn)))
+
+	default:
+		dn := fmt.Sprintf("%s=%s,%s", attr, escapeDnValue(loginName), c.am.UserDn)
+		if ok, err := c.bind(ctx, dn, password); err != nil || !ok {
+			return "", err
+		}
+		return dn, nil
+	}
+}
+
+// bind as username with password. It returns false when the credentials are
+// invalid.
+func (c *client) bind(ctx context.Context, username, password string) (bool, error) {
+	const op = "ldap.(client).bind"
+	if err := c.conn.Bind(username, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return false, nil
+		}
+		return false, errors.New(ctx, errors.Unknown, op, "unable to bind as the user", errors.WithWrap(err))
+	}
+	return true, nil
+}
+
+// searchUserDn returns the dn of the single entry matching filter under
+// baseDn, or an empty dn when none do.
+func (c *client) searchUserDn(ctx context.Context, baseDn, filter string) (string, error) {
+	const op = "ldap.(client).searchUserDn"
+	res, err := c.conn.Search(ldap.NewSearchRequest(baseDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		filter, []string{"dn"}, nil))
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded):
+		return "", errors.New(ctx, errors.NotSpecificIntegrity, op, "user search matched more than 1 entry")
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject):
+		return "", nil
+	case err != nil:
+		return "", errors.New(ctx, errors.Unknown, op, "unable to search for the user", errors.WithWrap(err))
+	}
+	switch len(res.Entries) {
+	case 0:
+		return "", nil
+	case 1:
+		return res.Entries[0].DN, nil
+	default:
+		return "", errors.New(ctx, errors.NotSpecificIntegrity, op, "user search matched more than 1 entry")
+	}
+}
+
+// groups returns the names of the groups of the user with loginName and dn.
+func (c *client) groups(ctx context.Context, loginName, dn string) ([]string, error) {
+	const op = "ldap.(client).groups"
+	switch {
+	case c.am.AnonGroupSearch:
+		if err := c.conn.UnauthenticatedBind(""); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind anonymously to search for groups", errors.WithWrap(err))
+		}
+	case c.am.BindDn != "":
+		if err := c.serviceBind(); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind to search for groups", errors.WithWrap(err))
+		}
+	}
+	filter, err := renderFilter(c.am.GroupFilter, DefaultGroupFilter, map[string]string{
+		"Username": ldap.EscapeFilter(loginName),
+		"UserDN":   ldap.EscapeFilter(dn),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	attr := c.am.GroupAttr
+	if attr == "" {
+		attr = DefaultGroupAttr
+	}
+	res, err := c.conn.Search(ldap.NewSearchRequest(c.am.GroupDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		filter, []string{attr}, nil))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to search for groups", errors.WithWrap(err))
+	}
+	groups := make([]string, 0, len(res.Entries))
+	for _, e := range res.Entries {
+		groups = append(groups, e.GetAttributeValues(attr)...)
+	}
+	return groups, nil
+}
+
+// serviceBind binds with the auth method's bind credential, or anonymously
+// when it has none.
+func (c *client) serviceBind() error {
+	if c.am.BindDn == "" {
+		return c.conn.UnauthenticatedBind("")
+	}
+	if c.am.BindPassword == "" {
+		return c.conn.UnauthenticatedBind(c.am.BindDn)
+	}
+	return c.conn.Bind(c.am.BindDn, c.am.BindPassword)
+}
+
+// renderFilter executes the filter template, or the defaultFilter when
+// filter is empty, with the data.
+func renderFilter(filter, defaultFilter string, data map[string]string) (string, error) {
+	if filter == "" {
+		filter = defaultFilter
+	}
+	t, err := template.New("filter").Option("missingkey=error").Parse(filter)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse filter template: %w", err)
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("unable to execute filter template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// escapeDnValue escapes the special characters of an attribute value of a
+// dn, as described in RFC 4514.
+func escapeDnValue(v string) string {
+	var b strings.Builder
+	for i, r := range v {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, r),
+			i == 0 && (r == ' ' || r == '#'),
+			i == len(v)-1 && r == ' ':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,310 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/ldap/testldap"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntries() []*testldap.Entry {
+	return []*testldap.Entry{
+		testldap.NewEntry("dc=example,dc=com", "objectClass", "domain"),
+		testldap.NewEntry("ou=people,dc=example,dc=com", "objectClass", "organizationalUnit"),
+		testldap.NewEntry("ou=groups,dc=example,dc=com", "objectClass", "organizationalUnit"),
+		testldap.NewEntry("cn=alice,ou=people,dc=example,dc=com",
+			"objectClass", "person",
+			"cn", "alice",
+			"uid", "alice",
+			"displayName", "Alice Doe",
+			"mail", "alice@example.com",
+			"userPrincipalName", "alice@example.com",
+			testldap.PasswordAttr, "alice-password"),
+		testldap.NewEntry("cn=bob,ou=people,dc=example,dc=com",
+			"objectClass", "person",
+			"cn", "bob",
+			"uid", "bob",
+			testldap.PasswordAttr, "bob-password"),
+		testldap.NewEntry("cn=admin,dc=example,dc=com",
+			"objectClass", "person",
+			"cn", "admin",
+			testldap.PasswordAttr, "admin-password"),
+		testldap.NewEntry("cn=admins,ou=groups,dc=example,dc=com",
+			"objectClass", "groupOfNames",
+			"cn", "admins",
+			"member", "cn=alice,ou=people,dc=example,dc=com"),
+		testldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com",
+			"objectClass", "posixGroup",
+			"cn", "developers",
+			"memberUid", "alice",
+			"memberUid", "bob"),
+	}
+}
+
+func TestClient_authenticate(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testldap.NewServer(t, testldap.WithEntries(testEntries()...))
+	anonSrv := testldap.NewServer(t, testldap.WithEntries(testEntries()...), testldap.WithAnonymousBind())
+	ldapsSrv := testldap.NewServer(t, testldap.WithEntries(testEntries()...), testldap.WithLdaps())
+
+	tests := []struct {
+		name         string
+		am           *store.AuthMethod
+		loginName    string
+		password     string
+		want         *authResult
+		wantErrMatch *errors.Template
+	}{
+		{
+			name:      "bind-with-user-attr",
+			am:        &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "ou=people,dc=example,dc=com"},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name:      "bad-password",
+			am:        &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "ou=people,dc=example,dc=com"},
+			loginName: "alice",
+			password:  "wrong",
+		},
+		{
+			name:      "unknown-user",
+			am:        &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "ou=people,dc=example,dc=com"},
+			loginName: "carol",
+			password:  "carol-password",
+		},
+		{
+			name:         "missing-password",
+			am:           &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "ou=people,dc=example,dc=com"},
+			loginName:    "alice",
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name: "search-with-bind-dn",
+			am: &store.AuthMethod{
+				Urls:         []string{srv.URL()},
+				UserDn:       "dc=example,dc=com",
+				UserAttr:     "uid",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want:      &authResult{dn: "cn=bob,ou=people,dc=example,dc=com", fullName: "bob"},
+		},
+		{
+			name: "search-with-user-filter",
+			am: &store.AuthMethod{
+				Urls:         []string{srv.URL()},
+				UserDn:       "dc=example,dc=com",
+				UserFilter:   "(&(objectClass=person)(mail={{.Username}}@example.com))",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name: "search-with-bad-bind-password",
+			am: &store.AuthMethod{
+				Urls:         []string{srv.URL()},
+				UserDn:       "dc=example,dc=com",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "wrong",
+			},
+			loginName:    "alice",
+			password:     "alice-password",
+			wantErrMatch: errors.T(errors.Unknown),
+		},
+		{
+			name:      "anonymous-search",
+			am:        &store.AuthMethod{Urls: []string{anonSrv.URL()}, UserDn: "dc=example,dc=com", DiscoverDn: true},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name:         "anonymous-search-not-allowed",
+			am:           &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "dc=example,dc=com", DiscoverDn: true},
+			loginName:    "alice",
+			password:     "alice-password",
+			wantErrMatch: errors.T(errors.Unknown),
+		},
+		{
+			name:         "ambiguous-search",
+			am:           &store.AuthMethod{Urls: []string{anonSrv.URL()}, UserDn: "dc=example,dc=com", DiscoverDn: true, UserFilter: "(objectClass=person)"},
+			loginName:    "alice",
+			password:     "alice-password",
+			wantErrMatch: errors.T(errors.NotSpecificIntegrity),
+		},
+		{
+			name:      "upn-domain",
+			am:        &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "dc=example,dc=com", UpnDomain: "example.com"},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name:      "upn-domain-bad-password",
+			am:        &store.AuthMethod{Urls: []string{srv.URL()}, UserDn: "dc=example,dc=com", UpnDomain: "example.com"},
+			loginName: "alice",
+			password:  "wrong",
+		},
+		{
+			name: "groups",
+			am: &store.AuthMethod{
+				Urls:    []string{srv.URL()},
+				UserDn:  "ou=people,dc=example,dc=com",
+				GroupDn: "ou=groups,dc=example,dc=com",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want: &authResult{
+				dn:       "cn=alice,ou=people,dc=example,dc=com",
+				fullName: "Alice Doe",
+				email:    "alice@example.com",
+				groups:   []string{"admins", "developers"},
+			},
+		},
+		{
+			name: "groups-with-filter",
+			am: &store.AuthMethod{
+				Urls:        []string{anonSrv.URL()},
+				UserDn:      "ou=people,dc=example,dc=com",
+				GroupDn:     "ou=groups,dc=example,dc=com",
+				GroupFilter: "(&(objectClass=groupOfNames)(member={{.UserDN}}))",
+				GroupAttr:   "cn",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want: &authResult{
+				dn:       "cn=alice,ou=people,dc=example,dc=com",
+				fullName: "Alice Doe",
+				email:    "alice@example.com",
+				groups:   []string{"admins"},
+			},
+		},
+		{
+			name: "anonymous-group-search",
+			am: &store.AuthMethod{
+				Urls:            []string{anonSrv.URL()},
+				UserDn:          "ou=people,dc=example,dc=com",
+				GroupDn:         "ou=groups,dc=example,dc=com",
+				AnonGroupSearch: true,
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want: &authResult{
+				dn:       "cn=bob,ou=people,dc=example,dc=com",
+				fullName: "bob",
+				groups:   []string{"developers"},
+			},
+		},
+		{
+			name: "start-tls",
+			am: &store.AuthMethod{
+				Urls:         []string{srv.URL()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				StartTls:     true,
+				Certificates: []string{srv.CACert()},
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name: "start-tls-untrusted",
+			am: &store.AuthMethod{
+				Urls:     []string{srv.URL()},
+				UserDn:   "ou=people,dc=example,dc=com",
+				StartTls: true,
+			},
+			loginName:    "alice",
+			password:     "alice-password",
+			wantErrMatch: errors.T(errors.Unavailable),
+		},
+		{
+			name: "ldaps",
+			am: &store.AuthMethod{
+				Urls:         []string{ldapsSrv.URL()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				Certificates: []string{ldapsSrv.CACert()},
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name: "ldaps-insecure-tls",
+			am: &store.AuthMethod{
+				Urls:        []string{ldapsSrv.URL()},
+				UserDn:      "ou=people,dc=example,dc=com",
+				InsecureTls: true,
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+		{
+			name: "ldaps-untrusted",
+			am: &store.AuthMethod{
+				Urls:   []string{ldapsSrv.URL()},
+				UserDn: "ou=people,dc=example,dc=com",
+			},
+			loginName:    "alice",
+			password:     "alice-password",
+			wantErrMatch: errors.T(errors.Unavailable),
+		},
+		{
+			name:      "fallback-url",
+			am:        &store.AuthMethod{Urls: []string{"ldap://127.0.0.1:1", srv.URL()}, UserDn: "ou=people,dc=example,dc=com"},
+			loginName: "alice",
+			password:  "alice-password",
+			want:      &authResult{dn: "cn=alice,ou=people,dc=example,dc=com", fullName: "Alice Doe", email: "alice@example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, err := newClient(ctx, &AuthMethod{AuthMethod: tt.am})
+			if err == nil {
+				defer c.close()
+				var got *authResult
+				got, err = c.authenticate(ctx, tt.loginName, tt.password)
+				if tt.wantErrMatch == nil {
+					require.NoError(err)
+					assert.Equal(tt.want, got)
+					return
+				}
+			}
+			require.Error(err)
+			assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+		})
+	}
+}
+
+func Test_escapeDnValue(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "alice", want: "alice"},
+		{in: "doe, john", want: `doe\, john`},
+		{in: "a+b=c", want: `a\+b\=c`},
+		{in: " #lead", want: `\ #lead`},
+		{in: "#hash", want: `\#hash`},
+		{in: "trail ", want: `trail\ `},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeDnValue(tt.in))
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package ldap
+
+import (
+	"crypto/x509"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName               string
+	withDescription        string
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withOrderByCreateTime  bool
+	ascending              bool
+	withPublicId           string
+	withStartTls           bool
+	withInsecureTls        bool
+	withDiscoverDn         bool
+	withAnonGroupSearch    bool
+	withUpnDomain          string
+	withUserDn             string
+	withUserAttr           string
+	withUserFilter         string
+	withGroupDn            string
+	withGroupAttr          string
+	withGroupFilter        string
+	withBindDn             string
+	withBindPassword       string
+	withCertificates       []*x509.Certificate
+	withFullName           string
+	withEmail              string
+	withDn                 string
+	withMemberOfGroups     []string
+	withReader             db.Reader
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(ascending bool) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = true
+		o.ascending = ascending
+	}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithStartTls optionally enables a StartTLS command after establishing an
+// unencrypted connection.
+func WithStartTls() Option {
+	return func(o *options) {
+		o.withStartTls = true
+	}
+}
+
+// WithInsecureTls optionally skips the verification of the LDAP server's
+// certificate. It should only be used for testing.
+func WithInsecureTls() Option {
+	return func(o *options) {
+		o.withInsecureTls = true
+	}
+}
+
+// WithDiscoverDn optionally uses an anonymous bind, or the bind credential
+// when one is set, to search for the DN of a user.
+func WithDiscoverDn() Option {
+	return func(o *options) {
+		o.withDiscoverDn = true
+	}
+}
+
+// WithAnonGroupSearch optionally uses an anonymous bind when searching for
+// the groups of a user.
+func WithAnonGroupSearch() Option {
+	return func(o *options) {
+		o.withAnonGroupSearch = true
+	}
+}
+
+// WithUpnDomain provides an optional userPrincipalName domain, which is used
+// to bind as [login name]@[domain].
+func WithUpnDomain(domain string) Option {
+	return func(o *options) {
+		o.withUpnDomain = domain
+	}
+}
+
+// WithUserDn provides an optional base DN of the user search.
+func WithUserDn(dn string) Option {
+	return func(o *options) {
+		o.withUserDn = dn
+	}
+}
+
+// WithUserAttr provides an optional attribute of the user entries which
+// matches the login name.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithUserFilter provides an optional go template of the user search filter.
+func WithUserFilter(filter string) Option {
+	return func(o *options) {
+		o.withUserFilter = filter
+	}
+}
+
+// WithGroupDn provides an optional base DN of the group search.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional attribute of the group entries whose
+// values are the names of the groups.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithGroupFilter provides an optional go template of the group search
+// filter.
+func WithGroupFilter(filter string) Option {
+	return func(o *options) {
+		o.withGroupFilter = filter
+	}
+}
+
+// WithBindCredential provides an optional DN and password used to bind when
+// searching for users and groups.
+func WithBindCredential(dn, password string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+		o.withBindPassword = password
+	}
+}
+
+// WithCertificates provides optional certificates used as trust anchors when
+// connecting to the LDAP servers.
+func WithCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withCertificates = certs
+	}
+}
+
+// WithFullName provides an optional full name.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithEmail provides an optional email address.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithDn provides an optional distinguished name.
+func WithDn(dn string) Option {
+	return func(o *options) {
+		o.withDn = dn
+	}
+}
+
+// WithMemberOfGroups provides optional groups an account is a member of.
+func WithMemberOfGroups(groups ...string) Option {
+	return func(o *options) {
+		o.withMemberOfGroups = groups
+	}
+}
+
+// WithReader provides an optional reader.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
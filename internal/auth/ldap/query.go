@@ -0,0 +1,17 @@
+package ldap
+
+const (
+	listAuthMethodBindPasswordsByKeyIdQuery = `
+select public_id, bind_password
+  from auth_ldap_method
+ where key_id = ?;
+`
+
+	rewrapAuthMethodBindPasswordQuery = `
+update auth_ldap_method
+   set bind_password      = ?,
+       bind_password_hmac = ?,
+       key_id             = ?
+ where public_id = ?;
+`
+)
@@ -0,0 +1,679 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	VersionField              = "Version"
+	NameField                 = "Name"
+	DescriptionField          = "Description"
+	FilterField               = "Filter"
+	StartTlsField             = "StartTls"
+	InsecureTlsField          = "InsecureTls"
+	DiscoverDnField           = "DiscoverDn"
+	AnonGroupSearchField      = "AnonGroupSearch"
+	UpnDomainField            = "UpnDomain"
+	UserDnField               = "UserDn"
+	UserAttrField             = "UserAttr"
+	UserFilterField           = "UserFilter"
+	GroupDnField              = "GroupDn"
+	GroupAttrField            = "GroupAttr"
+	GroupFilterField          = "GroupFilter"
+	BindDnField               = "BindDn"
+	BindPasswordField         = "BindPassword"
+	CtBindPasswordField       = "CtBindPassword"
+	BindPasswordHmacField     = "BindPasswordHmac"
+	KeyIdField                = "KeyId"
+	UrlsField                 = "Urls"
+	CertificatesField         = "Certificates"
+	AuthTokenTimeToLiveField  = "AuthTokenTimeToLive"
+	AuthTokenTimeToStaleField = "AuthTokenTimeToStale"
+)
+
+// CreateAuthMethod creates am (*AuthMethod) in the repo along with its
+// associated value objects of Urls and Certificates and returns the newly
+// created AuthMethod (with its PublicId set)
+//
+// The AuthMethod's public id and version must be empty (zero values).
+//
+// WithPublicId is the only supported option.
+func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).CreateAuthMethod"
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if am.Version != 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version must be empty")
+	}
+	if err := am.validate(ctx, op); err != nil {
+		return nil, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	am = am.Clone()
+	opts := getOpts(opt...)
+	am.PublicId = opts.withPublicId
+	if am.PublicId == "" {
+		id, err := newAuthMethodId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		am.PublicId = id
+	} else {
+		if !strings.HasPrefix(am.PublicId, AuthMethodPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "wrong auth method id prefix")
+		}
+	}
+
+	vo, err := am.convertValueObjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := am.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 1+len(vo.Urls)+len(vo.Certs))
+			ticket, err := w.GetTicket(am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			newAuthMethod := am.Clone()
+			var amOplogMsg oplog.Message
+			if err := w.Create(ctx, newAuthMethod, db.NewOplogMsg(&amOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &amOplogMsg)
+
+			urlOplogMsgs := make([]*oplog.Message, 0, len(vo.Urls))
+			if err := w.CreateItems(ctx, vo.Urls, db.NewOplogMsgs(&urlOplogMsgs)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add urls"))
+			}
+			msgs = append(msgs, urlOplogMsgs...)
+			if len(vo.Certs) > 0 {
+				certOplogMsgs := make([]*oplog.Message, 0, len(vo.Certs))
+				if err := w.CreateItems(ctx, vo.Certs, db.NewOplogMsgs(&certOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add certificates"))
+				}
+				msgs = append(msgs, certOplogMsgs...)
+			}
+
+			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			txRepo := &Repository{reader: reader, writer: w, kms: r.kms, defaultLimit: r.defaultLimit}
+			returnedAuthMethod, err = txRepo.lookupAuthMethod(ctx, am.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after create"))
+			}
+			if returnedAuthMethod == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after create")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in scope: %s: name %s already exists", am.ScopeId, am.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAuthMethod, nil
+}
+
+// LookupAuthMethod will lookup an auth method in the repo, along with its
+// associated value objects of Urls and Certificates. If it's not found, it
+// will return nil, nil. All options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).LookupAuthMethod"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	am, err := r.lookupAuthMethod(ctx, publicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return am, nil
+}
+
+// ListAuthMethods returns a slice of AuthMethods for the scopeIds. The
+// WithLimit, WithOrderByCreateTime and WithStartPageAfterItem options are
+// supported and all other options are ignored.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "ldap.(Repository).ListAuthMethods"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope IDs")
+	}
+	authMethods, err := r.getAuthMethods(ctx, "", scopeIds, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return authMethods, nil
+}
+
+// DeleteAuthMethod will delete the auth method from the repository. It is
+// idempotent so if the auth method was not found, return 0 (no rows affected)
+// and nil. No options are currently supported.
+func (r *Repository) DeleteAuthMethod(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "ldap.(Repository).DeleteAuthMethod"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	am, err := r.lookupAuthMethod(ctx, publicId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		// already deleted and this is not an error.
+		return db.NoRowsAffected, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	metadata := am.oplog(oplog.OpType_OP_TYPE_DELETE)
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			cp := am.Clone()
+			rowsDeleted, err = w.Delete(ctx, cp, db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return err
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete %s", publicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// UpdateAuthMethod will retrieve the auth method from the repository, and
+// update it based on the field masks provided.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should be
+// updated. Fields will be set to NULL if the field is a zero value and
+// included in fieldMask. Name, Description, StartTls, InsecureTls,
+// DiscoverDn, AnonGroupSearch, UpnDomain, UserDn, UserAttr, UserFilter,
+// GroupDn, GroupAttr, GroupFilter, BindDn, BindPassword, AuthTokenTimeToLive
+// and AuthTokenTimeToStale are all updatable fields. The AuthMethod's value objects of Urls and Certificates are also
+// updatable, each as a complete set. If no updatable fields are included in
+// the fieldMaskPaths, then an error is returned.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "ldap.(Repository).UpdateAuthMethod"
+	if am == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(StartTlsField, f):
+		case strings.EqualFold(InsecureTlsField, f):
+		case strings.EqualFold(DiscoverDnField, f):
+		case strings.EqualFold(AnonGroupSearchField, f):
+		case strings.EqualFold(UpnDomainField, f):
+		case strings.EqualFold(UserDnField, f):
+		case strings.EqualFold(UserAttrField, f):
+		case strings.EqualFold(UserFilterField, f):
+		case strings.EqualFold(GroupDnField, f):
+		case strings.EqualFold(GroupAttrField, f):
+		case strings.EqualFold(GroupFilterField, f):
+		case strings.EqualFold(BindDnField, f):
+		case strings.EqualFold(BindPasswordField, f):
+		case strings.EqualFold(UrlsField, f):
+		case strings.EqualFold(CertificatesField, f):
+		case strings.EqualFold(AuthTokenTimeToLiveField, f):
+		case strings.EqualFold(AuthTokenTimeToStaleField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	dbMask, nullFields := dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			NameField:                 am.Name,
+			DescriptionField:          am.Description,
+			StartTlsField:             am.StartTls,
+			InsecureTlsField:          am.InsecureTls,
+			DiscoverDnField:           am.DiscoverDn,
+			AnonGroupSearchField:      am.AnonGroupSearch,
+			UpnDomainField:            am.UpnDomain,
+			UserDnField:               am.UserDn,
+			UserAttrField:             am.UserAttr,
+			UserFilterField:           am.UserFilter,
+			GroupDnField:              am.GroupDn,
+			GroupAttrField:            am.GroupAttr,
+			GroupFilterField:          am.GroupFilter,
+			BindDnField:               am.BindDn,
+			BindPasswordField:         am.BindPassword,
+			UrlsField:                 am.Urls,
+			CertificatesField:         am.Certificates,
+			AuthTokenTimeToLiveField:  am.AuthTokenTimeToLive,
+			AuthTokenTimeToStaleField: am.AuthTokenTimeToStale,
+		},
+		fieldMaskPaths,
+		[]string{StartTlsField, InsecureTlsField, DiscoverDnField, AnonGroupSearchField},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	origAm, err := r.lookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	updated := applyUpdate(am, origAm, fieldMaskPaths)
+	if err := updated.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	var addUrls, deleteUrls, addCerts, deleteCerts []interface{}
+	var filteredDbMask, filteredNullFields []string
+	for _, f := range dbMask {
+		switch f {
+		case UrlsField, CertificatesField:
+		default:
+			filteredDbMask = append(filteredDbMask, f)
+		}
+	}
+	for _, f := range nullFields {
+		switch f {
+		case UrlsField, CertificatesField:
+		default:
+			filteredNullFields = append(filteredNullFields, f)
+		}
+	}
+	if strutil.StrListContains(dbMask, UrlsField) || strutil.StrListContains(nullFields, UrlsField) {
+		// the urls are ordered, so they're always replaced as a complete set.
+		origVo, err := origAm.convertValueObjects(ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		vo, err := updated.convertValueObjects(ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		deleteUrls, addUrls = origVo.Urls, vo.Urls
+	}
+	if strutil.StrListContains(dbMask, CertificatesField) || strutil.StrListContains(nullFields, CertificatesField) {
+		origVo, err := origAm.convertValueObjects(ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		vo, err := updated.convertValueObjects(ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		deleteCerts, addCerts = origVo.Certs, vo.Certs
+	}
+
+	// BindPassword uses the struct wrapping, so the encrypted fields need to
+	// be added to the dbMask or nullFields
+	if strutil.StrListContains(filteredDbMask, BindPasswordField) || strutil.StrListContains(filteredNullFields, BindPasswordField) {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := am.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		switch am.BindPassword {
+		case "":
+			filteredNullFields = append(filteredNullFields, CtBindPasswordField, BindPasswordHmacField, KeyIdField)
+		default:
+			filteredDbMask = append(filteredDbMask, CtBindPasswordField, BindPasswordHmacField, KeyIdField)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var updatedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 1+len(deleteUrls)+len(addUrls)+len(deleteCerts)+len(addCerts))
+			ticket, err := w.GetTicket(am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			var authMethodOplogMsg oplog.Message
+			updatedAm = am.Clone()
+			switch {
+			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
+				// the auth method's fields are not being updated, just its
+				// value objects, so we need to just update the auth method's
+				// version.
+				updatedAm.Version = version + 1
+				rowsUpdated, err = w.Update(ctx, updatedAm, []string{VersionField}, nil, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+			default:
+				rowsUpdated, err = w.Update(ctx, updatedAm, filteredDbMask, filteredNullFields, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+			}
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &authMethodOplogMsg)
+
+			for _, vo := range []struct {
+				name          string
+				adds, deletes []interface{}
+			}{
+				{name: "urls", adds: addUrls, deletes: deleteUrls},
+				{name: "certificates", adds: addCerts, deletes: deleteCerts},
+			} {
+				if len(vo.deletes) > 0 {
+					deleteOplogMsgs := make([]*oplog.Message, 0, len(vo.deletes))
+					rowsDeleted, err := w.DeleteItems(ctx, vo.deletes, db.NewOplogMsgs(&deleteOplogMsgs))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete %s", vo.name)))
+					}
+					if rowsDeleted != len(vo.deletes) {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%s deleted %d did not match request for %d", vo.name, rowsDeleted, len(vo.deletes)))
+					}
+					msgs = append(msgs, deleteOplogMsgs...)
+				}
+				if len(vo.adds) > 0 {
+					addOplogMsgs := make([]*oplog.Message, 0, len(vo.adds))
+					if err := w.CreateItems(ctx, vo.adds, db.NewOplogMsgs(&addOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to add %s", vo.name)))
+					}
+					msgs = append(msgs, addOplogMsgs...)
+				}
+			}
+
+			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{reader: reader, writer: w, kms: r.kms}
+			updatedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if updatedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in %s: name %s already exists", am.PublicId, am.Name))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return updatedAm, rowsUpdated, nil
+}
+
+// applyUpdate takes the new and applies it to the orig using the field
+// masks.
+func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
+	cp := orig.Clone()
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+			cp.Name = new.Name
+		case strings.EqualFold(DescriptionField, f):
+			cp.Description = new.Description
+		case strings.EqualFold(StartTlsField, f):
+			cp.StartTls = new.StartTls
+		case strings.EqualFold(InsecureTlsField, f):
+			cp.InsecureTls = new.InsecureTls
+		case strings.EqualFold(DiscoverDnField, f):
+			cp.DiscoverDn = new.DiscoverDn
+		case strings.EqualFold(AnonGroupSearchField, f):
+			cp.AnonGroupSearch = new.AnonGroupSearch
+		case strings.EqualFold(UpnDomainField, f):
+			cp.UpnDomain = new.UpnDomain
+		case strings.EqualFold(UserDnField, f):
+			cp.UserDn = new.UserDn
+		case strings.EqualFold(UserAttrField, f):
+			cp.UserAttr = new.UserAttr
+		case strings.EqualFold(UserFilterField, f):
+			cp.UserFilter = new.UserFilter
+		case strings.EqualFold(GroupDnField, f):
+			cp.GroupDn = new.GroupDn
+		case strings.EqualFold(GroupAttrField, f):
+			cp.GroupAttr = new.GroupAttr
+		case strings.EqualFold(GroupFilterField, f):
+			cp.GroupFilter = new.GroupFilter
+		case strings.EqualFold(BindDnField, f):
+			cp.BindDn = new.BindDn
+		case strings.EqualFold(BindPasswordField, f):
+			cp.BindPassword = new.BindPassword
+		case strings.EqualFold(UrlsField, f):
+			cp.Urls = append([]string(nil), new.Urls...)
+		case strings.EqualFold(CertificatesField, f):
+			cp.Certificates = append([]string(nil), new.Certificates...)
+		case strings.EqualFold(AuthTokenTimeToLiveField, f):
+			cp.AuthTokenTimeToLive = new.AuthTokenTimeToLive
+		case strings.EqualFold(AuthTokenTimeToStaleField, f):
+			cp.AuthTokenTimeToStale = new.AuthTokenTimeToStale
+		}
+	}
+	return cp
+}
+
+// lookupAuthMethod will lookup a single auth method
+func (r *Repository) lookupAuthMethod(ctx context.Context, authMethodId string, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).lookupAuthMethod"
+	ams, err := r.getAuthMethods(ctx, authMethodId, nil, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case len(ams) == 0:
+		return nil, nil // not an error to return no rows for a "lookup"
+	case len(ams) > 1:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%s matched more than 1 ", authMethodId))
+	default:
+		return ams[0], nil
+	}
+}
+
+// getAuthMethods allows the caller to either lookup a specific AuthMethod via
+// its id or search for a set AuthMethods within a set of scopes. Passing both
+// scopeIds and an authMethodId is an error. The WithLimit and
+// WithOrderByCreateTime options are supported and all other options are
+// ignored.
+//
+// The AuthMethods returned have their value objects (Urls and Certificates),
+// their decrypted BindPassword and their IsPrimaryAuthMethod bool set.
+//
+// When no record is found it returns nil, nil
+func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "ldap.(Repository).getAuthMethods"
+	if authMethodId == "" && len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing search criteria: both auth method id and scope IDs are empty")
+	}
+	if authMethodId != "" && len(scopeIds) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "searching for both an auth method id and scope IDs is not supported")
+	}
+
+	const aggregateDelimiter = "|"
+
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	dbArgs := []db.Option{db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem)}
+	if opts.withOrderByCreateTime {
+		if opts.ascending {
+			dbArgs = append(dbArgs, db.WithOrder("create_time asc"))
+		} else {
+			dbArgs = append(dbArgs, db.WithOrder("create_time"))
+		}
+	}
+
+	var where string
+	var args []interface{}
+	switch {
+	case authMethodId != "":
+		where, args = "public_id = ?", append(args, authMethodId)
+	default:
+		where, args = "scope_id in(?)", append(args, scopeIds)
+	}
+
+	var aggAuthMethods []*authMethodAgg
+	if err := r.reader.SearchWhere(ctx, &aggAuthMethods, where, args, dbArgs...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(aggAuthMethods) == 0 { // we're done if nothing is found.
+		return nil, nil
+	}
+
+	authMethods := make([]*AuthMethod, 0, len(aggAuthMethods))
+	for _, agg := range aggAuthMethods {
+		if len(agg.CtBindPassword) > 0 {
+			databaseWrapper, err := r.kms.GetWrapper(ctx, agg.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(agg.KeyId))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+			}
+			if err := structwrapping.UnwrapStruct(ctx, databaseWrapper, agg, nil); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+			}
+		}
+		am := AllocAuthMethod()
+		am.PublicId = agg.PublicId
+		am.ScopeId = agg.ScopeId
+		am.IsPrimaryAuthMethod = agg.IsPrimaryAuthMethod
+		am.Name = agg.Name
+		am.Description = agg.Description
+		am.CreateTime = agg.CreateTime
+		am.UpdateTime = agg.UpdateTime
+		am.Version = agg.Version
+		am.StartTls = agg.StartTls
+		am.InsecureTls = agg.InsecureTls
+		am.DiscoverDn = agg.DiscoverDn
+		am.AnonGroupSearch = agg.AnonGroupSearch
+		am.UpnDomain = agg.UpnDomain
+		am.UserDn = agg.UserDn
+		am.UserAttr = agg.UserAttr
+		am.UserFilter = agg.UserFilter
+		am.GroupDn = agg.GroupDn
+		am.GroupAttr = agg.GroupAttr
+		am.GroupFilter = agg.GroupFilter
+		am.BindDn = agg.BindDn
+		am.CtBindPassword = agg.CtBindPassword
+		am.BindPassword = agg.BindPassword
+		am.BindPasswordHmac = agg.BindPasswordHmac
+		am.KeyId = agg.KeyId
+		am.AuthTokenTimeToLive = agg.AuthTokenTimeToLive
+		am.AuthTokenTimeToStale = agg.AuthTokenTimeToStale
+		if agg.Urls != "" {
+			am.Urls = strings.Split(agg.Urls, aggregateDelimiter)
+		}
+		if agg.Certs != "" {
+			am.Certificates = strings.Split(agg.Certs, aggregateDelimiter)
+		}
+		authMethods = append(authMethods, &am)
+	}
+	return authMethods, nil
+}
+
+// authMethodAgg is a view that aggregates the auth method's value objects in to
+// string fields delimited with the aggregateDelimiter of "|"
+type authMethodAgg struct {
+	PublicId             string `gorm:"primary_key"`
+	ScopeId              string
+	IsPrimaryAuthMethod  bool
+	Name                 string
+	Description          string
+	CreateTime           *timestamp.Timestamp
+	UpdateTime           *timestamp.Timestamp
+	Version              uint32
+	StartTls             bool
+	InsecureTls          bool
+	DiscoverDn           bool
+	AnonGroupSearch      bool
+	UpnDomain            string
+	UserDn               string
+	UserAttr             string
+	UserFilter           string
+	GroupDn              string
+	GroupAttr            string
+	GroupFilter          string
+	BindDn               string
+	CtBindPassword       []byte `gorm:"column:bind_password" wrapping:"ct,bind_password"`
+	BindPassword         string `gorm:"-" wrapping:"pt,bind_password"`
+	BindPasswordHmac     string
+	KeyId                string
+	AuthTokenTimeToLive  uint32
+	AuthTokenTimeToStale uint32
+	Urls                 string
+	Certs                string
+}
+
+// TableName returns the table name for gorm
+func (agg *authMethodAgg) TableName() string { return "ldap_auth_method_with_value_obj" }
@@ -0,0 +1,273 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		am           func(*testing.T) *AuthMethod
+		wantErrMatch *errors.Template
+	}{
+		{
+			name: "valid",
+			am: func(t *testing.T) *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, TestConvertToUrls(t, "ldaps://ldap1.example.com", "ldap://ldap2.example.com"),
+					WithName("valid"),
+					WithStartTls(),
+					WithUserDn("ou=people,dc=example,dc=com"),
+					WithGroupDn("ou=groups,dc=example,dc=com"),
+					WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+				)
+				require.NoError(t, err)
+				return am
+			},
+		},
+		{
+			name: "valid-without-bind-credential",
+			am: func(t *testing.T) *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, TestConvertToUrls(t, "ldaps://ldap.example.com"), WithDiscoverDn())
+				require.NoError(t, err)
+				return am
+			},
+		},
+		{
+			name: "public-id-set",
+			am: func(t *testing.T) *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, TestConvertToUrls(t, "ldaps://ldap.example.com"))
+				require.NoError(t, err)
+				am.PublicId = "amldap_1234567890"
+				return am
+			},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name: "missing-urls",
+			am: func(t *testing.T) *AuthMethod {
+				am := AllocAuthMethod()
+				am.ScopeId = org.PublicId
+				return &am
+			},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			am := tt.am(t)
+			got, err := repo.CreateAuthMethod(ctx, am)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(am.Urls, got.Urls)
+			assert.Equal(am.BindPassword, got.BindPassword)
+			if am.BindPassword != "" {
+				assert.NotEmpty(got.CtBindPassword)
+				assert.NotEmpty(got.BindPasswordHmac)
+				assert.NotEmpty(got.KeyId)
+			}
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE)))
+
+			found, err := repo.LookupAuthMethod(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(got, found)
+		})
+	}
+}
+
+func TestRepository_ListAuthMethods(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org1, _ := iam.TestScopes(t, iamRepo)
+	org2, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	for _, org := range []*iam.Scope{org1, org1, org2} {
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+		require.NoError(t, err)
+		TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"})
+	}
+
+	got, err := repo.ListAuthMethods(ctx, []string{org1.PublicId})
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	got, err = repo.ListAuthMethods(ctx, []string{org1.PublicId, org2.PublicId})
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+
+	got, err = repo.ListAuthMethods(ctx, []string{org1.PublicId, org2.PublicId}, WithLimit(1))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = repo.ListAuthMethods(ctx, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %s", err)
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		update       func(*AuthMethod) []string
+		check        func(*testing.T, *AuthMethod)
+		wantErrMatch *errors.Template
+	}{
+		{
+			name: "name-and-description",
+			update: func(am *AuthMethod) []string {
+				am.Name, am.Description = "new name", "new description"
+				return []string{NameField, DescriptionField}
+			},
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.Equal(t, "new name", got.Name)
+				assert.Equal(t, "new description", got.Description)
+			},
+		},
+		{
+			name: "urls",
+			update: func(am *AuthMethod) []string {
+				am.Urls = []string{"ldap://ldap2.example.com", "ldaps://ldap1.example.com"}
+				return []string{UrlsField}
+			},
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.Equal(t, []string{"ldap://ldap2.example.com", "ldaps://ldap1.example.com"}, got.Urls)
+			},
+		},
+		{
+			name: "clear-urls",
+			update: func(am *AuthMethod) []string {
+				am.Urls = nil
+				return []string{UrlsField}
+			},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name: "flags",
+			update: func(am *AuthMethod) []string {
+				am.StartTls, am.InsecureTls, am.DiscoverDn, am.AnonGroupSearch = true, true, true, true
+				return []string{StartTlsField, InsecureTlsField, DiscoverDnField, AnonGroupSearchField}
+			},
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.True(t, got.StartTls)
+				assert.True(t, got.InsecureTls)
+				assert.True(t, got.DiscoverDn)
+				assert.True(t, got.AnonGroupSearch)
+			},
+		},
+		{
+			name: "bind-credential",
+			update: func(am *AuthMethod) []string {
+				am.BindDn, am.BindPassword = "cn=admin,dc=example,dc=com", "admin-password"
+				return []string{BindDnField, BindPasswordField}
+			},
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.Equal(t, "cn=admin,dc=example,dc=com", got.BindDn)
+				assert.Equal(t, "admin-password", got.BindPassword)
+				assert.NotEmpty(t, got.BindPasswordHmac)
+				assert.NotEmpty(t, got.KeyId)
+			},
+		},
+		{
+			name: "bind-password-without-bind-dn",
+			update: func(am *AuthMethod) []string {
+				am.BindPassword = "admin-password"
+				return []string{BindPasswordField}
+			},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name: "invalid-field",
+			update: func(am *AuthMethod) []string {
+				return []string{"CreateTime"}
+			},
+			wantErrMatch: errors.T(errors.InvalidFieldMask),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			orig := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1.example.com"})
+			am := orig.Clone()
+			mask := tt.update(am)
+			got, rowsUpdated, err := repo.UpdateAuthMethod(ctx, am, orig.Version, mask)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(1, rowsUpdated)
+			assert.Equal(orig.Version+1, got.Version)
+			tt.check(t, got)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE)))
+		})
+	}
+}
+
+func TestRepository_DeleteAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"})
+	deleted, err := repo.DeleteAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	deleted, err = repo.DeleteAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	found, err := repo.LookupAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,190 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// Authenticate authenticates loginName and password against the ldap servers
+// of the auth method with authMethodId and returns the account of the user.
+// It returns nil, nil when the user isn't found or the password doesn't
+// match.
+//
+// The account of the user is created on its first authentication and its
+// full name, email, dn and groups are updated with the ones of the user's
+// entry on each authentication. The account is then made a member of the
+// auth method's managed groups whose filter matches the account, and removed
+// from the others.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName, password string) (*Account, error) {
+	const op = "ldap.(Repository).Authenticate"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id", errors.WithoutEvent())
+	}
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name", errors.WithoutEvent())
+	}
+	if password == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password", errors.WithoutEvent())
+	}
+	loginName = strings.ToLower(strings.TrimSpace(loginName))
+
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+
+	c, err := newClient(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer c.close()
+	result, err := c.authenticate(ctx, loginName, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	acct, err := r.upsertAccount(ctx, am, loginName, result)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Get the set of all managed groups so we can filter
+	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId(), WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		evalData := map[string]interface{}{
+			"groups": result.groups,
+			"account": map[string]interface{}{
+				"login_name": acct.LoginName,
+				"dn":         acct.Dn,
+				"full_name":  acct.FullName,
+				"email":      acct.Email,
+			},
+		}
+		for _, mg := range mgs {
+			eval, err := bexpr.CreateEvaluator(mg.Filter)
+			if err != nil {
+				// We check all filters on ingress so this should never happen,
+				// but we validate anyways
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			match, err := eval.Evaluate(evalData)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if match {
+				matchedMgs = append(matchedMgs, mg)
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return acct, nil
+}
+
+// upsertAccount creates the account of the authenticated user with loginName
+// when it doesn't exist and otherwise updates its full name, email, dn and
+// groups with the ones of result.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, loginName string, result *authResult) (*Account, error) {
+	const op = "ldap.(Repository).upsertAccount"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if result == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authentication result")
+	}
+
+	acct, err := NewAccount(ctx, am.PublicId, loginName, WithFullName(result.fullName), WithEmail(result.email), WithDn(result.dn), WithMemberOfGroups(result.groups...))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedAccount *Account
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedAccount = AllocAccount()
+			err := reader.LookupWhere(ctx, returnedAccount, "auth_method_id = ? and login_name = ?", am.PublicId, acct.LoginName)
+			switch {
+			case errors.IsNotFoundError(err):
+				id, err := newAccountId(ctx)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				returnedAccount = acct.Clone()
+				returnedAccount.PublicId = id
+				if err := w.Create(ctx, returnedAccount, db.WithOplog(oplogWrapper, returnedAccount.oplog(oplog.OpType_OP_TYPE_CREATE, am.ScopeId))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create auth ldap account"))
+				}
+				return nil
+			case err != nil:
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth ldap account"))
+			}
+
+			var dbMask, nullFields []string
+			for _, f := range []struct {
+				name     string
+				cur, new string
+			}{
+				{"FullName", returnedAccount.FullName, acct.FullName},
+				{"Email", returnedAccount.Email, acct.Email},
+				{"Dn", returnedAccount.Dn, acct.Dn},
+				{"MemberOfGroups", returnedAccount.MemberOfGroups, acct.MemberOfGroups},
+			} {
+				switch {
+				case f.cur == f.new:
+				case f.new == "":
+					nullFields = append(nullFields, f.name)
+				default:
+					dbMask = append(dbMask, f.name)
+				}
+			}
+			if len(dbMask) == 0 && len(nullFields) == 0 {
+				return nil
+			}
+			returnedAccount.FullName = acct.FullName
+			returnedAccount.Email = acct.Email
+			returnedAccount.Dn = acct.Dn
+			returnedAccount.MemberOfGroups = acct.MemberOfGroups
+			rowsUpdated, err := w.Update(ctx, returnedAccount, dbMask, nullFields, db.WithOplog(oplogWrapper, returnedAccount.oplog(oplog.OpType_OP_TYPE_UPDATE, am.ScopeId)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth ldap account"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 row but got: %d", rowsUpdated))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAccount, nil
+}
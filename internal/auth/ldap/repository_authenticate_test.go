@@ -0,0 +1,99 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/testldap"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	srv := testldap.NewServer(t, testldap.WithEntries(testEntries()...))
+	certs, err := ParseCertificates(ctx, srv.CACert())
+	require.NoError(t, err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{srv.URL()},
+		WithStartTls(),
+		WithCertificates(certs...),
+		WithUserDn("dc=example,dc=com"),
+		WithUserAttr("uid"),
+		WithGroupDn("ou=groups,dc=example,dc=com"),
+		WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+	)
+	admins := TestManagedGroup(t, conn, am, `"/groups" contains "admins"`)
+	developers := TestManagedGroup(t, conn, am, `"/groups" contains "developers"`)
+	examples := TestManagedGroup(t, conn, am, `"/account/email" matches ".*@example.com"`)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	memberships := func(t *testing.T, acctId string) []string {
+		t.Helper()
+		members, err := repo.ListManagedGroupMembershipsByMember(ctx, acctId)
+		require.NoError(t, err)
+		var ids []string
+		for _, m := range members {
+			ids = append(ids, m.ManagedGroupId)
+		}
+		return ids
+	}
+
+	t.Run("bad-password", func(t *testing.T) {
+		acct, err := repo.Authenticate(ctx, am.PublicId, "alice", "wrong")
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+
+	t.Run("unknown-user", func(t *testing.T) {
+		acct, err := repo.Authenticate(ctx, am.PublicId, "carol", "carol-password")
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+
+	var aliceId string
+	t.Run("alice", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "Alice", "alice-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		aliceId = acct.PublicId
+		assert.Equal("alice", acct.LoginName)
+		assert.Equal("cn=alice,ou=people,dc=example,dc=com", acct.Dn)
+		assert.Equal("Alice Doe", acct.FullName)
+		assert.Equal("alice@example.com", acct.Email)
+		groups, err := acct.GetGroups(ctx)
+		require.NoError(err)
+		assert.ElementsMatch([]string{"admins", "developers"}, groups)
+		assert.ElementsMatch([]string{admins.PublicId, developers.PublicId, examples.PublicId}, memberships(t, acct.PublicId))
+	})
+
+	t.Run("alice-again", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "alice", "alice-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Equal(aliceId, acct.PublicId)
+	})
+
+	t.Run("bob", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Empty(acct.Email)
+		assert.ElementsMatch([]string{developers.PublicId}, memberships(t, acct.PublicId))
+	})
+}
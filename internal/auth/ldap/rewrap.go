@@ -0,0 +1,68 @@
+package ldap
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("auth_ldap_method", authMethodRewrapFn)
+}
+
+func authMethodRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "ldap.authMethodRewrapFn"
+	rows, err := reader.Query(ctx, listAuthMethodBindPasswordsByKeyIdQuery, []interface{}{dataKeyVersionId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list auth methods"))
+	}
+	var methods []*AuthMethod
+	for rows.Next() {
+		am := AllocAuthMethod()
+		if err := rows.Scan(&am.PublicId, &am.CtBindPassword); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		methods = append(methods, &am)
+	}
+	rows.Close()
+	if len(methods) == 0 {
+		return nil
+	}
+	old, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	current, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, am := range methods {
+				if err := am.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				// encrypt also computes the hmac of the bind password with
+				// the current key.
+				if err := am.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, rewrapAuthMethodBindPasswordQuery, []interface{}{am.CtBindPassword, am.BindPasswordHmac, am.KeyId, am.PublicId})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package ldap
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/require"
+)
+
+const TestFakeManagedGroupFilter = `"/groups" contains "admins"`
+
+// TestAuthMethod creates a test ldap auth method with its urls and
+// certificates. All the options supported by NewAuthMethod are supported.
+func TestAuthMethod(t *testing.T, conn *db.DB, databaseWrapper wrapping.Wrapper, scopeId string, urls []string, opt ...Option) *AuthMethod {
+	t.Helper()
+	ctx := context.TODO()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	authMethod, err := NewAuthMethod(ctx, scopeId, TestConvertToUrls(t, urls...), opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	authMethod.PublicId = id
+	require.NoError(authMethod.encrypt(ctx, databaseWrapper))
+	require.NoError(rw.Create(ctx, authMethod))
+
+	vo, err := authMethod.convertValueObjects(ctx)
+	require.NoError(err)
+	require.NoError(rw.CreateItems(ctx, vo.Urls))
+	if len(vo.Certs) > 0 {
+		require.NoError(rw.CreateItems(ctx, vo.Certs))
+	}
+	return authMethod
+}
+
+// TestAccount creates a test ldap auth account.
+func TestAccount(t *testing.T, conn *db.DB, am *AuthMethod, loginName string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, loginName, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test ldap managed group.
+func TestManagedGroup(t *testing.T, conn *db.DB, am *AuthMethod, filter string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestManagedGroupMember adds given account IDs to a managed group
+func TestManagedGroupMember(t *testing.T, conn *db.DB, managedGroupId, memberId string, opt ...Option) *ManagedGroupMemberAccount {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroupMemberAccount(ctx, managedGroupId, memberId, opt...)
+	require.NoError(err)
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestConvertToUrls will convert URL string representations to a slice of
+// *url.URL
+func TestConvertToUrls(t *testing.T, urls ...string) []*url.URL {
+	t.Helper()
+	require := require.New(t)
+	convertedUrls := make([]*url.URL, 0, len(urls))
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		require.NoError(err)
+		convertedUrls = append(convertedUrls, parsed)
+	}
+	return convertedUrls
+}
@@ -0,0 +1,539 @@
+// Package testldap provides an in-process LDAP server for tests. It supports
+// the subset of LDAPv3 used by the ldap auth method: simple binds, searches
+// with the and, or, not, equality, substrings and present filters, StartTLS
+// and LDAPS.
+package testldap
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/stretchr/testify/require"
+)
+
+// LDAP application tags and result codes used by the server.
+const (
+	appBindRequest       = 0
+	appBindResponse      = 1
+	appUnbindRequest     = 2
+	appSearchRequest     = 3
+	appSearchResultEntry = 4
+	appSearchResultDone  = 5
+	appExtendedRequest   = 23
+	appExtendedResponse  = 24
+
+	filterAnd        = 0
+	filterOr         = 1
+	filterNot        = 2
+	filterEquality   = 3
+	filterSubstrings = 4
+	filterPresent    = 7
+
+	scopeBaseObject   = 0
+	scopeSingleLevel  = 1
+	scopeWholeSubtree = 2
+
+	resultSuccess                 = 0
+	resultProtocolError           = 2
+	resultSizeLimitExceeded       = 4
+	resultNoSuchObject            = 32
+	resultInvalidCredentials      = 49
+	resultInsufficientAccessRight = 50
+	resultUnwillingToPerform      = 53
+
+	startTlsOid = "1.3.6.1.4.1.1466.20037"
+
+	// PasswordAttr is the attribute holding the password of an entry.
+	PasswordAttr = "userPassword"
+)
+
+// Entry is an entry of the directory.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// NewEntry returns an entry with dn and attrs, given as pairs of attribute
+// names and values.
+func NewEntry(dn string, attrs ...string) *Entry {
+	e := &Entry{DN: dn, Attributes: map[string][]string{}}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		e.Attributes[attrs[i]] = append(e.Attributes[attrs[i]], attrs[i+1])
+	}
+	return e
+}
+
+// values returns the values of the attribute named name, ignoring case.
+func (e *Entry) values(name string) []string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// Option configures a Server.
+type Option func(*options)
+
+type options struct {
+	withAnonymous bool
+	withLdaps     bool
+	withEntries   []*Entry
+}
+
+// WithAnonymousBind allows anonymous binds and unauthenticated searches.
+func WithAnonymousBind() Option {
+	return func(o *options) {
+		o.withAnonymous = true
+	}
+}
+
+// WithLdaps serves LDAPS instead of LDAP with StartTLS.
+func WithLdaps() Option {
+	return func(o *options) {
+		o.withLdaps = true
+	}
+}
+
+// WithEntries populates the directory with entries.
+func WithEntries(entries ...*Entry) Option {
+	return func(o *options) {
+		o.withEntries = append(o.withEntries, entries...)
+	}
+}
+
+// Server is an in-process LDAP server listening on 127.0.0.1.
+type Server struct {
+	opts     options
+	ln       net.Listener
+	tlsCfg   *tls.Config
+	caPem    string
+	mu       sync.RWMutex
+	entries  []*Entry
+	wg       sync.WaitGroup
+	shutdown chan struct{}
+}
+
+// NewServer starts a Server, which is stopped when the test completes.
+func NewServer(t *testing.T, opt ...Option) *Server {
+	t.Helper()
+	require := require.New(t)
+	s := &Server{shutdown: make(chan struct{})}
+	for _, o := range opt {
+		o(&s.opts)
+	}
+	s.entries = s.opts.withEntries
+
+	cert, caPem := testCertificate(t, "127.0.0.1", "localhost")
+	s.caPem = caPem
+	s.tlsCfg = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+
+	var err error
+	s.ln, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	if s.opts.withLdaps {
+		s.ln = tls.NewListener(s.ln, s.tlsCfg)
+	}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.Stop)
+	return s
+}
+
+// URL returns the url of the server.
+func (s *Server) URL() string {
+	scheme := "ldap"
+	if s.opts.withLdaps {
+		scheme = "ldaps"
+	}
+	return fmt.Sprintf("%s://%s", scheme, s.ln.Addr().String())
+}
+
+// CACert returns the PEM encoded certificate of the CA of the server's
+// certificate.
+func (s *Server) CACert() string {
+	return s.caPem
+}
+
+// AddEntries adds entries to the directory.
+func (s *Server) AddEntries(entries ...*Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entries...)
+}
+
+// Stop the server. It is safe to call more than once.
+func (s *Server) Stop() {
+	select {
+	case <-s.shutdown:
+		return
+	default:
+	}
+	close(s.shutdown)
+	_ = s.ln.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+// handle the requests of a connection until it's closed or unbound.
+func (s *Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	go func() {
+		<-s.shutdown
+		_ = conn.Close()
+	}()
+
+	bound := false
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(p.Children) < 2 {
+			return
+		}
+		msgId, _ := p.Children[0].Value.(int64)
+		req := p.Children[1]
+		switch req.Tag {
+		case appBindRequest:
+			code := s.bind(req)
+			bound = code == resultSuccess
+			if err := writeResult(conn, msgId, appBindResponse, code); err != nil {
+				return
+			}
+
+		case appUnbindRequest:
+			return
+
+		case appSearchRequest:
+			if !bound && !s.opts.withAnonymous {
+				if err := writeResult(conn, msgId, appSearchResultDone, resultInsufficientAccessRight); err != nil {
+					return
+				}
+				continue
+			}
+			if err := s.search(conn, msgId, req); err != nil {
+				return
+			}
+
+		case appExtendedRequest:
+			if len(req.Children) == 0 || req.Children[0].Data.String() != startTlsOid || s.opts.withLdaps {
+				if err := writeResult(conn, msgId, appExtendedResponse, resultProtocolError); err != nil {
+					return
+				}
+				continue
+			}
+			if err := writeResult(conn, msgId, appExtendedResponse, resultSuccess); err != nil {
+				return
+			}
+			tlsConn := tls.Server(conn, s.tlsCfg)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			defer func() { _ = tlsConn.Close() }()
+
+		default:
+			if err := writeResult(conn, msgId, req.Tag+1, resultProtocolError); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind checks the credentials of a simple bind request and returns its
+// result code. Entries are bound to with their dn or their
+// userPrincipalName.
+func (s *Server) bind(req *ber.Packet) ber.Tag {
+	if len(req.Children) < 3 {
+		return resultProtocolError
+	}
+	name := req.Children[1].Data.String()
+	password := req.Children[2].Data.String()
+	if password == "" {
+		if s.opts.withAnonymous {
+			return resultSuccess
+		}
+		return resultUnwillingToPerform
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, e := range s.entries {
+		if !equalDn(e.DN, name) && !containsFold(e.values("userPrincipalName"), name) {
+			continue
+		}
+		for _, pw := range e.values(PasswordAttr) {
+			if pw == password {
+				return resultSuccess
+			}
+		}
+	}
+	return resultInvalidCredentials
+}
+
+// search writes the entries matching the search request followed by the
+// result of the search.
+func (s *Server) search(w io.Writer, msgId int64, req *ber.Packet) error {
+	if len(req.Children) < 8 {
+		return writeResult(w, msgId, appSearchResultDone, resultProtocolError)
+	}
+	base := req.Children[0].Data.String()
+	scope, _ := req.Children[1].Value.(int64)
+	sizeLimit, _ := req.Children[3].Value.(int64)
+	filter := req.Children[6]
+	var attrs []string
+	for _, a := range req.Children[7].Children {
+		attrs = append(attrs, a.Data.String())
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	baseFound := false
+	var matches []*Entry
+	for _, e := range s.entries {
+		if equalDn(e.DN, base) {
+			baseFound = true
+		}
+		if !inScope(e.DN, base, scope) || !matchFilter(e, filter) {
+			continue
+		}
+		matches = append(matches, e)
+	}
+	if !baseFound {
+		return writeResult(w, msgId, appSearchResultDone, resultNoSuchObject)
+	}
+
+	code := ber.Tag(resultSuccess)
+	if sizeLimit > 0 && int64(len(matches)) > sizeLimit {
+		matches, code = matches[:sizeLimit], resultSizeLimitExceeded
+	}
+	for _, e := range matches {
+		if err := writeEntry(w, msgId, e, attrs); err != nil {
+			return err
+		}
+	}
+	return writeResult(w, msgId, appSearchResultDone, code)
+}
+
+// matchFilter reports whether e matches the filter. Values are compared
+// ignoring case.
+func matchFilter(e *Entry, f *ber.Packet) bool {
+	switch f.Tag {
+	case filterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(e, c) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, c := range f.Children {
+			if matchFilter(e, c) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(f.Children) == 1 && !matchFilter(e, f.Children[0])
+	case filterEquality:
+		if len(f.Children) != 2 {
+			return false
+		}
+		attr, value := f.Children[0].Data.String(), f.Children[1].Data.String()
+		if strings.EqualFold(attr, "dn") || strings.EqualFold(attr, "distinguishedName") {
+			return equalDn(e.DN, value)
+		}
+		return containsFold(e.values(attr), value)
+	case filterSubstrings:
+		if len(f.Children) != 2 {
+			return false
+		}
+		for _, v := range e.values(f.Children[0].Data.String()) {
+			if matchSubstrings(strings.ToLower(v), f.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		attr := f.Data.String()
+		return strings.EqualFold(attr, "objectClass") || len(e.values(attr)) > 0
+	default:
+		return false
+	}
+}
+
+// matchSubstrings reports whether v matches the initial, any and final
+// substrings.
+func matchSubstrings(v string, subs []*ber.Packet) bool {
+	for _, sub := range subs {
+		s := strings.ToLower(sub.Data.String())
+		switch sub.Tag {
+		case 0:
+			if !strings.HasPrefix(v, s) {
+				return false
+			}
+			v = v[len(s):]
+		case 1:
+			i := strings.Index(v, s)
+			if i < 0 {
+				return false
+			}
+			v = v[i+len(s):]
+		case 2:
+			if !strings.HasSuffix(v, s) {
+				return false
+			}
+			v = ""
+		}
+	}
+	return true
+}
+
+// inScope reports whether dn is within the scope of a search of base.
+func inScope(dn, base string, scope int64) bool {
+	dn, base = normalizeDn(dn), normalizeDn(base)
+	switch scope {
+	case scopeBaseObject:
+		return dn == base
+	case scopeSingleLevel:
+		i := strings.Index(dn, ",")
+		return i >= 0 && dn[i+1:] == base
+	case scopeWholeSubtree:
+		return base == "" || dn == base || strings.HasSuffix(dn, ","+base)
+	default:
+		return false
+	}
+}
+
+func normalizeDn(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, p := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(p))
+	}
+	return strings.Join(parts, ",")
+}
+
+func equalDn(a, b string) bool {
+	return normalizeDn(a) == normalizeDn(b)
+}
+
+func containsFold(values []string, v string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeEntry writes the search result entry of e with the attributes named
+// attrs, or all of them when attrs is empty or contains "*". Passwords are
+// never returned.
+func writeEntry(w io.Writer, msgId int64, e *Entry, attrs []string) error {
+	all := len(attrs) == 0 || containsFold(attrs, "*")
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, PasswordAttr) || (!all && !containsFold(attrs, name)) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		attributes.AppendChild(attr)
+	}
+	entry.AppendChild(attributes)
+	return writeMessage(w, msgId, entry)
+}
+
+// writeResult writes a response of type tag with the result code.
+func writeResult(w io.Writer, msgId int64, tag ber.Tag, code ber.Tag) error {
+	resp := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	resp.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	resp.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	resp.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return writeMessage(w, msgId, resp)
+}
+
+func writeMessage(w io.Writer, msgId int64, op *ber.Packet) error {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "Message ID"))
+	p.AppendChild(op)
+	_, err := w.Write(p.Bytes())
+	return err
+}
+
+// testCertificate generates a self signed certificate for hosts and returns
+// it along with the PEM encoding of its CA, which is itself.
+func testCertificate(t *testing.T, hosts ...string) (tls.Certificate, string) {
+	t.Helper()
+	require := require.New(t)
+
+	priv, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(err)
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	require.NoError(err)
+
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"Acme Co"},
+		},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	require.NoError(err)
+
+	return tls.Certificate{Certificate: [][]byte{derBytes}, PrivateKey: priv},
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}))
+}
@@ -0,0 +1,82 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultUrlTableName defines the default table name for a Url
+const defaultUrlTableName = "auth_ldap_url"
+
+// Url defines the LDAP URL of a server the auth method connects to. The
+// servers are tried in the order of their ConnectionPriority. It is assigned
+// to an LDAP AuthMethod and updates/deletes to that AuthMethod are cascaded
+// to its Urls. Urls are value objects of an AuthMethod, therefore there's no
+// need for oplog metadata, since only the AuthMethod will have metadata
+// because it's the root aggregate.
+type Url struct {
+	*store.Url
+	tableName string
+}
+
+// NewUrl creates a new in memory url assigned to an LDAP auth method.
+func NewUrl(ctx context.Context, authMethodId string, priority uint32, serverUrl string) (*Url, error) {
+	const op = "ldap.NewUrl"
+	u := &Url{
+		Url: &store.Url{
+			LdapMethodId:       authMethodId,
+			ConnectionPriority: priority,
+			ServerUrl:          serverUrl,
+		},
+	}
+	if err := u.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return u, nil
+}
+
+// validate the Url and on success return nil
+func (u *Url) validate(ctx context.Context, caller errors.Op) error {
+	if u.LdapMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing ldap auth method id")
+	}
+	if u.ConnectionPriority == 0 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing connection priority")
+	}
+	if err := validateUrl(u.ServerUrl); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("not a valid url: %s", u.ServerUrl), errors.WithWrap(err))
+	}
+	return nil
+}
+
+// AllocUrl makes an empty one in memory
+func AllocUrl() Url {
+	return Url{
+		Url: &store.Url{},
+	}
+}
+
+// Clone a Url
+func (u *Url) Clone() *Url {
+	cp := proto.Clone(u.Url)
+	return &Url{
+		Url: cp.(*store.Url),
+	}
+}
+
+// TableName returns the table name.
+func (u *Url) TableName() string {
+	if u.tableName != "" {
+		return u.tableName
+	}
+	return defaultUrlTableName
+}
+
+// SetTableName sets the table name.
+func (u *Url) SetTableName(n string) {
+	u.tableName = n
+}
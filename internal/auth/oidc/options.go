@@ -24,6 +24,7 @@ type options struct {
 	withName                string
 	withDescription         string
 	withLimit               int
+	withStartPageAfterItem  *db.PageItem
 	withMaxAge              int
 	withApiUrl              *url.URL
 	withCertificates        []*x509.Certificate
@@ -76,6 +77,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithMaxAge provides an optional max age.   Specifies the allowable elapsed
 // time in seconds since the last time the End-User was actively authenticated
 // by the OP. If the elapsed time is greater than this value, the OP MUST
@@ -12,4 +12,18 @@ const (
 			%s
 	returning public_id, version
        `
+
+	listAuthMethodSecretsByKeyIdQuery = `
+select public_id, client_secret
+  from auth_oidc_method
+ where key_id = ?;
+`
+
+	rewrapAuthMethodSecretQuery = `
+update auth_oidc_method
+   set client_secret      = ?,
+       client_secret_hmac = ?,
+       key_id             = ?
+ where public_id = ?;
+`
 )
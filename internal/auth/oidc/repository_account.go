@@ -129,7 +129,8 @@ func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt
 	return a, nil
 }
 
-// ListAccounts in an auth method and supports WithLimit option.
+// ListAccounts in an auth method and supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
 	const op = "oidc.(Repository).ListAccounts"
 	if withAuthMethodId == "" {
@@ -142,7 +143,7 @@ func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string,
 		limit = opts.withLimit
 	}
 	var accts []*Account
-	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -27,8 +27,8 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt
 }
 
 // ListAuthMethods returns a slice of AuthMethods for the scopeId. The
-// WithUnauthenticatedUser, WithLimit and WithOrder options are supported and
-//  all other options are ignored.
+// WithUnauthenticatedUser, WithLimit, WithOrder and WithStartPageAfterItem
+// options are supported and all other options are ignored.
 func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
 	const op = "oidc.(Repository).ListAuthMethods"
 	if len(scopeIds) == 0 {
@@ -88,7 +88,7 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbArgs = append(dbArgs, db.WithLimit(limit))
+	dbArgs = append(dbArgs, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 
 	if opts.withOrderByCreateTime {
 		if opts.ascending {
@@ -148,6 +148,8 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		am.ClientSecretHmac = agg.ClientSecretHmac
 		am.KeyId = agg.KeyId
 		am.MaxAge = int32(agg.MaxAge)
+		am.AuthTokenTimeToLive = agg.AuthTokenTimeToLive
+		am.AuthTokenTimeToStale = agg.AuthTokenTimeToStale
 		am.ApiUrl = agg.ApiUrl
 		if agg.Algs != "" {
 			am.SigningAlgs = strings.Split(agg.Algs, aggregateDelimiter)
@@ -189,6 +191,8 @@ type authMethodAgg struct {
 	ClientSecretHmac                  string
 	KeyId                             string
 	MaxAge                            int
+	AuthTokenTimeToLive               uint32
+	AuthTokenTimeToStale              uint32
 	Algs                              string
 	ApiUrl                            string
 	Auds                              string
@@ -36,6 +36,8 @@ const (
 	AccountClaimMapsField                  = "AccountClaimMaps"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
+	AuthTokenTimeToLiveField               = "AuthTokenTimeToLive"
+	AuthTokenTimeToStaleField              = "AuthTokenTimeToStale"
 )
 
 // UpdateAuthMethod will retrieve the auth method from the repository,
@@ -54,10 +56,10 @@ const (
 // fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a
 // zero value and included in fieldMask. Name, Description, Issuer,
-// ClientId, ClientSecret, MaxAge are all updatable fields.  The AuthMethod's
-// Value Objects of SigningAlgs, CallbackUrls, AudClaims and Certificates are
-// also updatable. if no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// ClientId, ClientSecret, MaxAge, AuthTokenTimeToLive and AuthTokenTimeToStale
+// are all updatable fields.  The AuthMethod's Value Objects of SigningAlgs,
+// CallbackUrls, AudClaims and Certificates are also updatable. if no updatable
+// fields are included in the fieldMaskPaths, then an error is returned.
 //
 // Options supported:
 //
@@ -93,18 +95,20 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 
 	dbMask, nullFields := dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			NameField:             am.Name,
-			DescriptionField:      am.Description,
-			IssuerField:           am.Issuer,
-			ClientIdField:         am.ClientId,
-			ClientSecretField:     am.ClientSecret,
-			MaxAgeField:           am.MaxAge,
-			SigningAlgsField:      am.SigningAlgs,
-			ApiUrlField:           am.ApiUrl,
-			AudClaimsField:        am.AudClaims,
-			CertificatesField:     am.Certificates,
-			ClaimsScopesField:     am.ClaimsScopes,
-			AccountClaimMapsField: am.AccountClaimMaps,
+			NameField:                 am.Name,
+			DescriptionField:          am.Description,
+			IssuerField:               am.Issuer,
+			ClientIdField:             am.ClientId,
+			ClientSecretField:         am.ClientSecret,
+			MaxAgeField:               am.MaxAge,
+			SigningAlgsField:          am.SigningAlgs,
+			ApiUrlField:               am.ApiUrl,
+			AudClaimsField:            am.AudClaims,
+			CertificatesField:         am.Certificates,
+			ClaimsScopesField:         am.ClaimsScopes,
+			AccountClaimMapsField:     am.AccountClaimMaps,
+			AuthTokenTimeToLiveField:  am.AuthTokenTimeToLive,
+			AuthTokenTimeToStaleField: am.AuthTokenTimeToStale,
 		},
 		fieldMaskPaths,
 		nil,
@@ -576,6 +580,8 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(CertificatesField, f):
 		case strings.EqualFold(ClaimsScopesField, f):
 		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(AuthTokenTimeToLiveField, f):
+		case strings.EqualFold(AuthTokenTimeToStaleField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -600,6 +606,10 @@ func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
 			cp.ClientSecret = new.ClientSecret
 		case MaxAgeField:
 			cp.MaxAge = new.MaxAge
+		case AuthTokenTimeToLiveField:
+			cp.AuthTokenTimeToLive = new.AuthTokenTimeToLive
+		case AuthTokenTimeToStaleField:
+			cp.AuthTokenTimeToStale = new.AuthTokenTimeToStale
 		case ApiUrlField:
 			cp.ApiUrl = new.ApiUrl
 		case SigningAlgsField:
@@ -93,7 +93,8 @@ func (r *Repository) LookupManagedGroup(ctx context.Context, withPublicId string
 	return a, nil
 }
 
-// ListManagedGroups in an auth method and supports WithLimit option.
+// ListManagedGroups in an auth method and supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroup, error) {
 	const op = "oidc.(Repository).ListManagedGroups"
 	if withAuthMethodId == "" {
@@ -106,7 +107,7 @@ func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId str
 		limit = opts.withLimit
 	}
 	var mgs []*ManagedGroup
-	err := r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -0,0 +1,181 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// maxFailedAttemptDelay caps the delay after consecutive failed
+// authentication attempts, however many attempts failed.
+const maxFailedAttemptDelay = time.Hour
+
+// accountLockout is the state of the failed authentication attempts of an
+// account along with the settings of its auth method controlling how they are
+// throttled.
+type accountLockout struct {
+	MaxFailedAttempts     uint32
+	LockoutDuration       uint32
+	FailedAttemptDelay    uint32
+	FailedAttemptCount    uint32
+	LastFailedAttemptTime sql.NullTime
+	LockedTime            sql.NullTime
+}
+
+// lockedUntil returns whether the account is locked at now and, if the lock
+// expires, when. A zero time is returned for a lock which lasts until the
+// account is unlocked.
+func (l accountLockout) lockedUntil(now time.Time) (time.Time, bool) {
+	if l.MaxFailedAttempts == 0 || !l.LockedTime.Valid {
+		return time.Time{}, false
+	}
+	if l.LockoutDuration == 0 {
+		return time.Time{}, true
+	}
+	until := l.LockedTime.Time.Add(time.Duration(l.LockoutDuration) * time.Second)
+	return until, now.Before(until)
+}
+
+// nextAttemptTime returns the time before which authentication attempts
+// against the account are rejected. The delay after the last failed attempt
+// doubles with each consecutive failed attempt, up to maxFailedAttemptDelay.
+// A zero time is returned if attempts aren't delayed.
+func (l accountLockout) nextAttemptTime() time.Time {
+	switch {
+	case l.FailedAttemptDelay == 0,
+		l.FailedAttemptCount == 0,
+		!l.LastFailedAttemptTime.Valid:
+		return time.Time{}
+	case l.LockedTime.Valid:
+		// The account was locked and the lock has expired, which already
+		// delayed this attempt.
+		return time.Time{}
+	}
+	delay := time.Duration(l.FailedAttemptDelay) * time.Second
+	for i := uint32(1); i < l.FailedAttemptCount && delay < maxFailedAttemptDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxFailedAttemptDelay {
+		delay = maxFailedAttemptDelay
+	}
+	return l.LastFailedAttemptTime.Time.Add(delay)
+}
+
+// attemptAllowed reports whether an authentication attempt against acct at
+// now is allowed, which it isn't while the account is locked or until the
+// delay after its last failed attempt has passed.
+func attemptAllowed(ctx context.Context, op string, acct *authAccount, now time.Time) bool {
+	if _, locked := acct.Lockout.lockedUntil(now); locked {
+		writeAuthenticationEvent(ctx, op, "authentication attempt against locked account", acct)
+		return false
+	}
+	if now.Before(acct.Lockout.nextAttemptTime()) {
+		writeAuthenticationEvent(ctx, op, "authentication attempt before failed attempt delay passed", acct)
+		return false
+	}
+	return true
+}
+
+// recordFailedAttempt records a failed authentication attempt against acct at
+// now, locking it if it reached the maximum number of failed attempts of its
+// auth method. acct is updated to the recorded state.
+func (r *Repository) recordFailedAttempt(ctx context.Context, acct *authAccount, now time.Time) error {
+	const op = "password.(Repository).recordFailedAttempt"
+	args := []interface{}{
+		sql.Named("account_id", acct.PublicId),
+		sql.Named("now", now),
+	}
+	rows, err := r.writer.Query(ctx, recordFailedAttemptQuery, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count uint32
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	acct.Lockout.FailedAttemptCount = count
+	acct.Lockout.LastFailedAttemptTime = sql.NullTime{Time: now, Valid: true}
+	acct.Lockout.LockedTime = sql.NullTime{}
+
+	if acct.Lockout.MaxFailedAttempts == 0 || count < acct.Lockout.MaxFailedAttempts {
+		writeAuthenticationEvent(ctx, op, "failed authentication attempt", acct)
+		return nil
+	}
+	if _, err := r.writer.Exec(ctx, lockAccountQuery, args); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock account"))
+	}
+	acct.Lockout.LockedTime = sql.NullTime{Time: now, Valid: true}
+	writeAuthenticationEvent(ctx, op, "account locked after failed authentication attempts", acct)
+	return nil
+}
+
+// clearFailedAttempts deletes the failed authentication attempts recorded for
+// accountId, unlocking it if it was locked.
+func (r *Repository) clearFailedAttempts(ctx context.Context, accountId string) error {
+	const op = "password.(Repository).clearFailedAttempts"
+	if _, err := r.writer.Exec(ctx, clearFailedAttemptsQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// UnlockAccount clears the failed authentication attempts recorded for
+// accountId, unlocking it if it was locked by too many failed attempts. The
+// account is returned.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) UnlockAccount(ctx context.Context, accountId string) (*Account, error) {
+	const op = "password.(Repository).UnlockAccount"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	if err := r.clearFailedAttempts(ctx, accountId); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, nil
+}
+
+// writeAuthenticationEvent emits an observation event about the failed
+// authentication attempts of acct.
+func writeAuthenticationEvent(ctx context.Context, op, msg string, acct *authAccount) {
+	var lockedUntil *time.Time
+	if until, locked := acct.Lockout.lockedUntil(time.Now()); locked && !until.IsZero() {
+		lockedUntil = &until
+	}
+	err := event.WriteObservation(ctx, event.Op(op),
+		event.WithHeader(
+			"password-authentication", struct {
+				Msg                string     `json:"msg"`
+				AuthMethodId       string     `json:"auth_method_id"`
+				AccountId          string     `json:"account_id"`
+				FailedAttemptCount uint32     `json:"failed_attempt_count"`
+				Locked             bool       `json:"locked"`
+				LockedUntil        *time.Time `json:"locked_until,omitempty"`
+			}{
+				Msg:                msg,
+				AuthMethodId:       acct.Account.GetAuthMethodId(),
+				AccountId:          acct.PublicId,
+				FailedAttemptCount: acct.Lockout.FailedAttemptCount,
+				Locked:             acct.Lockout.LockedTime.Valid,
+				LockedUntil:        lockedUntil,
+			}))
+	if err != nil {
+		event.WriteError(ctx, event.Op(op), err)
+	}
+}
@@ -0,0 +1,116 @@
+package password
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountLockout_lockedUntil(t *testing.T) {
+	t.Parallel()
+	lockedTime := time.Date(2021, 6, 16, 8, 30, 0, 0, time.UTC)
+	locked := sql.NullTime{Time: lockedTime, Valid: true}
+
+	tests := []struct {
+		name       string
+		lockout    accountLockout
+		now        time.Time
+		wantUntil  time.Time
+		wantLocked bool
+	}{
+		{
+			name:    "not locked",
+			lockout: accountLockout{MaxFailedAttempts: 3, LockoutDuration: 60, FailedAttemptCount: 2},
+			now:     lockedTime,
+		},
+		{
+			name:       "locked",
+			lockout:    accountLockout{MaxFailedAttempts: 3, LockoutDuration: 60, FailedAttemptCount: 3, LockedTime: locked},
+			now:        lockedTime.Add(59 * time.Second),
+			wantUntil:  lockedTime.Add(time.Minute),
+			wantLocked: true,
+		},
+		{
+			name:      "lock expired",
+			lockout:   accountLockout{MaxFailedAttempts: 3, LockoutDuration: 60, FailedAttemptCount: 3, LockedTime: locked},
+			now:       lockedTime.Add(time.Minute),
+			wantUntil: lockedTime.Add(time.Minute),
+		},
+		{
+			name:       "locked until unlocked",
+			lockout:    accountLockout{MaxFailedAttempts: 3, FailedAttemptCount: 3, LockedTime: locked},
+			now:        lockedTime.Add(24 * time.Hour),
+			wantLocked: true,
+		},
+		{
+			name:    "locking disabled",
+			lockout: accountLockout{LockoutDuration: 60, FailedAttemptCount: 3, LockedTime: locked},
+			now:     lockedTime,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			until, locked := tt.lockout.lockedUntil(tt.now)
+			assert.Equal(t, tt.wantLocked, locked)
+			assert.Equal(t, tt.wantUntil, until)
+		})
+	}
+}
+
+func TestAccountLockout_nextAttemptTime(t *testing.T) {
+	t.Parallel()
+	lastFailed := time.Date(2021, 6, 16, 8, 30, 0, 0, time.UTC)
+	failed := sql.NullTime{Time: lastFailed, Valid: true}
+
+	tests := []struct {
+		name    string
+		lockout accountLockout
+		want    time.Time
+	}{
+		{
+			name:    "delay disabled",
+			lockout: accountLockout{FailedAttemptCount: 2, LastFailedAttemptTime: failed},
+		},
+		{
+			name:    "no failed attempts",
+			lockout: accountLockout{FailedAttemptDelay: 2},
+		},
+		{
+			name:    "first failed attempt",
+			lockout: accountLockout{FailedAttemptDelay: 2, FailedAttemptCount: 1, LastFailedAttemptTime: failed},
+			want:    lastFailed.Add(2 * time.Second),
+		},
+		{
+			name:    "doubled for each failed attempt",
+			lockout: accountLockout{FailedAttemptDelay: 2, FailedAttemptCount: 4, LastFailedAttemptTime: failed},
+			want:    lastFailed.Add(16 * time.Second),
+		},
+		{
+			name:    "capped",
+			lockout: accountLockout{FailedAttemptDelay: 2, FailedAttemptCount: 1000, LastFailedAttemptTime: failed},
+			want:    lastFailed.Add(maxFailedAttemptDelay),
+		},
+		{
+			name: "after expired lock",
+			lockout: accountLockout{
+				MaxFailedAttempts:     3,
+				LockoutDuration:       60,
+				FailedAttemptDelay:    2,
+				FailedAttemptCount:    3,
+				LastFailedAttemptTime: failed,
+				LockedTime:            failed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.lockout.nextAttemptTime())
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package password
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct.
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,16 +16,20 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName              string
-	withDescription       string
-	withLoginName         string
-	withLimit             int
-	withConfig            Configuration
-	withPublicId          string
-	password              string
-	withPassword          bool
-	withOrderByCreateTime bool
-	ascending             bool
+	withName               string
+	withDescription        string
+	withLoginName          string
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withConfig             Configuration
+	withPublicId           string
+	password               string
+	withPassword           bool
+	withOrderByCreateTime  bool
+	ascending              bool
+	withTotpCode           string
+	withRecoveryCode       string
+	withNewPassword        string
 }
 
 func getDefaultOptions() options {
@@ -69,6 +75,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithPassword provides an optional password.
 func WithPassword(password string) Option {
 	return func(o *options) {
@@ -92,3 +107,27 @@ func WithOrderByCreateTime(ascending bool) Option {
 		o.ascending = ascending
 	}
 }
+
+// WithTotpCode provides an optional TOTP code to authenticate an account
+// enrolled for TOTP.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
+
+// WithRecoveryCode provides an optional recovery code to authenticate an
+// account enrolled for TOTP in place of a TOTP code.
+func WithRecoveryCode(code string) Option {
+	return func(o *options) {
+		o.withRecoveryCode = code
+	}
+}
+
+// WithNewPassword provides an optional new password to Authenticate, which
+// replaces the password of the account if it has expired.
+func WithNewPassword(password string) Option {
+	return func(o *options) {
+		o.withNewPassword = password
+	}
+}
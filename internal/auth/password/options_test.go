@@ -65,4 +65,22 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithTotpCode", func(t *testing.T) {
+		opts := getOpts(WithTotpCode("123456"))
+		testOpts := getDefaultOptions()
+		testOpts.withTotpCode = "123456"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRecoveryCode", func(t *testing.T) {
+		opts := getOpts(WithRecoveryCode("abcde-fghij"))
+		testOpts := getDefaultOptions()
+		testOpts.withRecoveryCode = "abcde-fghij"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithNewPassword", func(t *testing.T) {
+		opts := getOpts(WithNewPassword("password2"))
+		testOpts := getDefaultOptions()
+		testOpts.withNewPassword = "password2"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -0,0 +1,218 @@
+package password
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"golang.org/x/crypto/argon2"
+)
+
+// passwordPolicy is the password policy of an auth method. It is checked
+// whenever the password of one of its accounts is set.
+type passwordPolicy struct {
+	RequireLowercase     bool
+	RequireUppercase     bool
+	RequireDigit         bool
+	RequireSymbol        bool
+	PasswordHistoryCount uint32
+	MaxPasswordAgeDays   uint32
+}
+
+// missingCharacterClasses returns the character classes required by the
+// policy which password does not contain.
+func (p passwordPolicy) missingCharacterClasses(password string) []string {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+	var missing []string
+	if p.RequireLowercase && !lower {
+		missing = append(missing, "a lower case letter")
+	}
+	if p.RequireUppercase && !upper {
+		missing = append(missing, "an upper case letter")
+	}
+	if p.RequireDigit && !digit {
+		missing = append(missing, "a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		missing = append(missing, "a symbol")
+	}
+	return missing
+}
+
+// passwordExpired reports whether a password set at setTime is older than
+// maxAgeDays at now. A zero maxAgeDays never expires passwords.
+func passwordExpired(maxAgeDays uint32, setTime, now time.Time) bool {
+	if maxAgeDays == 0 {
+		return false
+	}
+	return !now.Before(setTime.AddDate(0, 0, int(maxAgeDays)))
+}
+
+// checkPasswordPolicy checks password against the password policy of the
+// auth method of cc before it is set as the password of accountId. The
+// recent passwords of the account are not checked if accountId is empty.
+func (r *Repository) checkPasswordPolicy(ctx context.Context, scopeId, accountId string, cc *currentConfig, password string) error {
+	const op = "password.(Repository).checkPasswordPolicy"
+	if missing := cc.Policy.missingCharacterClasses(password); len(missing) > 0 {
+		return errors.New(ctx, errors.PasswordTooWeak, op, fmt.Sprintf("password must contain %s", strings.Join(missing, ", ")))
+	}
+
+	rows, err := r.reader.Query(ctx, disallowedPasswordQuery, []interface{}{
+		sql.Named("auth_method_id", cc.PasswordMethodId),
+		sql.Named("password", strings.ToLower(password)),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var disallowed bool
+	for rows.Next() {
+		if err := rows.Scan(&disallowed); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if disallowed {
+		return errors.New(ctx, errors.PasswordDisallowed, op, "password is disallowed")
+	}
+
+	if accountId == "" || cc.Policy.PasswordHistoryCount == 0 {
+		return nil
+	}
+	reused, err := r.passwordReused(ctx, scopeId, accountId, password, cc.Policy.PasswordHistoryCount)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if reused {
+		return errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("password must not equal any of the last %d passwords", cc.Policy.PasswordHistoryCount))
+	}
+	return nil
+}
+
+type recentCredential struct {
+	*Argon2Credential
+	*Argon2Configuration
+}
+
+// passwordReused reports whether password equals one of the count most
+// recent passwords of accountId, which are its current password and the
+// count-1 most recent passwords in its password history.
+func (r *Repository) passwordReused(ctx context.Context, scopeId, accountId, password string, count uint32) (bool, error) {
+	const op = "password.(Repository).passwordReused"
+	rows, err := r.reader.Query(ctx, recentCredentialsQuery, []interface{}{
+		sql.Named("account_id", accountId),
+		sql.Named("history_limit", count-1),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var creds []recentCredential
+	for rows.Next() {
+		var c recentCredential
+		if err := r.reader.ScanRows(rows, &c); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c)
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+
+	for _, c := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.Argon2Credential.GetKeyId()))
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		}
+		key := argon2.IDKey([]byte(password), c.Salt, c.Iterations, c.Memory, uint8(c.Threads), c.KeyLength)
+		if subtle.ConstantTimeCompare(key, c.DerivedKey) == 1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// archiveCredential copies the current credential of accountId, if any, to
+// the password history of the account before it is replaced, keeping the
+// historyCount-1 most recent previous credentials of the account. w must be
+// the writer of the transaction replacing the credential.
+func archiveCredential(ctx context.Context, w db.Writer, accountId string, historyCount uint32) error {
+	const op = "password.archiveCredential"
+	var keep uint32
+	if historyCount > 1 {
+		keep = historyCount - 1
+		if _, err := w.Exec(ctx, archiveCredentialQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to archive credential"))
+		}
+	}
+	if _, err := w.Exec(ctx, trimCredentialHistoryQuery, []interface{}{
+		sql.Named("account_id", accountId),
+		sql.Named("keep", keep),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to trim password history"))
+	}
+	return nil
+}
+
+// disallowedPassword is a password which cannot be set as the password of
+// an account of a password auth method.
+type disallowedPassword struct {
+	PasswordMethodId string `gorm:"primary_key"`
+	Password         string `gorm:"primary_key"`
+}
+
+// TableName returns the table name.
+func (*disallowedPassword) TableName() string {
+	return "auth_password_method_disallowed_password"
+}
+
+// setDisallowedPasswords replaces the disallowed passwords of authMethodId
+// with passwords. Passwords are stored in lower case and empty or duplicate
+// passwords are ignored. w must be the writer of a transaction.
+func setDisallowedPasswords(ctx context.Context, w db.Writer, authMethodId string, passwords []string) error {
+	const op = "password.setDisallowedPasswords"
+	if _, err := w.Exec(ctx, deleteDisallowedPasswordsQuery, []interface{}{sql.Named("auth_method_id", authMethodId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete disallowed passwords"))
+	}
+	seen := make(map[string]bool, len(passwords))
+	var items []interface{}
+	for _, p := range passwords {
+		p = strings.ToLower(p)
+		if p == "" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		items = append(items, &disallowedPassword{PasswordMethodId: authMethodId, Password: p})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	if err := w.CreateItems(ctx, items); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create disallowed passwords"))
+	}
+	return nil
+}
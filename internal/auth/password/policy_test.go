@@ -0,0 +1,101 @@
+package password
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicy_missingCharacterClasses(t *testing.T) {
+	t.Parallel()
+	all := passwordPolicy{RequireLowercase: true, RequireUppercase: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name     string
+		policy   passwordPolicy
+		password string
+		want     []string
+	}{
+		{
+			name:     "no requirements",
+			password: "password",
+		},
+		{
+			name:     "all classes",
+			policy:   all,
+			password: "Passw0rd!",
+		},
+		{
+			name:     "missing all classes",
+			policy:   all,
+			password: "        ",
+			want:     []string{"a lower case letter", "an upper case letter", "a digit"},
+		},
+		{
+			name:     "missing upper case and symbol",
+			policy:   all,
+			password: "passw0rd",
+			want:     []string{"an upper case letter", "a symbol"},
+		},
+		{
+			name:     "non ascii",
+			policy:   all,
+			password: "ÄÖüß٣€",
+		},
+		{
+			name:     "letter without case is not a symbol",
+			policy:   passwordPolicy{RequireSymbol: true},
+			password: "密码密码",
+			want:     []string{"a symbol"},
+		},
+		{
+			name:     "only digit required",
+			policy:   passwordPolicy{RequireDigit: true},
+			password: "password",
+			want:     []string{"a digit"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.policy.missingCharacterClasses(tt.password))
+		})
+	}
+}
+
+func TestPasswordExpired(t *testing.T) {
+	t.Parallel()
+	setTime := time.Date(2021, 6, 16, 8, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		maxAgeDays uint32
+		now        time.Time
+		want       bool
+	}{
+		{
+			name: "expiration disabled",
+			now:  setTime.AddDate(10, 0, 0),
+		},
+		{
+			name:       "not expired",
+			maxAgeDays: 30,
+			now:        setTime.AddDate(0, 0, 30).Add(-time.Second),
+		},
+		{
+			name:       "expired",
+			maxAgeDays: 30,
+			now:        setTime.AddDate(0, 0, 30),
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, passwordExpired(tt.maxAgeDays, setTime, tt.now))
+		})
+	}
+}
@@ -20,11 +20,25 @@ select acct.name,                        -- Account.Name
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       coalesce(meth.max_failed_attempts, 0) as max_failed_attempts,
+       coalesce(meth.lockout_duration, 0) as lockout_duration,
+       coalesce(meth.failed_attempt_delay, 0) as failed_attempt_delay,
+       coalesce(lockout.failed_attempt_count, 0) as failed_attempt_count,
+       lockout.last_failed_attempt_time,
+       lockout.locked_time,
+       coalesce(meth.max_password_age_days, 0) as max_password_age_days,
+       cred.create_time as password_create_time,
+       meth.require_mfa,
+       totp.password_account_id is not null as totp_confirmed
   from auth_password_argon2_cred cred,
        auth_password_argon2_conf conf,
-       auth_password_account acct,
-       auth_password_method meth
+       auth_password_method meth,
+       auth_password_account acct
+  left join auth_password_account_lockout lockout
+         on lockout.password_account_id = acct.public_id
+  left join auth_password_totp_secret totp
+         on totp.password_account_id = acct.public_id
  where acct.auth_method_id = @auth_method_id
    and acct.login_name = @login_name
    and cred.password_conf_id = conf.private_id
@@ -40,5 +54,206 @@ select *
          from auth_password_account
         where public_id = @public_id
     );
+`
+	recordFailedAttemptQuery = `
+insert into auth_password_account_lockout as lockout
+  (password_account_id, failed_attempt_count, last_failed_attempt_time)
+values
+  (@account_id, 1, @now)
+on conflict (password_account_id) do update
+  set failed_attempt_count = case
+        when lockout.locked_time is null then lockout.failed_attempt_count + 1
+        else 1
+      end,
+      last_failed_attempt_time = @now,
+      locked_time = null
+returning failed_attempt_count;
+`
+	lockAccountQuery = `
+update auth_password_account_lockout
+   set locked_time = @now
+ where password_account_id = @account_id
+   and locked_time is null;
+`
+	clearFailedAttemptsQuery = `
+delete from auth_password_account_lockout
+ where password_account_id = @account_id;
+`
+	disallowedPasswordQuery = `
+select exists (
+       select 1
+         from auth_password_method_disallowed_password
+        where password_method_id = @auth_method_id
+          and password = @password
+       );
+`
+	deleteDisallowedPasswordsQuery = `
+delete from auth_password_method_disallowed_password
+ where password_method_id = @auth_method_id;
+`
+	recentCredentialsQuery = `
+select cred.private_id,       -- Argon2Credential.PrivateId
+       cred.password_conf_id, -- Argon2Credential.PasswordConfId
+       cred.salt,             -- Argon2Credential.CtSalt/Salt
+       cred.derived_key,      -- Argon2Credential.DerivedKey
+       cred.key_id,           -- Argon2Credential.KeyId
+       conf.key_length,       -- Argon2Configuration.KeyLength
+       conf.iterations,       -- Argon2Configuration.Iterations
+       conf.memory,           -- Argon2Configuration.Memory
+       conf.threads           -- Argon2Configuration.Threads
+  from (
+         select private_id, password_conf_id, salt, derived_key, key_id
+           from auth_password_argon2_cred
+          where password_account_id = @account_id
+          union all
+        (select private_id, password_conf_id, salt, derived_key, key_id
+           from auth_password_argon2_cred_history
+          where password_account_id = @account_id
+          order by create_time desc
+          limit @history_limit)
+       ) cred
+  join auth_password_argon2_conf conf
+    on conf.private_id = cred.password_conf_id;
+`
+	archiveCredentialQuery = `
+insert into auth_password_argon2_cred_history
+  (private_id, password_account_id, password_conf_id, password_method_id, salt, derived_key, key_id)
+select private_id, password_account_id, password_conf_id, password_method_id, salt, derived_key, key_id
+  from auth_password_argon2_cred
+ where password_account_id = @account_id;
+`
+	trimCredentialHistoryQuery = `
+delete from auth_password_argon2_cred_history
+ where password_account_id = @account_id
+   and private_id not in (
+       select private_id
+         from auth_password_argon2_cred_history
+        where password_account_id = @account_id
+        order by create_time desc
+        limit @keep
+   );
+`
+	deleteTotpSecretQuery = `
+delete from auth_password_totp_secret
+ where password_account_id = @account_id;
+`
+	deletePendingTotpSecretQuery = `
+delete from auth_password_totp_pending_secret
+ where password_account_id = @account_id;
+`
+	insertPendingTotpSecretQuery = `
+insert into auth_password_totp_pending_secret
+  (password_account_id, secret, key_id)
+values
+  (@account_id, @secret, @key_id);
+`
+	insertPendingRecoveryCodeQuery = `
+insert into auth_password_totp_pending_recovery_code
+  (password_account_id, code_hash)
+values
+  (@account_id, @code_hash);
+`
+	lookupTotpSecretQuery = `
+select secret,
+       key_id,
+       last_used_step
+  from auth_password_totp_secret
+ where password_account_id = @account_id;
+`
+	lookupPendingTotpSecretQuery = `
+select secret,
+       key_id,
+       0 as last_used_step
+  from auth_password_totp_pending_secret
+ where password_account_id = @account_id;
+`
+	confirmTotpSecretQuery = `
+insert into auth_password_totp_secret as totp
+  (password_account_id, secret, key_id, last_used_step)
+select password_account_id, secret, key_id, @step
+  from auth_password_totp_pending_secret
+ where password_account_id = @account_id
+    on conflict (password_account_id) do update
+   set secret         = excluded.secret,
+       key_id         = excluded.key_id,
+       last_used_step = excluded.last_used_step,
+       confirmed_time = now()
+ where totp.secret <> excluded.secret;
+`
+	deleteRecoveryCodesQuery = `
+delete from auth_password_totp_recovery_code
+ where password_account_id = @account_id;
+`
+	confirmRecoveryCodesQuery = `
+insert into auth_password_totp_recovery_code
+  (password_account_id, code_hash)
+select password_account_id, code_hash
+  from auth_password_totp_pending_recovery_code
+ where password_account_id = @account_id;
+`
+	useTotpStepQuery = `
+update auth_password_totp_secret
+   set last_used_step = @step
+ where password_account_id = @account_id
+   and last_used_step < @step;
+`
+	useRecoveryCodeQuery = `
+delete from auth_password_totp_recovery_code
+ where password_account_id = @account_id
+   and code_hash = @code_hash;
+`
+	totpAccountQuery = `
+select acct.public_id,
+       acct.auth_method_id,
+       acct.scope_id,
+       coalesce(meth.max_failed_attempts, 0) as max_failed_attempts,
+       coalesce(meth.lockout_duration, 0) as lockout_duration,
+       coalesce(meth.failed_attempt_delay, 0) as failed_attempt_delay,
+       coalesce(lockout.failed_attempt_count, 0) as failed_attempt_count,
+       lockout.last_failed_attempt_time,
+       lockout.locked_time,
+       meth.require_mfa,
+       totp.password_account_id is not null as totp_confirmed
+  from auth_password_method meth,
+       auth_password_account acct
+  left join auth_password_account_lockout lockout
+         on lockout.password_account_id = acct.public_id
+  left join auth_password_totp_secret totp
+         on totp.password_account_id = acct.public_id
+ where acct.public_id = @account_id
+   and acct.auth_method_id = meth.public_id;
+`
+	listArgon2CredentialHistoryByKeyIdQuery = `
+select private_id, salt
+  from auth_password_argon2_cred_history
+ where key_id = @key_id;
+`
+	rewrapArgon2CredentialHistoryQuery = `
+update auth_password_argon2_cred_history
+   set salt   = @salt,
+       key_id = @key_id
+ where private_id = @private_id;
+`
+	listTotpSecretsByKeyIdQuery = `
+select password_account_id, secret
+  from auth_password_totp_secret
+ where key_id = @key_id;
+`
+	rewrapTotpSecretQuery = `
+update auth_password_totp_secret
+   set secret = @secret,
+       key_id = @key_id
+ where password_account_id = @account_id;
+`
+	listPendingTotpSecretsByKeyIdQuery = `
+select password_account_id, secret
+  from auth_password_totp_pending_secret
+ where key_id = @key_id;
+`
+	rewrapPendingTotpSecretQuery = `
+update auth_password_totp_pending_secret
+   set secret = @secret,
+       key_id = @key_id
+ where password_account_id = @account_id;
 `
 )
@@ -23,7 +23,7 @@ import (
 // a.AuthMethodId.
 //
 // WithPassword and WithPublicId are the only valid options. All other options
-// are ignored.
+// are ignored. The password must meet the password policy of a.AuthMethodId.
 //
 // Both a.Name and a.Description are optional. If a.Name is set, it must be
 // unique within a.AuthMethodId.
@@ -78,6 +78,9 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 		if cc.MinPasswordLength > len(opts.password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be longer than %v", cc.MinPasswordLength))
 		}
+		if err := r.checkPasswordPolicy(ctx, scopeId, "", cc, opts.password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		if cred, err = newArgon2Credential(a.PublicId, opts.password, cc.argon2()); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -142,7 +145,8 @@ func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt
 	return a, nil
 }
 
-// ListAccounts in an auth method and supports WithLimit option.
+// ListAccounts in an auth method and supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
 	const op = "password.(Repository).ListAccounts"
 	if withAuthMethodId == "" {
@@ -155,7 +159,7 @@ func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string,
 		limit = opts.withLimit
 	}
 	var accts []*Account
-	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
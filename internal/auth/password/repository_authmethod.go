@@ -21,7 +21,7 @@ import (
 // options are ignored.
 //
 // Both m.Name and m.Description are optional. If m.Name is set, it must be
-// unique within m.ScopeId.
+// unique within m.ScopeId. m.DisallowedPasswords are stored in lower case.
 func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ...Option) (*AuthMethod, error) {
 	const op = "password.(Repository).CreateAuthMethod"
 	if m == nil {
@@ -85,6 +85,11 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ..
 			if err := w.Create(ctx, newAuthMethod, db.WithOplog(oplogWrapper, m.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create auth method"))
 			}
+			if len(m.DisallowedPasswords) > 0 {
+				if err := setDisallowedPasswords(ctx, w, m.PublicId, m.DisallowedPasswords); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -108,8 +113,8 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ..
 	return r.lookupAuthMethod(ctx, publicId)
 }
 
-// ListAuthMethods returns a slice of AuthMethods for the scopeId. WithLimit and
-// WithOrder options are the only option supported.
+// ListAuthMethods returns a slice of AuthMethods for the scopeId. WithLimit,
+// WithOrder and WithStartPageAfterItem options are the only options supported.
 func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
 	const op = "password.(Repository).ListAuthMethods"
 	if len(scopeIds) == 0 {
@@ -174,8 +179,15 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
 // value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// MinLoginNameLength, MaxFailedAttempts, LockoutDuration,
+// FailedAttemptDelay, RequireLowercase, RequireUppercase, RequireDigit,
+// RequireSymbol, PasswordHistoryCount, MaxPasswordAgeDays, RequireMfa,
+// AuthTokenTimeToLive, AuthTokenTimeToStale and DisallowedPasswords are the
+// only updatable fields, If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
+//
+// DisallowedPasswords replaces the disallowed passwords of the auth method
+// and is not returned in the written auth method.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -187,12 +199,27 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	if authMethod.ScopeId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
+	var updateDisallowedPasswords bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("MaxFailedAttempts", f):
+		case strings.EqualFold("LockoutDuration", f):
+		case strings.EqualFold("FailedAttemptDelay", f):
+		case strings.EqualFold("RequireLowercase", f):
+		case strings.EqualFold("RequireUppercase", f):
+		case strings.EqualFold("RequireDigit", f):
+		case strings.EqualFold("RequireSymbol", f):
+		case strings.EqualFold("PasswordHistoryCount", f):
+		case strings.EqualFold("MaxPasswordAgeDays", f):
+		case strings.EqualFold("RequireMfa", f):
+		case strings.EqualFold("AuthTokenTimeToLive", f):
+		case strings.EqualFold("AuthTokenTimeToStale", f):
+		case strings.EqualFold("DisallowedPasswords", f):
+			updateDisallowedPasswords = true
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -200,15 +227,27 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":               authMethod.Name,
-			"Description":        authMethod.Description,
-			"MinPasswordLength":  authMethod.MinPasswordLength,
-			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"Name":                 authMethod.Name,
+			"Description":          authMethod.Description,
+			"MinPasswordLength":    authMethod.MinPasswordLength,
+			"MinLoginNameLength":   authMethod.MinLoginNameLength,
+			"MaxFailedAttempts":    authMethod.MaxFailedAttempts,
+			"LockoutDuration":      authMethod.LockoutDuration,
+			"FailedAttemptDelay":   authMethod.FailedAttemptDelay,
+			"RequireLowercase":     authMethod.RequireLowercase,
+			"RequireUppercase":     authMethod.RequireUppercase,
+			"RequireDigit":         authMethod.RequireDigit,
+			"RequireSymbol":        authMethod.RequireSymbol,
+			"PasswordHistoryCount": authMethod.PasswordHistoryCount,
+			"MaxPasswordAgeDays":   authMethod.MaxPasswordAgeDays,
+			"RequireMfa":           authMethod.RequireMfa,
+			"AuthTokenTimeToLive":  authMethod.AuthTokenTimeToLive,
+			"AuthTokenTimeToStale": authMethod.AuthTokenTimeToStale,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"RequireLowercase", "RequireUppercase", "RequireDigit", "RequireSymbol", "RequireMfa"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateDisallowedPasswords {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
 	}
 
@@ -229,6 +268,12 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 				db.WithOplog(oplogWrapper, upAuthMethod.oplog(oplog.OpType_OP_TYPE_UPDATE)),
 				db.WithVersion(&version),
 			}
+			if len(dbMask) == 0 && len(nullFields) == 0 {
+				// Only the disallowed passwords are updated, which are
+				// stored in a separate table, so bump the version.
+				upAuthMethod.Version = version + 1
+				dbMask = []string{"Version"}
+			}
 			var err error
 			rowsUpdated, err = w.Update(
 				ctx,
@@ -243,6 +288,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if updateDisallowedPasswords && rowsUpdated == 1 {
+				if err := setDisallowedPasswords(ctx, w, upAuthMethod.PublicId, authMethod.DisallowedPasswords); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			// we need a new repo, that's using the same reader/writer as this TxHandler
 			txRepo := &Repository{
 				reader: reader,
@@ -313,7 +363,7 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbArgs = append(dbArgs, db.WithLimit(limit))
+	dbArgs = append(dbArgs, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 
 	if opts.withOrderByCreateTime {
 		if opts.ascending {
@@ -667,6 +667,28 @@ func TestRepository_UpdateAuthMethod(t *testing.T) {
 			wantRowsUpdate:   1,
 			skipVersionCheck: true,
 		},
+		{
+			name: "change auth token settings",
+			args: args{
+				updates: &store.AuthMethod{
+					AuthTokenTimeToLive:  3600,
+					AuthTokenTimeToStale: 600,
+				},
+				fieldMaskPaths: []string{"AuthTokenTimeToLive", "AuthTokenTimeToStale"},
+			},
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
+		{
+			name: "null auth token settings",
+			args: args{
+				updates:        &store.AuthMethod{},
+				fieldMaskPaths: []string{"AuthTokenTimeToLive", "AuthTokenTimeToStale"},
+			},
+			wantErr:          false,
+			wantRowsUpdate:   1,
+			skipVersionCheck: true,
+		},
 		{
 			name: "noop update",
 			args: args{
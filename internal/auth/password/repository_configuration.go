@@ -123,6 +123,7 @@ type currentConfig struct {
 	ConfType           string
 	MinLoginNameLength int
 	MinPasswordLength  int
+	Policy             passwordPolicy `gorm:"embedded"`
 
 	*Argon2Configuration
 }
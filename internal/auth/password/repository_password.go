@@ -5,12 +5,14 @@ import (
 	"crypto/subtle"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"golang.org/x/crypto/argon2"
 )
 
@@ -18,7 +20,12 @@ type authAccount struct {
 	*Account
 	*Argon2Credential
 	*Argon2Configuration
-	IsCurrentConf bool
+	IsCurrentConf      bool
+	Lockout            accountLockout `gorm:"embedded"`
+	MaxPasswordAgeDays uint32
+	PasswordCreateTime time.Time
+	RequireMfa         bool
+	TotpConfirmed      bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
@@ -32,7 +39,31 @@ type authAccount struct {
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+//
+// Failed authentication attempts are recorded for the account. Depending on
+// the settings of authMethodId, the account is locked after too many
+// consecutive failed attempts, and attempts are rejected without checking the
+// password while the account is locked or until the delay after the last
+// failed attempt has passed. Authentication fails in both cases.
+//
+// Returns nil, error with code PasswordExpired if loginName and password
+// match but the password is older than the maximum password age of
+// authMethodId. The password must be changed before the account can be
+// authenticated, which can be done by authenticating with WithNewPassword:
+// the expired password is then replaced with the new password, which must
+// meet the password policy of authMethodId, and the account is returned. The
+// new password is ignored if the password has not expired.
+//
+// Once the account has confirmed a TOTP secret, a TOTP code must be provided
+// with WithTotpCode, or a recovery code with WithRecoveryCode, and
+// authentication fails if the code is not valid. Returns nil, error with code
+// MfaRequired if loginName and password match but neither was provided.
+// Returns nil, error with code MfaNotEnrolled if loginName and password match
+// but authMethodId requires multi-factor authentication and the account has
+// no confirmed TOTP secret. Such an account enrolls with
+// EnrollTotpWithPassword and confirms its pending TOTP secret by
+// authenticating with a TOTP code generated from it.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).Authenticate"
 	if authMethodId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
@@ -59,6 +90,38 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 	if acct == nil {
 		return nil, nil
 	}
+	opts := getOpts(opt...)
+	ok, err := r.verifySecondFactor(ctx, scopeId, acct, opts)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		return nil, nil
+	}
+	if acct.Lockout.FailedAttemptCount > 0 {
+		if err := r.clearFailedAttempts(ctx, acct.PublicId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if passwordExpired(acct.MaxPasswordAgeDays, acct.PasswordCreateTime, time.Now()) {
+		if opts.withNewPassword == "" {
+			return nil, errors.New(ctx, errors.PasswordExpired, op, "password has expired and must be changed", errors.WithoutEvent())
+		}
+		if opts.withNewPassword == password {
+			return nil, errors.New(ctx, errors.PasswordsEqual, op, "passwords must not equal", errors.WithoutEvent())
+		}
+		oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+		}
+		updated, err := r.replacePassword(ctx, scopeId, acct, opts.withNewPassword, acct.Version, oplogWrapper, databaseWrapper)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		acct.Account.Version = updated.Version
+		acct.Account.CredentialId = updated.CredentialId
+		return acct.Account, nil
+	}
 
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
@@ -110,6 +173,9 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 // Returns nil, db.ErrorRecordNotFound if the account doesn't exist.
 // Returns nil, nil if old does not match the stored password for accountId.
 // Returns nil, error with code PasswordsEqual if old and new are equal.
+// Returns nil, error with code PasswordTooWeak, PasswordDisallowed or
+// PasswordReused if new does not meet the password policy of the auth
+// method of the account. An expired password can be changed.
 func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old, new string, version uint32) (*Account, error) {
 	const op = "password.(Repository).ChangePassword"
 	if accountId == "" {
@@ -155,14 +221,36 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if acct == nil {
 		return nil, nil
 	}
+	if acct.Lockout.FailedAttemptCount > 0 {
+		if err := r.clearFailedAttempts(ctx, acct.PublicId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
-	cc, err := r.currentConfig(ctx, authAccount.GetAuthMethodId())
+	updatedAccount, err := r.replacePassword(ctx, scopeId, acct, new, version, oplogWrapper, databaseWrapper)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedAccount, nil
+}
+
+// replacePassword replaces the password of the authenticated account acct
+// with new, which must meet the password policy of its auth method. The
+// previous password is added to the password history of the account. The
+// account is returned with its new version and CredentialId.
+func (r *Repository) replacePassword(ctx context.Context, scopeId string, acct *authAccount, new string, version uint32, oplogWrapper, databaseWrapper wrapping.Wrapper) (*Account, error) {
+	const op = "password.(Repository).replacePassword"
+	accountId := acct.PublicId
+	cc, err := r.currentConfig(ctx, acct.GetAuthMethodId())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("retrieve current password configuration"))
 	}
 	if cc.MinPasswordLength > len(new) {
 		return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be at least %d", cc.MinPasswordLength))
 	}
+	if err := r.checkPasswordPolicy(ctx, scopeId, accountId, cc, new); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	newCred, err := newArgon2Credential(accountId, new, cc.argon2())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -188,6 +276,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated account and %d rows updated", rowsUpdated))
 			}
 
+			if err := archiveCredential(ctx, w, accountId, cc.Policy.PasswordHistoryCount); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			rowsDeleted, err := w.Delete(ctx, oldCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -210,6 +301,10 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	return updatedAccount, nil
 }
 
+// authenticate returns the account for loginName in authMethodId if password
+// matches its password, recording a failed attempt otherwise. The failed
+// attempts of an account are not cleared when password matches, which is left
+// to the caller once the account is authenticated.
 func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*authAccount, error) {
 	const op = "password.(Repository).authenticate"
 	var accts []authAccount
@@ -238,6 +333,11 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 		acct = accts[0]
 	}
 
+	now := time.Now()
+	if !attemptAllowed(ctx, op, &acct, now) {
+		return nil, nil
+	}
+
 	// We don't pass a wrapper in here because for ecryption we want to indicate the expected key ID
 	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(acct.GetKeyId()))
 	if err != nil {
@@ -251,6 +351,9 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
 	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
 		// authentication failed, password does not match
+		if err := r.recordFailedAttempt(ctx, &acct, now); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		return nil, nil
 	}
 	return &acct, nil
@@ -258,6 +361,10 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
+//
+// Returns nil, error with code PasswordTooWeak, PasswordDisallowed or
+// PasswordReused if password does not meet the password policy of the auth
+// method of the account.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
 	const op = "password.(Repository).SetPassword"
 	if accountId == "" {
@@ -279,18 +386,26 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
+	cc, err := r.currentConfigForAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var historyCount uint32
+	if cc != nil {
+		historyCount = cc.Policy.PasswordHistoryCount
+	}
+
 	var newCred *Argon2Credential
 	if password != "" {
-		cc, err := r.currentConfigForAccount(ctx, accountId)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
 		if cc == nil {
 			return nil, errors.New(ctx, errors.RecordNotFound, op, "unable to retrieve current configuration")
 		}
 		if cc.MinPasswordLength > len(password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("password must be at least %v", cc.MinPasswordLength))
 		}
+		if err := r.checkPasswordPolicy(ctx, scopeId, accountId, cc, password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		newCred, err = newArgon2Credential(accountId, password, cc.argon2())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -322,6 +437,9 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if oldCred.PrivateId != "" {
+				if err := archiveCredential(ctx, w, accountId, historyCount); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 				dCred := oldCred.clone()
 				rowsDeleted, err := w.Delete(ctx, dCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 				if err != nil {
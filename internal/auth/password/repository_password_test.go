@@ -246,6 +246,221 @@ func TestRepository_AuthenticateRehash(t *testing.T) {
 	assert.NoError(db.TestVerifyOplog(t, rw, auth2Cred.PrivateId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
 }
 
+func TestRepository_AuthenticateLockout(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	authMethod.MaxFailedAttempts = 2
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxFailedAttempts"})
+	require.NoError(err)
+	assert.Equal(uint32(2), authMethod.MaxFailedAttempts)
+
+	loginName, passwd := "kazmierczak", "12345678"
+	acct := TestAccount(t, conn, authMethod.PublicId, loginName, WithPassword(passwd))
+
+	failedAttempts := func() uint32 {
+		var count uint32
+		rows, err := rw.Query(ctx, "select failed_attempt_count from auth_password_account_lockout where password_account_id = ?", []interface{}{acct.PublicId})
+		require.NoError(err)
+		defer rows.Close()
+		for rows.Next() {
+			require.NoError(rows.Scan(&count))
+		}
+		return count
+	}
+
+	// A failed attempt is recorded and cleared by a successful attempt
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "wrong password")
+	require.NoError(err)
+	assert.Nil(authAcct)
+	assert.Equal(uint32(1), failedAttempts())
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	require.NotNil(authAcct)
+	assert.Equal(uint32(0), failedAttempts())
+
+	// The account is locked after too many failed attempts, and stays locked
+	// until unlocked since no lockout duration is set
+	for i := 0; i < 2; i++ {
+		authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "wrong password")
+		require.NoError(err)
+		assert.Nil(authAcct)
+	}
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	assert.Nil(authAcct, "locked account authenticated")
+	assert.Equal(uint32(2), failedAttempts(), "attempts against a locked account are not recorded")
+
+	unlocked, err := repo.UnlockAccount(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(acct.PublicId, unlocked.PublicId)
+	assert.Equal(uint32(0), failedAttempts())
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+
+	// Attempts are rejected until the delay after the last failed attempt
+	// has passed
+	authMethod.MaxFailedAttempts = 0
+	authMethod.FailedAttemptDelay = 3600
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxFailedAttempts", "FailedAttemptDelay"})
+	require.NoError(err)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "wrong password")
+	require.NoError(err)
+	assert.Nil(authAcct)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	assert.Nil(authAcct, "authenticated before the delay passed")
+
+	_, err = repo.UnlockAccount(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.UnlockAccount(ctx, "acctpw_1234567890")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+}
+
+func TestRepository_PasswordPolicy(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	authMethod.RequireUppercase = true
+	authMethod.RequireDigit = true
+	authMethod.PasswordHistoryCount = 3
+	authMethod.DisallowedPasswords = []string{"Passw0rd1", "", "PASSW0RD1", "Letme1n"}
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version,
+		[]string{"RequireUppercase", "RequireDigit", "PasswordHistoryCount", "DisallowedPasswords"})
+	require.NoError(err)
+	assert.True(authMethod.RequireUppercase)
+	assert.False(authMethod.RequireLowercase)
+	assert.Empty(authMethod.DisallowedPasswords, "disallowed passwords are not returned")
+
+	wantErr := func(code errors.Code, err error) {
+		t.Helper()
+		assert.Truef(errors.Match(errors.T(code), err), "want err code: %q got: %q", code, err)
+	}
+
+	loginName := "kazmierczak"
+	_, err = repo.CreateAccount(ctx, o.GetPublicId(), &Account{Account: &store.Account{AuthMethodId: authMethod.PublicId, LoginName: loginName}}, WithPassword("password1"))
+	wantErr(errors.PasswordTooWeak, err)
+	_, err = repo.CreateAccount(ctx, o.GetPublicId(), &Account{Account: &store.Account{AuthMethodId: authMethod.PublicId, LoginName: loginName}}, WithPassword("pAsSw0rD1"))
+	wantErr(errors.PasswordDisallowed, err)
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{Account: &store.Account{AuthMethodId: authMethod.PublicId, LoginName: loginName}}, WithPassword("Password1"))
+	require.NoError(err)
+
+	// The current password and the previous passwords within the history
+	// count cannot be reused
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Password2", acct.Version)
+	require.NoError(err)
+	acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Password2", "Password3", acct.Version)
+	require.NoError(err)
+	for _, pw := range []string{"Password1", "Password2"} {
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, pw, acct.Version)
+		wantErr(errors.PasswordReused, err)
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Password3", pw, acct.Version)
+		wantErr(errors.PasswordReused, err)
+	}
+	_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Password3", acct.Version)
+	wantErr(errors.PasswordReused, err)
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Password3", "letme1n", acct.Version)
+	wantErr(errors.PasswordTooWeak, err)
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Password3", "LETME1N", acct.Version)
+	wantErr(errors.PasswordDisallowed, err)
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Password4", acct.Version)
+	require.NoError(err)
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Password1", acct.Version)
+	require.NoError(err, "the oldest password is no longer in the history")
+
+	// Clearing the disallowed passwords allows them to be set
+	authMethod.DisallowedPasswords = nil
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"DisallowedPasswords"})
+	require.NoError(err)
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Letme1n", acct.Version)
+	require.NoError(err)
+
+	// A password within the maximum password age authenticates; expired
+	// passwords are covered by TestPasswordExpired
+	authMethod.MaxPasswordAgeDays = 30
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxPasswordAgeDays"})
+	require.NoError(err)
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "Letme1n")
+	require.NoError(err)
+	assert.NotNil(authAcct)
+}
+
+func TestRepository_AuthenticateExpiredPassword(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	authMethod.MaxPasswordAgeDays = 30
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxPasswordAgeDays"})
+	require.NoError(err)
+
+	loginName := "kazmierczak"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{Account: &store.Account{AuthMethodId: authMethod.PublicId, LoginName: loginName}}, WithPassword("password1"))
+	require.NoError(err)
+
+	// the create time of a credential is immutable, so the trigger is
+	// disabled to age the password
+	_, err = rw.Exec(ctx, "alter table auth_password_argon2_cred disable trigger immutable_columns", nil)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, "update auth_password_argon2_cred set create_time = now() - interval '31 days' where password_account_id = ?", []interface{}{acct.PublicId})
+	require.NoError(err)
+	_, err = rw.Exec(ctx, "alter table auth_password_argon2_cred enable trigger immutable_columns", nil)
+	require.NoError(err)
+
+	got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password1")
+	assert.Truef(errors.Match(errors.T(errors.PasswordExpired), err), "want err code: %q got: %q", errors.PasswordExpired, err)
+	assert.Nil(got)
+
+	// a new password which doesn't meet the policy doesn't replace it
+	_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password1", WithNewPassword("password1"))
+	assert.Truef(errors.Match(errors.T(errors.PasswordsEqual), err), "want err code: %q got: %q", errors.PasswordsEqual, err)
+	_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password1", WithNewPassword("short"))
+	assert.Truef(errors.Match(errors.T(errors.PasswordTooShort), err), "want err code: %q got: %q", errors.PasswordTooShort, err)
+
+	// the wrong password doesn't replace it either
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "wrong-password", WithNewPassword("password2"))
+	require.NoError(err)
+	assert.Nil(got)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password1", WithNewPassword("password2"))
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(acct.PublicId, got.PublicId)
+	assert.Equal(acct.Version+1, got.Version)
+	assert.NotEqual(acct.CredentialId, got.CredentialId)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password1")
+	require.NoError(err)
+	assert.Nil(got)
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "password2")
+	require.NoError(err)
+	assert.NotNil(got)
+}
+
 func TestRepository_ChangePassword(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -554,3 +769,141 @@ func TestRepository_SetPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_AuthenticateTotp(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	loginName, passwd := "kazmierczak", "12345678"
+	acct := TestAccount(t, conn, authMethod.PublicId, loginName, WithPassword(passwd))
+
+	// An unconfirmed enrollment is not required to authenticate
+	_, enrollment, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(err)
+	assert.Contains(enrollment.Uri, "otpauth://totp/Boundary:"+loginName)
+	assert.Len(enrollment.RecoveryCodes, recoveryCodeCount)
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+
+	secret, err := totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(err)
+	step := time.Now().Unix() / totpPeriod
+
+	// A code of a time step outside the accepted skew is wrong
+	confirmed, err := repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(secret, step-10))
+	require.NoError(err)
+	assert.Nil(confirmed, "confirmed with a wrong code")
+	confirmed, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(secret, step))
+	require.NoError(err)
+	require.NotNil(confirmed)
+
+	// Once confirmed, a code is required
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.MfaRequired), err), "want err code: %q got: %q", errors.MfaRequired, err)
+	assert.Nil(authAcct)
+
+	// A code which was already used is rejected
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithTotpCode(totpCode(secret, step)))
+	require.NoError(err)
+	assert.Nil(authAcct, "authenticated with a used code")
+
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithTotpCode(totpCode(secret, step+1)))
+	require.NoError(err)
+	assert.NotNil(authAcct)
+
+	// A recovery code can be used once
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithRecoveryCode(enrollment.RecoveryCodes[0]))
+	require.NoError(err)
+	assert.NotNil(authAcct)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithRecoveryCode(enrollment.RecoveryCodes[0]))
+	require.NoError(err)
+	assert.Nil(authAcct, "authenticated with a used recovery code")
+
+	// Replacing the enrollment requires a valid current code, and the
+	// confirmed secret and recovery codes are kept until the replacement is
+	// confirmed
+	_, _, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.MfaRequired), err), "want err code: %q got: %q", errors.MfaRequired, err)
+	_, replacement, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId, WithRecoveryCode(enrollment.RecoveryCodes[0]))
+	require.NoError(err)
+	assert.Nil(replacement, "enrolled with a used recovery code")
+	_, replacement, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId, WithRecoveryCode(enrollment.RecoveryCodes[1]))
+	require.NoError(err)
+	require.NotNil(replacement)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithRecoveryCode(enrollment.RecoveryCodes[2]))
+	require.NoError(err)
+	assert.NotNil(authAcct)
+	replacementSecret, err := totpEncoding.DecodeString(replacement.Secret)
+	require.NoError(err)
+	confirmed, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(replacementSecret, step))
+	require.NoError(err)
+	require.NotNil(confirmed)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithRecoveryCode(enrollment.RecoveryCodes[3]))
+	require.NoError(err)
+	assert.Nil(authAcct, "authenticated with a recovery code of the replaced enrollment")
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithRecoveryCode(replacement.RecoveryCodes[0]))
+	require.NoError(err)
+	assert.NotNil(authAcct)
+
+	// Removing the enrollment requires a valid current code
+	_, err = repo.RemoveTotp(ctx, o.GetPublicId(), acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.MfaRequired), err), "want err code: %q got: %q", errors.MfaRequired, err)
+	removed, err := repo.RemoveTotp(ctx, o.GetPublicId(), acct.PublicId, WithRecoveryCode(enrollment.RecoveryCodes[4]))
+	require.NoError(err)
+	assert.Nil(removed, "removed with a recovery code of the replaced enrollment")
+	removed, err = repo.RemoveTotp(ctx, o.GetPublicId(), acct.PublicId, WithRecoveryCode(replacement.RecoveryCodes[1]))
+	require.NoError(err)
+	require.NotNil(removed)
+
+	// The auth method can require accounts to be enrolled
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+	authMethod.RequireMfa = true
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"RequireMfa"})
+	require.NoError(err)
+	assert.True(authMethod.RequireMfa)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.MfaNotEnrolled), err), "want err code: %q got: %q", errors.MfaNotEnrolled, err)
+	assert.Nil(authAcct)
+
+	// An account which is required to be enrolled enrolls with its password
+	// and confirms the enrollment by authenticating with a code
+	enrolledAcct, _, err := repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, loginName, "wrong password")
+	require.NoError(err)
+	assert.Nil(enrolledAcct, "enrolled with a wrong password")
+	enrolledAcct, enrollment, err = repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	require.NoError(err)
+	require.NotNil(enrolledAcct)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.MfaNotEnrolled), err), "want err code: %q got: %q", errors.MfaNotEnrolled, err)
+	assert.Nil(authAcct)
+	secret, err = totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(err)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithTotpCode(totpCode(secret, step-10)))
+	require.NoError(err)
+	assert.Nil(authAcct, "authenticated with a wrong code")
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd, WithTotpCode(totpCode(secret, step)))
+	require.NoError(err)
+	assert.NotNil(authAcct)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.MfaRequired), err), "want err code: %q got: %q", errors.MfaRequired, err)
+	assert.Nil(authAcct)
+	_, _, err = repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, loginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.MfaRequired), err), "want err code: %q got: %q", errors.MfaRequired, err)
+
+	_, _, err = repo.EnrollTotp(ctx, o.GetPublicId(), "acctpw_1234567890")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+	_, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, "123456")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+}
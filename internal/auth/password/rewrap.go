@@ -0,0 +1,201 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("auth_password_argon2_cred", argon2CredentialRewrapFn)
+	kms.RegisterTableRewrapFn("auth_password_argon2_cred_history", argon2CredentialHistoryRewrapFn)
+	kms.RegisterTableRewrapFn("auth_password_totp_secret", totpSecretRewrapFn)
+	kms.RegisterTableRewrapFn("auth_password_totp_pending_secret", pendingTotpSecretRewrapFn)
+}
+
+// rewrapWrappers returns the wrapper for the database key version
+// dataKeyVersionId and the wrapper for the current database key of the scope.
+func rewrapWrappers(ctx context.Context, dataKeyVersionId, scopeId string, kmsCache *kms.Kms) (old, current wrapping.Wrapper, err error) {
+	const op = "password.rewrapWrappers"
+	if old, err = kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId)); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	if current, err = kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	return old, current, nil
+}
+
+func argon2CredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.argon2CredentialRewrapFn"
+	var creds []*Argon2Credential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list argon2 credentials"))
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	old, current, err := rewrapWrappers(ctx, dataKeyVersionId, scopeId, kmsCache)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range creds {
+				if err := c.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := c.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Update(ctx, c, []string{"CtSalt", "KeyId"}, nil)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update argon2 credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 argon2 credential would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func argon2CredentialHistoryRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.argon2CredentialHistoryRewrapFn"
+	rows, err := reader.Query(ctx, listArgon2CredentialHistoryByKeyIdQuery, []interface{}{sql.Named("key_id", dataKeyVersionId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list previous argon2 credentials"))
+	}
+	var creds []*Argon2Credential
+	for rows.Next() {
+		c := &Argon2Credential{Argon2Credential: &store.Argon2Credential{}}
+		if err := rows.Scan(&c.PrivateId, &c.CtSalt); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c)
+	}
+	rows.Close()
+	if len(creds) == 0 {
+		return nil
+	}
+	old, current, err := rewrapWrappers(ctx, dataKeyVersionId, scopeId, kmsCache)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range creds {
+				if err := c.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := c.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, rewrapArgon2CredentialHistoryQuery, []interface{}{
+					sql.Named("salt", c.CtSalt),
+					sql.Named("key_id", c.KeyId),
+					sql.Named("private_id", c.PrivateId),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update previous argon2 credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 previous argon2 credential would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func totpSecretRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.totpSecretRewrapFn"
+	if err := rewrapTotpSecrets(ctx, dataKeyVersionId, scopeId, reader, writer, kmsCache, listTotpSecretsByKeyIdQuery, rewrapTotpSecretQuery); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func pendingTotpSecretRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.pendingTotpSecretRewrapFn"
+	if err := rewrapTotpSecrets(ctx, dataKeyVersionId, scopeId, reader, writer, kmsCache, listPendingTotpSecretsByKeyIdQuery, rewrapPendingTotpSecretQuery); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// rewrapTotpSecrets rewraps the TOTP secrets encrypted with the database key
+// version dataKeyVersionId which are listed by listQuery, updating them with
+// rewrapQuery. The queries are those of either the confirmed or the pending
+// secrets.
+func rewrapTotpSecrets(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms, listQuery, rewrapQuery string) error {
+	const op = "password.rewrapTotpSecrets"
+	rows, err := reader.Query(ctx, listQuery, []interface{}{sql.Named("key_id", dataKeyVersionId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list totp secrets"))
+	}
+	type accountSecret struct {
+		accountId string
+		secret    *totpSecret
+	}
+	var secrets []accountSecret
+	for rows.Next() {
+		s := accountSecret{secret: &totpSecret{}}
+		if err := rows.Scan(&s.accountId, &s.secret.CtSecret); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		secrets = append(secrets, s)
+	}
+	rows.Close()
+	if len(secrets) == 0 {
+		return nil
+	}
+	old, current, err := rewrapWrappers(ctx, dataKeyVersionId, scopeId, kmsCache)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, s := range secrets {
+				if err := s.secret.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := s.secret.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, rewrapQuery, []interface{}{
+					sql.Named("secret", s.secret.CtSecret),
+					sql.Named("key_id", s.secret.KeyId),
+					sql.Named("account_id", s.accountId),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update totp secret"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 totp secret would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,616 @@
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+// The TOTP parameters, from RFC 6238, are the defaults of authenticator apps.
+const (
+	totpIssuer       = "Boundary"
+	totpPeriod       = 30
+	totpDigits       = 6
+	totpSecretLength = 20
+
+	// totpSkew is the number of time steps before and after the current one
+	// for which TOTP codes are accepted, to allow for clock drift.
+	totpSkew = 1
+
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TotpEnrollment is a TOTP secret enrolled for an account along with its
+// recovery codes. It is only available when the secret is enrolled.
+type TotpEnrollment struct {
+	// Uri is the otpauth URI of the secret, which authenticator apps can
+	// import directly or from a QR code.
+	Uri string
+	// Secret is the base32 encoded secret.
+	Secret string
+	// RecoveryCodes are single use codes which can be provided instead of a
+	// TOTP code.
+	RecoveryCodes []string
+}
+
+// totpSecret is the confirmed or pending TOTP secret enrolled for an account.
+type totpSecret struct {
+	Secret       []byte `wrapping:"pt,secret"`
+	CtSecret     []byte `wrapping:"ct,secret"`
+	KeyId        string
+	LastUsedStep int64
+}
+
+func (s *totpSecret) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totpSecret).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, s, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	s.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (s *totpSecret) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totpSecret).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, s, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// totpCode returns the TOTP code of secret for the time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// validTotpStep returns the time step, within totpSkew steps of now and
+// after lastUsedStep, for which code is the TOTP code of secret. False is
+// returned if there is no such step.
+func validTotpStep(secret []byte, code string, now time.Time, lastUsedStep int64) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpUri returns the otpauth URI of secret for the account loginName.
+func totpUri(loginName string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", totpEncoding.EncodeToString(secret))
+	v.Set("issuer", totpIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(totpPeriod))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + loginName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// newRecoveryCodes returns recoveryCodeCount random recovery codes.
+func newRecoveryCodes() ([]string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
+	codes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, recoveryCodeLength)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		var b strings.Builder
+		for j, c := range buf {
+			if j == recoveryCodeLength/2 {
+				b.WriteByte('-')
+			}
+			b.WriteByte(alphabet[int(c)%len(alphabet)])
+		}
+		codes = append(codes, b.String())
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode returns the hash of code as stored in the database. Case,
+// dashes and spaces are ignored.
+func hashRecoveryCode(code string) []byte {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
+
+// EnrollTotp generates a new TOTP secret and new recovery codes for
+// accountId. The secret is encrypted with the database key of scopeId. The
+// secret is pending, replacing any previous pending secret of the account,
+// and is not used to authenticate the account until it is confirmed with
+// ConfirmTotp. The confirmed secret and the recovery codes of the account, if
+// any, keep being used until then.
+//
+// If the account has a confirmed TOTP secret, its current TOTP code must be
+// provided with WithTotpCode, or one of its recovery codes with
+// WithRecoveryCode, and a failed attempt is recorded for the account if the
+// code is not valid.
+//
+// Returns nil, nil, nil if the code is not valid or the account is locked.
+// Returns nil, nil, error with code MfaRequired if the account has a
+// confirmed TOTP secret and neither code was provided.
+// Returns nil, nil, error with code RecordNotFound if the account doesn't
+// exist.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string, opt ...Option) (*Account, *TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if scopeId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	ok, err := r.verifyCurrentSecondFactor(ctx, scopeId, accountId, getOpts(opt...))
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		return nil, nil, nil
+	}
+	enrollment, err := r.enrollTotp(ctx, scopeId, acct)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, enrollment, nil
+}
+
+// EnrollTotpWithPassword enrolls a pending TOTP secret, as EnrollTotp does,
+// for the account with loginName in authMethodId, which is authenticated with
+// its password instead of a second factor. This allows the accounts of an
+// auth method which requires multi-factor authentication to enroll, as they
+// cannot be authenticated before they have a confirmed TOTP secret. The
+// secret is confirmed by calling Authenticate with a TOTP code generated from
+// it.
+//
+// Returns nil, nil, nil if loginName and password don't match.
+// Returns nil, nil, error with code MfaRequired if loginName and password
+// match but the account already has a confirmed TOTP secret, which can only
+// be replaced with EnrollTotp.
+func (r *Repository) EnrollTotpWithPassword(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, *TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotpWithPassword"
+	if authMethodId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
+	}
+	if loginName == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing loginName", errors.WithoutEvent())
+	}
+	if password == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing password", errors.WithoutEvent())
+	}
+	if scopeId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scopeId", errors.WithoutEvent())
+	}
+	acct, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, nil, nil
+	}
+	if acct.TotpConfirmed {
+		return nil, nil, errors.New(ctx, errors.MfaRequired, op, "account already has a confirmed totp secret", errors.WithoutEvent())
+	}
+	enrollment, err := r.enrollTotp(ctx, scopeId, acct.Account)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return acct.Account, enrollment, nil
+}
+
+// enrollTotp generates a new TOTP secret and new recovery codes for acct and
+// stores them as its pending enrollment.
+func (r *Repository) enrollTotp(ctx context.Context, scopeId string, acct *Account) (*TotpEnrollment, error) {
+	const op = "password.(Repository).enrollTotp"
+	secret := &totpSecret{Secret: make([]byte, totpSecretLength)}
+	if _, err := rand.Read(secret.Secret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate totp secret"))
+	}
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate recovery codes"))
+	}
+	enrollment := &TotpEnrollment{
+		Uri:           totpUri(acct.GetLoginName(), secret.Secret),
+		Secret:        totpEncoding.EncodeToString(secret.Secret),
+		RecoveryCodes: codes,
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := secret.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	accountId := acct.GetPublicId()
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deletePendingTotpSecretQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete previous pending totp secret"))
+			}
+			if _, err := w.Exec(ctx, insertPendingTotpSecretQuery, []interface{}{
+				sql.Named("account_id", accountId),
+				sql.Named("secret", secret.CtSecret),
+				sql.Named("key_id", secret.KeyId),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create pending totp secret"))
+			}
+			for _, c := range codes {
+				if _, err := w.Exec(ctx, insertPendingRecoveryCodeQuery, []interface{}{
+					sql.Named("account_id", accountId),
+					sql.Named("code_hash", hashRecoveryCode(c)),
+				}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create pending recovery code"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return enrollment, nil
+}
+
+// ConfirmTotp confirms the pending TOTP secret of accountId if code is its
+// current TOTP code. The secret and its recovery codes replace the confirmed
+// secret and the recovery codes of the account, if any. Once confirmed, the
+// account must provide a TOTP code or a recovery code to be authenticated.
+// The account is returned.
+//
+// Returns nil, nil if code is not valid.
+// Returns nil, error with code RecordNotFound if the account doesn't exist or
+// has no pending TOTP secret.
+func (r *Repository) ConfirmTotp(ctx context.Context, scopeId, accountId, code string) (*Account, error) {
+	const op = "password.(Repository).ConfirmTotp"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if code == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing totp code")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	ok, err := r.confirmTotpSecret(ctx, scopeId, accountId, code)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return acct, nil
+}
+
+// RemoveTotp deletes the confirmed and the pending TOTP secrets and the
+// recovery codes of accountId. The account is returned.
+//
+// If the account has a confirmed TOTP secret, its current TOTP code must be
+// provided with WithTotpCode, or one of its recovery codes with
+// WithRecoveryCode, and a failed attempt is recorded for the account if the
+// code is not valid.
+//
+// Returns nil, nil if the code is not valid or the account is locked.
+// Returns nil, error with code MfaRequired if the account has a confirmed
+// TOTP secret and neither code was provided.
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) RemoveTotp(ctx context.Context, scopeId, accountId string, opt ...Option) (*Account, error) {
+	const op = "password.(Repository).RemoveTotp"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	ok, err := r.verifyCurrentSecondFactor(ctx, scopeId, accountId, getOpts(opt...))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		return nil, nil
+	}
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteTotpSecretQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete totp secret"))
+			}
+			if _, err := w.Exec(ctx, deletePendingTotpSecretQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete pending totp secret"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, nil
+}
+
+// lookupTotpSecret returns the TOTP secret of accountId read with query,
+// which is either the confirmed or the pending secret of the account,
+// decrypted with the database key of scopeId.
+//
+// Returns nil, error with code RecordNotFound if the account has no such
+// secret.
+func (r *Repository) lookupTotpSecret(ctx context.Context, scopeId, accountId, query string) (*totpSecret, error) {
+	const op = "password.(Repository).lookupTotpSecret"
+	rows, err := r.reader.Query(ctx, query, []interface{}{sql.Named("account_id", accountId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var secret *totpSecret
+	for rows.Next() {
+		secret = &totpSecret{}
+		if err := rows.Scan(&secret.CtSecret, &secret.KeyId, &secret.LastUsedStep); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if secret == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no totp secret enrolled")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(secret.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := secret.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decrypt totp secret"))
+	}
+	return secret, nil
+}
+
+// useTotpCode reports whether code is a TOTP code of the confirmed secret of
+// accountId which has not been used yet. A valid code is marked as used.
+//
+// Returns false, error with code RecordNotFound if the account has no
+// confirmed TOTP secret.
+func (r *Repository) useTotpCode(ctx context.Context, scopeId, accountId, code string) (bool, error) {
+	const op = "password.(Repository).useTotpCode"
+	secret, err := r.lookupTotpSecret(ctx, scopeId, accountId, lookupTotpSecretQuery)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	step, ok := validTotpStep(secret.Secret, code, time.Now(), secret.LastUsedStep)
+	if !ok {
+		return false, nil
+	}
+	// The update only succeeds for the first of concurrent requests using
+	// the same code.
+	rowsUpdated, err := r.writer.Exec(ctx, useTotpStepQuery, []interface{}{
+		sql.Named("account_id", accountId),
+		sql.Named("step", step),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsUpdated == 1, nil
+}
+
+// confirmTotpSecret reports whether code is a TOTP code of the pending secret
+// of accountId. If so, the pending secret and its recovery codes replace the
+// confirmed secret and the recovery codes of the account, and the code is
+// marked as used.
+//
+// Returns false, error with code RecordNotFound if the account has no pending
+// TOTP secret.
+func (r *Repository) confirmTotpSecret(ctx context.Context, scopeId, accountId, code string) (bool, error) {
+	const op = "password.(Repository).confirmTotpSecret"
+	secret, err := r.lookupTotpSecret(ctx, scopeId, accountId, lookupPendingTotpSecretQuery)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	step, ok := validTotpStep(secret.Secret, code, time.Now(), secret.LastUsedStep)
+	if !ok {
+		return false, nil
+	}
+	args := []interface{}{
+		sql.Named("account_id", accountId),
+		sql.Named("step", step),
+	}
+	var confirmed bool
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			// The secret is only confirmed for the first of concurrent
+			// requests confirming the same pending secret.
+			rowsInserted, err := w.Exec(ctx, confirmTotpSecretQuery, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to confirm totp secret"))
+			}
+			if confirmed = rowsInserted == 1; !confirmed {
+				return nil
+			}
+			if _, err := w.Exec(ctx, deleteRecoveryCodesQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete previous recovery codes"))
+			}
+			if _, err := w.Exec(ctx, confirmRecoveryCodesQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to confirm recovery codes"))
+			}
+			if _, err := w.Exec(ctx, deletePendingTotpSecretQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete pending totp secret"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return confirmed, nil
+}
+
+// useRecoveryCode reports whether code is an unused recovery code of
+// accountId, deleting it if so.
+func (r *Repository) useRecoveryCode(ctx context.Context, accountId, code string) (bool, error) {
+	const op = "password.(Repository).useRecoveryCode"
+	rowsDeleted, err := r.writer.Exec(ctx, useRecoveryCodeQuery, []interface{}{
+		sql.Named("account_id", accountId),
+		sql.Named("code_hash", hashRecoveryCode(code)),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted == 1, nil
+}
+
+// verifySecondFactor verifies the TOTP code or the recovery code in opts for
+// acct, whose password matched. A failed attempt is recorded for the account
+// if the code is not valid.
+//
+// If the auth method of the account requires multi-factor authentication and
+// the account has no confirmed TOTP secret, the TOTP code in opts confirms
+// the pending secret of the account, enrolled with EnrollTotpWithPassword.
+//
+// Returns false, error with code MfaRequired if the account is enrolled for
+// TOTP and opts has no code, or with code MfaNotEnrolled if the auth method
+// of the account requires multi-factor authentication and the account has
+// neither a confirmed TOTP secret nor a pending one with a TOTP code in opts.
+func (r *Repository) verifySecondFactor(ctx context.Context, scopeId string, acct *authAccount, opts options) (bool, error) {
+	const op = "password.(Repository).verifySecondFactor"
+	switch {
+	case !acct.TotpConfirmed && acct.RequireMfa && opts.withTotpCode == "":
+		return false, errors.New(ctx, errors.MfaNotEnrolled, op, "multi-factor authentication required but account not enrolled", errors.WithoutEvent())
+	case !acct.TotpConfirmed && !acct.RequireMfa:
+		return true, nil
+	case opts.withTotpCode == "" && opts.withRecoveryCode == "":
+		return false, errors.New(ctx, errors.MfaRequired, op, "totp code or recovery code required", errors.WithoutEvent())
+	}
+
+	var ok bool
+	var err error
+	switch {
+	case !acct.TotpConfirmed:
+		ok, err = r.confirmTotpSecret(ctx, scopeId, acct.PublicId, opts.withTotpCode)
+		if errors.IsNotFoundError(err) {
+			return false, errors.New(ctx, errors.MfaNotEnrolled, op, "multi-factor authentication required but account not enrolled", errors.WithoutEvent())
+		}
+	case opts.withRecoveryCode != "":
+		ok, err = r.useRecoveryCode(ctx, acct.PublicId, opts.withRecoveryCode)
+	default:
+		ok, err = r.useTotpCode(ctx, scopeId, acct.PublicId, opts.withTotpCode)
+	}
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		if err := r.recordFailedAttempt(ctx, acct, time.Now()); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+	}
+	return ok, nil
+}
+
+// verifyCurrentSecondFactor verifies the TOTP code or the recovery code in
+// opts for accountId before its TOTP enrollment is changed, as
+// verifySecondFactor does when authenticating the account. An account
+// without a confirmed TOTP secret has no second factor to verify. Attempts
+// are rejected while the account is locked or until the delay after its last
+// failed attempt has passed.
+//
+// Returns false, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) verifyCurrentSecondFactor(ctx context.Context, scopeId, accountId string, opts options) (bool, error) {
+	const op = "password.(Repository).verifyCurrentSecondFactor"
+	rows, err := r.reader.Query(ctx, totpAccountQuery, []interface{}{sql.Named("account_id", accountId)})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var acct *authAccount
+	for rows.Next() {
+		var aa authAccount
+		if err := r.reader.ScanRows(rows, &aa); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		acct = &aa
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case acct == nil:
+		return false, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	case !acct.TotpConfirmed:
+		return true, nil
+	case !attemptAllowed(ctx, op, acct, time.Now()):
+		return false, nil
+	}
+	ok, err := r.verifySecondFactor(ctx, scopeId, acct, opts)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if ok && acct.Lockout.FailedAttemptCount > 0 {
+		if err := r.clearFailedAttempts(ctx, acct.PublicId); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+	}
+	return ok, nil
+}
@@ -0,0 +1,134 @@
+package password
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotpCode(t *testing.T) {
+	t.Parallel()
+	// The SHA1 test vectors of RFC 6238, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, totpCode(secret, tt.unix/totpPeriod), "time %d", tt.unix)
+	}
+}
+
+func TestValidTotpStep(t *testing.T) {
+	t.Parallel()
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOk       bool
+	}{
+		{
+			name:     "current step",
+			code:     totpCode(secret, current),
+			wantStep: current,
+			wantOk:   true,
+		},
+		{
+			name:     "previous step",
+			code:     totpCode(secret, current-1),
+			wantStep: current - 1,
+			wantOk:   true,
+		},
+		{
+			name:     "next step",
+			code:     totpCode(secret, current+1),
+			wantStep: current + 1,
+			wantOk:   true,
+		},
+		{
+			name: "outside skew",
+			code: totpCode(secret, current-2),
+		},
+		{
+			name:         "already used",
+			code:         totpCode(secret, current),
+			lastUsedStep: current,
+		},
+		{
+			name:     "with spaces",
+			code:     " 005 924 ",
+			wantStep: current,
+			wantOk:   true,
+		},
+		{
+			name: "wrong length",
+			code: "05924",
+		},
+		{
+			name: "wrong code",
+			code: "000000",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			step, ok := validTotpStep(secret, tt.code, now, tt.lastUsedStep)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+}
+
+func TestTotpUri(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	secret := []byte("12345678901234567890")
+
+	u, err := url.Parse(totpUri("jim@example.com", secret))
+	require.NoError(err)
+	assert.Equal("otpauth", u.Scheme)
+	assert.Equal("totp", u.Host)
+	assert.Equal("/Boundary:jim@example.com", u.Path)
+	q := u.Query()
+	assert.Equal("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", q.Get("secret"))
+	assert.Equal("Boundary", q.Get("issuer"))
+	assert.Equal("SHA1", q.Get("algorithm"))
+	assert.Equal("6", q.Get("digits"))
+	assert.Equal("30", q.Get("period"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	codes, err := newRecoveryCodes()
+	require.NoError(err)
+	require.Len(codes, recoveryCodeCount)
+	seen := map[string]bool{}
+	for _, c := range codes {
+		assert.Regexp(regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`), c)
+		assert.False(seen[c], "duplicate recovery code")
+		seen[c] = true
+	}
+
+	assert.Equal(hashRecoveryCode("abcde-fghij"), hashRecoveryCode("ABCDE FGHIJ"))
+	assert.Equal(hashRecoveryCode("abcde-fghij"), hashRecoveryCode("abcdefghij"))
+	assert.NotEqual(hashRecoveryCode("abcde-fghij"), hashRecoveryCode("abcde-fghik"))
+}
@@ -31,6 +31,8 @@ type AuthMethod interface {
 	boundary.Resource
 	GetScopeId() string
 	GetIsPrimaryAuthMethod() bool
+	GetAuthTokenTimeToLive() uint32
+	GetAuthTokenTimeToStale() uint32
 }
 
 type Account interface {
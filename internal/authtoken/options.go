@@ -29,8 +29,10 @@ type options struct {
 	withTokenTimeToLiveDuration  time.Duration
 	withTokenTimeToStaleDuration time.Duration
 	withLimit                    int
+	withStartPageAfterItem       *db.PageItem
 	withStatus                   Status
 	withPublicId                 string
+	withAuthAccountId            string
 }
 
 func getDefaultOptions() options {
@@ -78,6 +80,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithStatus allows setting of the auth token's Status.
 func WithStatus(status Status) Option {
 	return func(o *options) {
@@ -91,3 +102,11 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithAuthAccountId restricts the auth tokens revoked to those issued for the
+// auth account id.
+func WithAuthAccountId(id string) Option {
+	return func(o *options) {
+		o.withAuthAccountId = id
+	}
+}
@@ -50,4 +50,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPublicId = "test-id"
 		assert.Equal(opts, testOpts)
 	})
+
+	t.Run("WithAuthAccountId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuthAccountId("acct-id"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthAccountId = "acct-id"
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,61 @@
+package authtoken
+
+const (
+	// cancelableSessionsQuery selects the pending or active sessions created
+	// with the auth tokens of the user @user_id, or only with those issued
+	// for the auth account @account_id if it isn't empty.
+	cancelableSessionsQuery = `
+select s.public_id
+  from session s
+  join session_state ss
+    on ss.session_id = s.public_id
+   and ss.end_time is null
+ where ss.state in ('pending', 'active')
+   and s.auth_token_id in (
+         select at.public_id
+           from auth_token at
+           join auth_account aa
+             on aa.public_id = at.auth_account_id
+          where aa.iam_user_id = @user_id
+            and (aa.public_id = @account_id or @account_id = '')
+       )
+ order by s.public_id;
+`
+
+	// revokeAuthTokensQuery deletes the auth tokens of the user @user_id, or
+	// only those issued for the auth account @account_id if it isn't empty.
+	// Deleting a token sets the auth_token_id of its sessions to null, which
+	// cancels them.
+	revokeAuthTokensQuery = `
+delete from auth_token
+ where auth_account_id in (
+         select public_id
+           from auth_account
+          where iam_user_id = @user_id
+            and (public_id = @account_id or @account_id = '')
+       )
+returning public_id;
+`
+
+	// authTokenWithSettingQuery selects the auth token @public_id from the
+	// auth_token_account view with the auth token time to live and time to
+	// stale of the auth method which issued it, as authTokenSettingQuery.
+	authTokenWithSettingQuery = `
+select at.*,
+       s.time_to_live,
+       s.time_to_stale
+  from auth_token_account at
+  left outer join auth_token_setting s
+    on s.auth_method_id = at.auth_method_id
+ where at.public_id = @public_id;
+`
+
+	// authTokenSettingQuery selects the auth token time to live and time to
+	// stale, in seconds, of the auth method @auth_method_id. Either is null if
+	// neither the auth method nor its scope sets it.
+	authTokenSettingQuery = `
+select time_to_live, time_to_stale
+  from auth_token_setting
+ where auth_method_id = @auth_method_id;
+`
+)
@@ -2,15 +2,18 @@ package authtoken
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/authtoken/store"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
 )
 
 var (
@@ -98,14 +101,6 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
 
-	// We truncate the expiration time to the nearest second to make testing in different platforms with
-	// different time resolutions easier.
-	expiration, err := ptypes.TimestampProto(time.Now().Add(r.timeToLiveDuration).Truncate(time.Second))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
-	}
-	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
-
 	var newAuthToken *AuthToken
 	_, err = r.writer.DoTx(
 		ctx,
@@ -125,6 +120,18 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 			at.AuthMethodId = acct.GetAuthMethodId()
 			at.IamUserId = acct.GetIamUserId()
 
+			timeToLive, _, err := r.authTokenSettings(ctx, read, at.AuthMethodId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// We truncate the expiration time to the nearest second to make testing in different platforms with
+			// different time resolutions easier.
+			expiration, err := ptypes.TimestampProto(time.Now().Add(timeToLive).Truncate(time.Second))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
+			}
+			at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+
 			newAuthToken = at.clone()
 			if err := newAuthToken.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -188,6 +195,12 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 // value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
 // All options are ignored.
 //
+// A token is valid until its expiration time and for as long as it is used
+// within the time to stale of the auth method which issued it. A token also
+// expires once it is older than the current time to live of its auth method,
+// so lowering the time to live of an auth method applies to the tokens it
+// already issued.
+//
 // NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
 func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ...Option) (*AuthToken, error) {
 	const op = "authtoken.(Repository).ValidateToken"
@@ -198,12 +211,8 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
 
-	retAT, err := r.LookupAuthToken(ctx, id, withTokenValue())
+	retAT, timeToLive, timeToStale, err := r.lookupAuthTokenWithSettings(ctx, id)
 	if err != nil {
-		retAT = nil
-		if errors.IsNotFoundError(err) {
-			return nil, nil
-		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if retAT == nil {
@@ -220,11 +229,19 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
 	}
 
+	created, err := ptypes.Timestamp(retAT.GetCreateTime().GetTimestamp())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("create time"), errors.WithCode(errors.InvalidTimeStamp))
+	}
+	if settingExp := created.Add(timeToLive); settingExp.Before(exp) {
+		exp = settingExp
+	}
+
 	now := time.Now()
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= r.timeToStaleDuration {
+	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= timeToStale {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -288,7 +305,7 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 }
 
 // ListAuthTokens lists auth tokens in the given scopes and supports the
-// WithLimit option.
+// WithLimit and WithStartPageAfterItem options.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
 	const op = "authtoken.(Repository).ListAuthTokens"
 	if len(withScopeIds) == 0 {
@@ -299,7 +316,7 @@ func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string,
 	// use the view, to bring in the required account columns. Just don't forget
 	// to convert them before returning them
 	var atvs []*authTokenView
-	if err := r.reader.SearchWhere(ctx, &atvs, "auth_account_id in (select public_id from auth_account where scope_id in (?))", []interface{}{withScopeIds}, db.WithLimit(opts.withLimit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &atvs, "auth_account_id in (select public_id from auth_account where scope_id in (?))", []interface{}{withScopeIds}, db.WithLimit(opts.withLimit), db.WithStartPageAfterItem(opts.withStartPageAfterItem)); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	authTokens := make([]*AuthToken, 0, len(atvs))
@@ -357,6 +374,173 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 	return rowsDeleted, nil
 }
 
+// RevokeAuthTokens deletes all the auth tokens of the user withIamUserId in a
+// single transaction, returning the ids of the deleted auth tokens and the ids
+// of the pending or active sessions created with them. Deleting an auth token
+// cancels the sessions created with it. The WithAuthAccountId option restricts
+// the revoked auth tokens to those issued for that auth account and all other
+// options are ignored.
+//
+// Note: no oplog entries are created for auth token operations (this is intentional).
+func (r *Repository) RevokeAuthTokens(ctx context.Context, withIamUserId string, opt ...Option) ([]string, []string, error) {
+	const op = "authtoken.(Repository).RevokeAuthTokens"
+	if withIamUserId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	opts := getOpts(opt...)
+	args := []interface{}{
+		sql.Named("user_id", withIamUserId),
+		sql.Named("account_id", opts.withAuthAccountId),
+	}
+
+	var authTokenIds, sessionIds []string
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			sessionIds, err = queryIds(ctx, w, cancelableSessionsQuery, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to find sessions to cancel"))
+			}
+			authTokenIds, err = queryIds(ctx, w, revokeAuthTokensQuery, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete auth tokens"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(withIamUserId))
+	}
+
+	err = event.WriteObservation(ctx, event.Op(op),
+		event.WithHeader(
+			"auth-token-revocation", struct {
+				UserId             string   `json:"user_id"`
+				AuthAccountId      string   `json:"auth_account_id,omitempty"`
+				AuthTokenIds       []string `json:"auth_token_ids"`
+				CanceledSessionIds []string `json:"canceled_session_ids"`
+			}{
+				UserId:             withIamUserId,
+				AuthAccountId:      opts.withAuthAccountId,
+				AuthTokenIds:       authTokenIds,
+				CanceledSessionIds: sessionIds,
+			}))
+	if err != nil {
+		event.WriteError(ctx, event.Op(op), err)
+	}
+	return authTokenIds, sessionIds, nil
+}
+
+// queryIds returns the ids in the single column of the rows returned by query.
+func queryIds(ctx context.Context, w db.Writer, query string, args []interface{}) ([]string, error) {
+	const op = "authtoken.queryIds"
+	rows, err := w.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// authTokenWithSettings is an auth token read from the auth_token_account view
+// with the auth token settings of the auth method which issued it.
+type authTokenWithSettings struct {
+	*store.AuthToken
+	TimeToLive  sql.NullInt64
+	TimeToStale sql.NullInt64
+}
+
+// lookupAuthTokenWithSettings returns the auth token id, with its token value,
+// and the time to live and time to stale of the auth tokens issued by its auth
+// method, as authTokenSettings, in a single query. A nil token is returned if
+// it doesn't exist.
+func (r *Repository) lookupAuthTokenWithSettings(ctx context.Context, id string) (*AuthToken, time.Duration, time.Duration, error) {
+	const op = "authtoken.(Repository).lookupAuthTokenWithSettings"
+	rows, err := r.reader.Query(ctx, authTokenWithSettingQuery, []interface{}{sql.Named("public_id", id)})
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var found *authTokenWithSettings
+	for rows.Next() {
+		ats := authTokenWithSettings{AuthToken: &store.AuthToken{}}
+		if err := r.reader.ScanRows(rows, &ats); err != nil {
+			return nil, 0, 0, errors.Wrap(ctx, err, op)
+		}
+		found = &ats
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, 0, errors.Wrap(ctx, err, op)
+	}
+	if found == nil {
+		return nil, 0, 0, nil
+	}
+
+	at := &AuthToken{AuthToken: found.AuthToken}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(at.GetKeyId()))
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := at.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, 0, 0, errors.Wrap(ctx, err, op)
+	}
+	at.CtToken = nil
+	at.KeyId = ""
+
+	timeToLive, timeToStale := r.timeToLiveDuration, r.timeToStaleDuration
+	if found.TimeToLive.Valid {
+		timeToLive = time.Duration(found.TimeToLive.Int64) * time.Second
+	}
+	if found.TimeToStale.Valid {
+		timeToStale = time.Duration(found.TimeToStale.Int64) * time.Second
+	}
+	return at, timeToLive, timeToStale, nil
+}
+
+// authTokenSettings returns the time to live and time to stale of the auth
+// tokens issued by authMethodId, which are the settings of the auth method or
+// of its scope. The settings of the repository are returned for the settings
+// neither of them sets.
+func (r *Repository) authTokenSettings(ctx context.Context, reader db.Reader, authMethodId string) (time.Duration, time.Duration, error) {
+	const op = "authtoken.(Repository).authTokenSettings"
+	timeToLive, timeToStale := r.timeToLiveDuration, r.timeToStaleDuration
+	rows, err := reader.Query(ctx, authTokenSettingQuery, []interface{}{sql.Named("auth_method_id", authMethodId)})
+	if err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var ttl, tts sql.NullInt64
+		if err := rows.Scan(&ttl, &tts); err != nil {
+			return 0, 0, errors.Wrap(ctx, err, op)
+		}
+		if ttl.Valid {
+			timeToLive = time.Duration(ttl.Int64) * time.Second
+		}
+		if tts.Valid {
+			timeToStale = time.Duration(tts.Int64) * time.Second
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op)
+	}
+	return timeToLive, timeToStale, nil
+}
+
 // IssueAuthToken will retrieve the "pending" token and update it's status to
 // "issued".  If the token has already been issued, an error is returned with a
 // nil token.  If no token is found for the tokenRequestId an error is returned
@@ -566,6 +566,81 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	}
 }
 
+func TestRepository_AuthTokenSettings(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	pwRepo, err := password.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	am := password.TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "name1")
+	u := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(acct.GetPublicId()))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	assertExpiresIn := func(t *testing.T, want time.Duration) *AuthToken {
+		t.Helper()
+		at, err := repo.CreateAuthToken(ctx, u, acct.GetPublicId())
+		require.NoError(t, err)
+		exp, err := ptypes.Timestamp(at.GetExpirationTime().GetTimestamp())
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(want), exp, 10*time.Second)
+		return at
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assertExpiresIn(t, defaultTokenTimeToLiveDuration)
+	})
+
+	org.AuthTokenTimeToLive = 8 * 60 * 60
+	org, _, err = iamRepo.UpdateScope(ctx, org, org.GetVersion(), []string{"AuthTokenTimeToLive"})
+	require.NoError(t, err)
+	t.Run("scope", func(t *testing.T) {
+		assertExpiresIn(t, 8*time.Hour)
+	})
+
+	am.AuthTokenTimeToLive = 60 * 60
+	am, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"AuthTokenTimeToLive"})
+	require.NoError(t, err)
+	t.Run("auth-method", func(t *testing.T) {
+		at := assertExpiresIn(t, time.Hour)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("lowered-time-to-live", func(t *testing.T) {
+		at := assertExpiresIn(t, time.Hour)
+		am.AuthTokenTimeToLive = 1
+		am, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"AuthTokenTimeToLive"})
+		require.NoError(t, err)
+		time.Sleep(time.Second)
+
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("time-to-stale", func(t *testing.T) {
+		am.AuthTokenTimeToLive = 0
+		am.AuthTokenTimeToStale = 1
+		am, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"AuthTokenTimeToLive", "AuthTokenTimeToStale"})
+		require.NoError(t, err)
+		at := assertExpiresIn(t, 8*time.Hour)
+		time.Sleep(time.Second)
+
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -628,6 +703,83 @@ func TestRepository_DeleteAuthToken(t *testing.T) {
 	}
 }
 
+func TestRepository_RevokeAuthTokens(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	authMethod := password.TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+	accts := password.TestMultipleAccounts(t, conn, authMethod.GetPublicId(), 3)
+	user := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[0].PublicId, accts[1].PublicId))
+	otherUser := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[2].PublicId))
+
+	createToken := func(u *iam.User, acctId string) string {
+		at, err := repo.CreateAuthToken(ctx, u, acctId)
+		require.NoError(t, err)
+		return at.GetPublicId()
+	}
+	acct0Tokens := []string{createToken(user, accts[0].PublicId), createToken(user, accts[0].PublicId)}
+	acct1Token := createToken(user, accts[1].PublicId)
+	otherToken := createToken(otherUser, accts[2].PublicId)
+
+	t.Run("missing-user-id", func(t *testing.T) {
+		assert := assert.New(t)
+		_, _, err := repo.RevokeAuthTokens(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	})
+
+	t.Run("account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tokenIds, sessionIds, err := repo.RevokeAuthTokens(ctx, user.GetPublicId(), WithAuthAccountId(accts[0].PublicId))
+		require.NoError(err)
+		assert.ElementsMatch(acct0Tokens, tokenIds)
+		assert.Empty(sessionIds)
+		for _, id := range acct0Tokens {
+			at, err := repo.LookupAuthToken(ctx, id)
+			require.NoError(err)
+			assert.Nil(at)
+		}
+		at, err := repo.LookupAuthToken(ctx, acct1Token)
+		require.NoError(err)
+		assert.NotNil(at)
+	})
+
+	t.Run("account-of-other-user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tokenIds, _, err := repo.RevokeAuthTokens(ctx, user.GetPublicId(), WithAuthAccountId(accts[2].PublicId))
+		require.NoError(err)
+		assert.Empty(tokenIds)
+		at, err := repo.LookupAuthToken(ctx, otherToken)
+		require.NoError(err)
+		assert.NotNil(at)
+	})
+
+	t.Run("user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tokenIds, _, err := repo.RevokeAuthTokens(ctx, user.GetPublicId())
+		require.NoError(err)
+		assert.Equal([]string{acct1Token}, tokenIds)
+
+		tokenIds, _, err = repo.RevokeAuthTokens(ctx, user.GetPublicId())
+		require.NoError(err)
+		assert.Empty(tokenIds)
+
+		at, err := repo.LookupAuthToken(ctx, otherToken)
+		require.NoError(err)
+		assert.NotNil(at)
+		// We should find no oplog since tokens are not replicated, so they don't need oplog entries.
+		assert.Error(db.TestVerifyOplog(t, rw, acct1Token, db.WithOperation(oplog.OpType_OP_TYPE_DELETE)))
+	})
+}
+
 func TestRepository_ListAuthTokens(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
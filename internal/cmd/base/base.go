@@ -15,6 +15,7 @@ import (
 	"syscall"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/sdk/wrapper"
 	"github.com/mitchellh/cli"
 	"github.com/pkg/errors"
@@ -84,6 +85,9 @@ type Command struct {
 	FlagVersion           int
 	FlagRecursive         bool
 	FlagFilter            string
+	FlagPageSize          uint
+	FlagListToken         string
+	FlagAll               bool
 
 	// Attribute values
 	FlagAttributes string
@@ -147,6 +151,9 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 		config.OutputCurlString = c.flagOutputCurlString
 	}
 
+	// Continue the caller's trace, if one was set in the environment
+	trace.InjectHttpHeaders(trace.ContextFromEnv(context.Background()), config.Headers)
+
 	c.client, err = api.NewClient(config)
 	if err != nil {
 		return nil, err
@@ -25,6 +25,7 @@ import (
 	berrors "github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -39,6 +40,7 @@ import (
 	"github.com/hashicorp/go-secure-stdlib/reloadutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mitchellh/cli"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc/grpclog"
 )
 
@@ -222,6 +224,34 @@ func (b *Server) AddEventerToContext(ctx context.Context) (context.Context, erro
 	return e, nil
 }
 
+// SetupTracing will install a tracer provider which exports the server's
+// spans as set out in c. Tracing is left disabled when c is nil. The provider
+// is shut down, flushing the remaining spans, by the server's shutdown funcs.
+func (b *Server) SetupTracing(ctx context.Context, c *trace.Config, serverName string) error {
+	const op = "base.(Server).SetupTracing"
+	if c == nil {
+		return nil
+	}
+	tp, err := trace.NewTracerProvider(ctx, c, serverName)
+	if err != nil {
+		return berrors.WrapDeprecated(err, op, berrors.WithMsg("unable to create tracer provider"))
+	}
+	otel.SetTracerProvider(tp)
+
+	b.ShutdownFuncs = append(b.ShutdownFuncs, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			return fmt.Errorf("Error shutting down tracer provider: %w", err)
+		}
+		return nil
+	})
+
+	b.Info["tracing"] = string(c.Exporter)
+	b.InfoKeys = append(b.InfoKeys, "tracing")
+	return nil
+}
+
 func (b *Server) SetupLogging(flagLogLevel, flagLogFormat, configLogLevel, configLogFormat string) error {
 	b.logOutput = os.Stderr
 	if b.CombineLogs {
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/commands/accessrequestscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/accountscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authenticate"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authmethodscmd"
@@ -9,9 +10,11 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/config"
 	"github.com/hashicorp/boundary/internal/cmd/commands/connect"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentiallibrariescmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/credentialscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstorescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
+	"github.com/hashicorp/boundary/internal/cmd/commands/events"
 	"github.com/hashicorp/boundary/internal/cmd/commands/groupscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostcatalogscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostscmd"
@@ -21,9 +24,11 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/rolescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/scopescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/server"
+	"github.com/hashicorp/boundary/internal/cmd/commands/sessionrecordingscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/sessionscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/targetscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/userscmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/workerscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/version"
 
 	"github.com/mitchellh/cli"
@@ -69,6 +74,47 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"authenticate ldap": func() (cli.Command, error) {
+			return &authenticate.LdapCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+
+		"access-requests": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"access-requests read": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"access-requests list": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"access-requests request": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "request",
+			}, nil
+		},
+		"access-requests approve": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "approve",
+			}, nil
+		},
+		"access-requests deny": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "deny",
+			}, nil
+		},
 
 		"accounts": func() (cli.Command, error) {
 			return &accountscmd.Command{
@@ -105,6 +151,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}, nil
 		},
+		"accounts unlock": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "unlock",
+			}, nil
+		},
+		"accounts enroll-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enroll-totp",
+			}, nil
+		},
+		"accounts confirm-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "confirm-totp",
+			}, nil
+		},
+		"accounts remove-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-totp",
+			}, nil
+		},
 		"accounts create": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -123,6 +193,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"accounts create ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"accounts update": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -141,6 +217,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"accounts update ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
@@ -183,6 +265,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"auth-methods create ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"auth-methods update": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui),
@@ -201,6 +289,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"auth-methods update ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"auth-methods change-state oidc": func() (cli.Command, error) {
 			return &authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui),
@@ -327,6 +421,17 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"events": func() (cli.Command, error) {
+			return &events.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"events verify": func() (cli.Command, error) {
+			return &events.VerifyCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+
 		"credential-libraries": func() (cli.Command, error) {
 			return &credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui),
@@ -404,6 +509,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credential-stores create static": func() (cli.Command, error) {
+			return &credentialstorescmd.StaticCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"credential-stores create vault": func() (cli.Command, error) {
 			return &credentialstorescmd.VaultCommand{
 				Command: base.NewCommand(ui),
@@ -416,6 +527,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-stores update static": func() (cli.Command, error) {
+			return &credentialstorescmd.StaticCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"credential-stores update vault": func() (cli.Command, error) {
 			return &credentialstorescmd.VaultCommand{
 				Command: base.NewCommand(ui),
@@ -423,6 +540,78 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"credentials": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials read": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"credentials delete": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"credentials list": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"credentials create": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create username-password": func() (cli.Command, error) {
+			return &credentialscmd.UsernamePasswordCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create ssh-private-key": func() (cli.Command, error) {
+			return &credentialscmd.SshPrivateKeyCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create json": func() (cli.Command, error) {
+			return &credentialscmd.JsonCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials update": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update username-password": func() (cli.Command, error) {
+			return &credentialscmd.UsernamePasswordCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update ssh-private-key": func() (cli.Command, error) {
+			return &credentialscmd.SshPrivateKeyCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update json": func() (cli.Command, error) {
+			return &credentialscmd.JsonCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
 				Command: base.NewCommand(ui),
@@ -704,6 +893,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"managed-groups create ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"managed-groups update": func() (cli.Command, error) {
 			return &managedgroupscmd.Command{
 				Command: base.NewCommand(ui),
@@ -716,6 +911,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"managed-groups update ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"roles": func() (cli.Command, error) {
 			return &rolescmd.Command{
@@ -824,6 +1025,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"scopes list-keys": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list-keys",
+			}, nil
+		},
+		"scopes rotate-keys": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "rotate-keys",
+			}, nil
+		},
+		"scopes destroy-key-version": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "destroy-key-version",
+			}, nil
+		},
 
 		"sessions": func() (cli.Command, error) {
 			return &sessionscmd.Command{
@@ -848,6 +1067,48 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions extend": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "extend",
+			}, nil
+		},
+		"sessions close-connection": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "close-connection",
+			}, nil
+		},
+
+		"session-recordings": func() (cli.Command, error) {
+			return &sessionrecordingscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"session-recordings read": func() (cli.Command, error) {
+			return &sessionrecordingscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"session-recordings list": func() (cli.Command, error) {
+			return &sessionrecordingscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"session-recordings download": func() (cli.Command, error) {
+			return &sessionrecordingscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "download",
+			}, nil
+		},
+		"session-recordings play": func() (cli.Command, error) {
+			return &sessionrecordingscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "play",
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targetscmd.Command{
@@ -890,6 +1151,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"targets create ssh": func() (cli.Command, error) {
+			return &targetscmd.SshCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"targets update": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -902,6 +1169,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"targets update ssh": func() (cli.Command, error) {
+			return &targetscmd.SshCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"targets add-host-sets": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -1028,5 +1301,65 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-accounts",
 			}, nil
 		},
+		"users explain": func() (cli.Command, error) {
+			return &userscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "explain",
+			}, nil
+		},
+		"users revoke-tokens": func() (cli.Command, error) {
+			return &userscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "revoke-tokens",
+			}, nil
+		},
+
+		"workers": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"workers read": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"workers update": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"workers delete": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"workers list": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"workers add-worker-tags": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "add-worker-tags",
+			}, nil
+		},
+		"workers set-worker-tags": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "set-worker-tags",
+			}, nil
+		},
+		"workers remove-worker-tags": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-worker-tags",
+			}, nil
+		},
 	}
 }
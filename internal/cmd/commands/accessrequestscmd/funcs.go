@@ -0,0 +1,292 @@
+package accessrequestscmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accessrequests"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+type extraCmdVars struct {
+	flagTargetId string
+	flagDuration string
+	flagReason   string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"request": {"target-id", "duration", "reason"},
+		"approve": {"id", "version"},
+		"deny":    {"id", "version"},
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary access-requests [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary access requests.",
+			"",
+			"    Request access to a target:",
+			"",
+			`      $ boundary access-requests request -target-id ttcp_1234567890 -duration 1h -reason "incident 42"`,
+			"",
+			"  Please see the access-requests subcommand help for detailed usage information.",
+		})
+
+	case "request":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests request [options] [args]",
+			"",
+			"  Request access to the target specified by ID for the given amount of time. The access window starts once the request is approved by another user. Example:",
+			"",
+			`    $ boundary access-requests request -target-id ttcp_1234567890 -duration 1h -reason "incident 42"`,
+			"",
+			"",
+		})
+
+	case "approve":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests approve [options] [args]",
+			"",
+			"  Approve the pending access request specified by ID, granting the requesting user access to the target until the end of the access window. Users can't approve their own access requests. Example:",
+			"",
+			`    $ boundary access-requests approve -id ar_1234567890`,
+			"",
+			"",
+		})
+
+	case "deny":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests deny [options] [args]",
+			"",
+			"  Deny the pending access request specified by ID. Users can't deny their own access requests. Example:",
+			"",
+			`    $ boundary access-requests deny -id ar_1234567890`,
+			"",
+			"",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "target-id":
+			f.StringVar(&base.StringVar{
+				Name:   "target-id",
+				Target: &c.flagTargetId,
+				Usage:  "The ID of the target to request access to.",
+			})
+		case "duration":
+			f.StringVar(&base.StringVar{
+				Name:   "duration",
+				Target: &c.flagDuration,
+				Usage:  "The length of the requested access window. Can be specified as an integer number of seconds or a duration string.",
+			})
+		case "reason":
+			f.StringVar(&base.StringVar{
+				Name:   "reason",
+				Target: &c.flagReason,
+				Usage:  "The justification for the access request, shown to approvers.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accessrequests.Option) bool {
+	switch c.Func {
+	case "request":
+		if c.flagTargetId == "" {
+			c.UI.Error("No target ID supplied via -target-id")
+			return false
+		}
+		if c.flagDuration == "" {
+			c.UI.Error("No access window duration supplied via -duration")
+			return false
+		}
+		secs, err := strconv.ParseUint(c.flagDuration, 10, 32)
+		if err != nil {
+			dur, err := time.ParseDuration(c.flagDuration)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDuration, err))
+				return false
+			}
+			secs = uint64(dur.Seconds())
+		}
+		if secs == 0 {
+			c.UI.Error("The access window duration supplied via -duration must be at least one second")
+			return false
+		}
+		*opts = append(*opts, accessrequests.WithDurationSeconds(uint32(secs)))
+		if c.flagReason != "" {
+			*opts = append(*opts, accessrequests.WithReason(c.flagReason))
+		}
+	}
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, accessRequestClient *accessrequests.Client, version uint32, opts []accessrequests.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "request":
+		return accessRequestClient.Request(c.Context, c.flagTargetId, opts...)
+	case "approve":
+		return accessRequestClient.Approve(c.Context, c.FlagId, version, opts...)
+	case "deny":
+		return accessRequestClient.Deny(c.Context, c.FlagId, version, opts...)
+	}
+	return origResult, origError
+}
+
+func (c *Command) printListTable(items []*accessrequests.AccessRequest) string {
+	if len(items) == 0 {
+		return "No access requests found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Access Request information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Status != "" {
+			output = append(output,
+				fmt.Sprintf("    Status:              %s", item.Status),
+			)
+		}
+		if item.TargetId != "" {
+			output = append(output,
+				fmt.Sprintf("    Target ID:           %s", item.TargetId),
+			)
+		}
+		if item.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:             %s", item.UserId),
+			)
+		}
+		if item.DurationSeconds != 0 {
+			output = append(output,
+				fmt.Sprintf("    Duration:            %s", time.Duration(item.DurationSeconds)*time.Second),
+			)
+		}
+		if !item.ValidUntil.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Valid Until:         %s", item.ValidUntil.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*accessrequests.AccessRequest)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Status != "" {
+		nonAttributeMap["Status"] = item.Status
+	}
+	if item.TargetId != "" {
+		nonAttributeMap["Target ID"] = item.TargetId
+	}
+	if item.UserId != "" {
+		nonAttributeMap["User ID"] = item.UserId
+	}
+	if item.Reason != "" {
+		nonAttributeMap["Reason"] = item.Reason
+	}
+	if item.DurationSeconds != 0 {
+		nonAttributeMap["Duration"] = (time.Duration(item.DurationSeconds) * time.Second).String()
+	}
+	if item.ApproverId != "" {
+		nonAttributeMap["Approver ID"] = item.ApproverId
+	}
+	if item.RoleId != "" {
+		nonAttributeMap["Role ID"] = item.RoleId
+	}
+	if !item.ValidUntil.IsZero() {
+		nonAttributeMap["Valid Until"] = item.ValidUntil.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Access Request information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
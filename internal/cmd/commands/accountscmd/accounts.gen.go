@@ -93,7 +93,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"auth-method-id", "filter"},
+	"list": {"auth-method-id", "filter", "page-size", "list-token", "all"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -179,6 +179,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, accounts.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, accounts.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, accounts.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -218,7 +226,11 @@ func (c *Command) Run(args []string) int {
 		result, err = accountsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = accountsClient.List(c.Context, c.FlagAuthMethodId, opts...)
+		if c.FlagAll {
+			listResult, err = accountsClient.ListAll(c.Context, c.FlagAuthMethodId, opts...)
+		} else {
+			listResult, err = accountsClient.List(c.Context, c.FlagAuthMethodId, opts...)
+		}
 
 	}
 
@@ -269,6 +281,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*accounts.Account)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*accounts.AccountListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -19,18 +19,27 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagTotpCode        string
+	flagCurrentTotpCode string
+	flagRecoveryCode    string
+	totpEnrollment      *accounts.TotpEnrollmentResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"unlock":          {"id"},
+		"enroll-totp":     {"id", "current-totp-code", "recovery-code"},
+		"confirm-totp":    {"id", "totp-code"},
+		"remove-totp":     {"id", "current-totp-code", "recovery-code"},
 	}
 }
 
@@ -42,6 +51,18 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "unlock":
+		return "Unlock an account locked by failed authentication attempts"
+
+	case "enroll-totp":
+		return "Enroll a TOTP secret for an account"
+
+	case "confirm-totp":
+		return "Confirm the TOTP enrollment of an account"
+
+	case "remove-totp":
+		return "Remove the TOTP enrollment of an account"
+
 	default:
 		return ""
 	}
@@ -86,6 +107,58 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command allows clearing the failed authentication attempts of password-type accounts, unlocking them if they were locked. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command allows enrolling a TOTP secret for password-type accounts. The secret is output as an otpauth URI, which can be imported into an authenticator app or rendered as a QR code, along with recovery codes which can be used once each instead of a TOTP code. Neither can be retrieved again. The enrollment must then be confirmed with the confirm-totp command, which replaces any previous enrollment of the account. Example:",
+			"",
+			"    Enroll a TOTP secret for a password-type account:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"  If the account has a confirmed enrollment, its current TOTP code must be provided with -current-totp-code, or one of its recovery codes with -recovery-code:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890 -current-totp-code 123456`,
+			"",
+			"",
+		})
+	case "confirm-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts confirm-totp [options] [args]",
+			"",
+			"  This command allows confirming the TOTP enrollment of password-type accounts with a TOTP code generated by the authenticator app. Once confirmed, the account must provide a TOTP code or a recovery code when authenticating. Example:",
+			"",
+			"    Confirm the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts confirm-totp -id acctpw_1234567890 -totp-code 123456`,
+			"",
+			"",
+		})
+	case "remove-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts remove-totp [options] [args]",
+			"",
+			"  This command allows removing the TOTP secret and the recovery codes of password-type accounts. If the account has a confirmed enrollment, its current TOTP code must be provided with -current-totp-code, or one of its recovery codes with -recovery-code. Example:",
+			"",
+			"    Remove the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts remove-totp -id acctpw_1234567890 -current-totp-code 123456`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -111,6 +184,24 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "totp-code":
+			f.StringVar(&base.StringVar{
+				Name:   "totp-code",
+				Target: &c.flagTotpCode,
+				Usage:  "The current TOTP code generated by the authenticator app from the enrolled secret.",
+			})
+		case "current-totp-code":
+			f.StringVar(&base.StringVar{
+				Name:   "current-totp-code",
+				Target: &c.flagCurrentTotpCode,
+				Usage:  "The current TOTP code generated by the authenticator app from the confirmed secret of the account, if it has one.",
+			})
+		case "recovery-code":
+			f.StringVar(&base.StringVar{
+				Name:   "recovery-code",
+				Target: &c.flagRecoveryCode,
+				Usage:  "A recovery code of the account to use instead of -current-totp-code. Each recovery code can only be used once.",
+			})
 		}
 	}
 }
@@ -160,6 +251,22 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		c.flagCurrentPassword = strings.TrimSpace(value)
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "totp-code") && c.flagTotpCode == "" {
+		c.UI.Error("TOTP code must be provided via -totp-code")
+		return false
+	}
+
+	if c.flagCurrentTotpCode != "" && c.flagRecoveryCode != "" {
+		c.UI.Error("Only one of -current-totp-code and -recovery-code may be provided")
+		return false
+	}
+	if c.flagCurrentTotpCode != "" {
+		*opts = append(*opts, accounts.WithTotpCode(c.flagCurrentTotpCode))
+	}
+	if c.flagRecoveryCode != "" {
+		*opts = append(*opts, accounts.WithRecoveryCode(c.flagRecoveryCode))
+	}
+
 	if strutil.StrListContains(flagsMap[c.Func], "new-password") && c.flagNewPassword == "" {
 		fmt.Print("New password is not set as flag, please enter it now (will be hidden): ")
 		value, err := password.Read(os.Stdin)
@@ -180,10 +287,60 @@ func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError
 		return accountClient.SetPassword(c.Context, c.FlagId, c.flagPassword, version, opts...)
 	case "change-password":
 		return accountClient.ChangePassword(c.Context, c.FlagId, c.flagCurrentPassword, c.flagNewPassword, version, opts...)
+	case "unlock":
+		return accountClient.Unlock(c.Context, c.FlagId, opts...)
+	case "enroll-totp":
+		var err error
+		c.totpEnrollment, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		return c.totpEnrollment, err
+	case "confirm-totp":
+		return accountClient.ConfirmTotp(c.Context, c.FlagId, c.flagTotpCode, opts...)
+	case "remove-totp":
+		return accountClient.RemoveTotp(c.Context, c.FlagId, opts...)
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "json":
+			if ok := c.PrintJsonItem(c.totpEnrollment); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+		case "table":
+			c.UI.Output(printTotpEnrollmentTable(c.totpEnrollment.Item))
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func printTotpEnrollmentTable(enrollment *accounts.TotpEnrollment) string {
+	ret := []string{
+		"",
+		"TOTP enrollment information:",
+		fmt.Sprintf("  Account ID:      %s", enrollment.Item.Id),
+		fmt.Sprintf("  TOTP Secret:     %s", enrollment.TotpSecret),
+		fmt.Sprintf("  TOTP URI:        %s", enrollment.TotpUri),
+		"",
+		"  Recovery Codes:",
+	}
+	for _, code := range enrollment.RecoveryCodes {
+		ret = append(ret, fmt.Sprintf("    %s", code))
+	}
+	ret = append(ret,
+		"",
+		"  Import the TOTP URI into an authenticator app, or render it as a QR code",
+		"  to scan it, and store the recovery codes safely; neither is shown again.",
+		"  Then confirm the enrollment with a TOTP code from the app:",
+		"",
+		fmt.Sprintf("    $ boundary accounts confirm-totp -id %s -totp-code <code>", enrollment.Item.Id),
+	)
+	return base.WrapForHelpText(ret)
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
@@ -309,5 +466,7 @@ func printItemTable(result api.GenericResult) string {
 }
 
 var keySubstMap = map[string]string{
-	"login_name": "Login Name",
+	"login_name":       "Login Name",
+	"dn":               "DN",
+	"member_of_groups": "Member Of Groups",
 }
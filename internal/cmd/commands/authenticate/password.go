@@ -21,15 +21,22 @@ var (
 )
 
 var (
-	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
-	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envPassword     = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
+	envLoginName    = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode     = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
+	envRecoveryCode = "BOUNDARY_AUTHENTICATE_PASSWORD_RECOVERY_CODE"
+	envNewPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_NEW_PASSWORD"
 )
 
 type PasswordCommand struct {
 	*base.Command
 
-	flagLoginName string
-	flagPassword  string
+	flagLoginName    string
+	flagPassword     string
+	flagTotpCode     string
+	flagRecoveryCode string
+	flagNewPassword  string
+	flagEnrollTotp   bool
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -44,6 +51,16 @@ func (c *PasswordCommand) Help() string {
 		"",
 		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password "bar"`,
 		"",
+		"  If the account is enrolled for TOTP and neither -totp-code nor -recovery-code is set, the command prompts for the TOTP code.",
+		"",
+		"  If the auth method requires multi-factor authentication and the account is not enrolled for TOTP, it can enroll by setting -enroll-totp. The command outputs the TOTP secret and recovery codes, then prompts for a TOTP code generated from the secret to confirm the enrollment and authenticate:",
+		"",
+		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password "bar" -enroll-totp`,
+		"",
+		"  If the password of the account has expired, it must be replaced by setting -new-password:",
+		"",
+		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password "bar" -new-password "baz"`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -66,6 +83,33 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "The current TOTP code of the account, if it is enrolled for TOTP",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "recovery-code",
+		Target: &c.flagRecoveryCode,
+		EnvVar: envRecoveryCode,
+		Usage:  "A recovery code of the account to use instead of a TOTP code. Each recovery code can only be used once.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "new-password",
+		Target: &c.flagNewPassword,
+		EnvVar: envNewPassword,
+		Usage:  "A new password which replaces the password of the account if it has expired",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "enroll-totp",
+		Target: &c.flagEnrollTotp,
+		Usage:  "Enroll the account for TOTP before authenticating, if the auth method requires multi-factor authentication and the account is not enrolled",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -114,6 +158,12 @@ func (c *PasswordCommand) Run(args []string) int {
 	case c.FlagAuthMethodId == "":
 		c.PrintCliError(errors.New("Auth method ID must be provided via -auth-method-id"))
 		return base.CommandUserError
+	case c.flagTotpCode != "" && c.flagRecoveryCode != "":
+		c.PrintCliError(errors.New("Only one of -totp-code and -recovery-code may be provided"))
+		return base.CommandUserError
+	case c.flagEnrollTotp && (c.flagTotpCode != "" || c.flagRecoveryCode != ""):
+		c.PrintCliError(errors.New("Neither -totp-code nor -recovery-code may be provided with -enroll-totp"))
+		return base.CommandUserError
 	}
 
 	if c.flagPassword == "" {
@@ -133,19 +183,102 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	result, err := authmethods.NewClient(client).Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]interface{}{
+	amClient := authmethods.NewClient(client)
+	authenticate := func() (*authmethods.AuthenticateResult, int) {
+		attrs := map[string]interface{}{
 			"login_name": c.flagLoginName,
 			"password":   c.flagPassword,
-		})
-	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil {
-			c.PrintApiError(apiErr, "Error from controller when performing authentication")
-			return base.CommandApiError
 		}
-		c.PrintCliError(fmt.Errorf("Error trying to perform authentication: %w", err))
-		return base.CommandCliError
+		if c.flagTotpCode != "" {
+			attrs["totp_code"] = c.flagTotpCode
+		}
+		if c.flagRecoveryCode != "" {
+			attrs["recovery_code"] = c.flagRecoveryCode
+		}
+		if c.flagNewPassword != "" {
+			attrs["new_password"] = c.flagNewPassword
+		}
+		if c.flagEnrollTotp {
+			attrs["enroll_totp"] = true
+		}
+		result, err := amClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when performing authentication")
+				return nil, base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to perform authentication: %w", err))
+			return nil, base.CommandCliError
+		}
+		return result, base.CommandSuccess
+	}
+
+	result, ret := authenticate()
+	if result == nil {
+		return ret
+	}
+	if c.flagEnrollTotp {
+		if ret := c.printTotpEnrollment(result); ret != base.CommandSuccess {
+			return ret
+		}
+		// The enrollment is confirmed by authenticating with a code
+		// generated from the enrolled secret.
+		c.flagEnrollTotp = false
+	}
+	if mfaRequired, _ := result.Attributes["mfa_required"].(bool); mfaRequired {
+		if c.flagTotpCode != "" || c.flagRecoveryCode != "" {
+			c.PrintCliError(errors.New("The controller did not accept the provided TOTP code or recovery code"))
+			return base.CommandCliError
+		}
+		fmt.Print("The account is enrolled for TOTP, please enter the current TOTP code now (will be hidden): ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). Use -totp-code or -recovery-code instead. The raw error was:\n\n%s", err.Error()))
+			return base.CommandUserError
+		}
+		c.flagTotpCode = strings.TrimSpace(value)
+		if result, ret = authenticate(); result == nil {
+			return ret
+		}
 	}
 
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// printTotpEnrollment outputs the TOTP enrollment in the response to an
+// authentication request with enroll_totp set.
+func (c *PasswordCommand) printTotpEnrollment(result *authmethods.AuthenticateResult) int {
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(&dummyGenericResponse{
+			item:     result.Attributes,
+			response: result.GetResponse(),
+		}); !ok {
+			return base.CommandCliError
+		}
+	default:
+		uri, _ := result.Attributes["totp_uri"].(string)
+		secret, _ := result.Attributes["totp_secret"].(string)
+		ret := []string{
+			"",
+			"TOTP enrollment information:",
+			fmt.Sprintf("  TOTP Secret:     %s", secret),
+			fmt.Sprintf("  TOTP URI:        %s", uri),
+			"",
+			"  Recovery Codes:",
+		}
+		codes, _ := result.Attributes["recovery_codes"].([]interface{})
+		for _, code := range codes {
+			ret = append(ret, fmt.Sprintf("    %v", code))
+		}
+		ret = append(ret,
+			"",
+			"  Import the TOTP URI into an authenticator app, or render it as a QR code",
+			"  to scan it, and store the recovery codes safely; neither is shown again.",
+			"",
+		)
+		c.UI.Output(base.WrapForHelpText(ret))
+	}
+	return base.CommandSuccess
+}
@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -166,6 +166,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, authmethods.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, authmethods.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, authmethods.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -185,7 +193,11 @@ func (c *Command) Run(args []string) int {
 		result, err = authmethodsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = authmethodsClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = authmethodsClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = authmethodsClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -236,6 +248,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*authmethods.AuthMethod)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*authmethods.AuthMethodListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -2,6 +2,7 @@ package authmethodscmd
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -9,8 +10,72 @@ import (
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
 )
 
+const (
+	authTokenTimeToLiveFlagName  = "auth-token-time-to-live"
+	authTokenTimeToStaleFlagName = "auth-token-time-to-stale"
+)
+
+// authTokenCmdVars are the flags setting the auth token settings of an auth
+// method, which are shared by the commands of every auth method type.
+type authTokenCmdVars struct {
+	flagAuthTokenTimeToLive  string
+	flagAuthTokenTimeToStale string
+}
+
+// authTokenFlag adds the auth token setting flag name to f, if it is one.
+func (v *authTokenCmdVars) authTokenFlag(f *base.FlagSet, name string) {
+	switch name {
+	case authTokenTimeToLiveFlagName:
+		f.StringVar(&base.StringVar{
+			Name:   authTokenTimeToLiveFlagName,
+			Target: &v.flagAuthTokenTimeToLive,
+			Usage:  `The duration after which the auth tokens issued by the auth method expire, such as "8h". Set to "null" to use the setting of the scope of the auth method.`,
+		})
+	case authTokenTimeToStaleFlagName:
+		f.StringVar(&base.StringVar{
+			Name:   authTokenTimeToStaleFlagName,
+			Target: &v.flagAuthTokenTimeToStale,
+			Usage:  `The duration after which the auth tokens issued by the auth method expire if they are not used, such as "1h". Set to "null" to use the setting of the scope of the auth method.`,
+		})
+	}
+}
+
+// authTokenFlagHandling adds the options setting the auth token settings
+// given by the flags to opts. It returns false if a flag can't be parsed.
+func (v *authTokenCmdVars) authTokenFlagHandling(ui cli.Ui, opts *[]authmethods.Option) bool {
+	for _, flag := range []struct {
+		name  string
+		value string
+		with  func(uint32) authmethods.Option
+		def   func() authmethods.Option
+	}{
+		{authTokenTimeToLiveFlagName, v.flagAuthTokenTimeToLive, authmethods.WithAuthTokenTimeToLive, authmethods.DefaultAuthTokenTimeToLive},
+		{authTokenTimeToStaleFlagName, v.flagAuthTokenTimeToStale, authmethods.WithAuthTokenTimeToStale, authmethods.DefaultAuthTokenTimeToStale},
+	} {
+		switch flag.value {
+		case "":
+		case "null":
+			*opts = append(*opts, flag.def())
+		default:
+			d, err := parseutil.ParseDurationSecond(flag.value)
+			if err != nil {
+				ui.Error(fmt.Sprintf("Error parsing -%s %q: %s", flag.name, flag.value, err))
+				return false
+			}
+			if d < time.Second || d.Seconds() > math.MaxUint32 {
+				ui.Error(fmt.Sprintf("Error parsing -%s %q: duration must be between 1s and %d seconds", flag.name, flag.value, uint32(math.MaxUint32)))
+				return false
+			}
+			*opts = append(*opts, flag.with(uint32(d.Seconds())))
+		}
+	}
+	return true
+}
+
 func init() {
 	extraOidcSynopsisFunc = extraSynopsisFuncImpl
 }
@@ -162,6 +227,12 @@ func printItemTable(result api.GenericResult) string {
 			nonAttributeMap["Is Primary For Scope"] = item.IsPrimary
 		}
 	}
+	if item.AuthTokenTimeToLive != 0 {
+		nonAttributeMap["Auth Token Time To Live"] = (time.Duration(item.AuthTokenTimeToLive) * time.Second).String()
+	}
+	if item.AuthTokenTimeToStale != 0 {
+		nonAttributeMap["Auth Token Time To Stale"] = (time.Duration(item.AuthTokenTimeToStale) * time.Second).String()
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -217,6 +288,16 @@ func printItemTable(result api.GenericResult) string {
 }
 
 var keySubstMap = map[string]string{
-	"min_login_name_length": "Minimum Login Name Length",
-	"min_password_length":   "Minimum Password Length",
+	"min_login_name_length":  "Minimum Login Name Length",
+	"min_password_length":    "Minimum Password Length",
+	"max_failed_attempts":    "Maximum Failed Attempts",
+	"lockout_duration":       "Lockout Duration",
+	"failed_attempt_delay":   "Failed Attempt Delay",
+	"require_lowercase":      "Require Lowercase",
+	"require_uppercase":      "Require Uppercase",
+	"require_digit":          "Require Digit",
+	"require_symbol":         "Require Symbol",
+	"password_history_count": "Password History Count",
+	"max_password_age_days":  "Maximum Password Age Days",
+	"require_mfa":            "Require MFA",
 }
@@ -0,0 +1,276 @@
+package authmethodscmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagHandlingFuncImpl
+}
+
+type extraLdapCmdVars struct {
+	authTokenCmdVars
+
+	flagUrls            []string
+	flagCertificates    []string
+	flagStartTls        string
+	flagInsecureTls     string
+	flagDiscoverDn      string
+	flagAnonGroupSearch string
+	flagUpnDomain       string
+	flagUserDn          string
+	flagUserAttr        string
+	flagUserFilter      string
+	flagGroupDn         string
+	flagGroupAttr       string
+	flagGroupFilter     string
+	flagBindDn          string
+	flagBindPassword    string
+}
+
+const (
+	urlFlagName             = "url"
+	certificateFlagName     = "certificate"
+	startTlsFlagName        = "start-tls"
+	insecureTlsFlagName     = "insecure-tls"
+	discoverDnFlagName      = "discover-dn"
+	anonGroupSearchFlagName = "anon-group-search"
+	upnDomainFlagName       = "upn-domain"
+	userDnFlagName          = "user-dn"
+	userAttrFlagName        = "user-attr"
+	userFilterFlagName      = "user-filter"
+	groupDnFlagName         = "group-dn"
+	groupAttrFlagName       = "group-attr"
+	groupFilterFlagName     = "group-filter"
+	bindDnFlagName          = "bind-dn"
+	bindPasswordFlagName    = "bind-password"
+)
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			urlFlagName,
+			certificateFlagName,
+			startTlsFlagName,
+			insecureTlsFlagName,
+			discoverDnFlagName,
+			anonGroupSearchFlagName,
+			upnDomainFlagName,
+			userDnFlagName,
+			userAttrFlagName,
+			userFilterFlagName,
+			groupDnFlagName,
+			groupAttrFlagName,
+			groupFilterFlagName,
+			bindDnFlagName,
+			bindPasswordFlagName,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("LDAP Auth Method Options")
+
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case urlFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   urlFlagName,
+				Target: &c.flagUrls,
+				Usage:  `The ldap:// or ldaps:// URL of an LDAP server. May be specified multiple times; the servers are tried in order.`,
+			})
+		case certificateFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificates,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as trust anchors when connecting to the LDAP servers. May be specified multiple times.",
+			})
+		case startTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   startTlsFlagName,
+				Target: &c.flagStartTls,
+				Usage:  "If true, issue a StartTLS command after establishing an unencrypted connection.",
+			})
+		case insecureTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   insecureTlsFlagName,
+				Target: &c.flagInsecureTls,
+				Usage:  "If true, skip the verification of the LDAP server's certificate. This should only be used for testing.",
+			})
+		case discoverDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   discoverDnFlagName,
+				Target: &c.flagDiscoverDn,
+				Usage:  "If true, search for the DN of a user before binding as that user, instead of building it from the user DN and user attribute.",
+			})
+		case anonGroupSearchFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   anonGroupSearchFlagName,
+				Target: &c.flagAnonGroupSearch,
+				Usage:  "If true, use an anonymous bind when searching for the groups of a user.",
+			})
+		case upnDomainFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   upnDomainFlagName,
+				Target: &c.flagUpnDomain,
+				Usage:  "The userPrincipalName domain. When set, users bind as [login name]@[domain].",
+			})
+		case userDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userDnFlagName,
+				Target: &c.flagUserDn,
+				Usage:  "The base DN under which to search for users.",
+			})
+		case userAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userAttrFlagName,
+				Target: &c.flagUserAttr,
+				Usage:  `The attribute of the user entries which matches the login name, for example "uid" or "sAMAccountName".`,
+			})
+		case userFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userFilterFlagName,
+				Target: &c.flagUserFilter,
+				Usage:  `A go template used to build the user search filter, for example "({{.UserAttr}}={{.Username}})".`,
+			})
+		case groupDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupDnFlagName,
+				Target: &c.flagGroupDn,
+				Usage:  "The base DN under which to search for groups. If not set, groups are not looked up.",
+			})
+		case groupAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupAttrFlagName,
+				Target: &c.flagGroupAttr,
+				Usage:  `The attribute of the group entries whose values are the names of the groups, for example "cn".`,
+			})
+		case groupFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupFilterFlagName,
+				Target: &c.flagGroupFilter,
+				Usage:  `A go template used to build the group search filter, for example "(member={{.UserDN}})".`,
+			})
+		case bindDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindDnFlagName,
+				Target: &c.flagBindDn,
+				Usage:  "The DN used to bind when searching for users and groups. If not set, an anonymous bind is used.",
+			})
+		case bindPasswordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindPasswordFlagName,
+				Target: &c.flagBindPassword,
+				Usage:  "The password used with the bind DN.",
+			})
+		default:
+			c.authTokenFlag(f, name)
+		}
+	}
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create ldap [options] [args]",
+			"",
+			"  Create an ldap-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create ldap -name prodops -url ldaps://ldap.example.com -user-dn "ou=people,dc=example,dc=com" -user-attr uid`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update ldap [options] [args]",
+			"",
+			"  Update an ldap-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update ldap -id amldap_1234567890 -name "devops" -description "Ldap auth-method for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch {
+	case len(c.flagUrls) == 0:
+	case len(c.flagUrls) == 1 && c.flagUrls[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodUrls())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodUrls(c.flagUrls))
+	}
+	switch {
+	case len(c.flagCertificates) == 0:
+	case len(c.flagCertificates) == 1 && c.flagCertificates[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodCertificates())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodCertificates(c.flagCertificates))
+	}
+
+	for _, b := range []struct {
+		name  string
+		value string
+		with  func(bool) authmethods.Option
+		def   func() authmethods.Option
+	}{
+		{startTlsFlagName, c.flagStartTls, authmethods.WithLdapAuthMethodStartTls, authmethods.DefaultLdapAuthMethodStartTls},
+		{insecureTlsFlagName, c.flagInsecureTls, authmethods.WithLdapAuthMethodInsecureTls, authmethods.DefaultLdapAuthMethodInsecureTls},
+		{discoverDnFlagName, c.flagDiscoverDn, authmethods.WithLdapAuthMethodDiscoverDn, authmethods.DefaultLdapAuthMethodDiscoverDn},
+		{anonGroupSearchFlagName, c.flagAnonGroupSearch, authmethods.WithLdapAuthMethodAnonGroupSearch, authmethods.DefaultLdapAuthMethodAnonGroupSearch},
+	} {
+		switch b.value {
+		case "":
+		case "null":
+			*opts = append(*opts, b.def())
+		default:
+			val, err := strconv.ParseBool(b.value)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s %q: %s", b.name, b.value, err))
+				return false
+			}
+			*opts = append(*opts, b.with(val))
+		}
+	}
+
+	for _, s := range []struct {
+		value string
+		with  func(string) authmethods.Option
+		def   func() authmethods.Option
+	}{
+		{c.flagUpnDomain, authmethods.WithLdapAuthMethodUpnDomain, authmethods.DefaultLdapAuthMethodUpnDomain},
+		{c.flagUserDn, authmethods.WithLdapAuthMethodUserDn, authmethods.DefaultLdapAuthMethodUserDn},
+		{c.flagUserAttr, authmethods.WithLdapAuthMethodUserAttr, authmethods.DefaultLdapAuthMethodUserAttr},
+		{c.flagUserFilter, authmethods.WithLdapAuthMethodUserFilter, authmethods.DefaultLdapAuthMethodUserFilter},
+		{c.flagGroupDn, authmethods.WithLdapAuthMethodGroupDn, authmethods.DefaultLdapAuthMethodGroupDn},
+		{c.flagGroupAttr, authmethods.WithLdapAuthMethodGroupAttr, authmethods.DefaultLdapAuthMethodGroupAttr},
+		{c.flagGroupFilter, authmethods.WithLdapAuthMethodGroupFilter, authmethods.DefaultLdapAuthMethodGroupFilter},
+		{c.flagBindDn, authmethods.WithLdapAuthMethodBindDn, authmethods.DefaultLdapAuthMethodBindDn},
+		{c.flagBindPassword, authmethods.WithLdapAuthMethodBindPassword, authmethods.DefaultLdapAuthMethodBindPassword},
+	} {
+		switch s.value {
+		case "":
+		case "null":
+			*opts = append(*opts, s.def())
+		default:
+			*opts = append(*opts, s.with(s.value))
+		}
+	}
+
+	return c.authTokenFlagHandling(c.UI, opts)
+}
@@ -17,6 +17,8 @@ func init() {
 }
 
 type extraOidcCmdVars struct {
+	authTokenCmdVars
+
 	flagState                             string
 	flagIssuer                            string
 	flagClientId                          string
@@ -62,6 +64,8 @@ func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 			allowedAudienceFlagName,
 			claimsScopes,
 			accountClaimMaps,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
 		},
 		"change-state": {
 			idFlagName,
@@ -156,6 +160,8 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 				Target: &c.flagDryRun,
 				Usage:  "Performs all completeness and validation checks with any newly-provided values without persisting the changes.",
 			})
+		default:
+			c.authTokenFlag(f, name)
 		}
 	}
 }
@@ -281,7 +287,7 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 		*opts = append(*opts, authmethods.WithOidcAuthMethodDryRun(c.flagDryRun))
 	}
 
-	return true
+	return c.authTokenFlagHandling(c.UI, opts)
 }
 
 func executeExtraOidcActionsImpl(c *OidcCommand, origResult api.GenericResult, origError error, amClient *authmethods.Client, version uint32, opts []authmethods.Option) (api.GenericResult, error) {
@@ -2,7 +2,9 @@ package authmethodscmd
 
 import (
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -15,14 +17,48 @@ func init() {
 }
 
 type extraPasswordCmdVars struct {
+	authTokenCmdVars
+
 	flagMinLoginNameLength string
 	flagMinPasswordLength  string
+	flagMaxFailedAttempts  string
+	flagLockoutDuration    string
+	flagFailedAttemptDelay string
+
+	flagRequiredCharacterClasses []string
+	flagDisallowedPasswordsFile  string
+	flagPasswordHistoryCount     string
+	flagMaxPasswordAgeDays       string
+	flagRequireMfa               string
+}
+
+// characterClassAttributes maps the character classes accepted by the
+// required-character-classes flag to the attribute requiring them.
+var characterClassAttributes = map[string]string{
+	"lowercase": "require_lowercase",
+	"uppercase": "require_uppercase",
+	"digit":     "require_digit",
+	"symbol":    "require_symbol",
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
+	flags := []string{
+		"min-login-name-length",
+		"min-password-length",
+		"max-failed-attempts",
+		"lockout-duration",
+		"failed-attempt-delay",
+		"required-character-classes",
+		"disallowed-passwords-file",
+		"password-history-count",
+		"max-password-age-days",
+		"require-mfa",
+		authTokenTimeToLiveFlagName,
+		authTokenTimeToStaleFlagName,
+	}
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": flags,
+		"update": flags,
 	}
 }
 
@@ -71,6 +107,56 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "max-failed-attempts":
+			f.StringVar(&base.StringVar{
+				Name:   "max-failed-attempts",
+				Target: &c.flagMaxFailedAttempts,
+				Usage:  `The number of consecutive failed authentication attempts after which an account is locked. Set to "null" to disable locking.`,
+			})
+		case "lockout-duration":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-duration",
+				Target: &c.flagLockoutDuration,
+				Usage:  `The number of seconds a locked account stays locked. Set to "null" to keep accounts locked until they are unlocked.`,
+			})
+		case "failed-attempt-delay":
+			f.StringVar(&base.StringVar{
+				Name:   "failed-attempt-delay",
+				Target: &c.flagFailedAttemptDelay,
+				Usage:  `The number of seconds after a failed authentication attempt before the account can be authenticated again, doubled after each consecutive failed attempt. Set to "null" to disable the delay.`,
+			})
+		case "required-character-classes":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "required-character-classes",
+				Target: &c.flagRequiredCharacterClasses,
+				Usage:  `The character classes passwords must contain: "lowercase", "uppercase", "digit" or "symbol". May be specified multiple times. The classes not specified are no longer required. Set to "null" to require none.`,
+			})
+		case "disallowed-passwords-file":
+			f.StringVar(&base.StringVar{
+				Name:   "disallowed-passwords-file",
+				Target: &c.flagDisallowedPasswordsFile,
+				Usage:  `A file containing the passwords which cannot be set as the password of an account, one per line, compared case-insensitively. Replaces any previously set disallowed passwords. Set to "null" to clear them.`,
+			})
+		case "password-history-count":
+			f.StringVar(&base.StringVar{
+				Name:   "password-history-count",
+				Target: &c.flagPasswordHistoryCount,
+				Usage:  `The number of most recent passwords of an account, including its current password, which cannot be reused. Set to "null" to allow passwords to be reused.`,
+			})
+		case "max-password-age-days":
+			f.StringVar(&base.StringVar{
+				Name:   "max-password-age-days",
+				Target: &c.flagMaxPasswordAgeDays,
+				Usage:  `The number of days after which the password of an account expires and must be changed. Set to "null" to disable expiration.`,
+			})
+		case "require-mfa":
+			f.StringVar(&base.StringVar{
+				Name:   "require-mfa",
+				Target: &c.flagRequireMfa,
+				Usage:  "If true, accounts must authenticate with a TOTP code or a recovery code in addition to their password, and accounts without a confirmed TOTP enrollment cannot authenticate.",
+			})
+		default:
+			c.authTokenFlag(f, name)
 		}
 	}
 }
@@ -83,35 +169,92 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		}
 		attributes[name] = value
 	}
-	switch c.flagMinLoginNameLength {
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"min_login_name_length", c.flagMinLoginNameLength},
+		{"min_password_length", c.flagMinPasswordLength},
+		{"max_failed_attempts", c.flagMaxFailedAttempts},
+		{"lockout_duration", c.flagLockoutDuration},
+		{"failed_attempt_delay", c.flagFailedAttemptDelay},
+		{"password_history_count", c.flagPasswordHistoryCount},
+		{"max_password_age_days", c.flagMaxPasswordAgeDays},
+	} {
+		switch attr.value {
+		case "":
+		case "null":
+			addAttribute(attr.name, nil)
+		default:
+			value, err := strconv.ParseUint(attr.value, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", attr.value, err))
+				return false
+			}
+			addAttribute(attr.name, uint32(value))
+		}
+	}
+
+	switch {
+	case len(c.flagRequiredCharacterClasses) == 0:
+	case len(c.flagRequiredCharacterClasses) == 1 && c.flagRequiredCharacterClasses[0] == "null":
+		for _, name := range characterClassAttributes {
+			addAttribute(name, nil)
+		}
+	default:
+		required := make(map[string]bool, len(c.flagRequiredCharacterClasses))
+		for _, class := range c.flagRequiredCharacterClasses {
+			name, ok := characterClassAttributes[strings.ToLower(class)]
+			if !ok {
+				c.UI.Error(fmt.Sprintf("Unknown character class %q", class))
+				return false
+			}
+			required[name] = true
+		}
+		for _, name := range characterClassAttributes {
+			if required[name] {
+				addAttribute(name, true)
+			} else {
+				addAttribute(name, nil)
+			}
+		}
+	}
+
+	switch c.flagRequireMfa {
 	case "":
 	case "null":
-		addAttribute("min_login_name_length", nil)
+		addAttribute("require_mfa", nil)
 	default:
-		length, err := strconv.ParseUint(c.flagMinLoginNameLength, 10, 32)
+		value, err := strconv.ParseBool(c.flagRequireMfa)
 		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMinLoginNameLength, err))
+			c.UI.Error(fmt.Sprintf("Error parsing -require-mfa %q: %s", c.flagRequireMfa, err))
 			return false
 		}
-		addAttribute("min_login_name_length", uint32(length))
+		addAttribute("require_mfa", value)
 	}
 
-	switch c.flagMinPasswordLength {
+	switch c.flagDisallowedPasswordsFile {
 	case "":
 	case "null":
-		addAttribute("min_password_length", nil)
+		addAttribute("disallowed_passwords", nil)
 	default:
-		length, err := strconv.ParseUint(c.flagMinPasswordLength, 10, 32)
+		contents, err := os.ReadFile(c.flagDisallowedPasswordsFile)
 		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMinPasswordLength, err))
+			c.UI.Error(fmt.Sprintf("Error reading disallowed passwords file: %s", err))
 			return false
 		}
-		addAttribute("min_password_length", uint32(length))
+		passwords := []string{}
+		for _, line := range strings.Split(string(contents), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				passwords = append(passwords, line)
+			}
+		}
+		addAttribute("disallowed_passwords", passwords)
 	}
 
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
 
-	return true
+	return c.authTokenFlagHandling(c.UI, opts)
 }
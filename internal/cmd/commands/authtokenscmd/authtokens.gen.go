@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -157,6 +157,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, authtokens.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, authtokens.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, authtokens.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -176,7 +184,11 @@ func (c *Command) Run(args []string) int {
 		result, err = authtokensClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = authtokensClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = authtokensClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = authtokensClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -227,6 +239,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*authtokens.AuthToken)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*authtokens.AuthTokenListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -102,6 +103,7 @@ type Command struct {
 	connsLeftCh        chan int32
 	connectionsLeft    *atomic.Int32
 	expiration         time.Time
+	expirationCh       chan time.Time
 	execCmdReturnValue *atomic.Int32
 	proxyCtx           context.Context
 	proxyCancel        context.CancelFunc
@@ -321,6 +323,7 @@ func (c *Command) Run(args []string) (retCode int) {
 
 	c.connectionsLeft = atomic.NewInt32(0)
 	c.connsLeftCh = make(chan int32)
+	c.expirationCh = make(chan time.Time, 1)
 
 	if c.flagListenAddr == "" {
 		c.flagListenAddr = "127.0.0.1"
@@ -431,12 +434,18 @@ func (c *Command) Run(args []string) (retCode int) {
 		return base.CommandUserError
 	}
 
+	// The certificate is valid until the latest time the session can be
+	// extended to, so prefer the session's expiration time when the
+	// controller provided it.
 	c.expiration = parsedCert.NotAfter
+	if exp := c.sessionAuthzData.GetExpirationTime(); exp != nil {
+		c.expiration = exp.AsTime()
+	}
 
 	// We don't _rely_ on client-side timeout verification but this prevents us
 	// seeming to be ready for a connection that will immediately fail when we
 	// try to actually make it
-	c.proxyCtx, c.proxyCancel = context.WithDeadline(c.Context, c.expiration)
+	c.proxyCtx, c.proxyCancel = context.WithDeadline(c.Context, parsedCert.NotAfter)
 	defer c.proxyCancel()
 
 	certPool := x509.NewCertPool()
@@ -598,7 +607,22 @@ func (c *Command) Run(args []string) (retCode int) {
 				timer.Stop()
 				return
 			case <-timer.C:
+				// The session may have been extended since we last learned
+				// its expiration time.
+				if exp, ok := c.refreshExpiration(); ok {
+					c.expiration = exp
+					timer.Reset(time.Until(exp))
+					continue
+				}
 				return
+			case exp := <-c.expirationCh:
+				if exp.After(c.expiration) {
+					c.expiration = exp
+					if !timer.Stop() {
+						<-timer.C
+					}
+					timer.Reset(time.Until(exp))
+				}
 			case connsLeft := <-c.connsLeftCh:
 				c.updateConnsLeft(connsLeft)
 				if connsLeft == 0 {
@@ -759,6 +783,14 @@ func (c *Command) runTcpProxyV1(
 	if handshakeResult.GetConnectionsLeft() != -1 {
 		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
 	}
+	// The worker sends the session's current expiration time, which is later
+	// than the one we know of if the session has been extended.
+	if exp := handshakeResult.GetExpiration(); exp != nil {
+		select {
+		case c.expirationCh <- exp.AsTime():
+		default:
+		}
+	}
 
 	// Get a wrapped net.Conn so we can use io.Copy
 	netConn := websocket.NetConn(c.proxyCtx, wsConn, websocket.MessageBinary)
@@ -783,6 +815,27 @@ func (c *Command) runTcpProxyV1(
 	return nil
 }
 
+// refreshExpiration reads the session to learn whether its expiration time has
+// been extended past the one we know of, and returns the new expiration time
+// if so.
+func (c *Command) refreshExpiration() (time.Time, bool) {
+	client, err := c.Client()
+	if err != nil {
+		return time.Time{}, false
+	}
+	ctx, cancel := context.WithTimeout(c.Context, sessionCancelTimeout)
+	defer cancel()
+	res, err := sessions.NewClient(client).Read(ctx, c.sessionAuthzData.GetSessionId())
+	if err != nil {
+		return time.Time{}, false
+	}
+	exp := res.GetItem().(*sessions.Session).ExpirationTime
+	if !exp.After(c.expiration) {
+		return time.Time{}, false
+	}
+	return exp, true
+}
+
 func (c *Command) updateConnsLeft(connsLeft int32) {
 	c.connectionsLeft.Store(connsLeft)
 
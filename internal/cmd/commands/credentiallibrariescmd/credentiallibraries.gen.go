@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"credential-store-id", "filter"},
+	"list": {"credential-store-id", "filter", "page-size", "list-token", "all"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -161,6 +161,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, credentiallibraries.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, credentiallibraries.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, credentiallibraries.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -180,7 +188,11 @@ func (c *Command) Run(args []string) int {
 		result, err = credentiallibrariesClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = credentiallibrariesClient.List(c.Context, c.FlagCredentialStoreId, opts...)
+		if c.FlagAll {
+			listResult, err = credentiallibrariesClient.ListAll(c.Context, c.FlagCredentialStoreId, opts...)
+		} else {
+			listResult, err = credentiallibrariesClient.List(c.Context, c.FlagCredentialStoreId, opts...)
+		}
 
 	}
 
@@ -231,6 +243,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*credentiallibraries.CredentialLibrary)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*credentiallibraries.CredentialLibraryListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
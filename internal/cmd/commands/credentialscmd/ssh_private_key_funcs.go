@@ -0,0 +1,105 @@
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSshPrivateKeyFlagsFunc = extraSshPrivateKeyFlagsFuncImpl
+	extraSshPrivateKeyActionsFlagsMapFunc = extraSshPrivateKeyActionsFlagsMapFuncImpl
+	extraSshPrivateKeyFlagsHandlingFunc = extraSshPrivateKeyFlagHandlingFuncImpl
+}
+
+const (
+	privateKeyFlagName = "private-key"
+)
+
+type extraSshPrivateKeyCmdVars struct {
+	flagUsername   string
+	flagPrivateKey string
+}
+
+func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			usernameFlagName,
+			privateKeyFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraSshPrivateKeyFlagsFuncImpl(c *SshPrivateKeyCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH Private Key Credential Options")
+
+	for _, name := range flagsSshPrivateKeyMap[c.Func] {
+		switch name {
+		case usernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameFlagName,
+				Target: &c.flagUsername,
+				Usage:  "The username associated with the credential.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM-encoded SSH private key associated with the credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraSshPrivateKeyFlagHandlingFuncImpl(c *SshPrivateKeyCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentials.WithSshPrivateKeyCredentialUsername(c.flagUsername))
+	}
+	switch c.flagPrivateKey {
+	case "":
+	default:
+		privateKey, err := parseutil.ParsePath(c.flagPrivateKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing private key flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentials.WithSshPrivateKeyCredentialPrivateKey(privateKey))
+	}
+
+	return true
+}
+
+func (c *SshPrivateKeyCommand) extraSshPrivateKeyHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create ssh-private-key [options] [args]",
+			"",
+			"  Create an ssh-private-key-type credential. Example:",
+			"",
+			`    $ boundary credentials create ssh-private-key -credential-store-id csst_1234567890 -username user -private-key file:///home/user/.ssh/id_ed25519`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update ssh-private-key [options] [args]",
+			"",
+			"  Update an ssh-private-key-type credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update ssh-private-key -id credspk_1234567890 -private-key file:///home/user/.ssh/id_ed25519`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*Command)(nil)
+	_ cli.CommandAutocomplete = (*Command)(nil)
+)
+
+type Command struct {
+	*base.Command
+}
+
+func (c *Command) Synopsis() string {
+	return "Work with Boundary's event sink outputs"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary events [sub command] [options] [args]",
+		"",
+		"  This command allows operations on the output of Boundary's event sinks. Example:",
+		"",
+		"    Verify the audit hash chain of a file sink's output:",
+		"",
+		`      $ boundary events verify -config=/etc/boundary/controller.hcl /var/log/boundary/audit-*.log /var/log/boundary/audit.log`,
+		"",
+		"  Please see the events subcommand help for detailed usage information.",
+	})
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	return nil
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	return cli.RunResultHelp
+}
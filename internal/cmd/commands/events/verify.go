@@ -0,0 +1,317 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/wrapper"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*VerifyCommand)(nil)
+	_ cli.CommandAutocomplete = (*VerifyCommand)(nil)
+)
+
+type VerifyCommand struct {
+	*base.Command
+	srv *base.Server
+
+	Config *config.Config
+
+	configWrapper wrapping.Wrapper
+
+	flagConfig    string
+	flagConfigKms string
+}
+
+// VerifyResult is the output of the verify command.
+type VerifyResult struct {
+	Records  int              `json:"records"`
+	Chains   int              `json:"chains"`
+	Problems []*VerifyProblem `json:"problems"`
+}
+
+// VerifyProblem is a problem found by the verify command.
+type VerifyProblem struct {
+	Kind    string `json:"kind"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	ChainId string `json:"chain_id,omitempty"`
+	Seq     uint64 `json:"seq,omitempty"`
+	Message string `json:"message"`
+}
+
+func (c *VerifyCommand) Synopsis() string {
+	return "Verify the audit hash chain of a file sink's output"
+}
+
+func (c *VerifyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary events verify [options] [files]",
+		"",
+		`  Verify the audit hash chain written by a file sink with "hash_chain" enabled in its "audit_config" block. The rotated files of the sink must be given in the order they were written, oldest first, followed by the sink's current file:`,
+		"",
+		"    $ boundary events verify -config=/etc/boundary/controller.hcl /var/log/boundary/audit-*.log /var/log/boundary/audit.log",
+		"",
+		"  The records are verified with the audit keys stored in the database, so the configuration file must contain the controller's database and root KMS configuration. Deleted records, records which are out of order, and modified records are reported. If any are found, the command exits with a non-zero status.",
+		"",
+		"  For a full list of examples, please see the documentation.",
+	}) + c.Flags().Help()
+}
+
+func (c *VerifyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	return set
+}
+
+func (c *VerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *VerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *VerifyCommand) Run(args []string) (retCode int) {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+	files := c.Flags().Args()
+	if len(files) == 0 {
+		c.UI.Error("No files to verify were given")
+		return base.CommandUserError
+	}
+
+	if c.configWrapper != nil {
+		defer func() {
+			if err := c.configWrapper.Finalize(c.Context); err != nil {
+				c.UI.Warn(fmt.Errorf("Error finalizing config kms: %w", err).Error())
+			}
+		}()
+	}
+
+	if c.Config.Controller == nil {
+		c.UI.Error(`"controller" config block not found`)
+		return base.CommandUserError
+	}
+	if c.Config.Controller.Database == nil {
+		c.UI.Error(`"controller.database" config block not found`)
+		return base.CommandUserError
+	}
+
+	c.srv = base.NewServer(&base.Command{UI: c.UI})
+	if err := c.srv.SetupLogging("", "", c.Config.LogLevel, c.Config.LogFormat); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+	if err := c.srv.SetupKMSes(c.UI, c.Config); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+	if c.srv.RootKms == nil {
+		c.UI.Error("Root KMS not found after parsing KMS blocks")
+		return base.CommandCliError
+	}
+
+	urlToParse := c.Config.Controller.Database.Url
+	if urlToParse == "" {
+		c.UI.Error(`"url" not specified in "database" config block`)
+		return base.CommandUserError
+	}
+	var err error
+	c.srv.DatabaseUrl, err = parseutil.ParsePath(urlToParse)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.UI.Error(fmt.Errorf("Error parsing database url: %w", err).Error())
+		return base.CommandUserError
+	}
+	if err := c.srv.ConnectToDatabase(c.Context, "postgres"); err != nil {
+		c.UI.Error(fmt.Errorf("Error connecting to database: %w", err).Error())
+		return base.CommandCliError
+	}
+	defer func() {
+		if err := c.srv.Database.Close(c.Context); err != nil {
+			c.UI.Warn(fmt.Errorf("Error closing database: %w", err).Error())
+		}
+	}()
+
+	rw := db.New(c.srv.Database)
+	kmsRepo, err := kms.NewRepository(rw, rw)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating kms repository: %w", err).Error())
+		return base.CommandCliError
+	}
+	kmsCache, err := kms.NewKms(kmsRepo)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating kms cache: %w", err).Error())
+		return base.CommandCliError
+	}
+	if err := kmsCache.AddExternalWrappers(kms.WithRootWrapper(c.srv.RootKms)); err != nil {
+		c.UI.Error(fmt.Errorf("Error adding config keys to kms: %w", err).Error())
+		return base.CommandCliError
+	}
+
+	verifier, err := event.NewHashChainVerifier(func(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+		return kmsCache.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeAudit, kms.WithKeyId(keyId))
+	})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error opening file: %w", err).Error())
+			return base.CommandUserError
+		}
+		err = verifier.Verify(c.Context, name, f)
+		f.Close()
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error verifying %s: %w", name, err).Error())
+			return base.CommandCliError
+		}
+	}
+
+	result := &VerifyResult{
+		Records:  verifier.Records,
+		Chains:   verifier.Chains,
+		Problems: make([]*VerifyProblem, 0, len(verifier.Problems)),
+	}
+	for _, p := range verifier.Problems {
+		result.Problems = append(result.Problems, &VerifyProblem{
+			Kind:    string(p.Kind),
+			File:    p.File,
+			Line:    p.Line,
+			ChainId: p.ChainId,
+			Seq:     p.Seq,
+			Message: p.Msg,
+		})
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(result)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(generateVerifyTableOutput(result))
+	}
+
+	if len(result.Problems) > 0 {
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+func (c *VerifyCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return base.CommandUserError
+	}
+
+	wrapperPath := c.flagConfig
+	if c.flagConfigKms != "" {
+		wrapperPath = c.flagConfigKms
+	}
+	wrapper, err := wrapper.GetWrapperFromPath(wrapperPath, "config")
+	if err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+	if wrapper != nil {
+		c.configWrapper = wrapper
+		if err := wrapper.Init(c.Context); err != nil {
+			c.UI.Error(fmt.Errorf("Could not initialize kms: %w", err).Error())
+			return base.CommandUserError
+		}
+	}
+
+	c.Config, err = config.LoadFile(c.flagConfig, wrapper)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return base.CommandUserError
+	}
+
+	return base.CommandSuccess
+}
+
+func generateVerifyTableOutput(in *VerifyResult) string {
+	nonAttributeMap := map[string]interface{}{
+		"Records":  in.Records,
+		"Chains":   in.Chains,
+		"Problems": len(in.Problems),
+	}
+
+	maxLength := 0
+	for k := range nonAttributeMap {
+		if len(k) > maxLength {
+			maxLength = len(k)
+		}
+	}
+
+	ret := []string{
+		"",
+		"Audit hash chain verification:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	if len(in.Problems) > 0 {
+		ret = append(ret,
+			"",
+			"  Problems:",
+		)
+		for _, p := range in.Problems {
+			ret = append(ret, fmt.Sprintf("    %s:%d: %s: %s", p.File, p.Line, p.Kind, strings.TrimSpace(p.Message)))
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -103,7 +103,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -198,6 +198,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, groups.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, groups.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, groups.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -259,7 +267,11 @@ func (c *Command) Run(args []string) int {
 		result, err = groupsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = groupsClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = groupsClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = groupsClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -310,6 +322,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*groups.Group)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*groups.GroupListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
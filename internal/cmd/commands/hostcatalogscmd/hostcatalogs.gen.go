@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -166,6 +166,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, hostcatalogs.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostcatalogs.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, hostcatalogs.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -185,7 +193,11 @@ func (c *Command) Run(args []string) int {
 		result, err = hostcatalogsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = hostcatalogsClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = hostcatalogsClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = hostcatalogsClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -236,6 +248,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*hostcatalogs.HostCatalog)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*hostcatalogs.HostCatalogListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -172,6 +172,14 @@ func (c *PluginCommand) Run(args []string) int {
 		opts = append(opts, hostcatalogs.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostcatalogs.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, hostcatalogs.WithListToken(c.FlagListToken))
+	}
+
 	switch c.FlagPluginId {
 	case "":
 	default:
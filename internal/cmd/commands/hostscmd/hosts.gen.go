@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"host-catalog-id", "filter"},
+	"list": {"host-catalog-id", "filter", "page-size", "list-token", "all"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -177,6 +177,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, hosts.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hosts.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, hosts.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -196,7 +204,11 @@ func (c *Command) Run(args []string) int {
 		result, err = hostsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = hostsClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		if c.FlagAll {
+			listResult, err = hostsClient.ListAll(c.Context, c.FlagHostCatalogId, opts...)
+		} else {
+			listResult, err = hostsClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		}
 
 	}
 
@@ -247,6 +259,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*hosts.Host)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*hosts.HostListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -93,7 +93,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"host-catalog-id", "filter"},
+	"list": {"host-catalog-id", "filter", "page-size", "list-token", "all"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -179,6 +179,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, hostsets.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostsets.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, hostsets.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -226,7 +234,11 @@ func (c *Command) Run(args []string) int {
 		result, err = hostsetsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = hostsetsClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		if c.FlagAll {
+			listResult, err = hostsetsClient.ListAll(c.Context, c.FlagHostCatalogId, opts...)
+		} else {
+			listResult, err = hostsetsClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		}
 
 	}
 
@@ -277,6 +289,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*hostsets.HostSet)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*hostsets.HostSetListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -0,0 +1,91 @@
+package managedgroupscmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+)
+
+func init() {
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagsHandlingFuncImpl
+}
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {filterFlagName},
+		"update": {filterFlagName},
+	}
+}
+
+type extraLdapCmdVars struct {
+	flagFilter string
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups create ldap [options] [args]",
+			"",
+			"  Create a ldap-type managed group. Example:",
+			"",
+			`    $ boundary managed-groups create ldap -filter '"/groups" contains "admins"' -description "Ldap managed group for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups update ldap [options] [args]",
+			"",
+			"  Update a ldap-type managed group given its ID. Example:",
+			"",
+			`    $ boundary managed-groups update ldap -id mgldap_1234567890 -name "devops" -description "Ldap managed group for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   filterFlagName,
+				Target: &c.flagFilter,
+				Usage:  "The filter defining the criteria against which an account's LDAP entry and groups will be evaluated to determine membership at login time.",
+			})
+		}
+	}
+}
+
+func extraLdapFlagsHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]managedgroups.Option) bool {
+	switch c.flagFilter {
+	case "null":
+		c.UI.Error("Filter must be defined, and cannot be cleared.")
+		return false
+
+	case "":
+		if c.Func == "create" {
+			c.UI.Error("The -filter flag must be provided when creating a managed group.")
+			return false
+		}
+
+	default:
+		_, err := bexpr.CreateEvaluator(c.flagFilter)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error when parsing filter to check validity: %v", err))
+			return false
+		}
+		*opts = append(*opts, managedgroups.WithLdapManagedGroupFilter(c.flagFilter))
+	}
+
+	return true
+}
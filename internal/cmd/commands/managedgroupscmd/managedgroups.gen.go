@@ -91,7 +91,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"auth-method-id", "filter"},
+	"list": {"auth-method-id", "filter", "page-size", "list-token", "all"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -177,6 +177,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, managedgroups.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, managedgroups.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, managedgroups.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -196,7 +204,11 @@ func (c *Command) Run(args []string) int {
 		result, err = managedgroupsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = managedgroupsClient.List(c.Context, c.FlagAuthMethodId, opts...)
+		if c.FlagAll {
+			listResult, err = managedgroupsClient.ListAll(c.Context, c.FlagAuthMethodId, opts...)
+		} else {
+			listResult, err = managedgroupsClient.List(c.Context, c.FlagAuthMethodId, opts...)
+		}
 
 	}
 
@@ -247,6 +259,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*managedgroups.ManagedGroup)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*managedgroups.ManagedGroupListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
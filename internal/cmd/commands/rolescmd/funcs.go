@@ -25,14 +25,16 @@ type extraCmdVars struct {
 	flagGrantScopeId string
 	flagPrincipals   []string
 	flagGrants       []string
+	flagValidFrom    string
+	flagValidUntil   string
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create":            {"grant-scope-id"},
 		"update":            {"grant-scope-id"},
-		"add-principals":    {"id", "principal", "version"},
-		"set-principals":    {"id", "principal", "version"},
+		"add-principals":    {"id", "principal", "version", "valid-from", "valid-until"},
+		"set-principals":    {"id", "principal", "version", "valid-from", "valid-until"},
 		"remove-principals": {"id", "principal", "version"},
 		"add-grants":        {"id", "grant", "version"},
 		"set-grants":        {"id", "grant", "version"},
@@ -79,6 +81,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890`,
 			"",
+			`  The "valid-from" and "valid-until" flags limit the time the principals are assigned to the role. Example:`,
+			"",
+			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890 -valid-until 2024-01-31T18:00:00Z`,
+			"",
 			"",
 		})
 
@@ -90,6 +96,8 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles set-principals -id r_1234567890 -principal u_anon -principal sg_1234567890`,
 			"",
+			`  The "valid-from" and "valid-until" flags limit the time the principals are assigned to the role. Principals already on the role are assigned again with the given window.`,
+			"",
 			"",
 		})
 
@@ -158,6 +166,18 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrincipals,
 				Usage:  "The principals (users or groups) to add, remove, or set. May be specified multiple times.",
 			})
+		case "valid-from":
+			f.StringVar(&base.StringVar{
+				Name:   "valid-from",
+				Target: &c.flagValidFrom,
+				Usage:  "The time, in RFC 3339 format, from which the principals are assigned to the role. If not set, they are assigned immediately.",
+			})
+		case "valid-until":
+			f.StringVar(&base.StringVar{
+				Name:   "valid-until",
+				Target: &c.flagValidUntil,
+				Usage:  "The time, in RFC 3339 format, until which the principals are assigned to the role. If not set, they stay assigned until removed.",
+			})
 		case "grant":
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "grant",
@@ -177,6 +197,23 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 		*opts = append(*opts, roles.WithGrantScopeId(c.flagGrantScopeId))
 	}
 
+	if c.flagValidFrom != "" {
+		validFrom, err := time.Parse(time.RFC3339, c.flagValidFrom)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q as an RFC 3339 time: %s", c.flagValidFrom, err))
+			return false
+		}
+		*opts = append(*opts, roles.WithValidFrom(validFrom))
+	}
+	if c.flagValidUntil != "" {
+		validUntil, err := time.Parse(time.RFC3339, c.flagValidUntil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q as an RFC 3339 time: %s", c.flagValidUntil, err))
+			return false
+		}
+		*opts = append(*opts, roles.WithValidUntil(validUntil))
+	}
+
 	switch c.Func {
 	case "add-principals", "remove-principals":
 		if len(c.flagPrincipals) == 0 {
@@ -359,6 +396,16 @@ func printItemTable(result api.GenericResult) string {
 			fmt.Sprintf("      Type:         %s", principal.Type),
 			fmt.Sprintf("      Scope ID:     %s", principal.ScopeId),
 		)
+		if !principal.ValidFrom.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Valid From:   %s", principal.ValidFrom.Local().Format(time.RFC1123)),
+			)
+		}
+		if !principal.ValidUntil.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Valid Until:  %s", principal.ValidUntil.Local().Format(time.RFC1123)),
+			)
+		}
 	}
 	if len(item.Grants) > 0 {
 		ret = append(ret,
@@ -103,7 +103,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -198,6 +198,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, roles.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, roles.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, roles.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -283,7 +291,11 @@ func (c *Command) Run(args []string) int {
 		result, err = rolesClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = rolesClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = rolesClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = rolesClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -334,6 +346,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*roles.Role)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*roles.RoleListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -2,30 +2,41 @@ package scopescmd
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 const (
 	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
 	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
 	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
+	flagKeyVersionIdName            = "key-version-id"
+	flagAuthTokenTimeToLiveName     = "auth-token-time-to-live"
+	flagAuthTokenTimeToStaleName    = "auth-token-time-to-stale"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
-		"update": {flagPrimaryAuthMethodIdName},
+		"create":              {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName, flagAuthTokenTimeToLiveName, flagAuthTokenTimeToStaleName},
+		"update":              {flagPrimaryAuthMethodIdName, flagAuthTokenTimeToLiveName, flagAuthTokenTimeToStaleName},
+		"list-keys":           {"id"},
+		"rotate-keys":         {"id"},
+		"destroy-key-version": {"id", flagKeyVersionIdName},
 	}
 }
 
@@ -33,6 +44,66 @@ type extraCmdVars struct {
 	flagSkipAdminRoleCreation   bool
 	flagSkipDefaultRoleCreation bool
 	flagPrimaryAuthMethodId     string
+	flagKeyVersionId            string
+	flagAuthTokenTimeToLive     string
+	flagAuthTokenTimeToStale    string
+	keys                        *scopes.KeyListResult
+	keysResult                  *scopes.KeyResult
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "list-keys":
+		return "List the keys of a scope"
+	case "rotate-keys":
+		return "Rotate the keys of a scope"
+	case "destroy-key-version":
+		return "Destroy a previous key version of a scope"
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "list-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes list-keys [options] [args]",
+			"",
+			"  List the root key and the data encryption keys of the scope specified by ID, along with their versions. Example:",
+			"",
+			`    $ boundary scopes list-keys -id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "rotate-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes rotate-keys [options] [args]",
+			"",
+			"  Create a new version of the root key and of each data encryption key of the scope specified by ID. New data is encrypted with the new key versions; existing data is re-encrypted in the background. Example:",
+			"",
+			`    $ boundary scopes rotate-keys -id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "destroy-key-version":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes destroy-key-version [options] [args]",
+			"",
+			"  Destroy a previous key version of the scope specified by ID. A key version can only be destroyed once no data is encrypted with it anymore. Example:",
+			"",
+			`    $ boundary scopes destroy-key-version -id o_1234567890 -key-version-id krkv_1234567890`,
+			"",
+			"",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+	return helpStr + c.Flags().Help()
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -56,6 +127,24 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrimaryAuthMethodId,
 				Usage:  "If set, the primary auth method id for the scope.  A primary auth method is allowed to create users on first login and is also used as a source for account full name and email for a scope's users",
 			})
+		case flagKeyVersionIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagKeyVersionIdName,
+				Target: &c.flagKeyVersionId,
+				Usage:  "The ID of the key version to destroy.",
+			})
+		case flagAuthTokenTimeToLiveName:
+			f.StringVar(&base.StringVar{
+				Name:   flagAuthTokenTimeToLiveName,
+				Target: &c.flagAuthTokenTimeToLive,
+				Usage:  `The duration after which the auth tokens issued by the auth methods of the scope expire, such as "8h", unless set on the auth method. Set to "null" to use the controller configuration.`,
+			})
+		case flagAuthTokenTimeToStaleName:
+			f.StringVar(&base.StringVar{
+				Name:   flagAuthTokenTimeToStaleName,
+				Target: &c.flagAuthTokenTimeToStale,
+				Usage:  `The duration after which the auth tokens issued by the auth methods of the scope expire if they are not used, such as "1h", unless set on the auth method. Set to "null" to use the controller configuration.`,
+			})
 		}
 	}
 }
@@ -70,10 +159,125 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]scopes.Opt
 	if c.flagPrimaryAuthMethodId != "" {
 		*opts = append(*opts, scopes.WithPrimaryAuthMethodId(c.flagPrimaryAuthMethodId))
 	}
+	if c.Func == "destroy-key-version" && c.flagKeyVersionId == "" {
+		c.UI.Error("Key version ID must be passed in via -key-version-id")
+		return false
+	}
+	for _, flag := range []struct {
+		name  string
+		value string
+		with  func(uint32) scopes.Option
+		def   func() scopes.Option
+	}{
+		{flagAuthTokenTimeToLiveName, c.flagAuthTokenTimeToLive, scopes.WithAuthTokenTimeToLive, scopes.DefaultAuthTokenTimeToLive},
+		{flagAuthTokenTimeToStaleName, c.flagAuthTokenTimeToStale, scopes.WithAuthTokenTimeToStale, scopes.DefaultAuthTokenTimeToStale},
+	} {
+		switch flag.value {
+		case "":
+		case "null":
+			*opts = append(*opts, flag.def())
+		default:
+			d, err := parseutil.ParseDurationSecond(flag.value)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s %q: %s", flag.name, flag.value, err))
+				return false
+			}
+			if d < time.Second || d.Seconds() > math.MaxUint32 {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s %q: duration must be between 1s and %d seconds", flag.name, flag.value, uint32(math.MaxUint32)))
+				return false
+			}
+			*opts = append(*opts, flag.with(uint32(d.Seconds())))
+		}
+	}
 
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, scopeClient *scopes.Client, _ uint32, opts []scopes.Option) (api.GenericResult, error) {
+	var err error
+	switch c.Func {
+	case "list-keys":
+		c.keys, err = scopeClient.ListKeys(c.Context, c.FlagId, opts...)
+		return nil, err
+	case "rotate-keys":
+		c.keysResult, err = scopeClient.RotateKeys(c.Context, c.FlagId, opts...)
+		return c.keysResult, err
+	case "destroy-key-version":
+		c.keysResult, err = scopeClient.DestroyKeyVersion(c.Context, c.FlagId, c.flagKeyVersionId, opts...)
+		return c.keysResult, err
+	}
+	return origResult, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "list-keys":
+		switch base.Format(c.UI) {
+		case "json":
+			if ok := c.PrintJsonItems(c.keys); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+		case "table":
+			c.UI.Output(printKeysTable(c.keys.Items))
+		}
+		return true, nil
+
+	case "rotate-keys", "destroy-key-version":
+		switch base.Format(c.UI) {
+		case "json":
+			if ok := c.PrintJsonItem(c.keysResult); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+		case "table":
+			c.UI.Output(fmt.Sprintf("The %s operation completed successfully.", c.Func))
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func printKeysTable(items []*scopes.Key) string {
+	if len(items) == 0 {
+		return "No keys found"
+	}
+	output := []string{
+		"",
+		"Key information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                    %s", item.Id),
+			fmt.Sprintf("    Purpose:             %s", item.Purpose),
+			fmt.Sprintf("    Type:                %s", item.Type),
+		)
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.Versions) > 0 {
+			output = append(output, "    Versions:")
+		}
+		for _, v := range item.Versions {
+			output = append(output,
+				fmt.Sprintf("      ID:                %s", v.Id),
+				fmt.Sprintf("        Version:         %d", v.Version),
+				fmt.Sprintf("        State:           %s", v.State),
+			)
+			if !v.CreatedTime.IsZero() {
+				output = append(output,
+					fmt.Sprintf("        Created Time:    %s", v.CreatedTime.Local().Format(time.RFC1123)),
+				)
+			}
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func (c *Command) printListTable(items []*scopes.Scope) string {
 	if len(items) == 0 {
 		return "No child scopes found"
@@ -152,6 +356,12 @@ func printItemTable(result api.GenericResult) string {
 	if item.PrimaryAuthMethodId != "" {
 		nonAttributeMap["Primary Auth Method ID"] = item.PrimaryAuthMethodId
 	}
+	if item.AuthTokenTimeToLive != 0 {
+		nonAttributeMap["Auth Token Time To Live"] = (time.Duration(item.AuthTokenTimeToLive) * time.Second).String()
+	}
+	if item.AuthTokenTimeToStale != 0 {
+		nonAttributeMap["Auth Token Time To Stale"] = (time.Duration(item.AuthTokenTimeToStale) * time.Second).String()
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
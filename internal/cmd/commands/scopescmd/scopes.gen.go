@@ -84,7 +84,7 @@ func (c *Command) Help() string {
 
 	default:
 
-		helpStr = helpMap["base"]()
+		helpStr = c.extraHelpFunc(helpMap)
 
 	}
 
@@ -103,7 +103,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -198,6 +198,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, scopes.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, scopes.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, scopes.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -233,7 +241,11 @@ func (c *Command) Run(args []string) int {
 		result, err = scopesClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = scopesClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = scopesClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = scopesClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -284,6 +296,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*scopes.Scope)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*scopes.ScopeListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/cmd/ops"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/schema"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -48,6 +49,7 @@ type Command struct {
 	Config     *config.Config
 	controller *controller.Controller
 	worker     *worker.Worker
+	opsServer  *ops.Server
 
 	configWrapper wrapping.Wrapper
 
@@ -174,6 +176,11 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	if err := c.SetupTracing(ctx, c.Config.Tracing, strings.Join(serverNames, "/")); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
 	// Initialize status grace period (0 denotes using env or default
 	// here)
 	c.SetStatusGracePeriodDuration(0)
@@ -237,6 +244,8 @@ func (c *Command) Run(args []string) int {
 				if lnConfig.Address == "" {
 					lnConfig.Address = "127.0.0.1:9202"
 				}
+			case ops.Purpose:
+				// Served once the controller and worker are started
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown listener purpose %q", lnConfig.Purpose[0]))
 				return base.CommandUserError
@@ -339,7 +348,7 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", ops.Purpose}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -475,6 +484,21 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
+	if err := c.StartOps(ctx); err != nil {
+		c.UI.Error(err.Error())
+		if c.worker != nil {
+			if err := c.worker.Shutdown(false); err != nil {
+				c.UI.Error(fmt.Errorf("Error with worker shutdown: %w", err).Error())
+			}
+		}
+		if c.controller != nil {
+			if err := c.controller.Shutdown(false); err != nil {
+				c.UI.Error(fmt.Errorf("Error with controller shutdown: %w", err).Error())
+			}
+		}
+		return base.CommandCliError
+	}
+
 	// Inform any tests that the server is ready
 	if c.startedCh != nil {
 		close(c.startedCh)
@@ -593,6 +617,25 @@ func (c *Command) StartWorker() error {
 	return nil
 }
 
+// StartOps starts serving the health and metrics endpoints on the listeners
+// with the "ops" purpose. It must be called after the controller and the
+// worker are started, since the health endpoint reports on them.
+func (c *Command) StartOps(ctx context.Context) error {
+	checks := make(map[string]ops.HealthCheckFn, 2)
+	if c.controller != nil {
+		checks["controller"] = c.controller.HealthCheck
+	}
+	if c.worker != nil {
+		w := c.worker
+		checks["worker"] = func(context.Context) error { return w.HealthCheck() }
+	}
+	c.opsServer = ops.NewServer(ctx, c.Listeners, checks)
+	if err := c.opsServer.Start(); err != nil {
+		return fmt.Errorf("Error starting ops server: %w", err)
+	}
+	return nil
+}
+
 func (c *Command) WaitForInterrupt() int {
 	const op = "server.(Command).WaitForInterrupt"
 	// Wait for shutdown
@@ -632,6 +675,14 @@ func (c *Command) WaitForInterrupt() int {
 				}
 			}
 
+			// The ops listeners are served until the end so that health
+			// checks observe the shutdown
+			if c.opsServer != nil {
+				if err := c.opsServer.Shutdown(); err != nil {
+					c.UI.Error(fmt.Errorf("Error shutting down ops server: %w", err).Error())
+				}
+			}
+
 			shutdownTriggered = true
 
 		case <-c.SighupCh:
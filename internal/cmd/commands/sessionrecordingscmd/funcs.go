@@ -0,0 +1,260 @@
+package sessionrecordingscmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/recording"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagOutput  string
+	flagMaxIdle time.Duration
+	download    *sessionrecordings.SessionRecordingDownloadResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"download": {"id", "output"},
+		"play":     {"id", "max-idle"},
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary session-recordings [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary session recordings.",
+			"",
+			"    Read a session recording:",
+			"",
+			`      $ boundary session-recordings read -id sr_1234567890`,
+			"",
+			"  Please see the session-recordings subcommand help for detailed usage information.",
+		})
+
+	case "download":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary session-recordings download [options] [args]",
+			"",
+			"  Download the session recording specified by ID in asciicast v2 format. Example:",
+			"",
+			`    $ boundary session-recordings download -id sr_1234567890 -output session.cast`,
+			"",
+			"",
+		})
+
+	case "play":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary session-recordings play [options] [args]",
+			"",
+			"  Play back the session recording specified by ID in the terminal. Example:",
+			"",
+			`    $ boundary session-recordings play -id sr_1234567890 -max-idle 2s`,
+			"",
+			"",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "output":
+			f.StringVar(&base.StringVar{
+				Name:   "output",
+				Target: &c.flagOutput,
+				Usage:  "The file to write the recording to. If not set, the recording is written to standard output.",
+			})
+		case "max-idle":
+			f.DurationVar(&base.DurationVar{
+				Name:   "max-idle",
+				Target: &c.flagMaxIdle,
+				Usage:  "The longest pause between two outputs during playback. If not set, pauses are played back as recorded.",
+			})
+		}
+	}
+}
+
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, sessionRecordingClient *sessionrecordings.Client, version uint32, opts []sessionrecordings.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "download", "play":
+		result, err := sessionRecordingClient.Download(c.Context, c.FlagId, opts...)
+		c.download = result
+		return result, err
+	}
+	return origResult, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "download":
+		if c.flagOutput == "" {
+			if _, err := os.Stdout.Write(c.download.Content); err != nil {
+				return false, fmt.Errorf("Error writing recording: %w", err)
+			}
+			return true, nil
+		}
+		if err := os.WriteFile(c.flagOutput, c.download.Content, 0o600); err != nil {
+			return false, fmt.Errorf("Error writing recording to %q: %w", c.flagOutput, err)
+		}
+		return true, nil
+
+	case "play":
+		if err := recording.Play(c.Context, os.Stdout, bytes.NewReader(c.download.Content), c.flagMaxIdle); err != nil {
+			return false, fmt.Errorf("Error playing recording: %w", err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (c *Command) printListTable(items []*sessionrecordings.SessionRecording) string {
+	if len(items) == 0 {
+		return "No session recordings found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Session Recording information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.SessionId != "" {
+			output = append(output,
+				fmt.Sprintf("    Session ID:          %s", item.SessionId),
+			)
+		}
+		if item.TargetId != "" {
+			output = append(output,
+				fmt.Sprintf("    Target ID:           %s", item.TargetId),
+			)
+		}
+		if item.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:             %s", item.UserId),
+			)
+		}
+		if !item.StartTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Start Time:          %s", item.StartTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.EndTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    End Time:            %s", item.EndTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*sessionrecordings.SessionRecording)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.SessionId != "" {
+		nonAttributeMap["Session ID"] = item.SessionId
+	}
+	if item.ConnectionId != "" {
+		nonAttributeMap["Connection ID"] = item.ConnectionId
+	}
+	if item.TargetId != "" {
+		nonAttributeMap["Target ID"] = item.TargetId
+	}
+	if item.UserId != "" {
+		nonAttributeMap["User ID"] = item.UserId
+	}
+	if item.WorkerId != "" {
+		nonAttributeMap["Worker ID"] = item.WorkerId
+	}
+	if item.Bytes != 0 {
+		nonAttributeMap["Bytes"] = item.Bytes
+	}
+	if !item.StartTime.IsZero() {
+		nonAttributeMap["Start Time"] = item.StartTime.Local().Format(time.RFC1123)
+	}
+	if !item.EndTime.IsZero() {
+		nonAttributeMap["End Time"] = item.EndTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Session Recording information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -2,6 +2,7 @@ package sessionscmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,12 +13,23 @@ import (
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
 }
 
+type extraCmdVars struct {
+	flagSeconds      string
+	seconds          uint32
+	flagConnectionId string
+}
+
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"cancel": {"id"},
+		"extend": {"id", "seconds"},
+
+		"close-connection": {"id", "connection-id"},
 	}
 }
 
@@ -48,6 +60,28 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "extend":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions extend [options] [args]",
+			"",
+			"  Extend the expiration time of the session specified by ID by the given amount of time. The expiration time can't be extended past the maximum extension allowed by the session's target. Example:",
+			"",
+			`    $ boundary sessions extend -id s_1234567890 -seconds 1h`,
+			"",
+			"",
+		})
+
+	case "close-connection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions close-connection [options] [args]",
+			"",
+			"  Close a single connection of the session specified by ID, leaving the session and its other connections active. If the connection is already closed, this command succeeds with no effect. Example:",
+			"",
+			`    $ boundary sessions close-connection -id s_1234567890 -connection-id sc_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -55,10 +89,63 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	return helpStr + c.Flags().Help()
 }
 
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "seconds",
+				Target: &c.flagSeconds,
+				Usage:  "The amount of time to extend the expiration time of the session by. Can be specified as an integer number of seconds or a duration string.",
+			})
+		case "connection-id":
+			f.StringVar(&base.StringVar{
+				Name:   "connection-id",
+				Target: &c.flagConnectionId,
+				Usage:  "The ID of the connection of the session to close.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]sessions.Option) bool {
+	switch c.Func {
+	case "extend":
+		if c.flagSeconds == "" {
+			c.UI.Error("No amount of time supplied via -seconds")
+			return false
+		}
+		secs, err := strconv.ParseUint(c.flagSeconds, 10, 32)
+		if err != nil {
+			dur, err := time.ParseDuration(c.flagSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSeconds, err))
+				return false
+			}
+			secs = uint64(dur.Seconds())
+		}
+		if secs == 0 {
+			c.UI.Error("The amount of time supplied via -seconds must be at least one second")
+			return false
+		}
+		c.seconds = uint32(secs)
+	case "close-connection":
+		if c.flagConnectionId == "" {
+			c.UI.Error("No connection ID supplied via -connection-id")
+			return false
+		}
+	}
+	return true
+}
+
 func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, sessionClient *sessions.Client, version uint32, opts []sessions.Option) (api.GenericResult, error) {
 	switch c.Func {
 	case "cancel":
 		return sessionClient.Cancel(c.Context, c.FlagId, version, opts...)
+	case "extend":
+		return sessionClient.Extend(c.Context, c.FlagId, version, c.seconds, opts...)
+	case "close-connection":
+		return sessionClient.CloseConnection(c.Context, c.FlagId, c.flagConnectionId, opts...)
 	}
 	return origResult, origError
 }
@@ -152,6 +239,9 @@ func printItemTable(result api.GenericResult) string {
 	if !item.ExpirationTime.IsZero() {
 		nonAttributeMap["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
 	}
+	if !item.MaxExpirationTime.IsZero() {
+		nonAttributeMap["Max Expiration Time"] = item.MaxExpirationTime.Local().Format(time.RFC1123)
+	}
 	if item.TargetId != "" {
 		nonAttributeMap["Target ID"] = item.TargetId
 	}
@@ -209,6 +299,27 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	var connectionsMaps []map[string]interface{}
+	if len(item.Connections) > 0 {
+		for _, conn := range item.Connections {
+			m := map[string]interface{}{
+				"ID":           conn.Id,
+				"Client":       fmt.Sprintf("%s:%d", conn.ClientTcpAddress, conn.ClientTcpPort),
+				"Endpoint":     fmt.Sprintf("%s:%d", conn.EndpointTcpAddress, conn.EndpointTcpPort),
+				"Created Time": conn.CreatedTime.Local().Format(time.RFC1123),
+			}
+			if conn.ClosedReason != "" {
+				m["Closed Reason"] = conn.ClosedReason
+				m["Bytes Up"] = conn.BytesUp
+				m["Bytes Down"] = conn.BytesDown
+			}
+			connectionsMaps = append(connectionsMaps, m)
+		}
+		if l := len("Closed Reason"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	ret := []string{
 		"",
 		"Session information:",
@@ -256,5 +367,18 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	if len(item.Connections) > 0 {
+		ret = append(ret,
+			"",
+			"  Connections:",
+		)
+		for _, m := range connectionsMaps {
+			ret = append(ret,
+				base.WrapMap(4, maxLength, m),
+				"",
+			)
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
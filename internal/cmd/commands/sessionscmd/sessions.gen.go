@@ -35,6 +35,8 @@ type Command struct {
 	Func string
 
 	plural string
+
+	extraCmdVars
 }
 
 func (c *Command) AutocompleteArgs() complete.Predictor {
@@ -86,7 +88,7 @@ var flagsMap = map[string][]string{
 
 	"read": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -157,9 +159,18 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, sessions.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, sessions.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, sessions.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
+
 	case "cancel":
 		switch c.FlagVersion {
 		case 0:
@@ -167,6 +178,15 @@ func (c *Command) Run(args []string) int {
 		default:
 			version = uint32(c.FlagVersion)
 		}
+
+	case "extend":
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, sessions.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+
 	}
 
 	if ok := extraFlagsHandlingFunc(c, f, &opts); !ok {
@@ -183,7 +203,11 @@ func (c *Command) Run(args []string) int {
 		result, err = sessionsClient.Read(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = sessionsClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = sessionsClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = sessionsClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -220,6 +244,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*sessions.Session)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*sessions.SessionListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -671,6 +671,9 @@ func printItemTable(result api.GenericResult) string {
 		if result.GetResponse().Map[globals.SessionMaxSecondsField] != nil {
 			nonAttributeMap["Session Max Seconds"] = item.SessionMaxSeconds
 		}
+		if result.GetResponse().Map[globals.SessionMaxExtensionSecondsField] != nil {
+			nonAttributeMap["Session Max Extension Seconds"] = item.SessionMaxExtensionSeconds
+		}
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
@@ -737,6 +740,24 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	if len(item.EgressCredentialSources) > 0 {
+		if credentialSourceMaps == nil {
+			credentialSourceMaps = make(map[credential.Purpose][]map[string]interface{})
+		}
+		var egressCredentialSourceMaps []map[string]interface{}
+		for _, source := range item.EgressCredentialSources {
+			m := map[string]interface{}{
+				"ID":                  source.Id,
+				"Credential Store ID": source.CredentialStoreId,
+			}
+			egressCredentialSourceMaps = append(egressCredentialSourceMaps, m)
+		}
+		credentialSourceMaps[credential.EgressPurpose] = egressCredentialSourceMaps
+		if l := len("Credential Store ID"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	ret := []string{
 		"",
 		"Target information:",
@@ -787,6 +808,17 @@ func printItemTable(result api.GenericResult) string {
 				)
 			}
 		}
+		if egressMap := credentialSourceMaps[credential.EgressPurpose]; len(egressMap) > 0 {
+			ret = append(ret,
+				"  Egress Credential Sources:",
+			)
+			for _, m := range egressMap {
+				ret = append(ret,
+					base.WrapMap(4, maxLength, m),
+					"",
+				)
+			}
+		}
 	}
 
 	if len(item.Attributes) > 0 {
@@ -907,7 +939,9 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 }
 
 var keySubstMap = map[string]string{
-	"default_port": "Default Port",
+	"default_port":       "Default Port",
+	"injection_protocol": "Injection Protocol",
+	"ssh_host_keys":      "SSH Host Keys",
 }
 
 func exampleOutput() string {
@@ -0,0 +1,205 @@
+package targetscmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSshActionsFlagsMapFunc = extraSshActionsFlagsMapFuncImpl
+	extraSshFlagsFunc = extraSshFlagsFuncImpl
+	extraSshFlagsHandlingFunc = extraSshFlagsHandlingFuncImpl
+}
+
+func extraSshActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"default-port", "session-max-seconds", "session-max-extension-seconds", "session-connection-limit", "worker-filter", "ssh-host-keys"},
+		"update": {"default-port", "session-max-seconds", "session-max-extension-seconds", "session-connection-limit", "worker-filter", "ssh-host-keys"},
+	}
+}
+
+type extraSshCmdVars struct {
+	flagDefaultPort                string
+	flagSessionMaxSeconds          string
+	flagSessionMaxExtensionSeconds string
+	flagSessionConnectionLimit     string
+	flagWorkerFilter               string
+	flagSshHostKeys                string
+}
+
+func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create ssh [options] [args]",
+			"",
+			"  Create an ssh-type target. Example:",
+			"",
+			`    $ boundary targets create ssh -name prodops -description "SSH target for ProdOps" -default-port 22 -ssh-host-keys file:///etc/boundary/prodops_host_keys`,
+			"",
+			"  The worker authenticates to the host with the target's egress credential sources, which can be added with \"boundary targets add-credential-sources -egress-credential-source\". The host must present one of the keys set with -ssh-host-keys.",
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update ssh [options] [args]",
+			"",
+			"  Update an ssh-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update ssh -id tssh_1234567890 -name "devops" -description "SSH target for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("SSH Target Options")
+
+	for _, name := range flagsSshMap[c.Func] {
+		switch name {
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-max-extension-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-extension-seconds",
+				Target: &c.flagSessionMaxExtensionSeconds,
+				Usage:  `The maximum total time the expiration of a session can be extended by. Zero means sessions can't be extended. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
+			})
+		case "ssh-host-keys":
+			fs.StringVar(&base.StringVar{
+				Name:   "ssh-host-keys",
+				Target: &c.flagSshHostKeys,
+				Usage:  `The public keys the hosts must present when the worker terminates the SSH connection, in authorized_keys format, one per line. Can be a "file://" path or an "env://" environment variable name.`,
+			})
+		}
+	}
+}
+
+func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionMaxExtensionSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxExtensionSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxExtensionSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxExtensionSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxExtensionSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxExtensionSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagSshHostKeys {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetSshHostKeys())
+	default:
+		keys, err := parseutil.ParsePath(c.flagSshHostKeys)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing ssh host keys: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetSshHostKeys(keys))
+	}
+
+	return true
+}
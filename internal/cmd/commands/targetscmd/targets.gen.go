@@ -94,7 +94,7 @@ var flagsMap = map[string][]string{
 
 	"delete": {"id"},
 
-	"list": {"scope-id", "filter", "recursive"},
+	"list": {"scope-id", "filter", "page-size", "list-token", "all", "recursive"},
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -185,6 +185,14 @@ func (c *Command) Run(args []string) int {
 		opts = append(opts, targets.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, targets.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, targets.WithListToken(c.FlagListToken))
+	}
+
 	var version uint32
 
 	switch c.Func {
@@ -304,7 +312,11 @@ func (c *Command) Run(args []string) int {
 		result, err = targetsClient.Delete(c.Context, c.FlagId, opts...)
 
 	case "list":
-		listResult, err = targetsClient.List(c.Context, c.FlagScopeId, opts...)
+		if c.FlagAll {
+			listResult, err = targetsClient.ListAll(c.Context, c.FlagScopeId, opts...)
+		} else {
+			listResult, err = targetsClient.List(c.Context, c.FlagScopeId, opts...)
+		}
 
 	}
 
@@ -355,6 +367,12 @@ func (c *Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*targets.Target)
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*targets.TargetListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
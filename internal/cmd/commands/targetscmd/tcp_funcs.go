@@ -1,6 +1,7 @@
 package targetscmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 func init() {
@@ -18,16 +20,19 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
-		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
+		"create": {"default-port", "session-max-seconds", "session-max-extension-seconds", "session-connection-limit", "worker-filter", "injection-protocol", "ssh-host-keys"},
+		"update": {"default-port", "session-max-seconds", "session-max-extension-seconds", "session-connection-limit", "worker-filter", "injection-protocol", "ssh-host-keys"},
 	}
 }
 
 type extraTcpCmdVars struct {
-	flagDefaultPort            string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
+	flagDefaultPort                string
+	flagSessionMaxSeconds          string
+	flagSessionMaxExtensionSeconds string
+	flagSessionConnectionLimit     string
+	flagWorkerFilter               string
+	flagInjectionProtocol          string
+	flagSshHostKeys                string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -75,6 +80,12 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagSessionMaxSeconds,
 				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
 			})
+		case "session-max-extension-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-extension-seconds",
+				Target: &c.flagSessionMaxExtensionSeconds,
+				Usage:  `The maximum total time the expiration of a session can be extended by. Zero means sessions can't be extended. Can be specified as an integer number of seconds or a duration string.`,
+			})
 		case "session-connection-limit":
 			fs.StringVar(&base.StringVar{
 				Name:   "session-connection-limit",
@@ -87,6 +98,18 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagWorkerFilter,
 				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
 			})
+		case "injection-protocol":
+			fs.StringVar(&base.StringVar{
+				Name:   "injection-protocol",
+				Target: &c.flagInjectionProtocol,
+				Usage:  `The protocol spoken to the target's hosts, used by workers to inject the target's egress credentials into connections. Can be "ssh" or "postgres".`,
+			})
+		case "ssh-host-keys":
+			fs.StringVar(&base.StringVar{
+				Name:   "ssh-host-keys",
+				Target: &c.flagSshHostKeys,
+				Usage:  `The public keys the hosts must present when the worker terminates the SSH connection, in authorized_keys format, one per line. Can be a "file://" path or an "env://" environment variable name.`,
+			})
 		}
 	}
 }
@@ -125,6 +148,26 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
 	}
 
+	switch c.flagSessionMaxExtensionSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxExtensionSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxExtensionSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxExtensionSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxExtensionSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxExtensionSeconds(final))
+	}
+
 	switch c.flagSessionConnectionLimit {
 	case "":
 	case "null":
@@ -150,5 +193,26 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
 	}
 
+	switch c.flagInjectionProtocol {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetInjectionProtocol())
+	default:
+		*opts = append(*opts, targets.WithTcpTargetInjectionProtocol(c.flagInjectionProtocol))
+	}
+
+	switch c.flagSshHostKeys {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetSshHostKeys())
+	default:
+		keys, err := parseutil.ParsePath(c.flagSshHostKeys)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing ssh host keys: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithTcpTargetSshHostKeys(keys))
+	}
+
 	return true
 }
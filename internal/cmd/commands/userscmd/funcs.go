@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/api/users"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/mitchellh/go-wordwrap"
@@ -17,10 +18,19 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
-	flagAccounts []string
+	flagAccounts     []string
+	flagResourceId   string
+	flagResourceType string
+	flagPinId        string
+	flagAction       string
+	flagClientIp     string
+	flagAccountId    string
+	explanation      *scopes.PermissionsExplanationResult
+	revokedTokens    *users.RevokeTokensResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +38,8 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-accounts":    {"id", "account", "version"},
 		"set-accounts":    {"id", "account", "version"},
 		"remove-accounts": {"id", "account", "version"},
+		"explain":         {"id", "scope-id", "resource-id", "resource-type", "pin-id", "action", "client-ip"},
+		"revoke-tokens":   {"id", "account-id"},
 	}
 }
 
@@ -44,6 +56,10 @@ func extraSynopsisFuncImpl(c *Command) string {
 			in = "Remove accounts from"
 		}
 		return wordwrap.WrapString(fmt.Sprintf("%s a user within Boundary", in), base.TermWidth)
+	case "explain":
+		return "Explain the permissions of a user within a scope"
+	case "revoke-tokens":
+		return "Revoke the auth tokens of a user and cancel their sessions"
 	}
 
 	return ""
@@ -85,6 +101,28 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "explain":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary users explain [options] [args]",
+			"",
+			`  Explains how the grants of a user given its ID apply within a scope. With a resource, it shows the actions the user is authorized to perform on the resource and the grants, along with their roles, which authorize them; with an action, it also shows whether the action is authorized and, if not, why grants with conditions did not apply. Without a resource, it lists all the grants of the user within the scope. Example:`,
+			"",
+			`    $ boundary users explain -id u_1234567890 -scope-id p_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"",
+		})
+
+	case "revoke-tokens":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary users revoke-tokens [options] [args]",
+			"",
+			`  Revokes all the auth tokens of a user given its ID and cancels the pending or active sessions created with them. With an account, only the auth tokens issued for that account of the user are revoked. Example:`,
+			"",
+			`    $ boundary users revoke-tokens -id u_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -100,12 +138,58 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagAccounts,
 				Usage:  "The accounts to add, remove, or set. May be specified multiple times.",
 			})
+		case "resource-id":
+			f.StringVar(&base.StringVar{
+				Name:   "resource-id",
+				Target: &c.flagResourceId,
+				Usage:  "The ID of the resource to explain the permissions on.",
+			})
+		case "resource-type":
+			f.StringVar(&base.StringVar{
+				Name:   "resource-type",
+				Target: &c.flagResourceType,
+				Usage:  "The type of the resource to explain the permissions on. Derived from the resource ID if not set; if no resource ID is set, the collection of resources of this type is explained.",
+			})
+		case "pin-id":
+			f.StringVar(&base.StringVar{
+				Name:   "pin-id",
+				Target: &c.flagPinId,
+				Usage:  "The ID of the parent of the resource, e.g. the host catalog of a host.",
+			})
+		case "action":
+			f.StringVar(&base.StringVar{
+				Name:   "action",
+				Target: &c.flagAction,
+				Usage:  "The action to decide whether the user is authorized to perform on the resource.",
+			})
+		case "client-ip":
+			f.StringVar(&base.StringVar{
+				Name:   "client-ip",
+				Target: &c.flagClientIp,
+				Usage:  "The client IP to evaluate the client_ip conditions of grants against.",
+			})
+		case "account-id":
+			f.StringVar(&base.StringVar{
+				Name:   "account-id",
+				Target: &c.flagAccountId,
+				Usage:  "If set, only the auth tokens issued for this account of the user are revoked.",
+			})
 		}
 	}
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]users.Option) bool {
 	switch c.Func {
+	case "explain":
+		if c.FlagScopeId == "" {
+			c.UI.Error("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID")
+			return false
+		}
+		if c.flagAction != "" && c.flagResourceId == "" && c.flagResourceType == "" {
+			c.UI.Error("An action can only be explained along with -resource-id or -resource-type")
+			return false
+		}
+
 	case "add-accounts", "remove-accounts":
 		if len(c.flagAccounts) == 0 {
 			c.UI.Error("No accounts supplied via -account")
@@ -135,10 +219,63 @@ func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError
 		return userClient.SetAccounts(c.Context, c.FlagId, version, c.flagAccounts, opts...)
 	case "remove-accounts":
 		return userClient.RemoveAccounts(c.Context, c.FlagId, version, c.flagAccounts, opts...)
+	case "explain":
+		client, err := c.Client()
+		if err != nil {
+			return nil, err
+		}
+		var scopeOpts []scopes.Option
+		if c.flagResourceId != "" {
+			scopeOpts = append(scopeOpts, scopes.WithResourceId(c.flagResourceId))
+		}
+		if c.flagResourceType != "" {
+			scopeOpts = append(scopeOpts, scopes.WithResourceType(c.flagResourceType))
+		}
+		if c.flagPinId != "" {
+			scopeOpts = append(scopeOpts, scopes.WithPinId(c.flagPinId))
+		}
+		if c.flagAction != "" {
+			scopeOpts = append(scopeOpts, scopes.WithAction(c.flagAction))
+		}
+		if c.flagClientIp != "" {
+			scopeOpts = append(scopeOpts, scopes.WithClientIp(c.flagClientIp))
+		}
+		c.explanation, err = scopes.NewClient(client).ExplainPermissions(c.Context, c.FlagScopeId, c.FlagId, scopeOpts...)
+		return c.explanation, err
+	case "revoke-tokens":
+		var err error
+		c.revokedTokens, err = userClient.RevokeTokens(c.Context, c.FlagId, c.flagAccountId, opts...)
+		return c.revokedTokens, err
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "explain":
+		switch base.Format(c.UI) {
+		case "json":
+			if ok := c.PrintJsonItem(c.explanation); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+		case "table":
+			c.UI.Output(printExplanationTable(c.explanation.Item))
+		}
+		return true, nil
+	case "revoke-tokens":
+		switch base.Format(c.UI) {
+		case "json":
+			if ok := c.PrintJsonItem(c.revokedTokens); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+		case "table":
+			c.UI.Output(printRevokedTokensTable(c.revokedTokens.Item))
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*users.User) string {
 	if len(items) == 0 {
 		return "No users found"
@@ -302,3 +439,109 @@ func printItemTable(result api.GenericResult) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printExplanationTable(item *scopes.PermissionsExplanation) string {
+	nonAttributeMap := map[string]interface{}{}
+	if item.UserId != "" {
+		nonAttributeMap["User ID"] = item.UserId
+	}
+	if item.ResourceId != "" {
+		nonAttributeMap["Resource ID"] = item.ResourceId
+	}
+	if item.ResourceType != "" {
+		nonAttributeMap["Resource Type"] = item.ResourceType
+	}
+	if item.PinId != "" {
+		nonAttributeMap["Pin ID"] = item.PinId
+	}
+	if item.Action != "" {
+		nonAttributeMap["Action"] = item.Action
+	}
+	if item.Authorized != nil {
+		nonAttributeMap["Authorized"] = *item.Authorized
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Permissions explanation:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	if len(item.DenialReasons) > 0 {
+		ret = append(ret,
+			"",
+			"  Denial Reasons:",
+			base.WrapSlice(4, item.DenialReasons),
+		)
+	}
+
+	if len(item.Grants) > 0 {
+		ret = append(ret,
+			"",
+			"  Grants:",
+		)
+		for _, g := range item.Grants {
+			m := map[string]interface{}{
+				"Role ID": g.RoleId,
+				"Grant":   g.Grant,
+			}
+			if len(g.AuthorizedActions) > 0 {
+				m["Authorized Actions"] = strings.Join(g.AuthorizedActions, ", ")
+			}
+			ret = append(ret,
+				base.WrapMap(4, len("Authorized Actions"), m),
+				"",
+			)
+		}
+	} else {
+		ret = append(ret,
+			"",
+			"  No grants of the user apply.",
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
+func printRevokedTokensTable(item *users.RevokedTokens) string {
+	ret := []string{
+		"",
+		"Revoked tokens information:",
+		fmt.Sprintf("  User ID:                 %s", item.Item.Id),
+		fmt.Sprintf("  Revoked Auth Tokens:     %d", len(item.AuthTokenIds)),
+		fmt.Sprintf("  Canceled Sessions:       %d", len(item.CanceledSessionIds)),
+	}
+	if len(item.AuthTokenIds) > 0 {
+		ret = append(ret,
+			"",
+			"  Auth Token IDs:",
+			base.WrapSlice(4, item.AuthTokenIds),
+		)
+	}
+	if len(item.CanceledSessionIds) > 0 {
+		ret = append(ret,
+			"",
+			"  Canceled Session IDs:",
+			base.WrapSlice(4, item.CanceledSessionIds),
+		)
+	}
+	return base.WrapForHelpText(ret)
+}
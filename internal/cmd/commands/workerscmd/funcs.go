@@ -0,0 +1,288 @@
+package workerscmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/go-wordwrap"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+type extraCmdVars struct {
+	flagTags []string
+	tags     map[string][]string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"add-worker-tags":    {"id", "tag", "version"},
+		"set-worker-tags":    {"id", "tag", "version"},
+		"remove-worker-tags": {"id", "tag", "version"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "add-worker-tags", "set-worker-tags", "remove-worker-tags":
+		var in string
+		switch {
+		case strings.HasPrefix(c.Func, "add"):
+			in = "Add API managed tags to"
+		case strings.HasPrefix(c.Func, "set"):
+			in = "Set the full contents of the API managed tags on"
+		case strings.HasPrefix(c.Func, "remove"):
+			in = "Remove API managed tags from"
+		}
+		return wordwrap.WrapString(fmt.Sprintf("%s a worker", in), base.TermWidth)
+
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary workers [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary workers. Workers register themselves with the controllers; they cannot be created through the API. Example:",
+			"",
+			"    Read a worker:",
+			"",
+			`      $ boundary workers read -id worker-1`,
+			"",
+			"  Please see the workers subcommand help for detailed usage information.",
+		})
+
+	case "add-worker-tags":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers add-worker-tags [options] [args]",
+			"",
+			`  Adds API managed tags to a worker given its ID. The "tag" flag can be specified multiple times. Example:`,
+			"",
+			`    $ boundary workers add-worker-tags -id worker-1 -tag region=us-east-1 -tag type=prod,dev`,
+			"",
+			"",
+		})
+
+	case "set-worker-tags":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers set-worker-tags [options] [args]",
+			"",
+			`  Sets the complete set of API managed tags on a worker given its ID. The "tag" flag can be specified multiple times. Use "null" to remove all API managed tags. Tags set in the worker's configuration are not affected. Example:`,
+			"",
+			`    $ boundary workers set-worker-tags -id worker-1 -tag region=us-east-1`,
+			"",
+			"",
+		})
+
+	case "remove-worker-tags":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers remove-worker-tags [options] [args]",
+			"",
+			`  Removes API managed tags from a worker given its ID. The "tag" flag can be specified multiple times. Example:`,
+			"",
+			`    $ boundary workers remove-worker-tags -id worker-1 -tag region=us-east-1`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "tag":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "tag",
+				Target: &c.flagTags,
+				Usage:  `The API managed tags to add, remove, or set, in the form "key=value". Multiple values for a key can be separated by commas. May be specified multiple times.`,
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]workers.Option) bool {
+	switch c.Func {
+	case "add-worker-tags", "remove-worker-tags", "set-worker-tags":
+		if len(c.flagTags) == 0 {
+			c.UI.Error("No tags supplied via -tag")
+			return false
+		}
+		if c.Func == "set-worker-tags" && len(c.flagTags) == 1 && c.flagTags[0] == "null" {
+			return true
+		}
+		c.tags = make(map[string][]string, len(c.flagTags))
+		for _, t := range c.flagTags {
+			kv := strings.SplitN(t, "=", 2)
+			if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+				c.UI.Error(fmt.Sprintf("Tag %q is not in the form key=value", t))
+				return false
+			}
+			key := strings.TrimSpace(kv[0])
+			for _, v := range strings.Split(kv[1], ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					c.tags[key] = append(c.tags[key], v)
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, workerClient *workers.Client, version uint32, opts []workers.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "add-worker-tags":
+		return workerClient.AddWorkerTags(c.Context, c.FlagId, version, c.tags, opts...)
+	case "set-worker-tags":
+		return workerClient.SetWorkerTags(c.Context, c.FlagId, version, c.tags, opts...)
+	case "remove-worker-tags":
+		return workerClient.RemoveWorkerTags(c.Context, c.FlagId, version, c.tags, opts...)
+	}
+	return origResult, origError
+}
+
+func (c *Command) printListTable(items []*workers.Worker) string {
+	if len(items) == 0 {
+		return "No workers found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Worker information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if item.Address != "" {
+			output = append(output,
+				fmt.Sprintf("    Address:             %s", item.Address),
+			)
+		}
+		if !item.LastStatusTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Last Status Time:    %s", item.LastStatusTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*workers.Worker)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.Address != "" {
+		nonAttributeMap["Address"] = item.Address
+	}
+	if !item.LastStatusTime.IsZero() {
+		nonAttributeMap["Last Status Time"] = item.LastStatusTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Worker information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	for _, tags := range []struct {
+		title string
+		tags  map[string][]string
+	}{
+		{"Config Tags", item.ConfigTags},
+		{"API Tags", item.ApiTags},
+		{"Canonical Tags", item.CanonicalTags},
+	} {
+		if len(tags.tags) == 0 {
+			continue
+		}
+		tagMap := make(map[string]interface{}, len(tags.tags))
+		for k, v := range tags.tags {
+			tagMap[k] = strings.Join(v, ", ")
+		}
+		ret = append(ret,
+			"",
+			fmt.Sprintf("  %s:", tags.title),
+			base.WrapMap(4, base.MaxAttributesLength(tagMap, nil, nil), tagMap),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
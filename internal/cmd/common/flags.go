@@ -111,6 +111,24 @@ func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string,
 					Target: &c.FlagFilter,
 					Usage:  "If set, the list operation will be filtered before being returned. The filter operates against each item in the list. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/resource-listing for details.",
 				})
+			case "page-size":
+				f.UintVar(&base.UintVar{
+					Name:   "page-size",
+					Target: &c.FlagPageSize,
+					Usage:  "If set, at most this many items are returned. If more items are available, a list token is returned that can be passed to -list-token to fetch the next page.",
+				})
+			case "list-token":
+				f.StringVar(&base.StringVar{
+					Name:   "list-token",
+					Target: &c.FlagListToken,
+					Usage:  "If set, the list operation continues after the last item returned by the list operation that returned this token.",
+				})
+			case "all":
+				f.BoolVar(&base.BoolVar{
+					Name:   "all",
+					Target: &c.FlagAll,
+					Usage:  "If set, all pages of the list are fetched and returned together. Combine with -page-size to control how many items are fetched per request.",
+				})
 			}
 		}
 	}
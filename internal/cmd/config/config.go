@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/configutil"
@@ -106,6 +107,10 @@ type Config struct {
 	// Eventing configuration for the controller
 	Eventing *event.EventerConfig `hcl:"events"`
 
+	// Tracing configuration, set in the "tracing" block of the "telemetry"
+	// stanza. Tracing is disabled when it's nil.
+	Tracing *trace.Config `hcl:"-"`
+
 	// Plugin-related options
 	Plugins Plugins `hcl:"plugins"`
 }
@@ -125,6 +130,14 @@ type Controller struct {
 	AuthTokenTimeToStale         interface{} `hcl:"auth_token_time_to_stale"`
 	AuthTokenTimeToStaleDuration time.Duration
 
+	// RecordingStorage is the location the ssh session recordings made by
+	// workers are read from. It must be the same location as the
+	// recording_storage of the workers, so an object store rather than a
+	// local directory is needed when controllers and workers run on
+	// different hosts. Workers cannot report recordings which are not under
+	// it, and recordings cannot be downloaded if it is empty.
+	RecordingStorage string `hcl:"recording_storage"`
+
 	// StatusGracePeriod represents the period of time (as a duration) that the
 	// controller will wait before marking connections from a disconnected worker
 	// as invalid.
@@ -161,6 +174,19 @@ type Worker struct {
 	TagsRaw interface{}         `hcl:"tags"`
 	Tags    map[string][]string `hcl:"-"`
 
+	// RecordingStorage is the location ssh session recordings are written
+	// to. It is either a path to a local directory or a URI of a registered
+	// recording storage scheme. Sessions are not recorded if it is empty.
+	RecordingStorage string `hcl:"recording_storage"`
+
+	// ShutdownDrainTimeout is the maximum time the worker waits, when shutting
+	// down, for the connections it proxies to be closed by their clients. The
+	// worker stops accepting new connections and reports itself as shutting
+	// down to the controllers in the meantime. Connections are not drained if
+	// it is not set.
+	ShutdownDrainTimeout         interface{}   `hcl:"shutdown_drain_timeout"`
+	ShutdownDrainTimeoutDuration time.Duration `hcl:"-"`
+
 	// StatusGracePeriod represents the period of time (as a duration) that the
 	// worker will wait before disconnecting connections if it cannot make a
 	// status report to a controller.
@@ -390,6 +416,17 @@ func Parse(d string) (*Config, error) {
 				}
 			}
 		}
+
+		if result.Worker.ShutdownDrainTimeout != nil {
+			t, err := parseutil.ParseDurationSecond(result.Worker.ShutdownDrainTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing the worker's shutdown drain timeout: %w", err)
+			}
+			if t < 0 {
+				return nil, errors.New("Worker shutdown drain timeout must not be negative")
+			}
+			result.Worker.ShutdownDrainTimeoutDuration = t
+		}
 	}
 
 	sharedConfig, err := configutil.ParseConfig(d)
@@ -437,10 +474,65 @@ func Parse(d string) (*Config, error) {
 	default:
 		return nil, fmt.Errorf(`too many "events" nodes (max 1, got %d)`, len(eventList.Items))
 	}
+	// The audit key used by hash chains is held by the controller's kms, so
+	// a worker on its own has none.
+	if result.Controller == nil {
+		for _, sc := range result.Eventing.Sinks {
+			if sc.AuditConfig != nil && sc.AuditConfig.HashChain {
+				return nil, fmt.Errorf(`sink %q: "hash_chain" requires a "controller" stanza, workers have no audit key`, sc.Name)
+			}
+		}
+	}
+
+	telemetryList := list.Filter("telemetry")
+	switch len(telemetryList.Items) {
+	case 0:
+	case 1:
+		if result.Tracing, err = parseTracing(telemetryList.Items[0]); err != nil {
+			return nil, fmt.Errorf(`error parsing "telemetry": %w`, err)
+		}
+	default:
+		return nil, fmt.Errorf(`too many "telemetry" nodes (max 1, got %d)`, len(telemetryList.Items))
+	}
 
 	return result, nil
 }
 
+// parseTracing parses the "tracing" block of the "telemetry" stanza. It
+// returns nil when there is no "tracing" block.
+func parseTracing(telemetryObj *ast.ObjectItem) (*trace.Config, error) {
+	telemetryObjType, ok := telemetryObj.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, fmt.Errorf(`error interpreting "telemetry" node as an object type`)
+	}
+	tracingList := telemetryObjType.List.Filter("tracing")
+	switch len(tracingList.Items) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf(`too many "tracing" nodes (max 1, got %d)`, len(tracingList.Items))
+	}
+
+	var result trace.Config
+	if err := hcl.DecodeObject(&result, tracingList.Items[0].Val); err != nil {
+		return nil, fmt.Errorf(`error decoding "tracing" node: %w`, err)
+	}
+	result.Exporter = trace.ExporterType(strings.ToLower(string(result.Exporter)))
+	result.Protocol = trace.Protocol(strings.ToLower(string(result.Protocol)))
+	if result.TimeoutHCL != "" {
+		var err error
+		result.Timeout, err = parseutil.ParseDurationSecond(result.TimeoutHCL)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse tracing timeout %s", result.TimeoutHCL)
+		}
+	}
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 	// Decode the outside struct
 	var result event.EventerConfig
@@ -474,6 +566,10 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
+			case s.WebhookConfig != nil:
+				s.Type = event.WebhookSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -495,6 +591,23 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// parse the duration strings specified in a webhook config into time.Durations
+		if s.WebhookConfig != nil {
+			var err error
+			if s.WebhookConfig.BatchTimeoutHCL != "" {
+				s.WebhookConfig.BatchTimeout, err = parseutil.ParseDurationSecond(s.WebhookConfig.BatchTimeoutHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse batch timeout %s", s.WebhookConfig.BatchTimeoutHCL)
+				}
+			}
+			if s.WebhookConfig.RequestTimeoutHCL != "" {
+				s.WebhookConfig.RequestTimeout, err = parseutil.ParseDurationSecond(s.WebhookConfig.RequestTimeoutHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse request timeout %s", s.WebhookConfig.RequestTimeoutHCL)
+				}
+			}
+		}
+
 		if err := s.Validate(); err != nil {
 			return nil, err
 		}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/go-secure-stdlib/configutil"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/stretchr/testify/assert"
@@ -524,3 +525,294 @@ func TestController_EventingConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEventing_SyslogAndWebhookSinks(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	c, err := Parse(`events {
+		sink "syslog" {
+			format = "cloudevents-json"
+			name = "siem"
+			event_types = [ "audit" ]
+			syslog {
+				network = "tcp"
+				address = "siem.example.com:514"
+				facility = "local3"
+			}
+		}
+		sink {
+			format = "cloudevents-json"
+			name = "alerts"
+			event_types = [ "error" ]
+			webhook {
+				url = "https://alerts.example.com/boundary"
+				headers = { "Authorization" = "Bearer token" }
+				hmac_secret = "secret"
+				batch_size = 10
+				batch_timeout = "2s"
+				request_timeout = 30
+				max_retries = 5
+			}
+		}
+	}`)
+	require.NoError(err)
+	assert.Equal(&event.EventerConfig{
+		Sinks: []*event.SinkConfig{
+			{
+				Type:       event.SyslogSink,
+				Name:       "siem",
+				Format:     event.JSONSinkFormat,
+				EventTypes: []event.Type{event.AuditType},
+				SyslogConfig: &event.SyslogSinkTypeConfig{
+					Network:  "tcp",
+					Address:  "siem.example.com:514",
+					Facility: "local3",
+				},
+			},
+			{
+				Type:       event.WebhookSink,
+				Name:       "alerts",
+				Format:     event.JSONSinkFormat,
+				EventTypes: []event.Type{event.ErrorType},
+				WebhookConfig: &event.WebhookSinkTypeConfig{
+					Url:               "https://alerts.example.com/boundary",
+					Headers:           map[string]string{"Authorization": "Bearer token"},
+					HmacSecret:        "secret",
+					BatchSize:         10,
+					BatchTimeout:      2 * time.Second,
+					BatchTimeoutHCL:   "2s",
+					RequestTimeout:    30 * time.Second,
+					RequestTimeoutHCL: "30",
+					MaxRetries:        5,
+				},
+			},
+		},
+	}, c.Eventing)
+
+	_, err = Parse(`events {
+		sink "webhook" {
+			format = "cloudevents-json"
+			name = "alerts"
+			event_types = [ "error" ]
+			webhook {
+				url = "ftp://alerts.example.com"
+			}
+		}
+	}`)
+	require.Error(err)
+	assert.Contains(err.Error(), "not a valid http or https url")
+}
+
+func TestParseEventing_HashChain(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	c, err := Parse(`controller {}
+	events {
+		audit_enabled = true
+		sink {
+			format = "cloudevents-json"
+			name = "audit-sink"
+			event_types = [ "audit" ]
+			file {
+				file_name = "audit.log"
+			}
+			audit_config {
+				hash_chain = true
+			}
+		}
+	}`)
+	require.NoError(err)
+	require.Len(c.Eventing.Sinks, 1)
+	require.NotNil(c.Eventing.Sinks[0].AuditConfig)
+	assert.True(c.Eventing.Sinks[0].AuditConfig.HashChain)
+
+	_, err = Parse(`worker {}
+	events {
+		audit_enabled = true
+		sink {
+			format = "cloudevents-json"
+			name = "audit-sink"
+			event_types = [ "audit" ]
+			file {
+				file_name = "audit.log"
+			}
+			audit_config {
+				hash_chain = true
+			}
+		}
+	}`)
+	require.Error(err)
+	assert.Contains(err.Error(), `"hash_chain" requires a "controller" stanza`)
+
+	_, err = Parse(`controller {}
+	events {
+		sink {
+			format = "cloudevents-text"
+			name = "audit-sink"
+			event_types = [ "audit" ]
+			file {
+				file_name = "audit.log"
+			}
+			audit_config {
+				hash_chain = true
+			}
+		}
+	}`)
+	require.Error(err)
+	assert.Contains(err.Error(), "hash chain requires a json sink format")
+}
+
+func TestParseTracing(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		in              string
+		want            *trace.Config
+		wantErrContains string
+	}{
+		{
+			name: "no-telemetry",
+			in:   `controller {}`,
+		},
+		{
+			name: "telemetry-without-tracing",
+			in: `telemetry {
+				prometheus_retention_time = "24h"
+			}`,
+		},
+		{
+			name: "otlp",
+			in: `telemetry {
+				tracing {
+					exporter = "OTLP"
+					endpoint = "collector.example.com:4317"
+					headers = {
+						"x-api-key" = "secret"
+					}
+					timeout = "5s"
+					sample_ratio = 0.25
+					service_name = "boundary-east"
+				}
+			}`,
+			want: &trace.Config{
+				Exporter:    trace.OtlpExporter,
+				Endpoint:    "collector.example.com:4317",
+				Headers:     map[string]string{"x-api-key": "secret"},
+				Timeout:     5 * time.Second,
+				TimeoutHCL:  "5s",
+				SampleRatio: func() *float64 { r := 0.25; return &r }(),
+				ServiceName: "boundary-east",
+			},
+		},
+		{
+			name: "file",
+			in: `telemetry {
+				tracing {
+					exporter = "file"
+					path = "/var/log/boundary/traces.json"
+				}
+			}`,
+			want: &trace.Config{
+				Exporter: trace.FileExporter,
+				Path:     "/var/log/boundary/traces.json",
+			},
+		},
+		{
+			name: "invalid-timeout",
+			in: `telemetry {
+				tracing {
+					exporter = "otlp"
+					timeout = "soon"
+				}
+			}`,
+			wantErrContains: "can't parse tracing timeout",
+		},
+		{
+			name: "invalid-config",
+			in: `telemetry {
+				tracing {
+					exporter = "file"
+				}
+			}`,
+			wantErrContains: "missing file exporter path",
+		},
+		{
+			name: "too-many-tracing-blocks",
+			in: `telemetry {
+				tracing {
+					exporter = "otlp"
+				}
+				tracing {
+					exporter = "otlp"
+				}
+			}`,
+			wantErrContains: `too many "tracing" nodes`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			c, err := Parse(tt.in)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, c.Tracing)
+		})
+	}
+}
+
+func TestWorker_ShutdownDrainTimeout(t *testing.T) {
+	t.Parallel()
+	config := `
+	worker {
+		name = "w_1234567890"
+		%s
+	}
+	`
+	cases := []struct {
+		name    string
+		setting string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:    "duration",
+			setting: `shutdown_drain_timeout = "5m"`,
+			want:    5 * time.Minute,
+		},
+		{
+			name:    "seconds",
+			setting: `shutdown_drain_timeout = 30`,
+			want:    30 * time.Second,
+		},
+		{
+			name:    "negative",
+			setting: `shutdown_drain_timeout = "-5m"`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			setting: `shutdown_drain_timeout = "forever"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(fmt.Sprintf(config, tt.setting))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Worker.ShutdownDrainTimeoutDuration)
+		})
+	}
+}
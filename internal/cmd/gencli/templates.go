@@ -24,7 +24,7 @@ func fillTemplates() {
 
 			fName := pkg
 			if data.SubActionPrefix != "" {
-				fName = fmt.Sprintf("%s_%s", data.SubActionPrefix, fName)
+				fName = fmt.Sprintf("%s_%s", strcase.ToSnake(data.SubActionPrefix), fName)
 			}
 			outFile, err := filepath.Abs(fmt.Sprintf("%s/%scmd/%s.gen.go", os.Getenv("CLI_GEN_BASEPATH"), pkg, fName))
 			if err != nil {
@@ -185,7 +185,7 @@ var flags{{ camelCase .SubActionPrefix }}Map = map[string][]string{
 	"delete": {"id"},
 	{{ end }}
 	{{ if eq $action "list" }}
-	"list": { "{{ kebabCase $input.Container }}-id", "filter" {{ if (eq $input.Container "Scope") }}, "recursive"{{ end }} },
+	"list": { "{{ kebabCase $input.Container }}-id", "filter", "page-size", "list-token", "all" {{ if (eq $input.Container "Scope") }}, "recursive"{{ end }} },
 	{{ end }}
 	{{ end }}
 	{{ end }}
@@ -314,6 +314,14 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 		opts = append(opts, {{ .Pkg }}.WithFilter(c.FlagFilter))
 	}
 
+	if c.FlagPageSize != 0 {
+		opts = append(opts, {{ .Pkg }}.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	if c.FlagListToken != "" {
+		opts = append(opts, {{ .Pkg }}.WithListToken(c.FlagListToken))
+	}
+
 	{{ if .HasScopeName }}
 	switch c.FlagScopeName {
 	case "":
@@ -397,7 +405,7 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 	{{ range $i, $action := $input.StdActions }}
 	{{ if eq $action "create" }}
 	case "create":
-		result, err = {{ $input.Pkg }}Client.Create(c.Context, {{ if (and $input.SubActionPrefix $input.NeedsSubtypeInCreate) }}"{{ $input.SubActionPrefix }}",{{ end }} c.Flag{{ $input.Container }}Id, opts...)
+		result, err = {{ $input.Pkg }}Client.Create(c.Context, {{ if (and $input.SubActionPrefix $input.NeedsSubtypeInCreate) }}"{{ snakeCase $input.SubActionPrefix }}",{{ end }} c.Flag{{ $input.Container }}Id, opts...)
 	{{ end }}
 	{{ if eq $action "read" }}
 	case "read":
@@ -413,7 +421,11 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 	{{ end }}
 	{{ if eq $action "list" }}
 	case "list":
-		listResult, err = {{ $input.Pkg}}Client.List(c.Context, c.Flag{{ $input.Container }}Id, opts...)
+		if c.FlagAll {
+			listResult, err = {{ $input.Pkg}}Client.ListAll(c.Context, c.Flag{{ $input.Container }}Id, opts...)
+		} else {
+			listResult, err = {{ $input.Pkg}}Client.List(c.Context, c.Flag{{ $input.Container }}Id, opts...)
+		}
 	{{ end }}
 	{{ end }}
 	}
@@ -469,6 +481,12 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 		case "table":
 			listedItems := listResult.GetItems().([]*{{ $input.Pkg }}.{{ camelCase $input.ResourceType }})
 			c.UI.Output(c.printListTable(listedItems))
+			if token := listResult.(*{{ $input.Pkg }}.{{ camelCase $input.ResourceType }}ListResult).ListToken; token != "" {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					fmt.Sprintf("More items are available; pass -list-token %s to fetch the next page.", token),
+				}))
+			}
 		}
 
 		return base.CommandSuccess
@@ -0,0 +1,202 @@
+// Package ops serves the operational endpoints of a Boundary server on the
+// listeners with the "ops" purpose: /health, which reports whether the
+// controller and the worker running in the process are able to do their work,
+// and /metrics, which exposes Prometheus metrics.
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// Purpose is the purpose of the listeners served by the ops server.
+	Purpose = "ops"
+
+	statusOk          = "ok"
+	statusUnavailable = "unavailable"
+
+	// healthCheckTimeout bounds the time spent checking the health of each
+	// component.
+	healthCheckTimeout = 5 * time.Second
+)
+
+// HealthCheckFn returns an error when the component it checks is unhealthy.
+type HealthCheckFn func(context.Context) error
+
+// Server serves the ops endpoints.
+type Server struct {
+	listeners []*base.ServerListener
+	servers   []*http.Server
+}
+
+// NewServer returns a Server for the listeners with the "ops" purpose. The
+// checks report the health of the components of the server, by name.
+func NewServer(ctx context.Context, listeners []*base.ServerListener, checks map[string]HealthCheckFn) *Server {
+	h := Handler(checks)
+
+	s := &Server{}
+	for _, ln := range listeners {
+		if len(ln.Config.Purpose) != 1 || ln.Config.Purpose[0] != Purpose {
+			continue
+		}
+		server := &http.Server{
+			Handler:           h,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			IdleTimeout:       5 * time.Minute,
+			BaseContext: func(net.Listener) context.Context {
+				return ctx
+			},
+		}
+		if ln.Config.HTTPReadHeaderTimeout > 0 {
+			server.ReadHeaderTimeout = ln.Config.HTTPReadHeaderTimeout
+		}
+		if ln.Config.HTTPReadTimeout > 0 {
+			server.ReadTimeout = ln.Config.HTTPReadTimeout
+		}
+		if ln.Config.HTTPWriteTimeout > 0 {
+			server.WriteTimeout = ln.Config.HTTPWriteTimeout
+		}
+		if ln.Config.HTTPIdleTimeout > 0 {
+			server.IdleTimeout = ln.Config.HTTPIdleTimeout
+		}
+		s.listeners = append(s.listeners, ln)
+		s.servers = append(s.servers, server)
+	}
+	return s
+}
+
+// Start starts serving the ops endpoints on the listeners.
+func (s *Server) Start() error {
+	for i, ln := range s.listeners {
+		server := s.servers[i]
+		switch ln.Config.TLSDisable {
+		case true:
+			l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+			if err != nil {
+				return fmt.Errorf("error getting non-tls ops listener: %w", err)
+			}
+			if l == nil {
+				return errors.New("could not get non-tls ops listener")
+			}
+			go server.Serve(l)
+
+		default:
+			for _, v := range []string{"", "http/1.1", "h2"} {
+				l := ln.Mux.GetListener(v)
+				if l == nil {
+					return fmt.Errorf("could not get tls proto %q ops listener", v)
+				}
+				go server.Serve(l)
+			}
+		}
+	}
+	return nil
+}
+
+// Shutdown stops serving the ops endpoints and closes the listeners.
+func (s *Server) Shutdown() error {
+	var retErr *multierror.Error
+	wg := new(sync.WaitGroup)
+	var lock sync.Mutex
+	for i, ln := range s.listeners {
+		server := s.servers[i]
+		timeout := ln.Config.MaxRequestDuration
+		if timeout == 0 {
+			timeout = globals.DefaultMaxRequestDuration
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			if err := server.Shutdown(ctx); err != nil {
+				lock.Lock()
+				retErr = multierror.Append(retErr, err)
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	for _, ln := range s.listeners {
+		if err := ln.Mux.Close(); err != nil {
+			retErr = multierror.Append(retErr, err)
+		}
+	}
+	return retErr.ErrorOrNil()
+}
+
+// Handler returns the http.Handler serving /health, which runs the checks,
+// and /metrics.
+func Handler(checks map[string]HealthCheckFn) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/health", healthHandler(checks))
+	mux.Handle("/metrics", metric.Handler())
+	return mux
+}
+
+// ComponentHealth is the health of a component of the server.
+type ComponentHealth struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthResponse is the body of the responses to /health. Status is "ok" when
+// all components are healthy; the response status code is then 200, and 503
+// otherwise.
+type HealthResponse struct {
+	Status     string                      `json:"status"`
+	Components map[string]*ComponentHealth `json:"components,omitempty"`
+}
+
+func healthHandler(checks map[string]HealthCheckFn) http.HandlerFunc {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		resp := &HealthResponse{
+			Status:     statusOk,
+			Components: make(map[string]*ComponentHealth, len(checks)),
+		}
+		for _, name := range names {
+			ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+			err := checks[name](ctx)
+			cancel()
+			ch := &ComponentHealth{Status: statusOk}
+			if err != nil {
+				ch.Status = statusUnavailable
+				ch.Error = err.Error()
+				resp.Status = statusUnavailable
+			}
+			resp.Components[name] = ch
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if resp.Status != statusOk {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
@@ -0,0 +1,93 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Health(t *testing.T) {
+	healthy := func(context.Context) error { return nil }
+	unhealthy := func(context.Context) error { return errors.New("database unreachable") }
+
+	tests := []struct {
+		name       string
+		checks     map[string]HealthCheckFn
+		method     string
+		wantCode   int
+		wantStatus string
+		wantErrors map[string]string
+	}{
+		{
+			name:       "no-components",
+			method:     http.MethodGet,
+			wantCode:   http.StatusOK,
+			wantStatus: statusOk,
+		},
+		{
+			name:       "healthy",
+			checks:     map[string]HealthCheckFn{"controller": healthy, "worker": healthy},
+			method:     http.MethodGet,
+			wantCode:   http.StatusOK,
+			wantStatus: statusOk,
+			wantErrors: map[string]string{"controller": "", "worker": ""},
+		},
+		{
+			name:       "unhealthy",
+			checks:     map[string]HealthCheckFn{"controller": unhealthy, "worker": healthy},
+			method:     http.MethodGet,
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: statusUnavailable,
+			wantErrors: map[string]string{"controller": "database unreachable", "worker": ""},
+		},
+		{
+			name:     "bad-method",
+			method:   http.MethodPost,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			rec := httptest.NewRecorder()
+			Handler(tt.checks).ServeHTTP(rec, httptest.NewRequest(tt.method, "/health", nil))
+			assert.Equal(tt.wantCode, rec.Code)
+			if tt.wantStatus == "" {
+				return
+			}
+			var got HealthResponse
+			require.NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(tt.wantStatus, got.Status)
+			assert.Len(got.Components, len(tt.wantErrors))
+			for name, wantErr := range tt.wantErrors {
+				require.Contains(got.Components, name)
+				assert.Equal(wantErr, got.Components[name].Error)
+				if wantErr == "" {
+					assert.Equal(statusOk, got.Components[name].Status)
+				} else {
+					assert.Equal(statusUnavailable, got.Components[name].Status)
+				}
+			}
+		})
+	}
+}
+
+func TestHandler_Metrics(t *testing.T) {
+	srv := httptest.NewServer(Handler(nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "go_goroutines")
+}
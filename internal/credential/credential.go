@@ -99,6 +99,28 @@ type Revoker interface {
 	Revoke(ctx context.Context, sessionId string) error
 }
 
+// Static is a static credential stored in a credential store.
+type Static interface {
+	Credential
+	boundary.Resource
+	GetStoreId() string
+}
+
+// Type is the type of a credential.
+type Type string
+
+func (t Type) String() string {
+	return string(t)
+}
+
+// Credential type values.
+const (
+	UnspecifiedType      Type = "unspecified"
+	UsernamePasswordType Type = "username_password"
+	SshPrivateKeyType    Type = "ssh_private_key"
+	JsonType             Type = "json"
+)
+
 // Password represents a secret password.
 type Password string
 
@@ -0,0 +1,4 @@
+// Package static provides static credentials that are stored, encrypted,
+// in Boundary's database. Static credentials are held in a static
+// credential store and can be attached to targets as credential sources.
+package static
@@ -0,0 +1,135 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ credential.Static = (*JsonCredential)(nil)
+
+// A JsonCredential contains the credential with a JSON object. It is owned
+// by a credential store.
+type JsonCredential struct {
+	*store.JsonCredential
+	tableName string `gorm:"-"`
+}
+
+// NewJsonCredential creates a new in memory static Credential containing a
+// JSON object that is assigned to storeId. Name and description are the
+// only valid options. All other options are ignored.
+func NewJsonCredential(storeId string, object map[string]interface{}, opt ...Option) (*JsonCredential, error) {
+	const op = "static.NewJsonCredential"
+	var objectBytes []byte
+	if len(object) > 0 {
+		var err error
+		if objectBytes, err = json.Marshal(object); err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to marshal json object"))
+		}
+	}
+
+	opts := getOpts(opt...)
+	l := &JsonCredential{
+		JsonCredential: &store.JsonCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Object:      objectBytes,
+		},
+	}
+	return l, nil
+}
+
+func allocJsonCredential() *JsonCredential {
+	return &JsonCredential{
+		JsonCredential: &store.JsonCredential{},
+	}
+}
+
+func (c *JsonCredential) clone() *JsonCredential {
+	cp := proto.Clone(c.JsonCredential)
+	return &JsonCredential{
+		JsonCredential: cp.(*store.JsonCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *JsonCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_json_credential"
+}
+
+// SetTableName sets the table name.
+func (c *JsonCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+// Secret returns the JSON object of the credential decoded into a map. The
+// object is only available if the credential has been decrypted.
+func (c *JsonCredential) Secret() credential.SecretData {
+	object := make(map[string]interface{})
+	if len(c.GetObject()) > 0 {
+		// The object was validated as a JSON object when the credential was
+		// created so an error here can be ignored.
+		_ = json.Unmarshal(c.GetObject(), &object)
+	}
+	return object
+}
+
+func (c *JsonCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-static-json"},
+		"op-type":            []string{op.String()},
+	}
+	if c.StoreId != "" {
+		metadata["store-id"] = []string{c.StoreId}
+	}
+	return metadata
+}
+
+func (c *JsonCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(JsonCredential).encrypt"
+	if len(c.Object) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no object defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.JsonCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	if err := c.hmacObject(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (c *JsonCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(JsonCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.JsonCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *JsonCredential) hmacObject(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(JsonCredential).hmacObject"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.Object, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.ObjectHmac = []byte(hm)
+	return nil
+}
@@ -0,0 +1,73 @@
+package static
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := credential.Register(Subtype, CredentialStorePrefix, UsernamePasswordCredentialPrefix, SshPrivateKeyCredentialPrefix, JsonCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the static package.
+const (
+	CredentialStorePrefix            = "csst"
+	UsernamePasswordCredentialPrefix = "credup"
+	SshPrivateKeyCredentialPrefix    = "credspk"
+	JsonCredentialPrefix             = "credjson"
+
+	Subtype = subtypes.Subtype("static")
+)
+
+func newCredentialStoreId() (string, error) {
+	id, err := db.NewPublicId(CredentialStorePrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newUsernamePasswordCredentialId() (string, error) {
+	id, err := db.NewPublicId(UsernamePasswordCredentialPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newUsernamePasswordCredentialId")
+	}
+	return id, nil
+}
+
+func newSshPrivateKeyCredentialId() (string, error) {
+	id, err := db.NewPublicId(SshPrivateKeyCredentialPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newSshPrivateKeyCredentialId")
+	}
+	return id, nil
+}
+
+func newJsonCredentialId() (string, error) {
+	id, err := db.NewPublicId(JsonCredentialPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newJsonCredentialId")
+	}
+	return id, nil
+}
+
+// CredentialTypeFromId returns the credential.Type of the static credential
+// with the provided id based on its prefix. credential.UnspecifiedType is
+// returned if the id does not belong to a static credential.
+func CredentialTypeFromId(id string) credential.Type {
+	switch {
+	case strings.HasPrefix(id, UsernamePasswordCredentialPrefix+"_"):
+		return credential.UsernamePasswordType
+	case strings.HasPrefix(id, SshPrivateKeyCredentialPrefix+"_"):
+		return credential.SshPrivateKeyType
+	case strings.HasPrefix(id, JsonCredentialPrefix+"_"):
+		return credential.JsonType
+	}
+	return credential.UnspecifiedType
+}
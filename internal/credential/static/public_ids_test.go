@@ -0,0 +1,44 @@
+package static
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialTypeFromId(t *testing.T) {
+	t.Parallel()
+	upId, err := newUsernamePasswordCredentialId()
+	require.NoError(t, err)
+	spkId, err := newSshPrivateKeyCredentialId()
+	require.NoError(t, err)
+	jsonId, err := newJsonCredentialId()
+	require.NoError(t, err)
+	storeId, err := newCredentialStoreId()
+	require.NoError(t, err)
+
+	tests := []struct {
+		id   string
+		want credential.Type
+	}{
+		{id: upId, want: credential.UsernamePasswordType},
+		{id: spkId, want: credential.SshPrivateKeyType},
+		{id: jsonId, want: credential.JsonType},
+		{id: storeId, want: credential.UnspecifiedType},
+		{id: "credup", want: credential.UnspecifiedType},
+		{id: "", want: credential.UnspecifiedType},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, CredentialTypeFromId(tt.id))
+		})
+	}
+
+	assert.Equal(t, Subtype, credential.SubtypeFromId(upId))
+	assert.Equal(t, Subtype, credential.SubtypeFromId(spkId))
+	assert.Equal(t, Subtype, credential.SubtypeFromId(jsonId))
+	assert.Equal(t, Subtype, credential.SubtypeFromId(storeId))
+}
@@ -0,0 +1,791 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"golang.org/x/crypto/ssh"
+)
+
+// CreateUsernamePasswordCredential inserts c into the repository and
+// returns a new UsernamePasswordCredential containing the credential's
+// PublicId. c is not changed. c must contain a valid StoreId, Username and
+// Password. c must not contain a PublicId. The PublicId is generated and
+// assigned by this method. The password is encrypted with the database
+// key of scopeId and is not included in the returned credential.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId.
+//
+// Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateUsernamePasswordCredential(ctx context.Context, scopeId string, c *UsernamePasswordCredential, _ ...Option) (*UsernamePasswordCredential, error) {
+	const op = "static.(Repository).CreateUsernamePasswordCredential"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.UsernamePasswordCredential == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.StoreId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	case c.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case c.Username == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+	case len(c.Password) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+	c = c.clone()
+
+	id, err := newUsernamePasswordCredentialId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCred *UsernamePasswordCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", c.StoreId)))
+	}
+
+	// Clear password fields, only PasswordHmac should be returned
+	newCred.CtPassword = nil
+	newCred.Password = nil
+	return newCred, nil
+}
+
+// CreateSshPrivateKeyCredential inserts c into the repository and returns
+// a new SshPrivateKeyCredential containing the credential's PublicId. c is
+// not changed. c must contain a valid StoreId, Username and PrivateKey. The
+// PrivateKey must be a PEM encoded private key which can be parsed by the
+// ssh package. c must not contain a PublicId. The PublicId is generated
+// and assigned by this method. The private key is encrypted with the
+// database key of scopeId and is not included in the returned credential.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId.
+//
+// Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateSshPrivateKeyCredential(ctx context.Context, scopeId string, c *SshPrivateKeyCredential, _ ...Option) (*SshPrivateKeyCredential, error) {
+	const op = "static.(Repository).CreateSshPrivateKeyCredential"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.SshPrivateKeyCredential == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.StoreId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	case c.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case c.Username == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+	case len(c.PrivateKey) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+	}
+	if _, err := ssh.ParsePrivateKey(c.PrivateKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse private key"))
+	}
+	c = c.clone()
+
+	id, err := newSshPrivateKeyCredentialId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCred *SshPrivateKeyCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", c.StoreId)))
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	newCred.CtPrivateKey = nil
+	newCred.PrivateKey = nil
+	return newCred, nil
+}
+
+// CreateJsonCredential inserts c into the repository and returns a new
+// JsonCredential containing the credential's PublicId. c is not changed.
+// c must contain a valid StoreId and Object. c must not contain a
+// PublicId. The PublicId is generated and assigned by this method. The
+// object is encrypted with the database key of scopeId and is not included
+// in the returned credential.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId.
+//
+// Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateJsonCredential(ctx context.Context, scopeId string, c *JsonCredential, _ ...Option) (*JsonCredential, error) {
+	const op = "static.(Repository).CreateJsonCredential"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.JsonCredential == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.StoreId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	case c.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case len(c.Object) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing object")
+	}
+	c = c.clone()
+
+	id, err := newJsonCredentialId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCred *JsonCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", c.StoreId)))
+	}
+
+	// Clear object fields, only ObjectHmac should be returned
+	newCred.CtObject = nil
+	newCred.Object = nil
+	return newCred, nil
+}
+
+// LookupCredential returns the static credential for publicId. The
+// returned credential is one of UsernamePasswordCredential,
+// SshPrivateKeyCredential or JsonCredential and does not contain its
+// secret. Returns nil, nil if no credential is found for publicId.
+func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ...Option) (credential.Static, error) {
+	const op = "static.(Repository).LookupCredential"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+
+	var cred credential.Static
+	switch CredentialTypeFromId(publicId) {
+	case credential.UsernamePasswordType:
+		c := allocUsernamePasswordCredential()
+		c.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		c.CtPassword = nil
+		cred = c
+	case credential.SshPrivateKeyType:
+		c := allocSshPrivateKeyCredential()
+		c.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		c.CtPrivateKey = nil
+		cred = c
+	case credential.JsonType:
+		c := allocJsonCredential()
+		c.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		c.CtObject = nil
+		cred = c
+	default:
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", publicId))
+	}
+	return cred, nil
+}
+
+// UpdateUsernamePasswordCredential updates the repository entry for
+// c.PublicId with the values in c for the fields listed in
+// fieldMaskPaths. It returns a new UsernamePasswordCredential containing
+// the updated values and a count of the number of records updated. c is
+// not changed.
+//
+// c must contain a valid PublicId. Only Name, Description, Username and
+// Password can be changed. If c.Name is set to a non-empty string, it
+// must be unique within c.StoreId. Username and Password cannot be set to
+// the empty string. If Password is changed it is encrypted with the
+// database key of scopeId.
+//
+// An attribute of c will be set to NULL in the database if the attribute
+// in c is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context, scopeId string, c *UsernamePasswordCredential, version uint32, fieldMaskPaths []string, _ ...Option) (*UsernamePasswordCredential, int, error) {
+	const op = "static.(Repository).UpdateUsernamePasswordCredential"
+	switch {
+	case c == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.UsernamePasswordCredential == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case scopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case len(fieldMaskPaths) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+	c = c.clone()
+
+	var dbMask, nullFields []string
+	var updatePassword bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(usernameField, f) && c.Username == "":
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+		case strings.EqualFold(usernameField, f):
+			dbMask = append(dbMask, usernameField)
+		case strings.EqualFold(passwordField, f) && len(c.Password) == 0:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+		case strings.EqualFold(passwordField, f):
+			updatePassword = true
+			dbMask = append(dbMask, "CtPassword", "PasswordHmac", "KeyId")
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+
+	if updatePassword {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCred *UsernamePasswordCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCred = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCred, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", c.Name, c.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(c.PublicId))
+	}
+
+	// Clear password fields, only PasswordHmac should be returned
+	returnedCred.CtPassword = nil
+	returnedCred.Password = nil
+	return returnedCred, rowsUpdated, nil
+}
+
+// UpdateSshPrivateKeyCredential updates the repository entry for
+// c.PublicId with the values in c for the fields listed in
+// fieldMaskPaths. It returns a new SshPrivateKeyCredential containing the
+// updated values and a count of the number of records updated. c is not
+// changed.
+//
+// c must contain a valid PublicId. Only Name, Description, Username and
+// PrivateKey can be changed. If c.Name is set to a non-empty string, it
+// must be unique within c.StoreId. Username and PrivateKey cannot be set
+// to the empty string. If PrivateKey is changed it is encrypted with the
+// database key of scopeId.
+//
+// An attribute of c will be set to NULL in the database if the attribute
+// in c is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context, scopeId string, c *SshPrivateKeyCredential, version uint32, fieldMaskPaths []string, _ ...Option) (*SshPrivateKeyCredential, int, error) {
+	const op = "static.(Repository).UpdateSshPrivateKeyCredential"
+	switch {
+	case c == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.SshPrivateKeyCredential == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case scopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case len(fieldMaskPaths) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+	c = c.clone()
+
+	var dbMask, nullFields []string
+	var updatePrivateKey bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(usernameField, f) && c.Username == "":
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+		case strings.EqualFold(usernameField, f):
+			dbMask = append(dbMask, usernameField)
+		case strings.EqualFold(privateKeyField, f) && len(c.PrivateKey) == 0:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+		case strings.EqualFold(privateKeyField, f):
+			if _, err := ssh.ParsePrivateKey(c.PrivateKey); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse private key"))
+			}
+			updatePrivateKey = true
+			dbMask = append(dbMask, "CtPrivateKey", "PrivateKeyHmac", "KeyId")
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+
+	if updatePrivateKey {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCred *SshPrivateKeyCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCred = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCred, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", c.Name, c.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(c.PublicId))
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	returnedCred.CtPrivateKey = nil
+	returnedCred.PrivateKey = nil
+	return returnedCred, rowsUpdated, nil
+}
+
+// UpdateJsonCredential updates the repository entry for c.PublicId with
+// the values in c for the fields listed in fieldMaskPaths. It returns a
+// new JsonCredential containing the updated values and a count of the
+// number of records updated. c is not changed.
+//
+// c must contain a valid PublicId. Only Name, Description and Object can
+// be changed. If c.Name is set to a non-empty string, it must be unique
+// within c.StoreId. Object cannot be empty. If Object is changed it is
+// encrypted with the database key of scopeId.
+//
+// An attribute of c will be set to NULL in the database if the attribute
+// in c is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateJsonCredential(ctx context.Context, scopeId string, c *JsonCredential, version uint32, fieldMaskPaths []string, _ ...Option) (*JsonCredential, int, error) {
+	const op = "static.(Repository).UpdateJsonCredential"
+	switch {
+	case c == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	case c.JsonCredential == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	case c.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case scopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case len(fieldMaskPaths) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+	c = c.clone()
+
+	var dbMask, nullFields []string
+	var updateObject bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(objectField, f) && len(c.Object) == 0:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing object")
+		case strings.EqualFold(objectField, f):
+			updateObject = true
+			dbMask = append(dbMask, "CtObject", "ObjectHmac", "KeyId")
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+
+	if updateObject {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCred *JsonCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCred = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCred, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", c.Name, c.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(c.PublicId))
+	}
+
+	// Clear object fields, only ObjectHmac should be returned
+	returnedCred.CtObject = nil
+	returnedCred.Object = nil
+	return returnedCred, rowsUpdated, nil
+}
+
+// ListCredentials returns a slice of static credentials for the storeId.
+// The returned credentials do not contain their secrets. WithLimit and
+// WithStartPageAfterItem are the only options supported.
+func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ...Option) ([]credential.Static, error) {
+	const op = "static.(Repository).ListCredentials"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no storeId")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	var upCreds []*UsernamePasswordCredential
+	if err := r.reader.SearchWhere(ctx, &upCreds, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var spkCreds []*SshPrivateKeyCredential
+	if err := r.reader.SearchWhere(ctx, &spkCreds, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var jsonCreds []*JsonCredential
+	if err := r.reader.SearchWhere(ctx, &jsonCreds, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	ret := make([]credential.Static, 0, len(upCreds)+len(spkCreds)+len(jsonCreds))
+	for _, c := range upCreds {
+		c.CtPassword = nil
+		ret = append(ret, c)
+	}
+	for _, c := range spkCreds {
+		c.CtPrivateKey = nil
+		ret = append(ret, c)
+	}
+	for _, c := range jsonCreds {
+		c.CtObject = nil
+		ret = append(ret, c)
+	}
+	if opts.withStartPageAfterItem != nil {
+		// Each credential type is listed in order, so merge them into a single
+		// ordered listing before the limit is applied.
+		sort.SliceStable(ret, func(i, j int) bool {
+			ti, tj := ret[i].GetCreateTime().AsTime(), ret[j].GetCreateTime().AsTime()
+			if !ti.Equal(tj) {
+				return ti.Before(tj)
+			}
+			return ret[i].GetPublicId() < ret[j].GetPublicId()
+		})
+	}
+	if limit > 0 && len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+// DeleteCredential deletes publicId from the repository and returns the
+// number of records deleted.
+func (r *Repository) DeleteCredential(ctx context.Context, scopeId, publicId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).DeleteCredential"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	var input interface{}
+	var md oplog.Metadata
+	switch CredentialTypeFromId(publicId) {
+	case credential.UsernamePasswordType:
+		c := allocUsernamePasswordCredential()
+		c.PublicId = publicId
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case credential.SshPrivateKeyType:
+		c := allocSshPrivateKeyCredential()
+		c.PublicId = publicId
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case credential.JsonType:
+		c := allocJsonCredential()
+		c.PublicId = publicId
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	default:
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", publicId))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, input, db.WithOplog(oplogWrapper, md))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", publicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// Retrieve returns the static credentials for the provided ids with their
+// secrets decrypted using the database key of scopeId. An error is
+// returned if any of the ids cannot be found.
+func (r *Repository) Retrieve(ctx context.Context, scopeId string, ids []string, _ ...Option) ([]credential.Static, error) {
+	const op = "static.(Repository).Retrieve"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if len(ids) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing ids")
+	}
+
+	var upIds, spkIds, jsonIds []string
+	for _, id := range ids {
+		switch CredentialTypeFromId(id) {
+		case credential.UsernamePasswordType:
+			upIds = append(upIds, id)
+		case credential.SshPrivateKeyType:
+			spkIds = append(spkIds, id)
+		case credential.JsonType:
+			jsonIds = append(jsonIds, id)
+		default:
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", id))
+		}
+	}
+
+	var upCreds []*UsernamePasswordCredential
+	if len(upIds) > 0 {
+		if err := r.reader.SearchWhere(ctx, &upCreds, "public_id in (?)", []interface{}{upIds}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	var spkCreds []*SshPrivateKeyCredential
+	if len(spkIds) > 0 {
+		if err := r.reader.SearchWhere(ctx, &spkCreds, "public_id in (?)", []interface{}{spkIds}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	var jsonCreds []*JsonCredential
+	if len(jsonIds) > 0 {
+		if err := r.reader.SearchWhere(ctx, &jsonCreds, "public_id in (?)", []interface{}{jsonIds}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if len(upCreds)+len(spkCreds)+len(jsonCreds) != len(ids) {
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, "mismatch between retrieved credentials and requested ids")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	ret := make([]credential.Static, 0, len(ids))
+	for _, c := range upCreds {
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, c)
+	}
+	for _, c := range spkCreds {
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, c)
+	}
+	for _, c := range jsonCreds {
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
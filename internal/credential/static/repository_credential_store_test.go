@@ -0,0 +1,400 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	tests := []struct {
+		name    string
+		in      *CredentialStore
+		opts    []Option
+		want    *CredentialStore
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-credential-store",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "nil-embedded-credential-store",
+			in:      &CredentialStore{},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-no-options",
+			in: &CredentialStore{
+				CredentialStore: &store.CredentialStore{},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{},
+			},
+		},
+		{
+			name: "valid-with-name-and-description",
+			in: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:        "test-name-repo",
+					Description: "test-description-repo",
+				},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:        "test-name-repo",
+					Description: "test-description-repo",
+				},
+			},
+		},
+		{
+			name: "invalid-public-id-prefix",
+			in: &CredentialStore{
+				CredentialStore: &store.CredentialStore{},
+			},
+			opts:    []Option{WithPublicId("csvlt_1234567890")},
+			wantErr: errors.InvalidPublicId,
+		},
+		{
+			name: "valid-with-public-id",
+			in: &CredentialStore{
+				CredentialStore: &store.CredentialStore{},
+			},
+			opts: []Option{WithPublicId(CredentialStorePrefix + "_1234567890")},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					PublicId: CredentialStorePrefix + "_1234567890",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			require.NotNil(repo)
+			_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+			if tt.in != nil && tt.in.CredentialStore != nil {
+				tt.in.ScopeId = prj.GetPublicId()
+			}
+			got, err := repo.CreateCredentialStore(ctx, tt.in, tt.opts...)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Empty(tt.in.PublicId)
+			require.NotNil(got)
+			assert.True(strings.HasPrefix(got.PublicId, CredentialStorePrefix+"_"))
+			if tt.want.PublicId != "" {
+				assert.Equal(tt.want.PublicId, got.PublicId)
+			}
+			assert.NotSame(tt.in, got)
+			assert.Equal(tt.want.Name, got.Name)
+			assert.Equal(tt.want.Description, got.Description)
+			assert.Equal(got.CreateTime, got.UpdateTime)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+
+	t.Run("invalid-duplicate-names", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := context.Background()
+		kms := kms.TestKms(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(err)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		in := &CredentialStore{
+			CredentialStore: &store.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Name:    "test-name-repo",
+			},
+		}
+
+		got, err := repo.CreateCredentialStore(ctx, in)
+		require.NoError(err)
+		require.NotNil(got)
+
+		got2, err := repo.CreateCredentialStore(ctx, in)
+		assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %v got err: %v", errors.NotUnique, err)
+		assert.Nil(got2)
+	})
+
+	t.Run("valid-duplicate-names-diff-scopes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := context.Background()
+		kms := kms.TestKms(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(err)
+		org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		in := &CredentialStore{
+			CredentialStore: &store.CredentialStore{
+				Name: "test-name-repo",
+			},
+		}
+		in2 := in.clone()
+
+		in.ScopeId = prj.GetPublicId()
+		got, err := repo.CreateCredentialStore(ctx, in)
+		require.NoError(err)
+		require.NotNil(got)
+
+		in2.ScopeId = org.GetPublicId()
+		got2, err := repo.CreateCredentialStore(ctx, in2)
+		require.NoError(err)
+		require.NotNil(got2)
+		assert.NotEqual(got.PublicId, got2.PublicId)
+	})
+}
+
+func TestRepository_LookupCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	badId, err := newCredentialStoreId()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		id      string
+		want    *CredentialStore
+		wantErr errors.Code
+	}{
+		{
+			name: "found",
+			id:   cs.GetPublicId(),
+			want: cs,
+		},
+		{
+			name: "not-found",
+			id:   badId,
+		},
+		{
+			name:    "empty-public-id",
+			wantErr: errors.InvalidParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+
+			got, err := repo.LookupCredentialStore(ctx, tt.id)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				return
+			}
+			require.NoError(err)
+			if tt.want == nil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(tt.want.GetPublicId(), got.GetPublicId())
+			assert.Equal(tt.want.GetScopeId(), got.GetScopeId())
+		})
+	}
+}
+
+func TestRepository_UpdateCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		orig      *CredentialStore
+		chgFn     func(*CredentialStore) *CredentialStore
+		masks     []string
+		want      *CredentialStore
+		wantCount int
+		wantErr   errors.Code
+	}{
+		{
+			name: "nil-credential-store",
+			orig: &CredentialStore{CredentialStore: &store.CredentialStore{}},
+			chgFn: func(*CredentialStore) *CredentialStore {
+				return nil
+			},
+			masks:   []string{nameField},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "empty-field-mask",
+			orig:    &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig"}},
+			wantErr: errors.EmptyFieldMask,
+		},
+		{
+			name:    "invalid-field-mask",
+			orig:    &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig"}},
+			masks:   []string{"ScopeId"},
+			wantErr: errors.InvalidFieldMask,
+		},
+		{
+			name: "change-name",
+			orig: &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig"}},
+			chgFn: func(cs *CredentialStore) *CredentialStore {
+				cs.Name = "changed"
+				return cs
+			},
+			masks:     []string{nameField},
+			want:      &CredentialStore{CredentialStore: &store.CredentialStore{Name: "changed"}},
+			wantCount: 1,
+		},
+		{
+			name: "change-description",
+			orig: &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig", Description: "orig"}},
+			chgFn: func(cs *CredentialStore) *CredentialStore {
+				cs.Description = "changed"
+				return cs
+			},
+			masks:     []string{descriptionField},
+			want:      &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig", Description: "changed"}},
+			wantCount: 1,
+		},
+		{
+			name: "delete-name",
+			orig: &CredentialStore{CredentialStore: &store.CredentialStore{Name: "orig", Description: "orig"}},
+			chgFn: func(cs *CredentialStore) *CredentialStore {
+				cs.Name = ""
+				return cs
+			},
+			masks:     []string{nameField},
+			want:      &CredentialStore{CredentialStore: &store.CredentialStore{Description: "orig"}},
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+			tt.orig.ScopeId = prj.GetPublicId()
+			orig, err := repo.CreateCredentialStore(ctx, tt.orig)
+			require.NoError(err)
+			require.NotNil(orig)
+
+			updated := orig.clone()
+			if tt.chgFn != nil {
+				updated = tt.chgFn(updated)
+			}
+			got, gotCount, err := repo.UpdateCredentialStore(ctx, updated, orig.Version, tt.masks)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Equal(tt.wantCount, gotCount, "row count")
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCount, gotCount, "row count")
+			require.NotNil(got)
+			assert.Equal(orig.PublicId, got.PublicId)
+			assert.Equal(tt.want.Name, got.Name)
+			assert.Equal(tt.want.Description, got.Description)
+			assert.Equal(orig.Version+1, got.Version)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+}
+
+func TestRepository_ListCredentialStores(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	_, prj2 := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 3)
+	TestCredentialStores(t, conn, wrapper, prj2.GetPublicId(), 2)
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.ListCredentialStores(ctx, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	got, err := repo.ListCredentialStores(ctx, []string{prj.GetPublicId()})
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+
+	got, err = repo.ListCredentialStores(ctx, []string{prj.GetPublicId(), prj2.GetPublicId()})
+	require.NoError(t, err)
+	assert.Len(t, got, 5)
+
+	got, err = repo.ListCredentialStores(ctx, []string{prj.GetPublicId(), prj2.GetPublicId()}, WithLimit(1))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestRepository_DeleteCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.DeleteCredentialStore(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	deleted, err := repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	// Deleting the store also deletes its credentials
+	got, err := repo.LookupCredential(ctx, cred.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	deleted, err = repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
@@ -0,0 +1,381 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateUsernamePasswordCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	tests := []struct {
+		name    string
+		scopeId string
+		in      *UsernamePasswordCredential
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-credential",
+			scopeId: prj.GetPublicId(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "nil-embedded-credential",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-scope-id",
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{StoreId: cs.GetPublicId(), Username: "user", Password: []byte("pass")}},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-store-id",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{Username: "user", Password: []byte("pass")}},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-username",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{StoreId: cs.GetPublicId(), Password: []byte("pass")}},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-password",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{StoreId: cs.GetPublicId(), Username: "user"}},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "public-id-set",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{PublicId: "credup_1234567890", StoreId: cs.GetPublicId(), Username: "user", Password: []byte("pass")}},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "valid",
+			scopeId: prj.GetPublicId(),
+			in:      &UsernamePasswordCredential{UsernamePasswordCredential: &store.UsernamePasswordCredential{StoreId: cs.GetPublicId(), Name: "valid", Username: "user", Password: []byte("pass")}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+
+			got, err := repo.CreateUsernamePasswordCredential(ctx, tt.scopeId, tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.True(strings.HasPrefix(got.GetPublicId(), UsernamePasswordCredentialPrefix+"_"))
+			assert.Equal(tt.in.GetUsername(), got.GetUsername())
+			assert.Empty(got.GetPassword())
+			assert.Empty(got.GetCtPassword())
+			assert.NotEmpty(got.GetPasswordHmac())
+			assert.NotEmpty(got.GetKeyId())
+
+			found, err := repo.LookupCredential(ctx, got.GetPublicId())
+			require.NoError(err)
+			require.NotNil(found)
+			assert.Equal(got.GetPublicId(), found.GetPublicId())
+		})
+	}
+}
+
+func TestRepository_CreateSshPrivateKeyCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("invalid-private-key", func(t *testing.T) {
+		assert := assert.New(t)
+		in, err := NewSshPrivateKeyCredential(cs.GetPublicId(), "user", credential.PrivateKey("not a private key"))
+		require.NoError(t, err)
+		got, err := repo.CreateSshPrivateKeyCredential(ctx, prj.GetPublicId(), in)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		in, err := NewSshPrivateKeyCredential(cs.GetPublicId(), "user", credential.PrivateKey(TestSshPrivateKeyPem(t)))
+		require.NoError(err)
+		got, err := repo.CreateSshPrivateKeyCredential(ctx, prj.GetPublicId(), in)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.True(strings.HasPrefix(got.GetPublicId(), SshPrivateKeyCredentialPrefix+"_"))
+		assert.Equal("user", got.GetUsername())
+		assert.Empty(got.GetPrivateKey())
+		assert.Empty(got.GetCtPrivateKey())
+		assert.NotEmpty(got.GetPrivateKeyHmac())
+	})
+}
+
+func TestRepository_CreateJsonCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("missing-object", func(t *testing.T) {
+		assert := assert.New(t)
+		in, err := NewJsonCredential(cs.GetPublicId(), nil)
+		require.NoError(t, err)
+		got, err := repo.CreateJsonCredential(ctx, prj.GetPublicId(), in)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		in, err := NewJsonCredential(cs.GetPublicId(), map[string]interface{}{"key": "value"})
+		require.NoError(err)
+		got, err := repo.CreateJsonCredential(ctx, prj.GetPublicId(), in)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.True(strings.HasPrefix(got.GetPublicId(), JsonCredentialPrefix+"_"))
+		assert.Empty(got.GetObject())
+		assert.Empty(got.GetCtObject())
+		assert.NotEmpty(got.GetObjectHmac())
+	})
+}
+
+func TestRepository_UpdateUsernamePasswordCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	tests := []struct {
+		name      string
+		chgFn     func(*UsernamePasswordCredential) *UsernamePasswordCredential
+		masks     []string
+		wantCount int
+		wantErr   errors.Code
+	}{
+		{
+			name:    "empty-field-mask",
+			wantErr: errors.EmptyFieldMask,
+		},
+		{
+			name:    "invalid-field-mask",
+			masks:   []string{"StoreId"},
+			wantErr: errors.InvalidFieldMask,
+		},
+		{
+			name: "delete-username",
+			chgFn: func(c *UsernamePasswordCredential) *UsernamePasswordCredential {
+				c.Username = ""
+				return c
+			},
+			masks:   []string{usernameField},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "change-name",
+			chgFn: func(c *UsernamePasswordCredential) *UsernamePasswordCredential {
+				c.Name = "changed"
+				return c
+			},
+			masks:     []string{nameField},
+			wantCount: 1,
+		},
+		{
+			name: "change-password",
+			chgFn: func(c *UsernamePasswordCredential) *UsernamePasswordCredential {
+				c.Password = []byte("changed")
+				return c
+			},
+			masks:     []string{passwordField},
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+
+			orig := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId(), WithName(tt.name))
+			origHmac := orig.GetPasswordHmac()
+
+			updated := orig.clone()
+			updated.Password = nil
+			updated.CtPassword = nil
+			if tt.chgFn != nil {
+				updated = tt.chgFn(updated)
+			}
+			got, gotCount, err := repo.UpdateUsernamePasswordCredential(ctx, prj.GetPublicId(), updated, orig.GetVersion(), tt.masks)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Equal(tt.wantCount, gotCount, "row count")
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCount, gotCount, "row count")
+			require.NotNil(got)
+			assert.Equal(updated.GetName(), got.GetName())
+			assert.Equal(updated.GetUsername(), got.GetUsername())
+			assert.Empty(got.GetPassword())
+			assert.Empty(got.GetCtPassword())
+			if len(updated.GetPassword()) > 0 {
+				assert.NotEqual(origHmac, got.GetPasswordHmac())
+			}
+			assert.Equal(orig.GetVersion()+1, got.GetVersion())
+		})
+	}
+}
+
+func TestRepository_ListCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	TestUsernamePasswordCredentials(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId(), 3)
+	TestSshPrivateKeyCredential(t, conn, wrapper, "user", TestSshPrivateKeyPem(t), cs.GetPublicId(), prj.GetPublicId())
+	TestJsonCredential(t, conn, wrapper, cs.GetPublicId(), prj.GetPublicId(), map[string]interface{}{"key": "value"})
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.ListCredentials(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	got, err := repo.ListCredentials(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Len(t, got, 5)
+	for _, c := range got {
+		assert.Equal(t, cs.GetPublicId(), c.GetStoreId())
+	}
+
+	got, err = repo.ListCredentials(ctx, cs.GetPublicId(), WithLimit(2))
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestRepository_DeleteCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.DeleteCredential(ctx, prj.GetPublicId(), "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	_, err = repo.DeleteCredential(ctx, prj.GetPublicId(), cs.GetPublicId())
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidPublicId), err), "want err: %q got: %q", errors.InvalidPublicId, err)
+
+	deleted, err := repo.DeleteCredential(ctx, prj.GetPublicId(), cred.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	deleted, err = repo.DeleteCredential(ctx, prj.GetPublicId(), cred.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
+
+func TestRepository_Retrieve(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	privKey := TestSshPrivateKeyPem(t)
+	upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+	spkCred := TestSshPrivateKeyCredential(t, conn, wrapper, "user", privKey, cs.GetPublicId(), prj.GetPublicId())
+	jsonCred := TestJsonCredential(t, conn, wrapper, cs.GetPublicId(), prj.GetPublicId(), map[string]interface{}{"key": "value"})
+
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	badId, err := newUsernamePasswordCredentialId()
+	require.NoError(t, err)
+	_, err = repo.Retrieve(ctx, prj.GetPublicId(), []string{upCred.GetPublicId(), badId})
+	assert.Truef(t, errors.Match(errors.T(errors.NotSpecificIntegrity), err), "want err: %q got: %q", errors.NotSpecificIntegrity, err)
+
+	got, err := repo.Retrieve(ctx, prj.GetPublicId(), []string{upCred.GetPublicId(), spkCred.GetPublicId(), jsonCred.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for _, c := range got {
+		switch c.GetPublicId() {
+		case upCred.GetPublicId():
+			assert.Equal(t, credential.SecretData(map[string]interface{}{"username": "user", "password": "pass"}), c.Secret())
+		case spkCred.GetPublicId():
+			assert.Equal(t, credential.SecretData(map[string]interface{}{"username": "user", "private_key": privKey}), c.Secret())
+		case jsonCred.GetPublicId():
+			assert.Equal(t, credential.SecretData(map[string]interface{}{"key": "value"}), c.Secret())
+		default:
+			t.Errorf("unexpected credential %s", c.GetPublicId())
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("credential_static_username_password_credential", usernamePasswordCredentialRewrapFn)
+	kms.RegisterTableRewrapFn("credential_static_ssh_private_key_credential", sshPrivateKeyCredentialRewrapFn)
+	kms.RegisterTableRewrapFn("credential_static_json_credential", jsonCredentialRewrapFn)
+}
+
+// rewrappable is implemented by the static credential types.
+type rewrappable interface {
+	encrypt(context.Context, wrapping.Wrapper) error
+	decrypt(context.Context, wrapping.Wrapper) error
+}
+
+func usernamePasswordCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "static.usernamePasswordCredentialRewrapFn"
+	var creds []*UsernamePasswordCredential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list username password credentials"))
+	}
+	rs := make([]rewrappable, 0, len(creds))
+	for _, c := range creds {
+		rs = append(rs, c)
+	}
+	if err := rewrapCredentials(ctx, dataKeyVersionId, scopeId, writer, kmsCache, rs, []string{"CtPassword", "PasswordHmac", "KeyId"}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func sshPrivateKeyCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "static.sshPrivateKeyCredentialRewrapFn"
+	var creds []*SshPrivateKeyCredential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list ssh private key credentials"))
+	}
+	rs := make([]rewrappable, 0, len(creds))
+	for _, c := range creds {
+		rs = append(rs, c)
+	}
+	if err := rewrapCredentials(ctx, dataKeyVersionId, scopeId, writer, kmsCache, rs, []string{"CtPrivateKey", "PrivateKeyHmac", "KeyId"}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func jsonCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "static.jsonCredentialRewrapFn"
+	var creds []*JsonCredential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list json credentials"))
+	}
+	rs := make([]rewrappable, 0, len(creds))
+	for _, c := range creds {
+		rs = append(rs, c)
+	}
+	if err := rewrapCredentials(ctx, dataKeyVersionId, scopeId, writer, kmsCache, rs, []string{"CtObject", "ObjectHmac", "KeyId"}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// rewrapCredentials decrypts creds with the database key version
+// dataKeyVersionId and updates them, encrypted with the current database key
+// of the scope. The fieldMask holds the ciphertext, hmac and key id fields of
+// the credential type.
+func rewrapCredentials(ctx context.Context, dataKeyVersionId, scopeId string, writer db.Writer, kmsCache *kms.Kms, creds []rewrappable, fieldMask []string) error {
+	const op = "static.rewrapCredentials"
+	if len(creds) == 0 {
+		return nil
+	}
+	old, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	current, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range creds {
+				if err := c.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				// encrypt also computes the hmac of the secret with the
+				// current key.
+				if err := c.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Update(ctx, c, fieldMask, nil)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 credential would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
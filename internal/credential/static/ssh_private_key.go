@@ -0,0 +1,124 @@
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ credential.Static = (*SshPrivateKeyCredential)(nil)
+
+// A SshPrivateKeyCredential contains the credential with a username and
+// a PEM encoded SSH private key. It is owned by a credential store.
+type SshPrivateKeyCredential struct {
+	*store.SshPrivateKeyCredential
+	tableName string `gorm:"-"`
+}
+
+// NewSshPrivateKeyCredential creates a new in memory static Credential
+// containing a username and a PEM encoded SSH private key that is assigned
+// to storeId. Name and description are the only valid options. All other
+// options are ignored.
+func NewSshPrivateKeyCredential(storeId string, username string, privateKey credential.PrivateKey, opt ...Option) (*SshPrivateKeyCredential, error) {
+	opts := getOpts(opt...)
+	l := &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Username:    username,
+			PrivateKey:  []byte(privateKey),
+		},
+	}
+	return l, nil
+}
+
+func allocSshPrivateKeyCredential() *SshPrivateKeyCredential {
+	return &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{},
+	}
+}
+
+func (c *SshPrivateKeyCredential) clone() *SshPrivateKeyCredential {
+	cp := proto.Clone(c.SshPrivateKeyCredential)
+	return &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: cp.(*store.SshPrivateKeyCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *SshPrivateKeyCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_ssh_private_key_credential"
+}
+
+// SetTableName sets the table name.
+func (c *SshPrivateKeyCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+// Secret returns the username and private key of the credential. The
+// private key is only available if the credential has been decrypted.
+func (c *SshPrivateKeyCredential) Secret() credential.SecretData {
+	return map[string]interface{}{
+		"username":    c.GetUsername(),
+		"private_key": string(c.GetPrivateKey()),
+	}
+}
+
+func (c *SshPrivateKeyCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-static-ssh-private-key"},
+		"op-type":            []string{op.String()},
+	}
+	if c.StoreId != "" {
+		metadata["store-id"] = []string{c.StoreId}
+	}
+	return metadata
+}
+
+func (c *SshPrivateKeyCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(SshPrivateKeyCredential).encrypt"
+	if len(c.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.SshPrivateKeyCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	if err := c.hmacPrivateKey(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (c *SshPrivateKeyCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(SshPrivateKeyCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.SshPrivateKeyCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *SshPrivateKeyCredential) hmacPrivateKey(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(SshPrivateKeyCredential).hmacPrivateKey"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.PrivateKey, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PrivateKeyHmac = []byte(hm)
+	return nil
+}
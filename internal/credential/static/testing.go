@@ -0,0 +1,197 @@
+package static
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a static credential store in the provided DB
+// with the provided scope and any values passed in through the Options
+// vargs. If any errors are encountered during the creation of the store,
+// the test will fail.
+func TestCredentialStore(t *testing.T, conn *db.DB, _ wrapping.Wrapper, scopeId string, opts ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(scopeId, opts...)
+	assert.NoError(t, err)
+	require.NotNil(t, cs)
+	id, err := newCredentialStoreId()
+	assert.NoError(t, err)
+	require.NotEmpty(t, id)
+	cs.PublicId = id
+
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, cs)
+		},
+	)
+	require.NoError(t, err2)
+
+	return cs
+}
+
+// TestCredentialStores creates count number of static credential stores in
+// the provided DB with the provided scope id. If any errors are
+// encountered during the creation of the credential stores, the test will
+// fail.
+func TestCredentialStores(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId string, count int) []*CredentialStore {
+	t.Helper()
+	var css []*CredentialStore
+	for i := 0; i < count; i++ {
+		css = append(css, TestCredentialStore(t, conn, wrapper, scopeId))
+	}
+	return css
+}
+
+// TestUsernamePasswordCredential creates a username password credential in
+// the provided DB with the provided username, password, store id and scope
+// id and any values passed in through the Options vargs. If any errors are
+// encountered during the creation of the credential, the test will fail.
+func TestUsernamePasswordCredential(
+	t *testing.T,
+	conn *db.DB,
+	wrapper wrapping.Wrapper,
+	username, password, storeId, scopeId string,
+	opt ...Option,
+) *UsernamePasswordCredential {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	cred, err := NewUsernamePasswordCredential(storeId, username, credential.Password(password), opt...)
+	require.NoError(t, err)
+	require.NotNil(t, cred)
+
+	id, err := newUsernamePasswordCredentialId()
+	require.NoError(t, err)
+	cred.PublicId = id
+
+	require.NoError(t, cred.encrypt(ctx, databaseWrapper))
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, cred)
+		},
+	)
+	require.NoError(t, err2)
+
+	return cred
+}
+
+// TestUsernamePasswordCredentials creates count number of username
+// password credentials in the provided DB with the provided store id and
+// scope id. If any errors are encountered during the creation of the
+// credentials, the test will fail.
+func TestUsernamePasswordCredentials(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, username, password, storeId, scopeId string, count int) []*UsernamePasswordCredential {
+	t.Helper()
+	var creds []*UsernamePasswordCredential
+	for i := 0; i < count; i++ {
+		creds = append(creds, TestUsernamePasswordCredential(t, conn, wrapper, username, password, storeId, scopeId, WithName(fmt.Sprintf("username-password-%d", i))))
+	}
+	return creds
+}
+
+// TestSshPrivateKeyCredential creates an ssh private key credential in the
+// provided DB with the provided username, private key, store id and scope
+// id and any values passed in through the Options vargs. If any errors are
+// encountered during the creation of the credential, the test will fail.
+func TestSshPrivateKeyCredential(
+	t *testing.T,
+	conn *db.DB,
+	wrapper wrapping.Wrapper,
+	username, privateKey, storeId, scopeId string,
+	opt ...Option,
+) *SshPrivateKeyCredential {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	cred, err := NewSshPrivateKeyCredential(storeId, username, credential.PrivateKey(privateKey), opt...)
+	require.NoError(t, err)
+	require.NotNil(t, cred)
+
+	id, err := newSshPrivateKeyCredentialId()
+	require.NoError(t, err)
+	cred.PublicId = id
+
+	require.NoError(t, cred.encrypt(ctx, databaseWrapper))
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, cred)
+		},
+	)
+	require.NoError(t, err2)
+
+	return cred
+}
+
+// TestJsonCredential creates a json credential in the provided DB with the
+// provided object, store id and scope id and any values passed in through
+// the Options vargs. If any errors are encountered during the creation of
+// the credential, the test will fail.
+func TestJsonCredential(
+	t *testing.T,
+	conn *db.DB,
+	wrapper wrapping.Wrapper,
+	storeId, scopeId string,
+	object map[string]interface{},
+	opt ...Option,
+) *JsonCredential {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	cred, err := NewJsonCredential(storeId, object, opt...)
+	require.NoError(t, err)
+	require.NotNil(t, cred)
+
+	id, err := newJsonCredentialId()
+	require.NoError(t, err)
+	cred.PublicId = id
+
+	require.NoError(t, cred.encrypt(ctx, databaseWrapper))
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, cred)
+		},
+	)
+	require.NoError(t, err2)
+
+	return cred
+}
+
+// TestSshPrivateKeyPem returns a newly generated PEM encoded ed25519
+// private key suitable for use in an SshPrivateKeyCredential.
+func TestSshPrivateKeyPem(t *testing.T) string {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
@@ -1,5 +1,7 @@
 package vault
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,16 +16,17 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName          string
-	withDescription   string
-	withLimit         int
-	withCACert        []byte
-	withNamespace     string
-	withTlsServerName string
-	withTlsSkipVerify bool
-	withClientCert    *ClientCertificate
-	withMethod        Method
-	withRequestBody   []byte
+	withName               string
+	withDescription        string
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withCACert             []byte
+	withNamespace          string
+	withTlsServerName      string
+	withTlsSkipVerify      bool
+	withClientCert         *ClientCertificate
+	withMethod             Method
+	withRequestBody        []byte
 }
 
 func getDefaultOptions() options {
@@ -53,6 +56,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithCACert provides an optional PEM-encoded certificate
 // to verify the Vault server's SSL certificate.
 func WithCACert(cert []byte) Option {
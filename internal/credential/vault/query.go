@@ -186,4 +186,11 @@ delete from credential_vault_credential
  where session_id is null
    and status not in ('active', 'revoke')
 `
+
+	rewrapTokenQuery = `
+update credential_vault_token
+   set token  = ?,
+       key_id = ?
+ where token_hmac = ?;
+`
 )
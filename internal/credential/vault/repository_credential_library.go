@@ -238,7 +238,7 @@ func (r *Repository) DeleteCredentialLibrary(ctx context.Context, scopeId string
 }
 
 // ListCredentialLibraries returns a slice of CredentialLibraries for the
-// storeId. WithLimit is the only option supported.
+// storeId. WithLimit and WithStartPageAfterItem are the only options supported.
 func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string, opt ...Option) ([]*CredentialLibrary, error) {
 	const op = "vault.(Repository).ListCredentialLibraries"
 	if storeId == "" {
@@ -251,7 +251,7 @@ func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string
 		limit = opts.withLimit
 	}
 	var libs []*CredentialLibrary
-	err := r.reader.SearchWhere(ctx, &libs, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &libs, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -638,7 +638,8 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 }
 
 // ListCredentialStores returns a slice of CredentialStores for the
-// scopeIds. WithLimit is the only option supported.
+// scopeIds. WithLimit and WithStartPageAfterItem are the only options
+// supported.
 func (r *Repository) ListCredentialStores(ctx context.Context, scopeIds []string, opt ...Option) ([]*CredentialStore, error) {
 	const op = "vault.(Repository).ListCredentialStores"
 	if len(scopeIds) == 0 {
@@ -651,7 +652,7 @@ func (r *Repository) ListCredentialStores(ctx context.Context, scopeIds []string
 		limit = opts.withLimit
 	}
 	var credentialStores []*publicStore
-	err := r.reader.SearchWhere(ctx, &credentialStores, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &credentialStores, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
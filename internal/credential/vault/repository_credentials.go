@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	vault "github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var _ credential.Issuer = (*Repository)(nil)
@@ -49,14 +51,22 @@ func (r *Repository) Issue(ctx context.Context, sessionId string, requests []cre
 		}
 
 		var secret *vault.Secret
+		_, span := trace.Start(ctx, "vault "+lib.HttpMethod,
+			attribute.String("vault.address", lib.VaultAddress),
+			attribute.String("vault.path", lib.VaultPath),
+			attribute.String("credential_library.id", lib.PublicId),
+		)
 		switch Method(lib.HttpMethod) {
 		case MethodGet:
 			secret, err = client.get(lib.VaultPath)
 		case MethodPost:
 			secret, err = client.post(lib.VaultPath, lib.HttpRequestBody)
 		default:
-			return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown http method: library: %s", lib.PublicId))
+			err = errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown http method: library: %s", lib.PublicId))
+			trace.End(span, err)
+			return nil, err
 		}
+		trace.End(span, err)
 
 		if err != nil {
 			// TODO(mgaffney) 05/2021: detect if the error is because of an
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("credential_vault_token", tokenRewrapFn)
+	kms.RegisterTableRewrapFn("credential_vault_client_certificate", clientCertificateRewrapFn)
+}
+
+// rewrapWrappers returns the wrapper for the database key version
+// dataKeyVersionId and the wrapper for the current database key of the scope.
+func rewrapWrappers(ctx context.Context, dataKeyVersionId, scopeId string, kmsCache *kms.Kms) (old, current wrapping.Wrapper, err error) {
+	const op = "vault.rewrapWrappers"
+	if old, err = kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId)); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	if current, err = kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	return old, current, nil
+}
+
+func tokenRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "vault.tokenRewrapFn"
+	var tokens []*Token
+	if err := reader.SearchWhere(ctx, &tokens, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list tokens"))
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	old, current, err := rewrapWrappers(ctx, dataKeyVersionId, scopeId, kmsCache)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, t := range tokens {
+				if err := t.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := t.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, rewrapTokenQuery, []interface{}{t.CtToken, t.KeyId, t.TokenHmac})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update token"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 token would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func clientCertificateRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "vault.clientCertificateRewrapFn"
+	var certs []*ClientCertificate
+	if err := reader.SearchWhere(ctx, &certs, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list client certificates"))
+	}
+	if len(certs) == 0 {
+		return nil
+	}
+	old, current, err := rewrapWrappers(ctx, dataKeyVersionId, scopeId, kmsCache)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range certs {
+				if err := c.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := c.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				query, values := c.insertQuery()
+				rowsUpdated, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update client certificate"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 client certificate would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -54,6 +56,9 @@ type Options struct {
 
 	withOnConflict   *OnConflict
 	withRowsAffected *int64
+
+	// WithStartPageAfterItem must be accessible from other packages.
+	WithStartPageAfterItem *PageItem
 }
 
 type oplogOpts struct {
@@ -215,3 +220,21 @@ func WithReturnRowsAffected(rowsAffected *int64) Option {
 		o.withRowsAffected = rowsAffected
 	}
 }
+
+// PageItem is the position of a resource in a listing ordered by create_time
+// and then public_id.
+type PageItem struct {
+	CreateTime time.Time
+	PublicId   string
+}
+
+// WithStartPageAfterItem provides an option to page through resources ordered
+// by create_time and then public_id: the resources are returned in that order,
+// starting with the first one which comes after item. The zero PageItem starts
+// with the first resource. It replaces any order provided by WithOrder and is
+// used together with WithLimit to fetch one page at a time.
+func WithStartPageAfterItem(item *PageItem) Option {
+	return func(o *Options) {
+		o.WithStartPageAfterItem = item
+	}
+}
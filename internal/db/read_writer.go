@@ -10,9 +10,11 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -209,8 +211,10 @@ func New(underlying *DB) *Db {
 // Exec will execute the sql with the values as parameters. The int returned
 // is the number of rows affected by the sql. No options are currently
 // supported.
-func (rw *Db) Exec(ctx context.Context, sql string, values []interface{}, _ ...Option) (int, error) {
+func (rw *Db) Exec(ctx context.Context, sql string, values []interface{}, _ ...Option) (_ int, retErr error) {
 	const op = "db.Exec"
+	ctx, span := startSpan(ctx, op, attribute.String("db.statement", sql))
+	defer func() { trace.End(span, retErr) }()
 	if sql == "" {
 		return NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing sql")
 	}
@@ -225,8 +229,10 @@ func (rw *Db) Exec(ctx context.Context, sql string, values []interface{}, _ ...O
 // operate within the context of any ongoing transaction for the db.Reader.  The
 // caller must close the returned *sql.Rows. Query can/should be used in
 // combination with ScanRows.
-func (rw *Db) Query(ctx context.Context, sql string, values []interface{}, _ ...Option) (*sql.Rows, error) {
+func (rw *Db) Query(ctx context.Context, sql string, values []interface{}, _ ...Option) (_ *sql.Rows, retErr error) {
 	const op = "db.Query"
+	ctx, span := startSpan(ctx, op, attribute.String("db.statement", sql))
+	defer func() { trace.End(span, retErr) }()
 	if sql == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing sql")
 	}
@@ -269,8 +275,10 @@ func (rw *Db) lookupAfterWrite(ctx context.Context, i interface{}, opt ...Option
 // cannot be used together.  WithLookup with to force a lookup after create.
 // OnConflict specifies alternative actions to take when an insert results in a
 // unique constraint or exclusion constraint error.
-func (rw *Db) Create(ctx context.Context, i interface{}, opt ...Option) error {
+func (rw *Db) Create(ctx context.Context, i interface{}, opt ...Option) (retErr error) {
 	const op = "db.Create"
+	ctx, span := startSpan(ctx, op, tableAttributes(i)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -392,8 +400,10 @@ func (rw *Db) Create(ctx context.Context, i interface{}, opt ...Option) error {
 // CreateItems will create multiple items of the same type. Supported options:
 // WithOplog and WithOplogMsgs.  WithOplog and WithOplogMsgs may not be used
 // together.  WithLookup is not a supported option.
-func (rw *Db) CreateItems(ctx context.Context, createItems []interface{}, opt ...Option) error {
+func (rw *Db) CreateItems(ctx context.Context, createItems []interface{}, opt ...Option) (retErr error) {
 	const op = "db.CreateItems"
+	ctx, span := startSpan(ctx, op, itemsAttributes(createItems)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -472,8 +482,10 @@ func (rw *Db) CreateItems(ctx context.Context, createItems []interface{}, opt ..
 // version matches the WithVersion option.  Zero is not a valid value for the
 // WithVersion option and will return an error. WithWhere allows specifying an
 // additional constraint on the operation in addition to the PKs.
-func (rw *Db) Update(ctx context.Context, i interface{}, fieldMaskPaths []string, setToNullPaths []string, opt ...Option) (int, error) {
+func (rw *Db) Update(ctx context.Context, i interface{}, fieldMaskPaths []string, setToNullPaths []string, opt ...Option) (_ int, retErr error) {
 	const op = "db.Update"
+	ctx, span := startSpan(ctx, op, tableAttributes(i)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -623,8 +635,10 @@ func (rw *Db) Update(ctx context.Context, i interface{}, fieldMaskPaths []string
 // in-memory oplog message. WithOplog and NewOplogMsg cannot be used together.
 // WithWhere allows specifying an additional constraint on the operation in
 // addition to the PKs. Delete returns the number of rows deleted and any errors.
-func (rw *Db) Delete(ctx context.Context, i interface{}, opt ...Option) (int, error) {
+func (rw *Db) Delete(ctx context.Context, i interface{}, opt ...Option) (_ int, retErr error) {
 	const op = "db.Delete"
+	ctx, span := startSpan(ctx, op, tableAttributes(i)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -695,8 +709,10 @@ func (rw *Db) Delete(ctx context.Context, i interface{}, opt ...Option) (int, er
 // DeleteItems will delete multiple items of the same type. Supported options:
 // WithOplog and WithOplogMsgs.  WithOplog and WithOplogMsgs may not be used
 // together.
-func (rw *Db) DeleteItems(ctx context.Context, deleteItems []interface{}, opt ...Option) (int, error) {
+func (rw *Db) DeleteItems(ctx context.Context, deleteItems []interface{}, opt ...Option) (_ int, retErr error) {
 	const op = "db.DeleteItems"
+	ctx, span := startSpan(ctx, op, itemsAttributes(deleteItems)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -1001,12 +1017,16 @@ func (rw *Db) newOplogMessage(ctx context.Context, opType OpType, i interface{},
 // you should ensure that any objects written to the db in your TxHandler are retryable, which
 // means that the object may be sent to the db several times (retried), so things like the primary key must
 // be reset before retry
-func (w *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, Handler TxHandler) (RetryInfo, error) {
+func (w *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, Handler TxHandler) (info RetryInfo, retErr error) {
 	const op = "db.DoTx"
+	ctx, span := startSpan(ctx, op)
+	defer func() {
+		span.SetAttributes(attribute.Int("db.retries", info.Retries))
+		trace.End(span, retErr)
+	}()
 	if w.underlying == nil {
 		return RetryInfo{}, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
-	info := RetryInfo{}
 	for attempts := uint(1); ; attempts++ {
 		if attempts > retries+1 {
 			return info, errors.New(ctx, errors.MaxRetries, op, fmt.Sprintf("Too many retries: %d of %d", attempts-1, retries+1), errors.WithoutEvent())
@@ -1043,8 +1063,10 @@ func (w *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, Handler Tx
 
 // LookupByPublicId will lookup resource by its public_id or private_id, which
 // must be unique. Options are ignored.
-func (rw *Db) LookupById(ctx context.Context, resourceWithIder interface{}, _ ...Option) error {
+func (rw *Db) LookupById(ctx context.Context, resourceWithIder interface{}, _ ...Option) (retErr error) {
 	const op = "db.LookupById"
+	ctx, span := startSpan(ctx, op, tableAttributes(resourceWithIder)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -1115,8 +1137,10 @@ func (rw *Db) LookupByPublicId(ctx context.Context, resource ResourcePublicIder,
 }
 
 // LookupWhere will lookup the first resource using a where clause with parameters (it only returns the first one)
-func (rw *Db) LookupWhere(ctx context.Context, resource interface{}, where string, args ...interface{}) error {
+func (rw *Db) LookupWhere(ctx context.Context, resource interface{}, where string, args ...interface{}) (retErr error) {
 	const op = "db.LookupWhere"
+	ctx, span := startSpan(ctx, op, tableAttributes(resource)...)
+	defer func() { trace.End(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -1138,9 +1162,11 @@ func (rw *Db) LookupWhere(ctx context.Context, resource interface{}, where strin
 //
 // Supports the WithLimit option.  If WithLimit < 0, then unlimited results are returned.
 // If WithLimit == 0, then default limits are used for results.
-// Supports the WithOrder and WithDebug options.
-func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
+// Supports the WithOrder, WithStartPageAfterItem and WithDebug options.
+func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) (retErr error) {
 	const op = "db.SearchWhere"
+	ctx, span := startSpan(ctx, op)
+	defer func() { trace.End(span, retErr) }()
 	opts := GetOpts(opt...)
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
@@ -1153,7 +1179,10 @@ func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where stri
 	}
 	var err error
 	db := rw.underlying.WithContext(ctx)
-	if opts.withOrder != "" {
+	switch {
+	case opts.WithStartPageAfterItem != nil:
+		db = db.Order("create_time asc, public_id asc")
+	case opts.withOrder != "":
 		db = db.Order(opts.withOrder)
 	}
 	if opts.withDebug {
@@ -1171,6 +1200,9 @@ func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where stri
 	if where != "" {
 		db = db.Where(where, args...)
 	}
+	if item := opts.WithStartPageAfterItem; item != nil && item.PublicId != "" {
+		db = db.Where("(create_time, public_id) > (?, ?)", item.CreateTime, item.PublicId)
+	}
 
 	// Perform the query
 	err = db.Find(resources).Error
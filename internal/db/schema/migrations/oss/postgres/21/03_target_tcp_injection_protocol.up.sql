@@ -0,0 +1,48 @@
+begin;
+
+  create table target_tcp_injection_protocol_enm (
+    name text primary key
+      constraint only_predefined_injection_protocols_allowed
+      check (
+        name in ('ssh', 'postgres')
+      )
+  );
+  comment on table target_tcp_injection_protocol_enm is
+    'target_tcp_injection_protocol_enm is an enumeration table for the protocols a worker can use to inject '
+    'egress credentials into the connection to the endpoint of a tcp target.';
+
+  insert into target_tcp_injection_protocol_enm (name)
+  values
+    ('ssh'),
+    ('postgres');
+
+  -- Add the injection protocol to the target_tcp table. A null value means the
+  -- worker proxies the connection without injecting any credentials.
+  alter table target_tcp
+    add column injection_protocol text
+      constraint target_tcp_injection_protocol_enm_fkey
+        references target_tcp_injection_protocol_enm (name)
+        on delete restrict
+        on update cascade;
+
+  -- Replaces the view created in 1/01 to include injection_protocol
+  drop view target_all_subtypes;
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    injection_protocol,
+    'tcp' as type
+  from target_tcp;
+
+commit;
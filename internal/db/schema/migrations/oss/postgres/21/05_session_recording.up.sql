@@ -0,0 +1,67 @@
+begin;
+
+  create table session_recording (
+    public_id wt_public_id primary key,
+    -- the session the recording was made for. Recordings are kept for audit
+    -- purposes after the session has been deleted.
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    -- the connection of the session the recording was made for
+    connection_id wt_public_id
+      constraint session_connection_fkey
+        references session_connection (public_id)
+        on delete set null
+        on update cascade,
+    -- the project which owns this recording
+    scope_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    -- the target, user and worker are copied from the session so they are
+    -- still available once the session has been deleted
+    target_id wt_public_id not null,
+    user_id text not null,
+    worker_id text not null,
+    -- the location of the recording in the worker's recording storage
+    storage_uri text not null
+      constraint storage_uri_must_not_be_empty
+        check(length(trim(storage_uri)) > 0),
+    -- the number of bytes in the recording
+    bytes bigint not null default 0
+      constraint bytes_must_not_be_negative
+        check(bytes >= 0),
+    start_time timestamp with time zone not null,
+    end_time timestamp with time zone not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint end_time_must_not_be_before_start_time
+      check(end_time >= start_time),
+    constraint session_recording_storage_uri_uq
+      unique(storage_uri)
+  );
+  comment on table session_recording is
+    'session_recording is a table where each row is a recording of a terminal channel '
+    'of an ssh session proxied by a worker.';
+
+  create trigger update_version_column after update on session_recording
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on session_recording
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on session_recording
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_recording
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'target_id', 'user_id', 'worker_id',
+                                                     'storage_uri', 'bytes', 'start_time', 'end_time', 'create_time');
+
+  create index session_recording_scope_id_ix on session_recording (scope_id);
+  create index session_recording_session_id_ix on session_recording (session_id);
+
+commit;
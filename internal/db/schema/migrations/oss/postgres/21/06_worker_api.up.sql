@@ -0,0 +1,31 @@
+begin;
+
+-- Workers are now a resource of the public API. The version is only
+-- incremented by changes made through the API, not by the status updates
+-- workers send, so it is maintained by the repository instead of the
+-- update_version_column trigger. The update_time column changes with every
+-- update of the row, so the time of the last status update is tracked
+-- separately.
+alter table server
+  add column version wt_version,
+  add column last_status_time wt_timestamp;
+
+update server
+   set last_status_time = update_time;
+
+-- server_api_tag holds the worker tags managed through the API. They are kept
+-- apart from server_tag because the tags in server_tag are replaced whenever
+-- a worker sends the tags from its configuration.
+create table server_api_tag (
+  server_id text
+    references server(private_id)
+    on delete cascade
+    on update cascade,
+  key wt_tagpair,
+  value wt_tagpair,
+  primary key(server_id, key, value)
+);
+comment on table server_api_tag is
+  'server_api_tag holds the tags of workers which are managed through the API.';
+
+commit;
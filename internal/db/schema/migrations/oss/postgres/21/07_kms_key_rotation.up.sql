@@ -0,0 +1,37 @@
+begin;
+
+-- When the keys of a scope are rotated, the existing DEK versions are
+-- re-encrypted with the new root key version so that the previous root key
+-- versions can be destroyed. The key and the root key version of a DEK
+-- version are no longer immutable.
+drop trigger immutable_columns on kms_database_key_version;
+create trigger immutable_columns before update on kms_database_key_version
+  for each row execute procedure immutable_columns('private_id', 'database_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_oplog_key_version;
+create trigger immutable_columns before update on kms_oplog_key_version
+  for each row execute procedure immutable_columns('private_id', 'oplog_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_session_key_version;
+create trigger immutable_columns before update on kms_session_key_version
+  for each row execute procedure immutable_columns('private_id', 'session_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_token_key_version;
+create trigger immutable_columns before update on kms_token_key_version
+  for each row execute procedure immutable_columns('private_id', 'token_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_oidc_key_version;
+create trigger immutable_columns before update on kms_oidc_key_version
+  for each row execute procedure immutable_columns('private_id', 'oidc_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_audit_key_version;
+create trigger immutable_columns before update on kms_audit_key_version
+  for each row execute procedure immutable_columns('private_id', 'audit_key_id', 'version', 'create_time');
+
+-- Vault tokens are re-encrypted with the current database key version after
+-- a rotation.
+drop trigger immutable_columns on credential_vault_token;
+create trigger immutable_columns before update on credential_vault_token
+  for each row execute procedure immutable_columns('token_hmac', 'store_id', 'create_time');
+
+commit;
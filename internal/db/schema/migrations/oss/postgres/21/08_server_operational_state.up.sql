@@ -0,0 +1,11 @@
+begin;
+
+-- Workers report their operational state in their status updates. A worker
+-- which is shutting down is no longer given new sessions, while the
+-- connections it proxies are drained.
+alter table server
+  add column operational_state text not null default 'active'
+    constraint server_operational_state_valid
+      check (operational_state in ('active', 'shutdown'));
+
+commit;
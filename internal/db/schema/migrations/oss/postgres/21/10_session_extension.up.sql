@@ -0,0 +1,114 @@
+begin;
+
+  -- The maximum total time that the expiration of a session created for a
+  -- target can be extended by. The default of 0 means its sessions can't be
+  -- extended.
+  alter table target_tcp
+    add column session_max_extension_seconds int not null default 0
+      constraint session_max_extension_seconds_must_not_be_negative
+        check(session_max_extension_seconds >= 0);
+
+  alter table target_ssh
+    add column session_max_extension_seconds int not null default 0
+      constraint session_max_extension_seconds_must_not_be_negative
+        check(session_max_extension_seconds >= 0);
+
+  -- Replaces the view created in 21/04 to include
+  -- session_max_extension_seconds. The view is replaced rather than dropped
+  -- since warehouse views depend on it, so the new column is added last.
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    injection_protocol,
+    'tcp' as type,
+    session_max_extension_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    null as injection_protocol,
+    'ssh' as type,
+    session_max_extension_seconds
+  from target_ssh;
+
+  -- The latest time the expiration of a session can be extended to. It's set
+  -- when the session is created from the session_max_extension_seconds of its
+  -- target, and the session's certificate is valid until then. Sessions created
+  -- before this column was added have a null max_expiration_time and can't be
+  -- extended.
+  alter table session
+    add column max_expiration_time timestamp with time zone
+      constraint max_expiration_time_must_not_be_before_expiration_time
+        check(max_expiration_time >= expiration_time);
+
+  -- Replaces the immutable columns trigger from 1/01: the expiration time can
+  -- now be extended, up to the immutable max_expiration_time.
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns
+    before update on session
+      for each row execute procedure immutable_columns('public_id', 'certificate', 'max_expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter');
+
+  -- Replaces the update version trigger from 0/50 so extending the expiration
+  -- time updates the version of the session.
+  drop trigger update_version_column on session;
+  create trigger update_version_column
+    after update of version, termination_reason, key_id, tofu_token, server_id, server_type, expiration_time on session
+      for each row execute procedure update_version_column();
+
+  -- Replaces the view created in 1/01 to include max_expiration_time
+  drop view session_with_state;
+  create view session_with_state as
+    select
+      s.public_id,
+      s.user_id,
+      s.host_id,
+      s.server_id,
+      s.server_type,
+      s.target_id,
+      s.host_set_id,
+      s.auth_token_id,
+      s.scope_id,
+      s.certificate,
+      s.expiration_time,
+      s.max_expiration_time,
+      s.connection_limit,
+      s.tofu_token,
+      s.key_id,
+      s.termination_reason,
+      s.version,
+      s.create_time,
+      s.update_time,
+      s.endpoint,
+      s.worker_filter,
+      ss.state,
+      ss.previous_end_time,
+      ss.start_time,
+      ss.end_time
+    from
+      session s,
+      session_state ss
+    where
+      s.public_id = ss.session_id;
+
+commit;
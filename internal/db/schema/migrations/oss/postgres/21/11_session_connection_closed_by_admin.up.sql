@@ -0,0 +1,46 @@
+begin;
+
+  -- Replaces the check constraint from 0/51 to add the 'closed by admin'
+  -- reason, which is recorded when a connection is closed through the
+  -- sessions close-connection action.
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'closed by admin'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('closed by admin');
+
+  -- keep_closed_by_admin_reason() is used in a before update trigger on the
+  -- session_connection table. A connection closed by an admin is later
+  -- reported closed by its worker, which doesn't know why the connection was
+  -- closed, so the reason recorded by the controller is kept.
+  create function keep_closed_by_admin_reason()
+    returns trigger
+  as $$
+  begin
+    if old.closed_reason = 'closed by admin' then
+      new.closed_reason = old.closed_reason;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger keep_closed_by_admin_reason
+    before update of closed_reason on session_connection
+      for each row execute procedure keep_closed_by_admin_reason();
+
+commit;
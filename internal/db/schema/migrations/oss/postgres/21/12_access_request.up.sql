@@ -0,0 +1,114 @@
+begin;
+
+  create table access_request_status_enm (
+    name text primary key
+      constraint only_predefined_access_request_statuses_allowed
+        check (
+          name in (
+            'pending',
+            'approved',
+            'denied',
+            'expired'
+          )
+        )
+  );
+  comment on table access_request_status_enm is
+    'access_request_status_enm is an enumeration table for the status of access requests.';
+
+  insert into access_request_status_enm (name)
+  values
+    ('pending'),
+    ('approved'),
+    ('denied'),
+    ('expired');
+
+  create table access_request (
+    public_id wt_public_id primary key,
+    -- the project of the target, which owns this access request
+    scope_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    target_id wt_public_id not null
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    -- the user requesting access to the target
+    user_id wt_user_id not null
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    -- the justification given by the user
+    reason text,
+    -- the length of the access window, which starts when the request is
+    -- approved
+    duration_seconds int not null
+      constraint duration_seconds_must_be_greater_than_0
+        check(duration_seconds > 0),
+    status text not null default 'pending'
+      constraint access_request_status_enm_fkey
+        references access_request_status_enm (name)
+        on delete restrict
+        on update cascade,
+    -- the user who approved or denied the request
+    approver_id wt_user_id
+      constraint approver_iam_user_fkey
+        references iam_user (public_id)
+        on delete set null
+        on update cascade,
+    -- the role granting access to the target while the request is approved
+    role_id wt_role_id
+      constraint iam_role_fkey
+        references iam_role (public_id)
+        on delete set null
+        on update cascade,
+    -- the end of the access window, set when the request is approved
+    valid_until timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint approved_request_must_have_valid_until
+      check(status <> 'approved' or valid_until is not null),
+    constraint user_must_not_be_approver
+      check(approver_id is null or approver_id <> user_id)
+  );
+  comment on table access_request is
+    'access_request is a table where each row is a request of a user for just-in-time access to a target.';
+
+  create trigger update_version_column after update on access_request
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on access_request
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on access_request
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on access_request
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'target_id', 'user_id', 'reason',
+                                                     'duration_seconds', 'create_time');
+
+  -- delete_access_request_role() is used in an after delete trigger on the
+  -- access_request table. It deletes the role created when the request was
+  -- approved, so the access granted by the request doesn't outlive it.
+  create function delete_access_request_role()
+    returns trigger
+  as $$
+  begin
+    if old.role_id is not null then
+      delete from iam_role where public_id = old.role_id;
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger delete_access_request_role after delete on access_request
+    for each row execute procedure delete_access_request_role();
+
+  create index access_request_scope_id_ix on access_request (scope_id);
+  create index access_request_status_valid_until_ix on access_request (status, valid_until);
+
+commit;
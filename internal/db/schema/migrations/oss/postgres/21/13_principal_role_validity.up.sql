@@ -0,0 +1,89 @@
+begin;
+
+  -- Adds an optional validity window to the assignment of principals to
+  -- roles. Grants of a role only apply to a principal between its valid_from
+  -- and valid_until, and assignments whose valid_until has passed are purged
+  -- by the controller.
+  alter table iam_user_role
+    add column valid_from timestamp with time zone,
+    add column valid_until timestamp with time zone,
+    add constraint valid_from_must_be_before_valid_until
+      check(valid_from is null or valid_until is null or valid_from < valid_until);
+
+  alter table iam_group_role
+    add column valid_from timestamp with time zone,
+    add column valid_until timestamp with time zone,
+    add constraint valid_from_must_be_before_valid_until
+      check(valid_from is null or valid_until is null or valid_from < valid_until);
+
+  alter table iam_managed_group_role
+    add column valid_from timestamp with time zone,
+    add column valid_until timestamp with time zone,
+    add constraint valid_from_must_be_before_valid_until
+      check(valid_from is null or valid_until is null or valid_from < valid_until);
+
+  create index iam_user_role_valid_until_ix on iam_user_role (valid_until)
+    where valid_until is not null;
+  create index iam_group_role_valid_until_ix on iam_group_role (valid_until)
+    where valid_until is not null;
+  create index iam_managed_group_role_valid_until_ix on iam_managed_group_role (valid_until)
+    where valid_until is not null;
+
+  -- Replaces the view from 9/04 to add the valid_from and valid_until
+  -- columns.
+  create or replace view iam_principal_role as
+  select
+    ur.create_time,
+    ur.principal_id,
+    ur.role_id,
+    u.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, u.scope_id, ur.principal_id) as scoped_principal_id,
+    'user' as type,
+    ur.valid_from,
+    ur.valid_until
+  from
+    iam_user_role ur,
+    iam_role r,
+    iam_user u
+  where
+    ur.role_id = r.public_id and
+    u.public_id = ur.principal_id
+  union
+  select
+    gr.create_time,
+    gr.principal_id,
+    gr.role_id,
+    g.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, g.scope_id, gr.principal_id) as scoped_principal_id,
+    'group' as type,
+    gr.valid_from,
+    gr.valid_until
+  from
+    iam_group_role gr,
+    iam_role r,
+    iam_group g
+  where
+    gr.role_id = r.public_id and
+    g.public_id = gr.principal_id
+  union
+  select
+    mgr.create_time,
+    mgr.principal_id,
+    mgr.role_id,
+    (select scope_id from auth_method am where am.public_id = amg.auth_method_id) as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, (select scope_id from auth_method am where am.public_id = amg.auth_method_id), mgr.principal_id) as scoped_principal_id,
+    'managed group' as type,
+    mgr.valid_from,
+    mgr.valid_until
+  from
+    iam_managed_group_role mgr,
+    iam_role r,
+    auth_managed_group amg
+  where
+    mgr.role_id = r.public_id and
+    amg.public_id = mgr.principal_id;
+
+commit;
@@ -0,0 +1,78 @@
+begin;
+
+  -- Adds the settings controlling how failed authentication attempts against
+  -- the accounts of a password auth method are throttled. A null value
+  -- disables the setting.
+  alter table auth_password_method
+    add column max_failed_attempts int
+      constraint max_failed_attempts_must_be_positive
+      check(max_failed_attempts > 0),
+    add column lockout_duration int
+      constraint lockout_duration_must_be_positive
+      check(lockout_duration > 0),
+    add column failed_attempt_delay int
+      constraint failed_attempt_delay_must_be_positive
+      check(failed_attempt_delay > 0);
+
+  comment on column auth_password_method.max_failed_attempts is
+    'The number of consecutive failed authentication attempts after which an account is locked';
+  comment on column auth_password_method.lockout_duration is
+    'The number of seconds an account stays locked; if null it stays locked until unlocked';
+  comment on column auth_password_method.failed_attempt_delay is
+    'The number of seconds, doubled after each consecutive failed authentication attempt, before an account can be authenticated again';
+
+  -- Replaces the view from 2/20 to add the throttling settings.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.max_failed_attempts,
+    am.lockout_duration,
+    am.failed_attempt_delay
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  create table auth_password_account_lockout (
+    password_account_id wt_public_id
+      primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    failed_attempt_count int not null
+      constraint failed_attempt_count_must_be_positive
+      check(failed_attempt_count > 0),
+    last_failed_attempt_time timestamp with time zone not null,
+    locked_time timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table auth_password_account_lockout is
+    'auth_password_account_lockout is a table where each row contains the consecutive failed authentication '
+    'attempts of a password account since its last successful authentication, and when the account was locked.';
+
+  create trigger update_time_column before update on auth_password_account_lockout
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_account_lockout
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_account_lockout
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+commit;
@@ -0,0 +1,147 @@
+begin;
+
+  -- Adds the password policy settings of a password auth method. A null
+  -- password_history_count or max_password_age_days disables the setting.
+  alter table auth_password_method
+    add column require_lowercase boolean not null default false,
+    add column require_uppercase boolean not null default false,
+    add column require_digit boolean not null default false,
+    add column require_symbol boolean not null default false,
+    add column password_history_count int
+      constraint password_history_count_must_be_positive
+      check(password_history_count > 0),
+    add column max_password_age_days int
+      constraint max_password_age_days_must_be_positive
+      check(max_password_age_days > 0);
+
+  comment on column auth_password_method.require_lowercase is
+    'If true, passwords must contain a lower case letter';
+  comment on column auth_password_method.require_uppercase is
+    'If true, passwords must contain an upper case letter';
+  comment on column auth_password_method.require_digit is
+    'If true, passwords must contain a digit';
+  comment on column auth_password_method.require_symbol is
+    'If true, passwords must contain a character which is not a letter or a digit';
+  comment on column auth_password_method.password_history_count is
+    'The number of most recent passwords of an account, including its current password, which cannot be reused';
+  comment on column auth_password_method.max_password_age_days is
+    'The number of days after which the password of an account expires and must be changed';
+
+  -- Replaces the view from 21/14 to add the password policy settings.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.max_failed_attempts,
+    am.lockout_duration,
+    am.failed_attempt_delay,
+    am.require_lowercase,
+    am.require_uppercase,
+    am.require_digit,
+    am.require_symbol,
+    am.password_history_count,
+    am.max_password_age_days
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- Replaces the view from 0/14 to add the password policy settings. The view
+  -- is dropped rather than replaced so the columns of auth_password_conf_union
+  -- remain last.
+  drop view auth_password_current_conf;
+  create view auth_password_current_conf as
+      -- Rerun this query whenever auth_password_conf_union is updated.
+      select pm.min_login_name_length, pm.min_password_length,
+             pm.require_lowercase,
+             pm.require_uppercase,
+             pm.require_digit,
+             pm.require_symbol,
+             coalesce(pm.password_history_count, 0) as password_history_count,
+             coalesce(pm.max_password_age_days, 0) as max_password_age_days,
+             c.*
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  create table auth_password_method_disallowed_password (
+    password_method_id wt_public_id not null
+      constraint auth_password_method_fkey
+        references auth_password_method (public_id)
+        on delete cascade
+        on update cascade,
+    password text not null
+      constraint password_must_not_be_empty
+      check(length(password) > 0),
+    create_time wt_timestamp,
+    primary key(password_method_id, password)
+  );
+  comment on table auth_password_method_disallowed_password is
+    'auth_password_method_disallowed_password is a table where each row contains a password, in lower case, '
+    'which cannot be set as the password of an account of the password auth method.';
+
+  create trigger default_create_time_column before insert on auth_password_method_disallowed_password
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_method_disallowed_password
+    for each row execute procedure immutable_columns('password_method_id', 'password', 'create_time');
+
+  -- auth_password_argon2_cred_history contains the previous credentials of a
+  -- password account. The rows are copied from auth_password_argon2_cred when
+  -- the password of an account is changed, and the create_time of a row is
+  -- when its password was replaced.
+  create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    password_conf_id wt_private_id not null,
+    password_method_id wt_public_id not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+      check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+      check(length(derived_key) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    constraint auth_password_argon2_conf_fkey
+      foreign key (password_method_id, password_conf_id)
+        references auth_password_argon2_conf (password_method_id, private_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table auth_password_argon2_cred_history is
+    'auth_password_argon2_cred_history is a table where each row contains a previous argon2 credential of a password account.';
+
+  create index auth_password_argon2_cred_history_account_create_time_idx
+    on auth_password_argon2_cred_history (password_account_id, create_time);
+
+  create trigger update_time_column before update on auth_password_argon2_cred_history
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_argon2_cred_history
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_argon2_cred_history
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'create_time');
+
+commit;
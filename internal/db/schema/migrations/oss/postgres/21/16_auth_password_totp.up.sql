@@ -0,0 +1,102 @@
+begin;
+
+  alter table auth_password_method
+    add column require_mfa boolean not null default false;
+
+  comment on column auth_password_method.require_mfa is
+    'If true, accounts must authenticate with a TOTP code or a recovery code in addition to their password';
+
+  -- Replaces the view from 21/15 to add require_mfa.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.max_failed_attempts,
+    am.lockout_duration,
+    am.failed_attempt_delay,
+    am.require_lowercase,
+    am.require_uppercase,
+    am.require_digit,
+    am.require_symbol,
+    am.password_history_count,
+    am.max_password_age_days,
+    am.require_mfa
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- auth_password_totp_secret contains the TOTP secret enrolled for a password
+  -- account. The secret is encrypted with the database key of the scope of the
+  -- account. A secret is only used to authenticate the account once it has been
+  -- confirmed with a TOTP code. last_used_step is the time step of the last
+  -- TOTP code accepted for the account, which prevents a code from being
+  -- reused.
+  create table auth_password_totp_secret (
+    password_account_id wt_public_id
+      primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+      check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    confirmed_time timestamp with time zone,
+    last_used_step bigint not null default 0
+  );
+  comment on table auth_password_totp_secret is
+    'auth_password_totp_secret is a table where each row contains the encrypted TOTP secret enrolled for a password account.';
+
+  create trigger update_time_column before update on auth_password_totp_secret
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_totp_secret
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp_secret
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  -- auth_password_totp_recovery_code contains the unused recovery codes of a
+  -- password account enrolled for TOTP. Only the SHA-256 hash of a recovery
+  -- code is stored and a recovery code is deleted when it is used.
+  create table auth_password_totp_recovery_code (
+    password_account_id wt_public_id not null
+      constraint auth_password_totp_secret_fkey
+        references auth_password_totp_secret (password_account_id)
+        on delete cascade
+        on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+      check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key(password_account_id, code_hash)
+  );
+  comment on table auth_password_totp_recovery_code is
+    'auth_password_totp_recovery_code is a table where each row contains the hash of an unused recovery code of a password account.';
+
+  create trigger default_create_time_column before insert on auth_password_totp_recovery_code
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp_recovery_code
+    for each row execute procedure immutable_columns('password_account_id', 'code_hash', 'create_time');
+
+commit;
@@ -0,0 +1,203 @@
+begin;
+
+  -- Adds the auth token settings which override the auth_token_time_to_live
+  -- and auth_token_time_to_stale controller configuration for the auth tokens
+  -- issued by an auth method or by the auth methods of a scope. Both are a
+  -- number of seconds and a null value inherits the setting.
+  alter table auth_password_method
+    add column auth_token_time_to_live int
+      constraint auth_token_time_to_live_must_be_positive
+      check(auth_token_time_to_live > 0),
+    add column auth_token_time_to_stale int
+      constraint auth_token_time_to_stale_must_be_positive
+      check(auth_token_time_to_stale > 0);
+
+  alter table auth_oidc_method
+    add column auth_token_time_to_live int
+      constraint auth_token_time_to_live_must_be_positive
+      check(auth_token_time_to_live > 0),
+    add column auth_token_time_to_stale int
+      constraint auth_token_time_to_stale_must_be_positive
+      check(auth_token_time_to_stale > 0);
+
+  alter table auth_ldap_method
+    add column auth_token_time_to_live int
+      constraint auth_token_time_to_live_must_be_positive
+      check(auth_token_time_to_live > 0),
+    add column auth_token_time_to_stale int
+      constraint auth_token_time_to_stale_must_be_positive
+      check(auth_token_time_to_stale > 0);
+
+  alter table iam_scope
+    add column auth_token_time_to_live int
+      constraint auth_token_time_to_live_must_be_positive
+      check(auth_token_time_to_live > 0),
+    add column auth_token_time_to_stale int
+      constraint auth_token_time_to_stale_must_be_positive
+      check(auth_token_time_to_stale > 0);
+
+  comment on column auth_password_method.auth_token_time_to_live is
+    'The number of seconds after which the auth tokens issued by the auth method expire';
+  comment on column auth_password_method.auth_token_time_to_stale is
+    'The number of seconds after which the unused auth tokens issued by the auth method expire';
+  comment on column auth_oidc_method.auth_token_time_to_live is
+    'The number of seconds after which the auth tokens issued by the auth method expire';
+  comment on column auth_oidc_method.auth_token_time_to_stale is
+    'The number of seconds after which the unused auth tokens issued by the auth method expire';
+  comment on column auth_ldap_method.auth_token_time_to_live is
+    'The number of seconds after which the auth tokens issued by the auth method expire';
+  comment on column auth_ldap_method.auth_token_time_to_stale is
+    'The number of seconds after which the unused auth tokens issued by the auth method expire';
+  comment on column iam_scope.auth_token_time_to_live is
+    'The number of seconds after which the auth tokens issued by the auth methods of the scope expire';
+  comment on column iam_scope.auth_token_time_to_stale is
+    'The number of seconds after which the unused auth tokens issued by the auth methods of the scope expire';
+
+  -- auth_token_setting returns the auth token settings of each auth method,
+  -- which are the settings of the auth method or, if not set, of its scope.
+  -- A null setting uses the controller configuration.
+  create view auth_token_setting as
+  select
+    am.public_id as auth_method_id,
+    coalesce(pw.auth_token_time_to_live,
+             oidc.auth_token_time_to_live,
+             ldap.auth_token_time_to_live,
+             s.auth_token_time_to_live) as time_to_live,
+    coalesce(pw.auth_token_time_to_stale,
+             oidc.auth_token_time_to_stale,
+             ldap.auth_token_time_to_stale,
+             s.auth_token_time_to_stale) as time_to_stale
+  from
+    auth_method am
+    join iam_scope s on am.scope_id = s.public_id
+    left outer join auth_password_method pw on am.public_id = pw.public_id
+    left outer join auth_oidc_method oidc on am.public_id = oidc.public_id
+    left outer join auth_ldap_method ldap on am.public_id = ldap.public_id;
+  comment on view auth_token_setting is
+    'auth_token_setting returns the auth token time to live and time to stale of each auth method, inherited from its scope if not set';
+
+  -- Replaces the view from 21/16 to add the auth token settings.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.max_failed_attempts,
+    am.lockout_duration,
+    am.failed_attempt_delay,
+    am.require_lowercase,
+    am.require_uppercase,
+    am.require_digit,
+    am.require_symbol,
+    am.password_history_count,
+    am.max_password_age_days,
+    am.require_mfa,
+    am.auth_token_time_to_live,
+    am.auth_token_time_to_stale
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- Replaces the view from 6/01 to add the auth token settings.
+  drop view oidc_auth_method_with_value_obj;
+  create view oidc_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.disable_discovered_config_validation,
+    am.issuer,
+    am.client_id,
+    am.client_secret,
+    am.client_secret_hmac,
+    am.key_id,
+    am.max_age,
+    am.auth_token_time_to_live,
+    am.auth_token_time_to_stale,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct alg.signing_alg_name, '|') as algs,
+    string_agg(distinct aud.aud_claim, '|') as auds,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct cs.scope, '|') as claims_scopes,
+    string_agg(distinct concat_ws('=', acm.from_claim, acm.to_claim), '|') as account_claim_maps
+  from
+    auth_oidc_method am
+    left outer join iam_scope                   s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_oidc_signing_alg       alg   on am.public_id = alg.oidc_method_id
+    left outer join auth_oidc_aud_claim         aud   on am.public_id = aud.oidc_method_id
+    left outer join auth_oidc_certificate       cert  on am.public_id = cert.oidc_method_id
+    left outer join auth_oidc_scope             cs    on am.public_id = cs.oidc_method_id
+    left outer join auth_oidc_account_claim_map acm   on am.public_id = acm.oidc_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view oidc_auth_method_with_value_obj is
+    'oidc auth method with its associated value objects (algs, auds, certs, scopes) as columns with | delimited values';
+
+  -- Replaces the view from 21/09 to add the auth token settings.
+  drop view ldap_auth_method_with_value_obj;
+  create view ldap_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.user_dn,
+    am.user_attr,
+    am.user_filter,
+    am.group_dn,
+    am.group_attr,
+    am.group_filter,
+    am.bind_dn,
+    am.bind_password,
+    am.bind_password_hmac,
+    am.key_id,
+    am.auth_token_time_to_live,
+    am.auth_token_time_to_stale,
+    -- the aggregated columns will be null if there are no associated value objects
+    (select string_agg(url.url, '|' order by url.connection_priority)
+       from auth_ldap_url url
+      where url.ldap_method_id = am.public_id) as urls,
+    (select string_agg(cert.certificate, '|')
+       from auth_ldap_certificate cert
+      where cert.ldap_method_id = am.public_id) as certs
+  from
+    auth_ldap_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects (urls and certs) as columns with | delimited values';
+
+commit;
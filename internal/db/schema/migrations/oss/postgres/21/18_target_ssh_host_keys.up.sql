@@ -0,0 +1,58 @@
+begin;
+
+  -- The public keys, in authorized_keys format and one per line, that the
+  -- endpoint of a target must present when the worker terminates the ssh
+  -- connection to it. The worker refuses to inject egress credentials into a
+  -- connection to a host which presents any other key.
+  alter table target_tcp
+    add column ssh_host_keys text
+      constraint ssh_host_keys_must_not_be_empty
+        check(length(trim(ssh_host_keys)) > 0);
+
+  alter table target_ssh
+    add column ssh_host_keys text
+      constraint ssh_host_keys_must_not_be_empty
+        check(length(trim(ssh_host_keys)) > 0);
+
+  -- Replaces the view created in 21/10 to include ssh_host_keys. The view is
+  -- replaced rather than dropped since warehouse views depend on it, so the
+  -- new column is added last.
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    injection_protocol,
+    'tcp' as type,
+    session_max_extension_seconds,
+    ssh_host_keys
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    null as injection_protocol,
+    'ssh' as type,
+    session_max_extension_seconds,
+    ssh_host_keys
+  from target_ssh;
+
+commit;
@@ -0,0 +1,41 @@
+begin;
+
+-- key_id is the id of the oplog key version which encrypted the data of an
+-- entry. It is null for entries written before this migration until the key
+-- rewrap job sets it.
+alter table oplog_entry
+  add column key_id text
+    constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0);
+
+create index oplog_entry_key_id_idx
+  on oplog_entry (key_id);
+
+-- The data of oplog entries is re-encrypted with the current oplog key version
+-- after a rotation, so that the previous versions can be destroyed.
+drop trigger immutable_columns on oplog_entry;
+create trigger immutable_columns before update on oplog_entry
+  for each row execute procedure immutable_columns('id', 'update_time', 'create_time', 'version', 'aggregate_name');
+
+-- oplog_entry_data_rewrapped() ensures the data of an entry only changes when
+-- it is re-encrypted with another key version.
+create function oplog_entry_data_rewrapped() returns trigger
+as $$
+begin
+  if new.data is distinct from old.data and (new.key_id is null or new.key_id is not distinct from old.key_id) then
+    raise exception 'oplog entry data can only be updated with a new key_id';
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+create trigger oplog_entry_data_rewrapped before update on oplog_entry
+  for each row execute procedure oplog_entry_data_rewrapped();
+
+-- Session credentials are re-encrypted with the current database key version
+-- after a rotation.
+drop trigger immutable_columns on session_credential;
+create trigger immutable_columns before update on session_credential
+  for each row execute procedure immutable_columns('session_id', 'credential_sha256', 'create_time');
+
+commit;
@@ -0,0 +1,84 @@
+begin;
+
+  -- auth_password_totp_pending_secret contains the TOTP secret enrolled for a
+  -- password account which has not been confirmed with a TOTP code yet. The
+  -- secret is encrypted with the database key of the scope of the account.
+  -- Once confirmed, the secret replaces the confirmed secret of the account in
+  -- auth_password_totp_secret, so the confirmed secret keeps being used to
+  -- authenticate the account until then.
+  create table auth_password_totp_pending_secret (
+    password_account_id wt_public_id
+      primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+      check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0)
+  );
+  comment on table auth_password_totp_pending_secret is
+    'auth_password_totp_pending_secret is a table where each row contains the encrypted TOTP secret enrolled for a password account and not confirmed yet.';
+
+  create trigger update_time_column before update on auth_password_totp_pending_secret
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_totp_pending_secret
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp_pending_secret
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  -- auth_password_totp_pending_recovery_code contains the recovery codes
+  -- generated along with a pending TOTP secret. They replace the recovery codes
+  -- of the account when the secret is confirmed.
+  create table auth_password_totp_pending_recovery_code (
+    password_account_id wt_public_id not null
+      constraint auth_password_totp_pending_secret_fkey
+        references auth_password_totp_pending_secret (password_account_id)
+        on delete cascade
+        on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+      check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key(password_account_id, code_hash)
+  );
+  comment on table auth_password_totp_pending_recovery_code is
+    'auth_password_totp_pending_recovery_code is a table where each row contains the hash of a recovery code generated along with a pending TOTP secret.';
+
+  create trigger default_create_time_column before insert on auth_password_totp_pending_recovery_code
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp_pending_recovery_code
+    for each row execute procedure immutable_columns('password_account_id', 'code_hash', 'create_time');
+
+  -- Secrets which were never confirmed become pending secrets, so
+  -- auth_password_totp_secret only contains confirmed secrets.
+  insert into auth_password_totp_pending_secret
+    (password_account_id, secret, key_id)
+  select password_account_id, secret, key_id
+    from auth_password_totp_secret
+   where confirmed_time is null;
+
+  insert into auth_password_totp_pending_recovery_code
+    (password_account_id, code_hash)
+  select code.password_account_id, code.code_hash
+    from auth_password_totp_recovery_code code
+    join auth_password_totp_secret totp
+      on totp.password_account_id = code.password_account_id
+   where totp.confirmed_time is null;
+
+  delete from auth_password_totp_secret
+   where confirmed_time is null;
+
+  alter table auth_password_totp_secret
+    alter column confirmed_time set default current_timestamp,
+    alter column confirmed_time set not null;
+
+commit;
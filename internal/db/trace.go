@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/observability/trace"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts the span of the Db operation op, which must be ended with
+// trace.End.
+func startSpan(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	return trace.Start(ctx, op, append([]attribute.KeyValue{attribute.String("db.system", "postgresql")}, attrs...)...)
+}
+
+// tableAttributes returns the span attributes of the table of i, which are
+// empty when i doesn't have a TableName.
+func tableAttributes(i interface{}) []attribute.KeyValue {
+	if t, ok := i.(interface{ TableName() string }); ok {
+		return []attribute.KeyValue{attribute.String("db.sql.table", t.TableName())}
+	}
+	return nil
+}
+
+// itemsAttributes returns the span attributes of the items of CreateItems
+// and DeleteItems, which are all of the same type.
+func itemsAttributes(items []interface{}) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.Int("db.items", len(items))}
+	if len(items) > 0 {
+		attrs = append(attrs, tableAttributes(items[0])...)
+	}
+	return attrs
+}
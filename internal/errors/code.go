@@ -21,25 +21,27 @@ const (
 	Unknown Code = 0 // Unknown will be equal to a zero value for Codes
 
 	// General function errors are reserved Codes 100-999
-	InvalidParameter         Code = 100 // InvalidParameter represents an invalid parameter for an operation.
-	InvalidAddress           Code = 101 // InvalidAddress represents an invalid host address for an operation
-	InvalidPublicId          Code = 102 // InvalidPublicId represents an invalid public Id for an operation
-	InvalidFieldMask         Code = 103 // InvalidFieldMask represents an invalid field mast for an operation
-	EmptyFieldMask           Code = 104 // EmptyFieldMask represents an empty field mask for an operation
-	KeyNotFound              Code = 105 // KeyNotFound represents that a key/version was not found in the KMS
-	TicketAlreadyRedeemed    Code = 106 // TicketAlreadyRedeemed represents that the ticket version has already been redeemed
-	TicketNotFound           Code = 107 // TicketNotFound represents that the ticket was not found
-	Io                       Code = 108 // Io represents that an io error occurred in an underlying call (i.e binary.Write)
-	InvalidTimeStamp         Code = 109 // InvalidTimeStamp represents an invalid time stamp for an operation
-	SessionNotFound          Code = 110 // SessionNotFound represents that the session was not found
-	InvalidSessionState      Code = 111 // InvalidSessionState represents that the session was in an invalid state
-	TokenMismatch            Code = 112 // TokenMismatch represents that there was a token mismatch
-	TooShort                 Code = 113 // TooShort represents an error that means the provided input is not meeting minimum length requirements
-	AccountAlreadyAssociated Code = 114 // AccountAlreadyAssociated represents an attempt to associate an account failed since it was already associated.
-	InvalidJobRunState       Code = 115 // InvalidJobRunState represents that a JobRun was in an invalid state
-	InvalidDynamicCredential Code = 116 // InvalidDynamicCredential represents that a dynamic credential for a session was in an invalid state
-	JobAlreadyRunning        Code = 117 // JobAlreadyRunning represents that a Job is already running when an attempt to run again was made
-	SubtypeAlreadyRegistered Code = 118 // SubtypeAlreadyRegistered represents that a value has already been registered in the subtype registry system.
+	InvalidParameter          Code = 100 // InvalidParameter represents an invalid parameter for an operation.
+	InvalidAddress            Code = 101 // InvalidAddress represents an invalid host address for an operation
+	InvalidPublicId           Code = 102 // InvalidPublicId represents an invalid public Id for an operation
+	InvalidFieldMask          Code = 103 // InvalidFieldMask represents an invalid field mast for an operation
+	EmptyFieldMask            Code = 104 // EmptyFieldMask represents an empty field mask for an operation
+	KeyNotFound               Code = 105 // KeyNotFound represents that a key/version was not found in the KMS
+	TicketAlreadyRedeemed     Code = 106 // TicketAlreadyRedeemed represents that the ticket version has already been redeemed
+	TicketNotFound            Code = 107 // TicketNotFound represents that the ticket was not found
+	Io                        Code = 108 // Io represents that an io error occurred in an underlying call (i.e binary.Write)
+	InvalidTimeStamp          Code = 109 // InvalidTimeStamp represents an invalid time stamp for an operation
+	SessionNotFound           Code = 110 // SessionNotFound represents that the session was not found
+	InvalidSessionState       Code = 111 // InvalidSessionState represents that the session was in an invalid state
+	TokenMismatch             Code = 112 // TokenMismatch represents that there was a token mismatch
+	TooShort                  Code = 113 // TooShort represents an error that means the provided input is not meeting minimum length requirements
+	AccountAlreadyAssociated  Code = 114 // AccountAlreadyAssociated represents an attempt to associate an account failed since it was already associated.
+	InvalidJobRunState        Code = 115 // InvalidJobRunState represents that a JobRun was in an invalid state
+	InvalidDynamicCredential  Code = 116 // InvalidDynamicCredential represents that a dynamic credential for a session was in an invalid state
+	JobAlreadyRunning         Code = 117 // JobAlreadyRunning represents that a Job is already running when an attempt to run again was made
+	SubtypeAlreadyRegistered  Code = 118 // SubtypeAlreadyRegistered represents that a value has already been registered in the subtype registry system.
+	KeyInUse                  Code = 119 // KeyInUse represents that a key version is still in use and can't be destroyed
+	InvalidAccessRequestState Code = 120 // InvalidAccessRequestState represents that an access request was in an invalid state
 
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
@@ -59,6 +61,32 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// PasswordTooWeak results from attempting to set a password which does
+	// not contain the character classes required by the password policy.
+	PasswordTooWeak Code = 204
+
+	// PasswordDisallowed results from attempting to set a password which is
+	// in the disallowed passwords of the password policy.
+	PasswordDisallowed Code = 205
+
+	// PasswordReused results from attempting to set a password which equals
+	// one of the recent passwords of the account.
+	PasswordReused Code = 206
+
+	// PasswordExpired is returned from Authenticate when the password of the
+	// account is older than the maximum password age.
+	PasswordExpired Code = 207
+
+	// MfaRequired is returned from Authenticate when the password of an
+	// account enrolled for TOTP matches but no TOTP code or recovery code
+	// was provided.
+	MfaRequired Code = 208
+
+	// MfaNotEnrolled is returned from Authenticate when the auth method
+	// requires multi-factor authentication and the account has no confirmed
+	// TOTP secret.
+	MfaNotEnrolled Code = 209
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
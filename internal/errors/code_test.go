@@ -117,6 +117,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    SubtypeAlreadyRegistered,
 			want: SubtypeAlreadyRegistered,
 		},
+		{
+			name: "KeyInUse",
+			c:    KeyInUse,
+			want: KeyInUse,
+		},
+		{
+			name: "InvalidAccessRequestState",
+			c:    InvalidAccessRequestState,
+			want: InvalidAccessRequestState,
+		},
 		{
 			name: "InvalidDynamicCredential",
 			c:    InvalidDynamicCredential,
@@ -162,6 +172,36 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "PasswordTooWeak",
+			c:    PasswordTooWeak,
+			want: PasswordTooWeak,
+		},
+		{
+			name: "PasswordDisallowed",
+			c:    PasswordDisallowed,
+			want: PasswordDisallowed,
+		},
+		{
+			name: "PasswordReused",
+			c:    PasswordReused,
+			want: PasswordReused,
+		},
+		{
+			name: "PasswordExpired",
+			c:    PasswordExpired,
+			want: PasswordExpired,
+		},
+		{
+			name: "MfaRequired",
+			c:    MfaRequired,
+			want: MfaRequired,
+		},
+		{
+			name: "MfaNotEnrolled",
+			c:    MfaNotEnrolled,
+			want: MfaNotEnrolled,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
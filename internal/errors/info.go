@@ -96,6 +96,14 @@ var errorCodeInfo = map[Code]Info{
 		Message: "subtype already registered",
 		Kind:    Parameter,
 	},
+	KeyInUse: {
+		Message: "key version is still in use",
+		Kind:    State,
+	},
+	InvalidAccessRequestState: {
+		Message: "access request is in an invalid state",
+		Kind:    State,
+	},
 	InvalidDynamicCredential: {
 		Message: "dynamic credential for session is in an invalid state",
 		Kind:    Integrity,
@@ -116,6 +124,30 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	PasswordTooWeak: {
+		Message: "missing required character classes",
+		Kind:    Password,
+	},
+	PasswordDisallowed: {
+		Message: "disallowed password",
+		Kind:    Password,
+	},
+	PasswordReused: {
+		Message: "password recently used",
+		Kind:    Password,
+	},
+	PasswordExpired: {
+		Message: "password expired",
+		Kind:    Password,
+	},
+	MfaRequired: {
+		Message: "multi-factor authentication required",
+		Kind:    Password,
+	},
+	MfaNotEnrolled: {
+		Message: "multi-factor authentication not enrolled",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
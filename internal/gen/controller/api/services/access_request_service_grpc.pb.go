@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccessRequestServiceClient is the client API for AccessRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessRequestServiceClient interface {
+	// GetAccessRequest returns a stored Access Request if present. The
+	// provided request must include the Access Request ID for the Access
+	// Request being retrieved. If that ID is missing, malformed or references
+	// a non existing resource an error is returned.
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error)
+	// ListAccessRequests returns a list of stored Access Requests which exist
+	// inside the scope referenced inside the request. The request must
+	// include the scope ID for the Access Requests being retrieved. If the
+	// scope ID is missing, malformed, or references a non existing scope, an
+	// error is returned.
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	// RequestAccess creates a pending Access Request of the calling User for
+	// access to a Target for a bounded time window. The provided item must
+	// include the Target ID and the duration of the window.
+	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error)
+	// ApproveAccessRequest approves a pending Access Request, granting the
+	// requesting User access to the Target until the end of the requested
+	// time window. Users can't approve their own Access Requests.
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	// DenyAccessRequest denies a pending Access Request. Users can't deny
+	// their own Access Requests.
+	DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error)
+}
+
+type accessRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessRequestServiceClient(cc grpc.ClientConnInterface) AccessRequestServiceClient {
+	return &accessRequestServiceClient{cc}
+}
+
+func (c *accessRequestServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error) {
+	out := new(GetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccessRequestService/GetAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccessRequestService/ListAccessRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error) {
+	out := new(RequestAccessResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccessRequestService/RequestAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccessRequestService/ApproveAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error) {
+	out := new(DenyAccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccessRequestService/DenyAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessRequestServiceServer is the server API for AccessRequestService service.
+// All implementations must embed UnimplementedAccessRequestServiceServer
+// for forward compatibility
+type AccessRequestServiceServer interface {
+	// GetAccessRequest returns a stored Access Request if present. The
+	// provided request must include the Access Request ID for the Access
+	// Request being retrieved. If that ID is missing, malformed or references
+	// a non existing resource an error is returned.
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error)
+	// ListAccessRequests returns a list of stored Access Requests which exist
+	// inside the scope referenced inside the request. The request must
+	// include the scope ID for the Access Requests being retrieved. If the
+	// scope ID is missing, malformed, or references a non existing scope, an
+	// error is returned.
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	// RequestAccess creates a pending Access Request of the calling User for
+	// access to a Target for a bounded time window. The provided item must
+	// include the Target ID and the duration of the window.
+	RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error)
+	// ApproveAccessRequest approves a pending Access Request, granting the
+	// requesting User access to the Target until the end of the requested
+	// time window. Users can't approve their own Access Requests.
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	// DenyAccessRequest denies a pending Access Request. Users can't deny
+	// their own Access Requests.
+	DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error)
+	mustEmbedUnimplementedAccessRequestServiceServer()
+}
+
+// UnimplementedAccessRequestServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAccessRequestServiceServer struct {
+}
+
+func (UnimplementedAccessRequestServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedAccessRequestServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedAccessRequestServiceServer) RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedAccessRequestServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedAccessRequestServiceServer) DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
+func (UnimplementedAccessRequestServiceServer) mustEmbedUnimplementedAccessRequestServiceServer() {}
+
+// UnsafeAccessRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessRequestServiceServer will
+// result in compilation errors.
+type UnsafeAccessRequestServiceServer interface {
+	mustEmbedUnimplementedAccessRequestServiceServer()
+}
+
+func RegisterAccessRequestServiceServer(s grpc.ServiceRegistrar, srv AccessRequestServiceServer) {
+	s.RegisterService(&AccessRequestService_ServiceDesc, srv)
+}
+
+func _AccessRequestService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).GetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccessRequestService/GetAccessRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccessRequestService/ListAccessRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccessRequestService/RequestAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccessRequestService/ApproveAccessRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).DenyAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccessRequestService/DenyAccessRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).DenyAccessRequest(ctx, req.(*DenyAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessRequestService_ServiceDesc is the grpc.ServiceDesc for AccessRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.AccessRequestService",
+	HandlerType: (*AccessRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _AccessRequestService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _AccessRequestService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "RequestAccess",
+			Handler:    _AccessRequestService_RequestAccess_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _AccessRequestService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyAccessRequest",
+			Handler:    _AccessRequestService_DenyAccessRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/access_request_service.proto",
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SessionRecordingServiceClient is the client API for SessionRecordingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SessionRecordingServiceClient interface {
+	// GetSessionRecording returns a stored Session Recording if present. The
+	// provided request must include the Session Recording ID for the Session
+	// Recording being retrieved. If that ID is missing, malformed or
+	// references a non existing resource an error is returned.
+	GetSessionRecording(ctx context.Context, in *GetSessionRecordingRequest, opts ...grpc.CallOption) (*GetSessionRecordingResponse, error)
+	// ListSessionRecordings returns a list of stored Session Recordings which
+	// exist inside the scope referenced inside the request. The request must
+	// include the scope ID for the Session Recordings being retrieved. If the
+	// scope ID is missing, malformed, or references a non existing scope, an
+	// error is returned.
+	ListSessionRecordings(ctx context.Context, in *ListSessionRecordingsRequest, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error)
+	// DownloadSessionRecording returns the content of a stored Session
+	// Recording in asciicast v2 format. Downloading a recording requires the
+	// read action on it.
+	DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (*DownloadSessionRecordingResponse, error)
+}
+
+type sessionRecordingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionRecordingServiceClient(cc grpc.ClientConnInterface) SessionRecordingServiceClient {
+	return &sessionRecordingServiceClient{cc}
+}
+
+func (c *sessionRecordingServiceClient) GetSessionRecording(ctx context.Context, in *GetSessionRecordingRequest, opts ...grpc.CallOption) (*GetSessionRecordingResponse, error) {
+	out := new(GetSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionRecordingService/GetSessionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecordingServiceClient) ListSessionRecordings(ctx context.Context, in *ListSessionRecordingsRequest, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error) {
+	out := new(ListSessionRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionRecordingService/ListSessionRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecordingServiceClient) DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (*DownloadSessionRecordingResponse, error) {
+	out := new(DownloadSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionRecordingService/DownloadSessionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionRecordingServiceServer is the server API for SessionRecordingService service.
+// All implementations must embed UnimplementedSessionRecordingServiceServer
+// for forward compatibility
+type SessionRecordingServiceServer interface {
+	// GetSessionRecording returns a stored Session Recording if present. The
+	// provided request must include the Session Recording ID for the Session
+	// Recording being retrieved. If that ID is missing, malformed or
+	// references a non existing resource an error is returned.
+	GetSessionRecording(context.Context, *GetSessionRecordingRequest) (*GetSessionRecordingResponse, error)
+	// ListSessionRecordings returns a list of stored Session Recordings which
+	// exist inside the scope referenced inside the request. The request must
+	// include the scope ID for the Session Recordings being retrieved. If the
+	// scope ID is missing, malformed, or references a non existing scope, an
+	// error is returned.
+	ListSessionRecordings(context.Context, *ListSessionRecordingsRequest) (*ListSessionRecordingsResponse, error)
+	// DownloadSessionRecording returns the content of a stored Session
+	// Recording in asciicast v2 format. Downloading a recording requires the
+	// read action on it.
+	DownloadSessionRecording(context.Context, *DownloadSessionRecordingRequest) (*DownloadSessionRecordingResponse, error)
+	mustEmbedUnimplementedSessionRecordingServiceServer()
+}
+
+// UnimplementedSessionRecordingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSessionRecordingServiceServer struct {
+}
+
+func (UnimplementedSessionRecordingServiceServer) GetSessionRecording(context.Context, *GetSessionRecordingRequest) (*GetSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionRecording not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) ListSessionRecordings(context.Context, *ListSessionRecordingsRequest) (*ListSessionRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionRecordings not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) DownloadSessionRecording(context.Context, *DownloadSessionRecordingRequest) (*DownloadSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadSessionRecording not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) mustEmbedUnimplementedSessionRecordingServiceServer() {
+}
+
+// UnsafeSessionRecordingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionRecordingServiceServer will
+// result in compilation errors.
+type UnsafeSessionRecordingServiceServer interface {
+	mustEmbedUnimplementedSessionRecordingServiceServer()
+}
+
+func RegisterSessionRecordingServiceServer(s grpc.ServiceRegistrar, srv SessionRecordingServiceServer) {
+	s.RegisterService(&SessionRecordingService_ServiceDesc, srv)
+}
+
+func _SessionRecordingService_GetSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).GetSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionRecordingService/GetSessionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).GetSessionRecording(ctx, req.(*GetSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecordingService_ListSessionRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).ListSessionRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionRecordingService/ListSessionRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).ListSessionRecordings(ctx, req.(*ListSessionRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecordingService_DownloadSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).DownloadSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionRecordingService/DownloadSessionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).DownloadSessionRecording(ctx, req.(*DownloadSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionRecordingService_ServiceDesc is the grpc.ServiceDesc for SessionRecordingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionRecordingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.SessionRecordingService",
+	HandlerType: (*SessionRecordingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSessionRecording",
+			Handler:    _SessionRecordingService_GetSessionRecording_Handler,
+		},
+		{
+			MethodName: "ListSessionRecordings",
+			Handler:    _SessionRecordingService_ListSessionRecordings_Handler,
+		},
+		{
+			MethodName: "DownloadSessionRecording",
+			Handler:    _SessionRecordingService_DownloadSessionRecording_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/session_recording_service.proto",
+}
@@ -66,6 +66,12 @@ type UserServiceClient interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(ctx context.Context, in *RemoveUserAccountsRequest, opts ...grpc.CallOption) (*RemoveUserAccountsResponse, error)
+	// RevokeUserTokens deletes all the auth tokens of the specified User, or
+	// only those issued for the provided Account, and cancels the pending or
+	// active sessions created with them. The provided request must include the
+	// User id. If the provided Account id is not associated with the User an
+	// error is returned.
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error)
 }
 
 type userServiceClient struct {
@@ -148,6 +154,15 @@ func (c *userServiceClient) RemoveUserAccounts(ctx context.Context, in *RemoveUs
 	return out, nil
 }
 
+func (c *userServiceClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error) {
+	out := new(RevokeUserTokensResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.UserService/RevokeUserTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -200,6 +215,12 @@ type UserServiceServer interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error)
+	// RevokeUserTokens deletes all the auth tokens of the specified User, or
+	// only those issued for the provided Account, and cancels the pending or
+	// active sessions created with them. The provided request must include the
+	// User id. If the provided Account id is not associated with the User an
+	// error is returned.
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -231,6 +252,9 @@ func (UnimplementedUserServiceServer) SetUserAccounts(context.Context, *SetUserA
 func (UnimplementedUserServiceServer) RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserAccounts not implemented")
 }
+func (UnimplementedUserServiceServer) RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -388,6 +412,24 @@ func _UserService_RemoveUserAccounts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.UserService/RevokeUserTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -427,6 +469,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUserAccounts",
 			Handler:    _UserService_RemoveUserAccounts_Handler,
 		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _UserService_RevokeUserTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/user_service.proto",
@@ -31,6 +31,9 @@ type SessionServiceClient interface {
 	ConnectConnection(ctx context.Context, in *ConnectConnectionRequest, opts ...grpc.CallOption) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*CloseConnectionResponse, error)
+	// CreateSessionRecording records on the controller that a worker has
+	// written a recording of a connection to its recording storage.
+	CreateSessionRecording(ctx context.Context, in *CreateSessionRecordingRequest, opts ...grpc.CallOption) (*CreateSessionRecordingResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -95,6 +98,15 @@ func (c *sessionServiceClient) CloseConnection(ctx context.Context, in *CloseCon
 	return out, nil
 }
 
+func (c *sessionServiceClient) CreateSessionRecording(ctx context.Context, in *CreateSessionRecordingRequest, opts ...grpc.CallOption) (*CreateSessionRecordingResponse, error) {
+	out := new(CreateSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.servers.services.v1.SessionService/CreateSessionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -112,6 +124,9 @@ type SessionServiceServer interface {
 	ConnectConnection(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+	// CreateSessionRecording records on the controller that a worker has
+	// written a recording of a connection to its recording storage.
+	CreateSessionRecording(context.Context, *CreateSessionRecordingRequest) (*CreateSessionRecordingResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -137,6 +152,9 @@ func (UnimplementedSessionServiceServer) ConnectConnection(context.Context, *Con
 func (UnimplementedSessionServiceServer) CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
 }
+func (UnimplementedSessionServiceServer) CreateSessionRecording(context.Context, *CreateSessionRecordingRequest) (*CreateSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSessionRecording not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +276,24 @@ func _SessionService_CloseConnection_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_CreateSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CreateSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.servers.services.v1.SessionService/CreateSessionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CreateSessionRecording(ctx, req.(*CreateSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +325,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseConnection",
 			Handler:    _SessionService_CloseConnection_Handler,
 		},
+		{
+			MethodName: "CreateSessionRecording",
+			Handler:    _SessionService_CreateSessionRecording_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/servers/services/v1/session_service.proto",
@@ -39,3 +39,9 @@ func (c *mockSessionServiceClient) ConnectConnection(_ context.Context, _ *Conne
 func (c *mockSessionServiceClient) CloseConnection(_ context.Context, _ *CloseConnectionRequest, _ ...grpc.CallOption) (*CloseConnectionResponse, error) {
 	panic("not implemented")
 }
+
+func (c *mockSessionServiceClient) CreateSessionRecording(_ context.Context, _ *CreateSessionRecordingRequest, _ ...grpc.CallOption) (*CreateSessionRecordingResponse, error) {
+	return &CreateSessionRecordingResponse{
+		RecordingId: "sr_1234567890",
+	}, nil
+}
@@ -1,5 +1,7 @@
 package host
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // GetOpts - iterate the inbound Options and return a struct
 func GetOpts(opt ...Option) (options, error) {
 	opts := getDefaultOptions()
@@ -16,9 +18,10 @@ type Option func(*options) error
 
 // options = how options are represented
 type options struct {
-	WithLimit             int
-	WithOrderByCreateTime bool
-	Ascending             bool
+	WithLimit              int
+	WithStartPageAfterItem *db.PageItem
+	WithOrderByCreateTime  bool
+	Ascending              bool
 }
 
 func getDefaultOptions() options {
@@ -35,6 +38,16 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) error {
+		o.WithStartPageAfterItem = item
+		return nil
+	}
+}
+
 // WithOrderByCreateTime provides an option to specify ordering by the
 // CreateTime field.
 func WithOrderByCreateTime(ascending bool) Option {
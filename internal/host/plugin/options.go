@@ -1,6 +1,9 @@
 package plugin
 
-import "google.golang.org/protobuf/types/known/structpb"
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"google.golang.org/protobuf/types/known/structpb"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -27,6 +30,7 @@ type options struct {
 	withIpAddresses         []string
 	withDnsNames            []string
 	withLimit               int
+	withStartPageAfterItem  *db.PageItem
 	withSetIds              []string
 }
 
@@ -115,6 +119,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithSetIds provides the ability to restrict lookups to particular matching
 // sets.
 func WithSetIds(with []string) Option {
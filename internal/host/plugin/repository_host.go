@@ -33,7 +33,7 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 }
 
 // ListHostsByCatalogId returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// WithLimit and WithStartPageAfterItem are the only options supported.
 func (r *Repository) ListHostsByCatalogId(ctx context.Context, catalogId string, opt ...Option) ([]*Host, *hostplugin.Plugin, error) {
 	const op = "plugin.(Repository).ListHostsByCatalogId"
 	if catalogId == "" {
@@ -46,7 +46,7 @@ func (r *Repository) ListHostsByCatalogId(ctx context.Context, catalogId string,
 		limit = opts.withLimit
 	}
 	var hostAggs []*hostAgg
-	err := r.reader.SearchWhere(ctx, &hostAggs, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hostAggs, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 
 	switch {
 	case err != nil:
@@ -469,7 +469,8 @@ func (r *Repository) LookupCatalog(ctx context.Context, id string, _ ...Option)
 	return c, plg, nil
 }
 
-// ListCatalogs returns a slice of HostCatalogs for the scope IDs. WithLimit is the only option supported.
+// ListCatalogs returns a slice of HostCatalogs for the scope IDs. WithLimit and
+// WithStartPageAfterItem are the only options supported.
 func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ...host.Option) ([]*HostCatalog, []*hostplugin.Plugin, error) {
 	const op = "plugin.(Repository).ListCatalogs"
 	if len(scopeIds) == 0 {
@@ -485,7 +486,7 @@ func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ..
 		limit = opts.WithLimit
 	}
 	var hostCatalogs []*HostCatalog
-	if err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.WithStartPageAfterItem)); err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	plgIds := make([]string, 0, len(hostCatalogs))
@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("host_plugin_catalog_secret", hostCatalogSecretRewrapFn)
+}
+
+func hostCatalogSecretRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "plugin.hostCatalogSecretRewrapFn"
+	var secrets []*HostCatalogSecret
+	if err := reader.SearchWhere(ctx, &secrets, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host catalog secrets"))
+	}
+	if len(secrets) == 0 {
+		return nil
+	}
+	old, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	current, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, s := range secrets {
+				if err := s.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := s.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				query, values := s.upsertQuery()
+				rowsUpdated, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update host catalog secret"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 host catalog secret would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
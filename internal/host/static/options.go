@@ -1,5 +1,7 @@
 package static
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,11 +16,12 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName        string
-	withDescription string
-	withLimit       int
-	withAddress     string
-	withPublicId    string
+	withName               string
+	withDescription        string
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withAddress            string
+	withPublicId           string
 }
 
 func getDefaultOptions() options {
@@ -52,6 +55,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithAddress provides an optional address.
 func WithAddress(address string) Option {
 	return func(o *options) {
@@ -215,7 +215,7 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 }
 
 // ListHosts returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// WithLimit and WithStartPageAfterItem are the only options supported.
 func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
 	const op = "static.(Repository).ListHosts"
 	if catalogId == "" {
@@ -228,7 +228,7 @@ func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Opt
 		limit = opts.withLimit
 	}
 	var hosts []*Host
-	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
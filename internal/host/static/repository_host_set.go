@@ -244,8 +244,8 @@ func (r *Repository) lookupSet(ctx context.Context, publicId string, opt ...Opti
 	return s, hosts, nil
 }
 
-// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
-// only option supported.
+// ListSets returns a slice of HostSets for the catalogId. WithLimit and
+// WithStartPageAfterItem are the only options supported.
 func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
 	const op = "static.(Repository).ListSets"
 	if catalogId == "" {
@@ -258,7 +258,7 @@ func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Opti
 		limit = opts.withLimit
 	}
 	var sets []*HostSet
-	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -1,6 +1,11 @@
 package iam
 
-import "io"
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -20,6 +25,7 @@ type options struct {
 	withName                    string
 	withDescription             string
 	withLimit                   int
+	withStartPageAfterItem      *db.PageItem
 	withGrantScopeId            string
 	withSkipVetForWrite         bool
 	withDisassociate            bool
@@ -29,6 +35,8 @@ type options struct {
 	withRandomReader            io.Reader
 	withAccountIds              []string
 	withPrimaryAuthMethodId     string
+	withValidFrom               time.Time
+	withValidUntil              time.Time
 }
 
 func getDefaultOptions() options {
@@ -72,6 +80,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithGrantScopeId provides an option to specify the scope ID for grants in
 // roles.
 func WithGrantScopeId(id string) Option {
@@ -141,3 +158,19 @@ func WithPrimaryAuthMethodId(id string) Option {
 		o.withPrimaryAuthMethodId = id
 	}
 }
+
+// WithValidFrom provides an option to specify the start of the window in
+// which a principal is assigned to a role.
+func WithValidFrom(t time.Time) Option {
+	return func(o *options) {
+		o.withValidFrom = t
+	}
+}
+
+// WithValidUntil provides an option to specify the end of the window in
+// which a principal is assigned to a role.
+func WithValidUntil(t time.Time) Option {
+	return func(o *options) {
+		o.withValidUntil = t
+	}
+}
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -79,4 +80,20 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrimaryAuthMethodId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithValidFrom", func(t *testing.T) {
+		assert := assert.New(t)
+		now := time.Now()
+		opts := getOpts(WithValidFrom(now))
+		testOpts := getDefaultOptions()
+		testOpts.withValidFrom = now
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithValidUntil", func(t *testing.T) {
+		assert := assert.New(t)
+		now := time.Now()
+		opts := getOpts(WithValidUntil(now))
+		testOpts := getDefaultOptions()
+		testOpts.withValidUntil = now
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -2,8 +2,10 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"google.golang.org/protobuf/proto"
@@ -75,9 +77,9 @@ var (
 	_ db.VetForWriter = (*UserRole)(nil)
 )
 
-// NewUserRole creates a new user role in memory. No options are supported
-// currently.
-func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
+// NewUserRole creates a new user role in memory. Supports the WithValidFrom
+// and WithValidUntil options.
+func NewUserRole(roleId, userId string, opt ...Option) (*UserRole, error) {
 	const op = "iam.NewUserRole"
 	if roleId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing role id")
@@ -85,10 +87,16 @@ func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
 	if userId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
 	}
+	validFrom, validUntil, err := validityWindow(getOpts(opt...))
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &UserRole{
 		UserRole: &store.UserRole{
 			PrincipalId: userId,
 			RoleId:      roleId,
+			ValidFrom:   validFrom,
+			ValidUntil:  validUntil,
 		},
 	}, nil
 }
@@ -152,8 +160,8 @@ var (
 	_ db.VetForWriter = (*GroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewGroupRole creates a new group role in memory. Supports the WithValidFrom
+// and WithValidUntil options.
 func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	const op = "iam.NewGroupRole"
 	if roleId == "" {
@@ -162,10 +170,16 @@ func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	if groupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing group id")
 	}
+	validFrom, validUntil, err := validityWindow(getOpts(opt...))
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &GroupRole{
 		GroupRole: &store.GroupRole{
 			PrincipalId: groupId,
 			RoleId:      roleId,
+			ValidFrom:   validFrom,
+			ValidUntil:  validUntil,
 		},
 	}, nil
 }
@@ -229,8 +243,8 @@ var (
 	_ db.VetForWriter = (*ManagedGroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewManagedGroupRole creates a new managed group role in memory. Supports the WithValidFrom
+// and WithValidUntil options.
 func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*ManagedGroupRole, error) {
 	const op = "iam.NewManagedGroupRole"
 	if roleId == "" {
@@ -239,10 +253,16 @@ func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*Managed
 	if managedGroupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing managed group id")
 	}
+	validFrom, validUntil, err := validityWindow(getOpts(opt...))
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &ManagedGroupRole{
 		ManagedGroupRole: &store.ManagedGroupRole{
 			PrincipalId: managedGroupId,
 			RoleId:      roleId,
+			ValidFrom:   validFrom,
+			ValidUntil:  validUntil,
 		},
 	}, nil
 }
@@ -294,3 +314,38 @@ func (r *ManagedGroupRole) SetTableName(n string) {
 		r.tableName = n
 	}
 }
+
+// validityWindow returns the window in which a principal is assigned to a role
+// from the WithValidFrom and WithValidUntil options. Unset bounds are nil.
+func validityWindow(opts options) (*timestamp.Timestamp, *timestamp.Timestamp, error) {
+	const op = "iam.validityWindow"
+	var validFrom, validUntil *timestamp.Timestamp
+	if !opts.withValidFrom.IsZero() {
+		validFrom = timestamp.New(opts.withValidFrom)
+	}
+	if !opts.withValidUntil.IsZero() {
+		validUntil = timestamp.New(opts.withValidUntil)
+	}
+	if validFrom != nil && validUntil != nil && !opts.withValidFrom.Before(opts.withValidUntil) {
+		return nil, nil, errors.NewDeprecated(errors.InvalidParameter, op, "valid from must be before valid until")
+	}
+	return validFrom, validUntil, nil
+}
+
+// sameValidityWindow reports whether the window of the principal role matches
+// the window set by the WithValidFrom and WithValidUntil options. The database
+// stores timestamps with microsecond precision, so the bounds are compared to
+// that precision.
+func sameValidityWindow(pr *PrincipalRole, opts options) bool {
+	equal := func(ts *timestamp.Timestamp, t time.Time) bool {
+		if ts.GetTimestamp() == nil {
+			return t.IsZero()
+		}
+		if t.IsZero() {
+			return false
+		}
+		d := ts.AsTime().Sub(t)
+		return d > -time.Microsecond && d < time.Microsecond
+	}
+	return equal(pr.GetValidFrom(), opts.withValidFrom) && equal(pr.GetValidUntil(), opts.withValidUntil)
+}
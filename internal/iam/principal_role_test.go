@@ -3,8 +3,10 @@ package iam
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,8 @@ func TestNewUserRole(t *testing.T) {
 	orgRole := TestRole(t, conn, org.PublicId)
 	projRole := TestRole(t, conn, proj.PublicId)
 	user := TestUser(t, repo, org.PublicId)
+	validFrom := time.Now().Add(time.Hour)
+	validUntil := validFrom.Add(time.Hour)
 
 	type args struct {
 		roleId string
@@ -60,6 +64,33 @@ func TestNewUserRole(t *testing.T) {
 				return &r
 			}(),
 		},
+		{
+			name: "valid-with-window",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithValidFrom(validFrom), WithValidUntil(validUntil)},
+			},
+			want: func() *UserRole {
+				r := allocUserRole()
+				r.RoleId = orgRole.PublicId
+				r.PrincipalId = user.PublicId
+				r.ValidFrom = timestamp.New(validFrom)
+				r.ValidUntil = timestamp.New(validUntil)
+				return &r
+			}(),
+		},
+		{
+			name: "valid-from-after-valid-until",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithValidFrom(validUntil), WithValidUntil(validFrom)},
+			},
+			want:      nil,
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
 		{
 			name: "empty-role-id",
 			args: args{
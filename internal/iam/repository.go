@@ -51,7 +51,7 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 }
 
 // list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// repo defaultLimit, and the WithStartPageAfterItem option
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	opts := getOpts(opt...)
 	limit := r.defaultLimit
@@ -59,7 +59,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit))
+	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 }
 
 // create will create a new iam resource in the db repository with an oplog entry
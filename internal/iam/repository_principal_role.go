@@ -16,8 +16,10 @@ import (
 // groupIds) to a role (roleId).  The role's current db version must match the
 // roleVersion or an error will be returned.  The list of current PrincipalRoles
 // after the adds will be returned on success. Zero is not a valid value for
-// the WithVersion option and will return an error.
-func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, error) {
+// the WithVersion option and will return an error. The WithValidFrom and
+// WithValidUntil options set the window in which the added principals are
+// assigned to the role.
+func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, error) {
 	const op = "iam.(Repository).AddPrincipalRoles"
 	if roleId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -35,7 +37,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 
 	newUserRoles := make([]interface{}, 0, len(userIds))
 	for _, id := range userIds {
-		usrRole, err := NewUserRole(roleId, id)
+		usrRole, err := NewUserRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role"))
 		}
@@ -43,7 +45,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newGrpRoles := make([]interface{}, 0, len(groupIds))
 	for _, id := range groupIds {
-		grpRole, err := NewGroupRole(roleId, id)
+		grpRole, err := NewGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role"))
 		}
@@ -51,7 +53,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newManagedGrpRoles := make([]interface{}, 0, len(managedGroupIds))
 	for _, id := range managedGroupIds {
-		managedGrpRole, err := NewManagedGroupRole(roleId, id)
+		managedGrpRole, err := NewManagedGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role"))
 		}
@@ -148,8 +150,10 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 // principals as need to reconcile the existing principals with the principals
 // requested. If both userIds and groupIds are empty, the principal roles will
 // be cleared. Zero is not a valid value for the WithVersion option and will
-// return an error.
-func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, int, error) {
+// return an error. The WithValidFrom and WithValidUntil options set the window
+// in which the principals are assigned to the role; existing principals with a
+// different window are deleted and added again with the requested window.
+func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, int, error) {
 	const op = "iam.(Repository).SetPrincipalRoles"
 	if roleId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -167,7 +171,7 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds)
+	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds, opt...)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
@@ -461,6 +465,17 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// ListExpiredPrincipalRoles returns the principal roles whose valid_until has
+// passed and supports the WithLimit option.
+func (r *Repository) ListExpiredPrincipalRoles(ctx context.Context, opt ...Option) ([]PrincipalRole, error) {
+	const op = "iam.(Repository).ListExpiredPrincipalRoles"
+	var roles []PrincipalRole
+	if err := r.list(ctx, &roles, "valid_until <= current_timestamp", nil, opt...); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup expired principal roles"))
+	}
+	return roles, nil
+}
+
 type PrincipalSet struct {
 	AddUserRoles            []interface{}
 	AddGroupRoles           []interface{}
@@ -474,9 +489,12 @@ type PrincipalSet struct {
 }
 
 // TODO: Should this be moved inside the transaction, at this point?
-// PrincipalsToSet sets principals on a role from the given lists.
-func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string) (*PrincipalSet, error) {
+// PrincipalsToSet sets principals on a role from the given lists. Supports the
+// WithValidFrom and WithValidUntil options; existing principals whose window
+// doesn't match are deleted and added again.
+func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string, opt ...Option) (*PrincipalSet, error) {
 	const op = "iam.(Repository).PrincipalsToSet"
+	opts := getOpts(opt...)
 	// TODO(mgaffney) 08/2020: Use SQL to calculate changes.
 	if role == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role")
@@ -504,8 +522,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	userIdsMap := map[string]struct{}{}
 	for _, id := range userIds {
 		userIdsMap[id] = struct{}{}
-		if _, ok := existingUsers[id]; !ok {
-			usrRole, err := NewUserRole(role.PublicId, id)
+		if p, ok := existingUsers[id]; !ok || !sameValidityWindow(&p, opts) {
+			usrRole, err := NewUserRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for add"))
 			}
@@ -516,8 +534,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	groupIdsMap := map[string]struct{}{}
 	for _, id := range groupIds {
 		groupIdsMap[id] = struct{}{}
-		if _, ok := existingGroups[id]; !ok {
-			grpRole, err := NewGroupRole(role.PublicId, id)
+		if p, ok := existingGroups[id]; !ok || !sameValidityWindow(&p, opts) {
+			grpRole, err := NewGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for add"))
 			}
@@ -528,8 +546,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	managedGroupIdsMap := map[string]struct{}{}
 	for _, id := range managedGroupIds {
 		managedGroupIdsMap[id] = struct{}{}
-		if _, ok := existingManagedGroups[id]; !ok {
-			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id)
+		if p, ok := existingManagedGroups[id]; !ok || !sameValidityWindow(&p, opts) {
+			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for add"))
 			}
@@ -538,7 +556,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteUserRoles []interface{}
 	for _, p := range existingUsers {
-		if _, ok := userIdsMap[p.PrincipalId]; !ok {
+		if _, ok := userIdsMap[p.PrincipalId]; !ok || !sameValidityWindow(&p, opts) {
 			usrRole, err := NewUserRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for delete"))
@@ -548,7 +566,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteGrpRoles []interface{}
 	for _, p := range existingGroups {
-		if _, ok := groupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := groupIdsMap[p.PrincipalId]; !ok || !sameValidityWindow(&p, opts) {
 			grpRole, err := NewGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for delete"))
@@ -558,7 +576,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteManagedGrpRoles []interface{}
 	for _, p := range existingManagedGroups {
-		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok || !sameValidityWindow(&p, opts) {
 			managedGrpRole, err := NewManagedGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for delete"))
@@ -602,7 +620,8 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 				groups = make([]string, 0, len(principals))
 			}
 			groups = append(groups, principal)
-		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix):
+		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix),
+			strings.HasPrefix(principal, intglobals.LdapManagedGroupPrefix):
 			if managedGroups == nil {
 				managedGroups = make([]string, 0, len(principals))
 			}
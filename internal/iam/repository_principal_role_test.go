@@ -297,6 +297,76 @@ func TestRepository_ListPrincipalRoles(t *testing.T) {
 	}
 }
 
+func TestRepository_ListExpiredPrincipalRoles(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	role := TestRole(t, conn, org.PublicId)
+	expiredUser := TestUser(t, repo, org.PublicId)
+	TestUserRole(t, conn, role.PublicId, expiredUser.PublicId, WithValidUntil(now.Add(-time.Minute)))
+	activeUser := TestUser(t, repo, org.PublicId)
+	TestUserRole(t, conn, role.PublicId, activeUser.PublicId, WithValidUntil(now.Add(time.Hour)))
+	permanentUser := TestUser(t, repo, org.PublicId)
+	TestUserRole(t, conn, role.PublicId, permanentUser.PublicId)
+	expiredGroup := TestGroup(t, conn, org.PublicId)
+	TestGroupRole(t, conn, role.PublicId, expiredGroup.PublicId, WithValidFrom(now.Add(-time.Hour)), WithValidUntil(now.Add(-time.Minute)))
+
+	got, err := repo.ListExpiredPrincipalRoles(ctx)
+	require.NoError(err)
+	gotIds := make([]string, 0, len(got))
+	for _, pr := range got {
+		assert.Equal(role.PublicId, pr.GetRoleId())
+		require.NotNil(pr.GetValidUntil())
+		gotIds = append(gotIds, pr.GetPrincipalId())
+	}
+	assert.ElementsMatch([]string{expiredUser.PublicId, expiredGroup.PublicId}, gotIds)
+}
+
+func TestRepository_SetPrincipalRoles_ValidityWindow(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	role := TestRole(t, conn, org.PublicId)
+	user := TestUser(t, repo, org.PublicId)
+	validUntil := time.Now().Add(time.Hour)
+
+	got, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId}, WithValidUntil(validUntil))
+	require.NoError(err)
+	require.Len(got, 1)
+	require.NotNil(got[0].GetValidUntil())
+	assert.WithinDuration(validUntil, got[0].GetValidUntil().AsTime(), time.Millisecond)
+	assert.Nil(got[0].GetValidFrom())
+
+	// setting the same window is not a change
+	got, rowsAffected, err := repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+1, []string{user.PublicId}, WithValidUntil(validUntil))
+	require.NoError(err)
+	assert.Equal(0, rowsAffected)
+	require.Len(got, 1)
+
+	// removing the window replaces the assignment
+	got, rowsAffected, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+1, []string{user.PublicId})
+	require.NoError(err)
+	assert.Equal(2, rowsAffected)
+	require.Len(got, 1)
+	assert.Equal(user.PublicId, got[0].GetPrincipalId())
+	assert.Nil(got[0].GetValidUntil())
+
+	_, _, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+2, []string{user.PublicId}, WithValidFrom(validUntil), WithValidUntil(validUntil.Add(-time.Minute)))
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
 func TestRepository_DeletePrincipalRoles(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -181,7 +181,8 @@ func (r *Repository) DeleteRole(ctx context.Context, withPublicId string, _ ...O
 	return rowsDeleted, nil
 }
 
-// ListRoles lists roles in the given scopes and supports WithLimit option.
+// ListRoles lists roles in the given scopes and supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListRoles(ctx context.Context, withScopeIds []string, opt ...Option) ([]*Role, error) {
 	const op = "iam.(Repository).ListRoles"
 	if len(withScopeIds) == 0 {
@@ -385,6 +385,10 @@ func (r *Repository) ListRoleGrants(ctx context.Context, roleId string, opt ...O
 	return roleGrants, nil
 }
 
+// GrantsForUser returns the grants of the roles the user is assigned to,
+// directly or through groups and managed groups. Principals are only assigned
+// to a role within the validity window of the assignment, which is checked
+// against the current time of the database.
 func (r *Repository) GrantsForUser(ctx context.Context, userId string, _ ...Option) ([]perms.GrantTuple, error) {
 	const op = "iam.(Repository).GrantsForUser"
 	if userId == "" {
@@ -424,18 +428,24 @@ managed_group_roles (role_id) as (
     from iam_managed_group_role,
          user_managed_groups
    where principal_id in (user_managed_groups.id)
+     and (valid_from is null or valid_from <= current_timestamp)
+     and (valid_until is null or valid_until > current_timestamp)
 ),
 group_roles (role_id) as (
   select role_id
     from iam_group_role,
          user_groups
    where principal_id in (user_groups.id)
+     and (valid_from is null or valid_from <= current_timestamp)
+     and (valid_until is null or valid_until > current_timestamp)
 ),
 user_roles (role_id) as (
   select role_id
     from iam_user_role,
          users
    where principal_id in (users.id)
+     and (valid_from is null or valid_from <= current_timestamp)
+     and (valid_until is null or valid_until > current_timestamp)
 ),
 user_group_roles (role_id) as (
   select role_id
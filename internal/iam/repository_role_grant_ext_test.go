@@ -5,6 +5,7 @@ import (
 	"fmt"
 	mathrand "math/rand"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/db"
@@ -250,3 +251,64 @@ func TestGrantsForUser(t *testing.T) {
 		t.Log("finished user", user.PublicId, "total roles", len(expectedRoleIds), "roles from users", rolesFromUsers, "roles from groups", rolesFromGroups, "roles from managed groups", rolesFromManagedGroups)
 	}
 }
+
+func TestGrantsForUser_ValidityWindow(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	o, _ := iam.TestScopes(
+		t,
+		iamRepo,
+		iam.WithSkipAdminRoleCreation(true),
+		iam.WithSkipDefaultRoleCreation(true),
+	)
+	user := iam.TestUser(t, iamRepo, o.GetPublicId())
+	group := iam.TestGroup(t, conn, o.GetPublicId())
+	iam.TestGroupMember(t, conn, group.PublicId, user.PublicId)
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		opt       []iam.Option
+		wantGrant bool
+	}{
+		{
+			name:      "no-window",
+			wantGrant: true,
+		},
+		{
+			name:      "current-window",
+			opt:       []iam.Option{iam.WithValidFrom(now.Add(-time.Hour)), iam.WithValidUntil(now.Add(time.Hour))},
+			wantGrant: true,
+		},
+		{
+			name: "not-yet-valid",
+			opt:  []iam.Option{iam.WithValidFrom(now.Add(time.Hour))},
+		},
+		{
+			name: "expired",
+			opt:  []iam.Option{iam.WithValidUntil(now.Add(-time.Hour))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRole := iam.TestRole(t, conn, o.GetPublicId())
+			iam.TestRoleGrant(t, conn, userRole.PublicId, "id=*;type=*;actions=*")
+			iam.TestUserRole(t, conn, userRole.PublicId, user.PublicId, tt.opt...)
+			groupRole := iam.TestRole(t, conn, o.GetPublicId())
+			iam.TestRoleGrant(t, conn, groupRole.PublicId, "id=*;type=*;actions=*")
+			iam.TestGroupRole(t, conn, groupRole.PublicId, group.PublicId, tt.opt...)
+
+			tuples, err := iamRepo.GrantsForUser(ctx, user.PublicId)
+			require.NoError(t, err)
+			roleIds := make(map[string]bool, len(tuples))
+			for _, tuple := range tuples {
+				roleIds[tuple.RoleId] = true
+			}
+			assert.Equal(t, tt.wantGrant, roleIds[userRole.PublicId])
+			assert.Equal(t, tt.wantGrant, roleIds[groupRole.PublicId])
+		})
+	}
+}
@@ -375,7 +375,8 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 // UpdateScope will update a scope in the repository and return the written
 // scope.  fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
-// included in fieldMask. Name and Description are the only updatable fields,
+// included in fieldMask. Name, Description, PrimaryAuthMethodId,
+// AuthTokenTimeToLive and AuthTokenTimeToStale are the only updatable fields,
 // and everything else is ignored.  If no updatable fields are included in the
 // fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint32, fieldMaskPaths []string, _ ...Option) (*Scope, int, error) {
@@ -392,9 +393,11 @@ func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"name":                scope.Name,
-			"description":         scope.Description,
-			"PrimaryAuthMethodId": scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"name":                 scope.Name,
+			"description":          scope.Description,
+			"PrimaryAuthMethodId":  scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"AuthTokenTimeToLive":  scope.AuthTokenTimeToLive,
+			"AuthTokenTimeToStale": scope.AuthTokenTimeToStale,
 		},
 		fieldMaskPaths,
 		nil,
@@ -452,7 +455,8 @@ func (r *Repository) DeleteScope(ctx context.Context, withPublicId string, _ ...
 	return rowsDeleted, nil
 }
 
-// ListScopes with the parent IDs, supports the WithLimit option.
+// ListScopes with the parent IDs, supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListScopes(ctx context.Context, withParentIds []string, opt ...Option) ([]*Scope, error) {
 	const op = "iam.(Repository).ListScopes"
 	if len(withParentIds) == 0 {
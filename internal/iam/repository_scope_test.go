@@ -179,6 +179,34 @@ func Test_Repository_Scope_Update(t *testing.T) {
 		assert.Equal("test2", s.GetName())
 		assert.Equal("desc-id-2", s.GetDescription())
 	})
+	t.Run("auth-token-settings", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id := testId(t)
+
+		s := testOrg(t, repo, id, "")
+		s.AuthTokenTimeToLive = 3600
+		s.AuthTokenTimeToStale = 600
+		s, updatedRows, err := repo.UpdateScope(context.Background(), s, 1, []string{"AuthTokenTimeToLive", "AuthTokenTimeToStale"})
+		require.NoError(err)
+		assert.Equal(1, updatedRows)
+		require.NotNil(s)
+
+		foundScope, err := repo.LookupScope(context.Background(), s.PublicId)
+		require.NoError(err)
+		assert.EqualValues(3600, foundScope.GetAuthTokenTimeToLive())
+		assert.EqualValues(600, foundScope.GetAuthTokenTimeToStale())
+
+		s.AuthTokenTimeToLive = 0
+		s, updatedRows, err = repo.UpdateScope(context.Background(), s, 2, []string{"AuthTokenTimeToLive"})
+		require.NoError(err)
+		assert.Equal(1, updatedRows)
+		require.NotNil(s)
+
+		foundScope, err = repo.LookupScope(context.Background(), s.PublicId)
+		require.NoError(err)
+		assert.Zero(foundScope.GetAuthTokenTimeToLive())
+		assert.EqualValues(600, foundScope.GetAuthTokenTimeToStale())
+	})
 	t.Run("bad-parent-scope", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		id := testId(t)
@@ -205,7 +205,8 @@ func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, _ ...O
 	return rowsDeleted, nil
 }
 
-// ListUsers lists users in the given scopes and supports the WithLimit option.
+// ListUsers lists users in the given scopes and supports the WithLimit and
+// WithStartPageAfterItem options.
 func (r *Repository) ListUsers(ctx context.Context, withScopeIds []string, opt ...Option) ([]*User, error) {
 	const op = "iam.(Repository).ListUsers"
 	if len(withScopeIds) == 0 {
@@ -875,7 +876,7 @@ func (r *Repository) getUsers(ctx context.Context, userId string, scopeIds []str
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbArgs = append(dbArgs, db.WithLimit(limit))
+	dbArgs = append(dbArgs, db.WithLimit(limit), db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 
 	var args []interface{}
 	var where []string
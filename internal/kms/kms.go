@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -43,13 +45,21 @@ func (e *ExternalWrappers) Recovery() wrapping.Wrapper {
 	return e.recovery
 }
 
-// Kms is a way to access wrappers for a given scope and purpose. Since keys can
-// never change, only be added or (eventually) removed, it opportunistically
-// caches, going to the database as needed.
+// dekVersionCheckInterval is how long a cached wrapper is used for encryption
+// before checking that its encrypting key is still the current version.
+const dekVersionCheckInterval = 10 * time.Second
+
+// Kms is a way to access wrappers for a given scope and purpose. Keys are only
+// changed by rotation, which adds new versions, and destruction of versions
+// that are no longer used, so it opportunistically caches, going to the
+// database as needed. Rotation through this controller clears the cache; since
+// keys can also be rotated through another controller, the encrypting key of a
+// cached wrapper is checked against the current version at most once every
+// dekVersionCheckInterval.
 type Kms struct {
 
-	// scopePurposeCache holds a per-scope-purpose multiwrapper containing the
-	// current encrypting key and all previous key versions, for decryption
+	// scopePurposeCache holds a per-scope-purpose *cachedWrapper containing
+	// the current encrypting key and all previous key versions, for decryption
 	scopePurposeCache sync.Map
 
 	externalScopeCache      map[string]*ExternalWrappers
@@ -60,6 +70,14 @@ type Kms struct {
 	repo *Repository
 }
 
+// cachedWrapper is a multiwrapper held in the scopePurposeCache along with the
+// time, in unix nanoseconds, its encrypting key was last known to be the
+// current version.
+type cachedWrapper struct {
+	wrapper *multiwrapper.MultiWrapper
+	checked int64
+}
+
 // NewKms takes in a repo and returns a Kms.
 func NewKms(repo *Repository, opt ...Option) (*Kms, error) {
 	const op = "kms.NewKms"
@@ -161,15 +179,34 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 
 	opts := getOpts(opt...)
 	// Fast-path: we have a valid key at the scope/purpose. Verify the key with
-	// that ID is in the multiwrapper; if not, fall through to reload from the
-	// DB.
+	// that ID is in the multiwrapper or, when no ID is given, that the
+	// multiwrapper encrypts with the current key version; if not, fall through
+	// to reload from the DB. The current version is looked up when the wrapper
+	// was not checked within dekVersionCheckInterval, or always when a
+	// repository is given, so that it is compared within its transaction.
 	val, ok := k.scopePurposeCache.Load(scopeId + purpose.String())
 	if ok {
-		wrapper := val.(*multiwrapper.MultiWrapper)
+		cached := val.(*cachedWrapper)
+		wrapper := cached.wrapper
 		if opts.withKeyId == "" {
-			return wrapper, nil
-		}
-		if keyIdWrapper := wrapper.WrapperForKeyID(opts.withKeyId); keyIdWrapper != nil {
+			checked := time.Unix(0, atomic.LoadInt64(&cached.checked))
+			if opts.withRepository == nil && time.Since(checked) < dekVersionCheckInterval {
+				return wrapper, nil
+			}
+			repo := opts.withRepository
+			if repo == nil {
+				repo = k.repo
+			}
+			now := time.Now()
+			currentId, err := repo.currentDekVersionId(ctx, scopeId, purpose)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if wrapper.KeyID() == currentId {
+				atomic.StoreInt64(&cached.checked, now.UnixNano())
+				return wrapper, nil
+			}
+		} else if keyIdWrapper := wrapper.WrapperForKeyID(opts.withKeyId); keyIdWrapper != nil {
 			return keyIdWrapper, nil
 		}
 		// Fall through to refresh our multiwrapper for this scope/purpose from the DB
@@ -179,6 +216,7 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	// root for the scope as we'll need it to decrypt the value coming from the
 	// DB. We don't cache the roots as we expect that after a few calls the
 	// scope-purpose cache will catch everything in steady-state.
+	loaded := time.Now()
 	rootWrapper, rootKeyId, err := k.loadRoot(ctx, scopeId, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error loading root key for scope %s", scopeId)))
@@ -191,7 +229,7 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error loading %s for scope %s", purpose.String(), scopeId)))
 	}
-	k.scopePurposeCache.Store(scopeId+purpose.String(), wrapper)
+	k.scopePurposeCache.Store(scopeId+purpose.String(), &cachedWrapper{wrapper: wrapper, checked: loaded.UnixNano()})
 
 	if opts.withKeyId != "" {
 		if keyIdWrapper := wrapper.WrapperForKeyID(opts.withKeyId); keyIdWrapper != nil {
@@ -203,6 +241,49 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	return wrapper, nil
 }
 
+// RotateKeys creates a new version of the root key and of each DEK of the
+// scope; see Repository.RotateKeys. Supports the WithRandomReader option.
+func (k *Kms) RotateKeys(ctx context.Context, scopeId string, opt ...Option) error {
+	const op = "kms.(Kms).RotateKeys"
+	rootWrapper := k.GetExternalWrappers().Root()
+	if rootWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing root wrapper")
+	}
+	if err := k.repo.RotateKeys(ctx, rootWrapper, scopeId, opt...); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	k.clearScopeCache(scopeId)
+	return nil
+}
+
+// ListKeys returns the keys of the scope and their versions.
+func (k *Kms) ListKeys(ctx context.Context, scopeId string) ([]*Key, error) {
+	const op = "kms.(Kms).ListKeys"
+	keys, err := k.repo.ListKeys(ctx, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return keys, nil
+}
+
+// DestroyKeyVersion destroys a key version of the scope which is no longer
+// used; see Repository.DestroyKeyVersion.
+func (k *Kms) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string) error {
+	const op = "kms.(Kms).DestroyKeyVersion"
+	if err := k.repo.DestroyKeyVersion(ctx, scopeId, keyVersionId); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	k.clearScopeCache(scopeId)
+	return nil
+}
+
+// clearScopeCache removes the cached wrappers of the scope.
+func (k *Kms) clearScopeCache(scopeId string) {
+	for _, purpose := range dekTables {
+		k.scopePurposeCache.Delete(scopeId + purpose.purpose.String())
+	}
+}
+
 func (k *Kms) loadRoot(ctx context.Context, scopeId string, opt ...Option) (*multiwrapper.MultiWrapper, string, error) {
 	const op = "kms.loadRoot"
 	opts := getOpts(opt...)
@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"crypto/rand"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/db"
@@ -35,4 +36,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withOrderByVersion = db.DescendingOrderBy
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRandomReader", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRandomReader(rand.Reader))
+		testOpts := getDefaultOptions()
+		testOpts.withRandomReader = rand.Reader
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -1,6 +1,8 @@
 package kms
 
 import (
+	"io"
+
 	"github.com/hashicorp/boundary/internal/db"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 )
@@ -26,6 +28,7 @@ type options struct {
 	withRepository        *Repository
 	withOrderByVersion    db.OrderBy
 	withKeyId             string
+	withRandomReader      io.Reader
 }
 
 func getDefaultOptions() options {
@@ -87,3 +90,10 @@ func WithKeyId(keyId string) Option {
 		o.withKeyId = keyId
 	}
 }
+
+// WithRandomReader sets the source of randomness used to generate new keys
+func WithRandomReader(r io.Reader) Option {
+	return func(o *options) {
+		o.withRandomReader = r
+	}
+}
@@ -0,0 +1,606 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/aead"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
+)
+
+// KekType and DekType are the types of the keys returned by ListKeys: the root
+// key of a scope encrypts keys, the other keys encrypt data.
+const (
+	KekType = "kek"
+	DekType = "dek"
+)
+
+// RootKeyPurpose is the purpose reported by ListKeys for the root key of a
+// scope.
+const RootKeyPurpose = "root"
+
+// Key is a key of a scope as returned by ListKeys. It does not contain any
+// key material.
+type Key struct {
+	Id         string
+	Scope      string
+	Purpose    string
+	Type       string
+	CreateTime time.Time
+	// Versions is ordered by version, descending; the first version is the
+	// one currently used to encrypt.
+	Versions []*KeyVersion
+}
+
+// KeyVersion is a version of a Key.
+type KeyVersion struct {
+	Id         string
+	Version    uint32
+	CreateTime time.Time
+}
+
+// dekTable holds the names of the tables storing the DEKs of a purpose and
+// their versions.
+type dekTable struct {
+	purpose      KeyPurpose
+	keyTable     string
+	versionTable string
+	keyIdColumn  string
+}
+
+// dekTables lists the DEKs of a scope in the order ListKeys returns them.
+var dekTables = []dekTable{
+	{KeyPurposeDatabase, DefaultDatabaseKeyTableName, DefaultDatabaseKeyVersionTableName, "database_key_id"},
+	{KeyPurposeOplog, DefaultOplogKeyTableName, DefaultOplogKeyVersionTableName, "oplog_key_id"},
+	{KeyPurposeTokens, DefaultTokenKeyTableName, DefaultTokenKeyVersionTableName, "token_key_id"},
+	{KeyPurposeSessions, DefaultSessionKeyTableName, DefaultSessionKeyVersionTableName, "session_key_id"},
+	{KeyPurposeOidc, DefaultOidcKeyTableName, DefaultOidcKeyVersionTableName, "oidc_key_id"},
+	{KeyPurposeAudit, DefaultAuditKeyTableName, DefaultAuditKeyVersionTableName, "audit_key_id"},
+}
+
+// dekVersionReferences holds, per purpose, a query returning the number of
+// items still encrypted with the DEK version @key_version_id. Audit key
+// versions are never destroyed, see DestroyKeyVersion.
+var dekVersionReferences = map[KeyPurpose]string{
+	KeyPurposeDatabase: `
+select
+  (select count(*) from credential_vault_token where key_id = @key_version_id) +
+  (select count(*) from credential_vault_client_certificate where key_id = @key_version_id) +
+  (select count(*) from credential_static_username_password_credential where key_id = @key_version_id) +
+  (select count(*) from credential_static_ssh_private_key_credential where key_id = @key_version_id) +
+  (select count(*) from credential_static_json_credential where key_id = @key_version_id) +
+  (select count(*) from session_credential where key_id = @key_version_id) +
+  (select count(*) from auth_oidc_method where key_id = @key_version_id) +
+  (select count(*) from auth_ldap_method where key_id = @key_version_id) +
+  (select count(*) from auth_password_argon2_cred where key_id = @key_version_id) +
+  (select count(*) from auth_password_argon2_cred_history where key_id = @key_version_id) +
+  (select count(*) from auth_password_totp_secret where key_id = @key_version_id) +
+  (select count(*) from auth_password_totp_pending_secret where key_id = @key_version_id) +
+  (select count(*) from host_plugin_catalog_secret where key_id = @key_version_id);
+`,
+	KeyPurposeTokens: `
+select count(*)
+  from auth_token
+ where key_id = @key_version_id;
+`,
+	KeyPurposeSessions: `
+select count(*)
+  from session
+ where key_id = @key_version_id;
+`,
+	// The oidc key encrypts the state of authentication attempts, which isn't
+	// stored: a version is in use until the attempts started before the next
+	// version have expired, after oidc.AttemptExpiration. The oidc client
+	// secrets are encrypted with the database key.
+	KeyPurposeOidc: `
+select count(*)
+ where @next_version_create_time > now() - interval '5 minutes';
+`,
+	// Oplog entries written before their key version was recorded count if
+	// they were created before the next version of the key, until the key
+	// rewrap job has recorded their key version.
+	KeyPurposeOplog: `
+select count(*)
+  from oplog_entry
+ where key_id = @key_version_id
+    or (key_id is null and create_time < @next_version_create_time);
+`,
+}
+
+// currentDekVersionQuery returns the id of the current version of the DEK of
+// the scope stored in a key table and version table.
+const currentDekVersionQuery = `
+select kv.private_id
+  from kms_root_key rk
+  join %[1]s k
+    on k.root_key_id = rk.private_id
+  join %[2]s kv
+    on kv.%[3]s = k.private_id
+ where rk.scope_id = ?
+ order by kv.version desc
+ limit 1;
+`
+
+// currentDekVersionId returns the id of the current version of the DEK of the
+// scope for the purpose.
+func (r *Repository) currentDekVersionId(ctx context.Context, scopeId string, purpose KeyPurpose) (string, error) {
+	const op = "kms.(Repository).currentDekVersionId"
+	for _, t := range dekTables {
+		if t.purpose != purpose {
+			continue
+		}
+		rows, err := r.reader.Query(ctx, fmt.Sprintf(currentDekVersionQuery, t.keyTable, t.versionTable, t.keyIdColumn), []interface{}{scopeId})
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		var id string
+		for rows.Next() {
+			if err := rows.Scan(&id); err != nil {
+				return "", errors.Wrap(ctx, err, op)
+			}
+		}
+		if id == "" {
+			return "", errors.New(ctx, errors.KeyNotFound, op, fmt.Sprintf("no %s key versions found for scope %s", purpose, scopeId))
+		}
+		return id, nil
+	}
+	return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported purpose %q", purpose))
+}
+
+// dekVersion is implemented by all the DEK version types.
+type dekVersion interface {
+	DekVersion
+	GetCtKey() []byte
+	Encrypt(context.Context, wrapping.Wrapper) error
+	TableName() string
+}
+
+// newDekVersion returns a new, unencrypted version of the DEK with id keyId.
+func newDekVersion(ctx context.Context, purpose KeyPurpose, keyId, rootKeyVersionId string, key []byte) (dekVersion, error) {
+	const op = "kms.newDekVersion"
+	var id string
+	var err error
+	var kv dekVersion
+	switch purpose {
+	case KeyPurposeDatabase:
+		k := AllocDatabaseKeyVersion()
+		id, err = newDatabaseKeyVersionId()
+		k.PrivateId, k.DatabaseKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	case KeyPurposeOplog:
+		k := AllocOplogKeyVersion()
+		id, err = newOplogKeyVersionId()
+		k.PrivateId, k.OplogKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	case KeyPurposeTokens:
+		k := AllocTokenKeyVersion()
+		id, err = newTokenKeyVersionId()
+		k.PrivateId, k.TokenKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	case KeyPurposeSessions:
+		k := AllocSessionKeyVersion()
+		id, err = newSessionKeyVersionId()
+		k.PrivateId, k.SessionKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	case KeyPurposeOidc:
+		k := AllocOidcKeyVersion()
+		id, err = newOidcKeyVersionId()
+		k.PrivateId, k.OidcKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	case KeyPurposeAudit:
+		k := AllocAuditKeyVersion()
+		id, err = newAuditKeyVersionId(ctx)
+		k.PrivateId, k.AuditKeyId, k.RootKeyVersionId, k.Key = id, keyId, rootKeyVersionId, key
+		kv = &k
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported purpose %q", purpose))
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return kv, nil
+}
+
+// listDekVersions returns all the versions of the DEK with id keyId, decrypted
+// with rkvWrapper.
+func (r *Repository) listDekVersions(ctx context.Context, purpose KeyPurpose, rkvWrapper wrapping.Wrapper, keyId string) ([]DekVersion, error) {
+	const op = "kms.(Repository).listDekVersions"
+	opts := []Option{WithLimit(-1), WithOrderByVersion(db.DescendingOrderBy)}
+	switch purpose {
+	case KeyPurposeDatabase:
+		return r.ListDatabaseKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	case KeyPurposeOplog:
+		return r.ListOplogKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	case KeyPurposeTokens:
+		return r.ListTokenKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	case KeyPurposeSessions:
+		return r.ListSessionKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	case KeyPurposeOidc:
+		return r.ListOidcKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	case KeyPurposeAudit:
+		return r.ListAuditKeyVersions(ctx, rkvWrapper, keyId, opts...)
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported purpose %q", purpose))
+	}
+}
+
+// rootKeyVersionWrapper returns an aead wrapper for the root key version k.
+func rootKeyVersionWrapper(ctx context.Context, k *RootKeyVersion) (*aead.Wrapper, error) {
+	const op = "kms.rootKeyVersionWrapper"
+	wrapper := aead.NewWrapper(nil)
+	if _, err := wrapper.SetConfig(map[string]string{
+		"key_id": k.GetPrivateId(),
+	}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error setting config on aead root wrapper"))
+	}
+	if err := wrapper.SetAESGCMKeyBytes(k.GetKey()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error setting key bytes on aead root wrapper"))
+	}
+	return wrapper, nil
+}
+
+// lookupScopeRootKey returns the root key of the scope.
+func lookupScopeRootKey(ctx context.Context, reader db.Reader, scopeId string) (*RootKey, error) {
+	const op = "kms.lookupScopeRootKey"
+	var rootKeys []*RootKey
+	if err := reader.SearchWhere(ctx, &rootKeys, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(rootKeys) == 0 {
+		return nil, errors.New(ctx, errors.KeyNotFound, op, fmt.Sprintf("missing root key for scope %s", scopeId))
+	}
+	return rootKeys[0], nil
+}
+
+// scopeDekIds returns the ids of the DEKs of the root key, by purpose.
+func (r *Repository) scopeDekIds(ctx context.Context, rootKeyId string) (map[KeyPurpose]string, error) {
+	const op = "kms.(Repository).scopeDekIds"
+	ids := make(map[KeyPurpose]string, len(dekTables))
+	for _, t := range dekTables {
+		rows, err := r.reader.Query(ctx, fmt.Sprintf("select private_id from %s where root_key_id = ?", t.keyTable), []interface{}{rootKeyId})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			ids[t.purpose] = id
+		}
+		rows.Close()
+	}
+	return ids, nil
+}
+
+// RotateKeys rotates the keys of a scope: a new version of the scope's root key
+// and of each of its DEKs is created, and all existing DEK versions are
+// re-encrypted with the new root key version. Data encrypted with previous DEK
+// versions stays readable; it is re-encrypted with the new DEK versions
+// separately. The rootWrapper is the external wrapper which encrypts root
+// keys. Supports the WithRandomReader option.
+func (r *Repository) RotateKeys(ctx context.Context, rootWrapper wrapping.Wrapper, scopeId string, opt ...Option) error {
+	const op = "kms.(Repository).RotateKeys"
+	if rootWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing root wrapper")
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	randomReader := opts.withRandomReader
+	if randomReader == nil {
+		randomReader = rand.Reader
+	}
+
+	rootKey, err := lookupScopeRootKey(ctx, r.reader, scopeId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	rootKeyVersions, err := r.ListRootKeyVersions(ctx, rootWrapper, rootKey.GetPrivateId(), WithLimit(-1))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var rootMulti *multiwrapper.MultiWrapper
+	for _, rkv := range rootKeyVersions {
+		w, err := rootKeyVersionWrapper(ctx, rkv)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if rootMulti == nil {
+			rootMulti = multiwrapper.NewMultiWrapper(w)
+		} else {
+			rootMulti.AddWrapper(w)
+		}
+	}
+	if rootMulti == nil {
+		return errors.New(ctx, errors.KeyNotFound, op, fmt.Sprintf("no root key versions found for scope %s", scopeId))
+	}
+	dekIds, err := r.scopeDekIds(ctx, rootKey.GetPrivateId())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	versions := make(map[KeyPurpose][]DekVersion, len(dekIds))
+	for purpose, keyId := range dekIds {
+		if versions[purpose], err = r.listDekVersions(ctx, purpose, rootMulti, keyId); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to list %s key versions", purpose)))
+		}
+	}
+
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			k, err := generateKey(ctx, randomReader)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rkv := AllocRootKeyVersion()
+			if rkv.PrivateId, err = newRootKeyVersionId(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rkv.RootKeyId = rootKey.GetPrivateId()
+			rkv.Key = k
+			if err := rkv.Encrypt(ctx, rootWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// no oplog entries for root key version
+			if err := w.Create(ctx, &rkv); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create root key version"))
+			}
+			rkvWrapper, err := rootKeyVersionWrapper(ctx, &rkv)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			for _, t := range dekTables {
+				purpose := t.purpose
+				if _, ok := dekIds[purpose]; !ok {
+					continue
+				}
+				for _, v := range versions[purpose] {
+					kv := v.(dekVersion)
+					if err := kv.Encrypt(ctx, rkvWrapper); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					rowsUpdated, err := w.Exec(ctx,
+						fmt.Sprintf("update %s set key = ?, root_key_version_id = ? where private_id = ?", kv.TableName()),
+						[]interface{}{kv.GetCtKey(), rkv.PrivateId, kv.GetPrivateId()})
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rewrap %s key version %s", purpose, kv.GetPrivateId())))
+					}
+					if rowsUpdated != 1 {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d %s key versions would have been updated", rowsUpdated, purpose))
+					}
+				}
+
+				k, err := generateKey(ctx, randomReader)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				kv, err := newDekVersion(ctx, purpose, dekIds[purpose], rkv.PrivateId, k)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := kv.Encrypt(ctx, rkvWrapper); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				// no oplog entries for key versions
+				if err := w.Create(ctx, kv); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to create %s key version", purpose)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for scope %s", scopeId)))
+	}
+	return nil
+}
+
+// listVersions returns the versions stored in table whose keyIdColumn is
+// keyId, ordered by version, descending.
+func listVersions(ctx context.Context, reader db.Reader, table, keyIdColumn, keyId string) ([]*KeyVersion, error) {
+	const op = "kms.listVersions"
+	rows, err := reader.Query(ctx,
+		fmt.Sprintf("select private_id, version, create_time from %s where %s = ? order by version desc", table, keyIdColumn),
+		[]interface{}{keyId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var versions []*KeyVersion
+	for rows.Next() {
+		var v KeyVersion
+		if err := rows.Scan(&v.Id, &v.Version, &v.CreateTime); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		versions = append(versions, &v)
+	}
+	return versions, nil
+}
+
+// ListKeys returns the root key and the DEKs of a scope with their versions.
+// No key material is returned.
+func (r *Repository) ListKeys(ctx context.Context, scopeId string, _ ...Option) ([]*Key, error) {
+	const op = "kms.(Repository).ListKeys"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	keys, err := listKeys(ctx, r.reader, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return keys, nil
+}
+
+// listKeys returns the root key and the DEKs of a scope with their versions,
+// read with reader.
+func listKeys(ctx context.Context, reader db.Reader, scopeId string) ([]*Key, error) {
+	const op = "kms.listKeys"
+	rootKey, err := lookupScopeRootKey(ctx, reader, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rootVersions, err := listVersions(ctx, reader, DefaultRootKeyVersionTableName, "root_key_id", rootKey.GetPrivateId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	keys := []*Key{{
+		Id:         rootKey.GetPrivateId(),
+		Scope:      scopeId,
+		Purpose:    RootKeyPurpose,
+		Type:       KekType,
+		CreateTime: rootKey.GetCreateTime().AsTime(),
+		Versions:   rootVersions,
+	}}
+
+	for _, t := range dekTables {
+		rows, err := reader.Query(ctx, fmt.Sprintf("select private_id, create_time from %s where root_key_id = ?", t.keyTable), []interface{}{rootKey.GetPrivateId()})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		var deks []*Key
+		for rows.Next() {
+			k := &Key{
+				Scope:   scopeId,
+				Purpose: t.purpose.String(),
+				Type:    DekType,
+			}
+			if err := rows.Scan(&k.Id, &k.CreateTime); err != nil {
+				rows.Close()
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			deks = append(deks, k)
+		}
+		rows.Close()
+		for _, k := range deks {
+			if k.Versions, err = listVersions(ctx, reader, t.versionTable, t.keyIdColumn, k.Id); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// DestroyKeyVersion deletes the key version with id keyVersionId of a key of
+// the scope. The version currently used to encrypt can't be destroyed, nor can
+// a version which still encrypts data: a root key version still encrypting DEK
+// versions, or a DEK version with data encrypted by it. Audit key versions are
+// never destroyed, they are needed to verify the audit events written with
+// them. In these cases an error with the KeyInUse code is returned.
+//
+// The references are checked in the transaction deleting the version, after
+// locking its row.
+func (r *Repository) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, _ ...Option) error {
+	const op = "kms.(Repository).DestroyKeyVersion"
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if keyVersionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing key version id")
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			keys, err := listKeys(ctx, reader, scopeId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			var key *Key
+			var idx int
+			for _, k := range keys {
+				for i, v := range k.Versions {
+					if v.Id == keyVersionId {
+						key, idx = k, i
+					}
+				}
+			}
+			if key == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("key version %s not found in scope %s", keyVersionId, scopeId))
+			}
+			if idx == 0 {
+				return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("key version %s is the current version of the %s key", keyVersionId, key.Purpose))
+			}
+			if key.Purpose == KeyPurposeAudit.String() {
+				return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("key version %s of the %s key is needed to verify audit events", keyVersionId, key.Purpose))
+			}
+
+			table := DefaultRootKeyVersionTableName
+			purpose := KeyPurposeUnknown
+			for _, t := range dekTables {
+				if t.purpose.String() == key.Purpose {
+					table, purpose = t.versionTable, t.purpose
+				}
+			}
+			// Lock the version so no key or data can be encrypted with it
+			// between the checks below and its deletion.
+			rows, err := reader.Query(ctx, fmt.Sprintf("select private_id from %s where private_id = ? for update", table), []interface{}{keyVersionId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rows.Close()
+
+			if key.Purpose == RootKeyPurpose {
+				for _, t := range dekTables {
+					if err := checkNoReferences(ctx, reader, fmt.Sprintf("select count(*) from %s where root_key_version_id = @key_version_id", t.versionTable), keyVersionId); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+			} else if q, ok := dekVersionReferences[purpose]; ok {
+				// The next version is the one before in the descending list.
+				if err := checkNoReferences(ctx, reader, q, keyVersionId, sql.Named("next_version_create_time", key.Versions[idx-1].CreateTime)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
+			// no oplog entries for key versions
+			rowsDeleted, err := w.Exec(ctx, fmt.Sprintf("delete from %s where private_id = ?", table), []interface{}{keyVersionId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", keyVersionId)))
+	}
+	return nil
+}
+
+// checkNoReferences returns a KeyInUse error if query, which must return a
+// single count, returns a count greater than zero for the key version.
+func checkNoReferences(ctx context.Context, reader db.Reader, query, keyVersionId string, args ...interface{}) error {
+	const op = "kms.checkNoReferences"
+	rows, err := reader.Query(ctx, query, append([]interface{}{sql.Named("key_version_id", keyVersionId)}, args...))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if count > 0 {
+		return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("key version %s still encrypts %d items", keyVersionId, count))
+	}
+	return nil
+}
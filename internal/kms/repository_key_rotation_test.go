@@ -0,0 +1,182 @@
+package kms_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKms_RotateKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Error(kmsCache.RotateKeys(ctx, ""))
+		assert.True(errors.Match(errors.T(errors.KeyNotFound), kmsCache.RotateKeys(ctx, "o_doesntexist")))
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	keys, err := kmsCache.ListKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	// The root key and the database, oplog, tokens, sessions, oidc and audit keys.
+	require.Len(keys, 7)
+	assert.Equal(kms.RootKeyPurpose, keys[0].Purpose)
+	assert.Equal(kms.KekType, keys[0].Type)
+	for _, k := range keys {
+		assert.Equal(org.GetPublicId(), k.Scope)
+		assert.Len(k.Versions, 1)
+	}
+	oldRootVersion := keys[0].Versions[0].Id
+
+	before, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	blob, err := before.Encrypt(ctx, []byte("secret"), nil)
+	require.NoError(err)
+
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+
+	keys, err = kmsCache.ListKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	require.Len(keys, 7)
+	for _, k := range keys {
+		require.Len(k.Versions, 2)
+		assert.Equal(uint32(2), k.Versions[0].Version)
+		assert.Equal(uint32(1), k.Versions[1].Version)
+	}
+
+	// New data is encrypted with the new version, data encrypted before the
+	// rotation can still be decrypted.
+	after, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.NotEqual(before.KeyID(), after.KeyID())
+	old, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase, kms.WithKeyId(blob.KeyInfo.KeyID))
+	require.NoError(err)
+	pt, err := old.Decrypt(ctx, blob, nil)
+	require.NoError(err)
+	assert.Equal([]byte("secret"), pt)
+
+	// Current versions can't be destroyed.
+	err = kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), keys[0].Versions[0].Id)
+	assert.True(errors.Match(errors.T(errors.KeyInUse), err))
+
+	// The previous root key version no longer encrypts any key.
+	require.NoError(kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), oldRootVersion))
+	err = kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), oldRootVersion)
+	assert.True(errors.Match(errors.T(errors.RecordNotFound), err))
+
+	// The previous oidc key version may still encrypt the state of recent
+	// authentication attempts, audit key versions are never destroyed.
+	for _, k := range keys {
+		switch k.Purpose {
+		case kms.KeyPurposeOidc.String(), kms.KeyPurposeAudit.String():
+			err = kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), k.Versions[1].Id)
+			assert.True(errors.Match(errors.T(errors.KeyInUse), err))
+		}
+	}
+	keys, err = kmsCache.ListKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	for _, k := range keys {
+		switch k.Purpose {
+		case kms.RootKeyPurpose:
+			assert.Len(k.Versions, 1)
+		default:
+			assert.Len(k.Versions, 2)
+		}
+	}
+}
+
+func TestKms_GetWrapperAfterRotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	// other is the kms of another controller, which has cached the wrapper
+	// before the rotation.
+	other := kms.TestKms(t, conn, wrapper)
+	before, err := other.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+	current, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.NotEqual(before.KeyID(), current.KeyID())
+
+	// The other controller keeps encrypting with its cached wrapper until it
+	// checks the current version again, which it always does when given a
+	// repository.
+	cached, err := other.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.Equal(before.KeyID(), cached.KeyID())
+
+	rw := db.New(conn)
+	repo, err := kms.NewRepository(rw, rw)
+	require.NoError(err)
+	after, err := other.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase, kms.WithRepository(repo))
+	require.NoError(err)
+	assert.Equal(current.KeyID(), after.KeyID())
+}
+
+func TestKms_RewrapOplog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	assert, require := assert.New(t), require.New(t)
+
+	// creating a user writes an oplog entry encrypted with the oplog key of
+	// the org
+	iam.TestUser(t, iamRepo, org.GetPublicId())
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+
+	keys, err := kmsCache.ListKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	var oplogKey *kms.Key
+	for _, k := range keys {
+		if k.Purpose == kms.KeyPurposeOplog.String() {
+			oplogKey = k
+		}
+	}
+	require.NotNil(oplogKey)
+	require.Len(oplogKey.Versions, 2)
+	current, previous := oplogKey.Versions[0].Id, oplogKey.Versions[1].Id
+
+	err = kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), previous)
+	assert.True(errors.Match(errors.T(errors.KeyInUse), err))
+
+	// entries written before their key version was recorded still count
+	_, err = rw.Exec(ctx, "update oplog_entry set key_id = null where key_id = ?", []interface{}{previous})
+	require.NoError(err)
+	err = kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), previous)
+	assert.True(errors.Match(errors.T(errors.KeyInUse), err))
+
+	require.NoError(kmsCache.RewrapData(ctx))
+	require.NoError(kmsCache.DestroyKeyVersion(ctx, org.GetPublicId(), previous))
+
+	rows, err := rw.Query(ctx, "select count(*) from oplog_entry where key_id = ?", []interface{}{current})
+	require.NoError(err)
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		require.NoError(rows.Scan(&count))
+	}
+	assert.Greater(count, 0)
+}
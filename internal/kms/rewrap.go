@@ -0,0 +1,285 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	oplogstore "github.com/hashicorp/boundary/internal/oplog/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// RewrapFn re-encrypts, with the current database key of the scope, the rows
+// of a table that are encrypted with the previous database key version
+// dataKeyVersionId.
+type RewrapFn func(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *Kms) error
+
+var (
+	tableRewrapFns   = map[string]RewrapFn{}
+	tableRewrapFnsMu sync.RWMutex
+)
+
+// RegisterTableRewrapFn registers the RewrapFn for a table holding data
+// encrypted with the database key. It is meant to be called from the init
+// function of the package owning the table.
+func RegisterTableRewrapFn(tableName string, fn RewrapFn) {
+	tableRewrapFnsMu.Lock()
+	defer tableRewrapFnsMu.Unlock()
+	if _, ok := tableRewrapFns[tableName]; ok {
+		panic(fmt.Sprintf("rewrap function for table %s already registered", tableName))
+	}
+	tableRewrapFns[tableName] = fn
+}
+
+// ListTablesSupportingRewrap returns the sorted names of the tables with a
+// registered RewrapFn.
+func ListTablesSupportingRewrap() []string {
+	tableRewrapFnsMu.RLock()
+	defer tableRewrapFnsMu.RUnlock()
+	tables := make([]string, 0, len(tableRewrapFns))
+	for t := range tableRewrapFns {
+		tables = append(tables, t)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// previousKeyVersionsQuery returns the scope and the id of the versions of the
+// DEKs stored in a key table and version table which are no longer current.
+const previousKeyVersionsQuery = `
+select rk.scope_id, kv.private_id
+  from kms_root_key rk
+  join %[1]s k
+    on k.root_key_id = rk.private_id
+  join %[2]s kv
+    on kv.%[3]s = k.private_id
+ where kv.version < (select max(v.version)
+                       from %[2]s v
+                      where v.%[3]s = k.private_id)
+ order by rk.scope_id, kv.version;
+`
+
+// oplogRewrapBatchSize is the number of oplog entries updated per transaction
+// by RewrapData.
+const oplogRewrapBatchSize = 1000
+
+const (
+	listOplogEntriesWithoutKeyIdQuery = `
+select id, data
+  from oplog_entry
+ where key_id is null
+   and id > ?
+ order by id
+ limit ?;
+`
+	setOplogEntryKeyIdQuery = `
+update oplog_entry
+   set key_id = ?
+ where id = ?;
+`
+	listOplogEntriesByKeyIdQuery = `
+select id, data
+  from oplog_entry
+ where key_id = ?
+ order by id
+ limit ?;
+`
+	rewrapOplogEntryQuery = `
+update oplog_entry
+   set data   = ?,
+       key_id = ?
+ where id = ?;
+`
+)
+
+// scopeKeyVersion is a DEK version of a scope.
+type scopeKeyVersion struct {
+	scopeId, keyVersionId string
+}
+
+// previousKeyVersions returns the versions of the DEKs of a purpose which are
+// no longer current.
+func (k *Kms) previousKeyVersions(ctx context.Context, purpose KeyPurpose) ([]scopeKeyVersion, error) {
+	const op = "kms.(Kms).previousKeyVersions"
+	var query string
+	for _, t := range dekTables {
+		if t.purpose == purpose {
+			query = fmt.Sprintf(previousKeyVersionsQuery, t.keyTable, t.versionTable, t.keyIdColumn)
+		}
+	}
+	if query == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported purpose %q", purpose))
+	}
+	rows, err := k.repo.reader.Query(ctx, query, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var versions []scopeKeyVersion
+	for rows.Next() {
+		var v scopeKeyVersion
+		if err := rows.Scan(&v.scopeId, &v.keyVersionId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
+
+// RewrapData re-encrypts the data encrypted with database key versions that
+// are no longer current with the current database key of their scope, using
+// the registered RewrapFns, and the oplog entries encrypted with oplog key
+// versions that are no longer current with the current oplog key of their
+// scope. Once done, the previous versions can be destroyed.
+func (k *Kms) RewrapData(ctx context.Context) error {
+	const op = "kms.(Kms).RewrapData"
+	versions, err := k.previousKeyVersions(ctx, KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	tables := ListTablesSupportingRewrap()
+	tableRewrapFnsMu.RLock()
+	fns := make([]RewrapFn, 0, len(tables))
+	for _, table := range tables {
+		fns = append(fns, tableRewrapFns[table])
+	}
+	tableRewrapFnsMu.RUnlock()
+	for _, v := range versions {
+		for i, table := range tables {
+			if err := fns[i](ctx, v.keyVersionId, v.scopeId, k.repo.reader, k.repo.writer, k); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rewrap %s for key version %s", table, v.keyVersionId)))
+			}
+		}
+	}
+
+	if err := k.setOplogEntryKeyIds(ctx); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if versions, err = k.previousKeyVersions(ctx, KeyPurposeOplog); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, v := range versions {
+		if err := k.rewrapOplogEntries(ctx, v); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rewrap oplog entries for key version %s", v.keyVersionId)))
+		}
+	}
+	return nil
+}
+
+// oplogEntry is the id and encrypted data of an oplog entry.
+type oplogEntry struct {
+	id   uint32
+	data []byte
+}
+
+// listOplogEntries returns the oplog entries returned by query.
+func (k *Kms) listOplogEntries(ctx context.Context, query string, args ...interface{}) ([]oplogEntry, error) {
+	const op = "kms.(Kms).listOplogEntries"
+	rows, err := k.repo.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var entries []oplogEntry
+	for rows.Next() {
+		var e oplogEntry
+		if err := rows.Scan(&e.id, &e.data); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// setOplogEntryKeyIds sets the key id of the oplog entries written before it
+// was recorded, from the key id stored with their encrypted data.
+func (k *Kms) setOplogEntryKeyIds(ctx context.Context) error {
+	const op = "kms.(Kms).setOplogEntryKeyIds"
+	var lastId uint32
+	for {
+		entries, err := k.listOplogEntries(ctx, listOplogEntriesWithoutKeyIdQuery, lastId, oplogRewrapBatchSize)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		_, err = k.repo.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				for _, e := range entries {
+					var blobInfo wrapping.EncryptedBlobInfo
+					if err := proto.Unmarshal(e.data, &blobInfo); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg(fmt.Sprintf("unable to decode oplog entry %d", e.id)))
+					}
+					keyId := blobInfo.GetKeyInfo().GetKeyID()
+					if keyId == "" {
+						continue
+					}
+					if _, err := w.Exec(ctx, setOplogEntryKeyIdQuery, []interface{}{keyId, e.id}); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update oplog entry %d", e.id)))
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		lastId = entries[len(entries)-1].id
+	}
+}
+
+// rewrapOplogEntries re-encrypts the oplog entries encrypted with the oplog
+// key version v with the current oplog key of its scope.
+func (k *Kms) rewrapOplogEntries(ctx context.Context, v scopeKeyVersion) error {
+	const op = "kms.(Kms).rewrapOplogEntries"
+	var old, current wrapping.Wrapper
+	for {
+		entries, err := k.listOplogEntries(ctx, listOplogEntriesByKeyIdQuery, v.keyVersionId, oplogRewrapBatchSize)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		if old == nil {
+			if old, err = k.GetWrapper(ctx, v.scopeId, KeyPurposeOplog, WithKeyId(v.keyVersionId)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous oplog wrapper"))
+			}
+			if current, err = k.GetWrapper(ctx, v.scopeId, KeyPurposeOplog); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current oplog wrapper"))
+			}
+		}
+		_, err = k.repo.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				for _, e := range entries {
+					entry := &oplog.Entry{Entry: &oplogstore.Entry{CtData: e.data}, Cipherer: old}
+					if err := entry.DecryptData(ctx); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to decrypt oplog entry %d", e.id)))
+					}
+					entry.Cipherer = current
+					if err := entry.EncryptData(ctx); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to encrypt oplog entry %d", e.id)))
+					}
+					rowsUpdated, err := w.Exec(ctx, rewrapOplogEntryQuery, []interface{}{entry.CtData, entry.KeyId, e.id})
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update oplog entry %d", e.id)))
+					}
+					if rowsUpdated != 1 {
+						return errors.New(ctx, errors.MultipleRecords, op, "more than 1 oplog entry would have been updated")
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+}
@@ -12,6 +12,11 @@ type AuditConfig struct {
 	// FilterOperations to be applied to DataClassifications.
 	FilterOverrides AuditFilterOperations `hcl:"audit_filter_overrides"`
 
+	// HashChain enables a tamper-evident hash chain of the audit events written
+	// to the sink. Each event is written as a HashChainRecord with a sequence
+	// number and the hmac of the previous event. Requires a json sink format.
+	HashChain bool `hcl:"hash_chain"`
+
 	// wrapper to use for audit event crypto operations.
 	wrapper wrapping.Wrapper
 }
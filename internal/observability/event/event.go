@@ -41,6 +41,7 @@ type Auth struct {
 	GrantsInfo           *GrantsInfo `json:"grants_info,omitempty"`
 	UserEmail            string      `json:"email,omitempty" class:"sensitive"`
 	UserName             string      `json:"name,omitempty" class:"sensitive"`
+	DenialReasons        []string    `json:"denial_reasons,omitempty" class:"public"`
 }
 
 type Request struct {
@@ -253,9 +253,42 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case SyslogSink:
+			sinkNode, err = newSyslogSink(s.Format, s.SyslogConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			id, err := NewId("syslog")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
+		case WebhookSink:
+			webhookNode, err := newWebhookSink(s.Format, s.WebhookConfig, func(err error) {
+				e.logger.Error("encountered an error delivering events to a webhook sink", "sink", s.Name, "error:", err.Error())
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			// batched events must be sent before Boundary stops
+			e.flushableNodes = append(e.flushableNodes, webhookNode)
+			sinkNode = webhookNode
+			id, err := NewId("webhook")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		default:
 			return nil, fmt.Errorf("%s: unknown sink type %s", op, s.Type)
 		}
+		if s.AuditConfig != nil && s.AuditConfig.HashChain {
+			chainNode, err := newHashChainSink(sinkNode, s.Format, opts.withAuditWrapper)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			e.auditWrapperNodes = append(e.auditWrapperNodes, chainNode)
+			sinkNode = chainNode
+		}
 		err = e.broker.RegisterNode(sinkId, sinkNode)
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register sink node %s: %w", op, sinkId, err)
@@ -280,13 +313,16 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		}
 		if addToAudit {
 			var fop AuditFilterOperations
+			var hashChain bool
 			if s.AuditConfig != nil {
 				fop = s.AuditConfig.FilterOverrides
+				hashChain = s.AuditConfig.HashChain
 			}
 			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop))
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
+			s.AuditConfig.HashChain = hashChain
 			encryptFilter, err := NewAuditEncryptFilter(opt...)
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
@@ -550,6 +586,10 @@ func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wr
 			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
+		case *hashChainSink:
+			if err := w.Rotate(newWrapper); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
 		default:
 			return fmt.Errorf("%s: unsupported node type (%s): %w", op, reflect.TypeOf(w), ErrInvalidParameter)
 		}
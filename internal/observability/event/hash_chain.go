@@ -0,0 +1,386 @@
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// hashChainInfo is used when deriving the hash chain key from the audit
+// wrapper, so it's distinct from the key used to hmac event data.
+var hashChainInfo = []byte("boundary-audit-hash-chain")
+
+// HashChainRecord is written by a sink with an audit hash chain in place of
+// each audit event. Every record carries its sequence number within the chain
+// and the hmac of the previous record, so deleted, reordered or modified
+// records can be detected.
+type HashChainRecord struct {
+	// ChainId identifies the chain, a new chain is started each time the
+	// eventer is created.
+	ChainId string `json:"chain_id"`
+	// Seq is the record's sequence number within the chain, starting at 1.
+	Seq uint64 `json:"seq"`
+	// PrevHmac is the Hmac of the previous record in the chain, which is empty
+	// for the first record.
+	PrevHmac string `json:"prev_hmac"`
+	// Hmac is the hmac-sha256 of the record's chain id, sequence number,
+	// previous hmac and event.
+	Hmac string `json:"hmac"`
+	// KeyId is the id of the audit key used to compute the Hmac.
+	KeyId string `json:"key_id"`
+	// Event is the formatted audit event.
+	Event json.RawMessage `json:"event"`
+}
+
+// hmacData returns the data covered by the record's hmac.
+func (r *HashChainRecord) hmacData() []byte {
+	var b bytes.Buffer
+	b.WriteString(r.ChainId)
+	b.WriteByte(':')
+	b.WriteString(strconv.FormatUint(r.Seq, 10))
+	b.WriteByte(':')
+	b.WriteString(r.PrevHmac)
+	b.WriteByte(':')
+	b.Write(r.Event)
+	return b.Bytes()
+}
+
+func hashChainHmac(ctx context.Context, w wrapping.Wrapper, data []byte) (string, error) {
+	return crypto.HmacSha256(ctx, data, w, nil, hashChainInfo, crypto.WithPrefix("hmac-sha256:"), crypto.WithBase64Encoding())
+}
+
+// maxPendingHashChainEvents is the number of audit events a hashChainSink
+// holds while it has no wrapper.
+const maxPendingHashChainEvents = 1024
+
+// hashChainSink is an eventlogger.Node which wraps a sink and replaces each
+// formatted audit event with a HashChainRecord before it's written by the
+// wrapped sink. Other event types are passed to the wrapped sink unchanged.
+//
+// The audit key is only available once the controller has connected to its
+// database, so audit events processed before the sink is given a wrapper are
+// held and added to the chain when the first wrapper is rotated in.
+type hashChainSink struct {
+	sink    eventlogger.Node
+	format  string
+	chainId string
+
+	// l protects the wrapper, the pending events and the chain's state. It's
+	// held while the wrapped sink writes a record, so records are written in
+	// sequence order.
+	l        sync.Mutex
+	wrapper  wrapping.Wrapper
+	pending  []pendingHashChainEvent
+	seq      uint64
+	prevHmac string
+}
+
+// pendingHashChainEvent is an audit event held by a hashChainSink until it
+// has a wrapper.
+type pendingHashChainEvent struct {
+	e     *eventlogger.Event
+	event []byte
+}
+
+var _ eventlogger.Node = (*hashChainSink)(nil)
+
+func newHashChainSink(sink eventlogger.Node, format SinkFormat, w wrapping.Wrapper) (*hashChainSink, error) {
+	const op = "event.newHashChainSink"
+	if sink == nil {
+		return nil, fmt.Errorf("%s: missing sink: %w", op, ErrInvalidParameter)
+	}
+	switch format {
+	case JSONSinkFormat, JSONHclogSinkFormat:
+	default:
+		return nil, fmt.Errorf("%s: %q is not a json sink format: %w", op, format, ErrInvalidParameter)
+	}
+	id, err := NewId("chain")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &hashChainSink{
+		sink:    sink,
+		format:  string(format),
+		chainId: id,
+		wrapper: w,
+	}, nil
+}
+
+// Type defines the hashChainSink as a NodeTypeSink
+func (s *hashChainSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// Reopen reopens the wrapped sink.
+func (s *hashChainSink) Reopen() error {
+	return s.sink.Reopen()
+}
+
+// Rotate supports rotating the sink's wrapper. Audit events held while the
+// sink had no wrapper are added to the chain with the new wrapper. No
+// options are currently supported.
+func (s *hashChainSink) Rotate(w wrapping.Wrapper, _ ...Option) error {
+	const op = "event.(hashChainSink).Rotate"
+	if w == nil {
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.wrapper = w
+	for len(s.pending) > 0 {
+		p := s.pending[0]
+		if err := s.chain(context.Background(), p.e, p.event); err != nil {
+			return fmt.Errorf("%s: unable to write held audit events: %w", op, err)
+		}
+		s.pending[0] = pendingHashChainEvent{}
+		s.pending = s.pending[1:]
+	}
+	s.pending = nil
+	return nil
+}
+
+// Process adds audit events to the chain and sends them to the wrapped sink.
+// The chain's state is only advanced once the wrapped sink has written the
+// record.
+func (s *hashChainSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(hashChainSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	if Type(e.Type) != AuditType {
+		return s.sink.Process(ctx, e)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+	var event bytes.Buffer
+	if err := json.Compact(&event, val); err != nil {
+		return nil, fmt.Errorf("%s: unable to compact event: %w", op, err)
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.wrapper == nil {
+		if len(s.pending) >= maxPendingHashChainEvents {
+			return nil, fmt.Errorf("%s: missing wrapper and %d audit events are already held: %w", op, len(s.pending), ErrInvalidParameter)
+		}
+		s.pending = append(s.pending, pendingHashChainEvent{e: e, event: event.Bytes()})
+		return nil, nil
+	}
+	if err := s.chain(ctx, e, event.Bytes()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nil, nil
+}
+
+// chain writes the compacted audit event as the next record of the chain.
+// The caller must hold s.l.
+func (s *hashChainSink) chain(ctx context.Context, e *eventlogger.Event, event []byte) error {
+	const op = "event.(hashChainSink).chain"
+	r := &HashChainRecord{
+		ChainId:  s.chainId,
+		Seq:      s.seq + 1,
+		PrevHmac: s.prevHmac,
+		KeyId:    s.wrapper.KeyID(),
+		Event:    event,
+	}
+	var err error
+	if r.Hmac, err = hashChainHmac(ctx, s.wrapper, r.hmacData()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// HTML escaping is disabled so the event is written exactly as it was
+	// hmac'd.
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("%s: unable to marshal record: %w", op, err)
+	}
+
+	// The event's formatted data is shared with other pipelines, so the
+	// record is sent to the wrapped sink in a copy of the event.
+	chained := &eventlogger.Event{
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+		Formatted: map[string][]byte{s.format: b.Bytes()},
+		Payload:   e.Payload,
+	}
+	if _, err := s.sink.Process(ctx, chained); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.seq, s.prevHmac = r.Seq, r.Hmac
+	return nil
+}
+
+// HashChainProblemKind describes a problem found when verifying an audit hash
+// chain.
+type HashChainProblemKind string
+
+const (
+	// HashChainGap means one or more records of a chain are missing.
+	HashChainGap HashChainProblemKind = "gap"
+	// HashChainReordered means a record is out of sequence order.
+	HashChainReordered HashChainProblemKind = "reordered"
+	// HashChainModified means a record, or its link to the previous record,
+	// doesn't match its hmac.
+	HashChainModified HashChainProblemKind = "modified"
+)
+
+// HashChainProblem is a problem found when verifying an audit hash chain.
+type HashChainProblem struct {
+	Kind    HashChainProblemKind
+	File    string
+	Line    int
+	ChainId string
+	Seq     uint64
+	Msg     string
+}
+
+// HashChainKeyFn returns the wrapper of the audit key with the given id.
+type HashChainKeyFn func(ctx context.Context, keyId string) (wrapping.Wrapper, error)
+
+// HashChainVerifier verifies the audit hash chains written by sinks with
+// hash_chain enabled. Rotated files of a sink must be verified in the order
+// they were written, since the chain continues from one file to the next.
+type HashChainVerifier struct {
+	keyFn HashChainKeyFn
+	keys  map[string]wrapping.Wrapper
+
+	chainId  string
+	seq      uint64
+	prevHmac string
+
+	// Records is the number of chained records verified.
+	Records int
+	// Chains is the number of chains found.
+	Chains int
+	// Problems are the problems found.
+	Problems []HashChainProblem
+}
+
+// NewHashChainVerifier creates a new verifier which uses keyFn to get the
+// audit keys referenced by records.
+func NewHashChainVerifier(keyFn HashChainKeyFn) (*HashChainVerifier, error) {
+	const op = "event.NewHashChainVerifier"
+	if keyFn == nil {
+		return nil, fmt.Errorf("%s: missing key function: %w", op, ErrInvalidParameter)
+	}
+	return &HashChainVerifier{
+		keyFn: keyFn,
+		keys:  map[string]wrapping.Wrapper{},
+	}, nil
+}
+
+// Verify reads the records of a file sink's output from r and adds the
+// problems found to the verifier's Problems. The name is used to identify the
+// file in problems. Events which are not part of a chain are skipped.
+func (v *HashChainVerifier) Verify(ctx context.Context, name string, r io.Reader) error {
+	const op = "event.(HashChainVerifier).Verify"
+	if r == nil {
+		return fmt.Errorf("%s: missing reader: %w", op, ErrInvalidParameter)
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	var line int
+	for sc.Scan() {
+		line++
+		raw := bytes.TrimSpace(sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		problem := func(kind HashChainProblemKind, rec *HashChainRecord, format string, args ...interface{}) {
+			p := HashChainProblem{
+				Kind: kind,
+				File: name,
+				Line: line,
+				Msg:  fmt.Sprintf(format, args...),
+			}
+			if rec != nil {
+				p.ChainId, p.Seq = rec.ChainId, rec.Seq
+			}
+			v.Problems = append(v.Problems, p)
+		}
+
+		var rec HashChainRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			problem(HashChainModified, nil, "unable to parse record: %s", err)
+			continue
+		}
+		if rec.ChainId == "" {
+			// not an audit event, or written without a hash chain
+			continue
+		}
+		v.Records++
+
+		w, err := v.key(ctx, rec.KeyId)
+		if err != nil {
+			return fmt.Errorf("%s: unable to get audit key %q for %s line %d: %w", op, rec.KeyId, name, line, err)
+		}
+		var event bytes.Buffer
+		if err := json.Compact(&event, rec.Event); err != nil {
+			problem(HashChainModified, &rec, "unable to parse event: %s", err)
+			continue
+		}
+		rec.Event = event.Bytes()
+		want, err := hashChainHmac(ctx, w, rec.hmacData())
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if want != rec.Hmac {
+			problem(HashChainModified, &rec, "record does not match its hmac")
+			// when the record is in sequence, the chain continues from its hmac
+			// so the next record is still verified
+			if rec.ChainId == v.chainId && rec.Seq == v.seq+1 {
+				v.seq, v.prevHmac = rec.Seq, rec.Hmac
+			}
+			continue
+		}
+
+		switch {
+		case rec.ChainId != v.chainId:
+			v.Chains++
+			if rec.Seq != 1 || rec.PrevHmac != "" {
+				problem(HashChainGap, &rec, "chain starts at sequence %d", rec.Seq)
+			}
+		case rec.Seq <= v.seq:
+			// the chain's state is left at the highest sequence seen, so the
+			// records which follow are still verified
+			problem(HashChainReordered, &rec, "sequence %d follows sequence %d", rec.Seq, v.seq)
+			continue
+		case rec.Seq > v.seq+1:
+			problem(HashChainGap, &rec, "sequences %d to %d are missing", v.seq+1, rec.Seq-1)
+		case rec.PrevHmac != v.prevHmac:
+			problem(HashChainModified, &rec, "previous hmac does not match the previous record")
+		}
+		v.chainId, v.seq, v.prevHmac = rec.ChainId, rec.Seq, rec.Hmac
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("%s: unable to read %s: %w", op, name, err)
+	}
+	return nil
+}
+
+func (v *HashChainVerifier) key(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+	if w, ok := v.keys[keyId]; ok {
+		return w, nil
+	}
+	w, err := v.keyFn(ctx, keyId)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return nil, fmt.Errorf("missing wrapper: %w", ErrInvalidParameter)
+	}
+	v.keys[keyId] = w
+	return w, nil
+}
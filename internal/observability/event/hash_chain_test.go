@@ -0,0 +1,372 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-hclog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRecordingSink is a sink which records the formatted events it receives.
+type testRecordingSink struct {
+	format  string
+	records []string
+}
+
+func (s *testRecordingSink) Type() eventlogger.NodeType { return eventlogger.NodeTypeSink }
+func (s *testRecordingSink) Reopen() error              { return nil }
+
+func (s *testRecordingSink) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("event was not marshaled")
+	}
+	s.records = append(s.records, string(val))
+	return nil, nil
+}
+
+func testHashChainKeyFn(t *testing.T, w wrapping.Wrapper) HashChainKeyFn {
+	t.Helper()
+	return func(_ context.Context, keyId string) (wrapping.Wrapper, error) {
+		if keyId != w.KeyID() {
+			return nil, fmt.Errorf("unknown key id %q", keyId)
+		}
+		return w, nil
+	}
+}
+
+func Test_newHashChainSink(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		sink            eventlogger.Node
+		format          SinkFormat
+		wantErrIs       error
+		wantErrContains string
+	}{
+		{
+			name:            "missing-sink",
+			format:          JSONSinkFormat,
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing sink",
+		},
+		{
+			name:            "text-format",
+			sink:            &testRecordingSink{},
+			format:          TextSinkFormat,
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "is not a json sink format",
+		},
+		{
+			name:   "valid-hclog-json",
+			sink:   &testRecordingSink{},
+			format: JSONHclogSinkFormat,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := newHashChainSink(tt.sink, tt.format, nil)
+			if tt.wantErrIs != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tt.wantErrIs)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.chainId)
+			assert.Equal(eventlogger.NodeTypeSink, got.Type())
+		})
+	}
+}
+
+func TestHashChainSink_Process(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	newEvent := func(typ Type, payload string) *eventlogger.Event {
+		return &eventlogger.Event{
+			Type:      eventlogger.EventType(typ),
+			CreatedAt: time.Now(),
+			Formatted: map[string][]byte{string(JSONSinkFormat): []byte(payload + "\n")},
+		}
+	}
+
+	inner := &testRecordingSink{format: string(JSONSinkFormat)}
+	s, err := newHashChainSink(inner, JSONSinkFormat, nil)
+	require.NoError(err)
+
+	// audit events are held until the sink has a wrapper
+	_, err = s.Process(ctx, newEvent(AuditType, `{"id":"0"}`))
+	require.NoError(err)
+	require.Empty(inner.records)
+
+	w := testWrapper(t)
+	require.NoError(s.Rotate(w))
+	require.Len(inner.records, 1)
+	e := newEvent(AuditType, `{"id": "1", "data": "<a&b>"}`)
+	_, err = s.Process(ctx, e)
+	require.NoError(err)
+	_, err = s.Process(ctx, newEvent(AuditType, `{"id":"2"}`))
+	require.NoError(err)
+	_, err = s.Process(ctx, newEvent(ErrorType, `{"id":"3"}`))
+	require.NoError(err)
+
+	// the original event's formatted data isn't modified
+	got, _ := e.Format(string(JSONSinkFormat))
+	assert.Equal(`{"id": "1", "data": "<a&b>"}`+"\n", string(got))
+
+	require.Len(inner.records, 4)
+	assert.Equal(`{"id":"3"}`+"\n", inner.records[3])
+	var held, first, second HashChainRecord
+	require.NoError(json.Unmarshal([]byte(inner.records[0]), &held))
+	require.NoError(json.Unmarshal([]byte(inner.records[1]), &first))
+	require.NoError(json.Unmarshal([]byte(inner.records[2]), &second))
+	assert.Contains(inner.records[0], `"event":{"id":"0"}`)
+	assert.Contains(inner.records[1], `"event":{"id":"1","data":"<a&b>"}`)
+	assert.Equal(s.chainId, held.ChainId)
+	assert.Equal(uint64(1), held.Seq)
+	assert.Empty(held.PrevHmac)
+	assert.Equal(w.KeyID(), held.KeyId)
+	assert.True(strings.HasPrefix(held.Hmac, "hmac-sha256:"))
+	assert.Equal(uint64(2), first.Seq)
+	assert.Equal(held.Hmac, first.PrevHmac)
+	assert.Equal(uint64(3), second.Seq)
+	assert.Equal(first.Hmac, second.PrevHmac)
+
+	v, err := NewHashChainVerifier(testHashChainKeyFn(t, w))
+	require.NoError(err)
+	require.NoError(v.Verify(ctx, "records", strings.NewReader(strings.Join(inner.records, ""))))
+	assert.Empty(v.Problems)
+	assert.Equal(3, v.Records)
+	assert.Equal(1, v.Chains)
+}
+
+func TestHashChainSink_PendingLimit(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	inner := &testRecordingSink{format: string(JSONSinkFormat)}
+	s, err := newHashChainSink(inner, JSONSinkFormat, nil)
+	require.NoError(err)
+	newEvent := func() *eventlogger.Event {
+		return &eventlogger.Event{
+			Type:      eventlogger.EventType(AuditType),
+			CreatedAt: time.Now(),
+			Formatted: map[string][]byte{string(JSONSinkFormat): []byte(`{"id":"1"}`)},
+		}
+	}
+	for i := 0; i < maxPendingHashChainEvents; i++ {
+		_, err := s.Process(ctx, newEvent())
+		require.NoError(err)
+	}
+	_, err = s.Process(ctx, newEvent())
+	require.Error(err)
+	assert.Contains(err.Error(), "missing wrapper")
+
+	require.NoError(s.Rotate(testWrapper(t)))
+	assert.Len(inner.records, maxPendingHashChainEvents)
+	assert.Empty(s.pending)
+}
+
+func TestHashChainVerifier_Verify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	w := testWrapper(t)
+
+	// newChain returns the records of a chain of n audit events.
+	newChain := func(t *testing.T, n int) []string {
+		t.Helper()
+		inner := &testRecordingSink{format: string(JSONSinkFormat)}
+		s, err := newHashChainSink(inner, JSONSinkFormat, w)
+		require.NoError(t, err)
+		for i := 1; i <= n; i++ {
+			_, err := s.Process(ctx, &eventlogger.Event{
+				Type:      eventlogger.EventType(AuditType),
+				Formatted: map[string][]byte{string(JSONSinkFormat): []byte(fmt.Sprintf(`{"id":"%d"}`, i))},
+			})
+			require.NoError(t, err)
+		}
+		return inner.records
+	}
+	chain := newChain(t, 5)
+	restarted := newChain(t, 2)
+
+	tests := []struct {
+		name         string
+		files        [][]string
+		wantRecords  int
+		wantChains   int
+		wantProblems []HashChainProblemKind
+		wantErr      string
+	}{
+		{
+			name:        "valid-rotated-files",
+			files:       [][]string{chain[:2], chain[2:]},
+			wantRecords: 5,
+			wantChains:  1,
+		},
+		{
+			name:        "valid-restart",
+			files:       [][]string{chain, restarted},
+			wantRecords: 7,
+			wantChains:  2,
+		},
+		{
+			name:        "non-chained-events-are-skipped",
+			files:       [][]string{{chain[0], `{"id":"error"}` + "\n", chain[1]}},
+			wantRecords: 2,
+			wantChains:  1,
+		},
+		{
+			name:         "deleted-record",
+			files:        [][]string{{chain[0], chain[1], chain[3], chain[4]}},
+			wantRecords:  4,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainGap},
+		},
+		{
+			name:         "deleted-first-file",
+			files:        [][]string{chain[2:]},
+			wantRecords:  3,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainGap},
+		},
+		{
+			name:         "reordered-records",
+			files:        [][]string{{chain[0], chain[2], chain[1], chain[3]}},
+			wantRecords:  4,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainGap, HashChainReordered},
+		},
+		{
+			name:         "reordered-files",
+			files:        [][]string{chain[3:], chain[:3]},
+			wantRecords:  5,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainGap, HashChainReordered, HashChainReordered, HashChainReordered},
+		},
+		{
+			name:         "modified-event",
+			files:        [][]string{{chain[0], strings.Replace(chain[1], `"id":"2"`, `"id":"x"`, 1), chain[2]}},
+			wantRecords:  3,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainModified},
+		},
+		{
+			name:         "unparsable-record",
+			files:        [][]string{{chain[0], "not json\n", chain[1]}},
+			wantRecords:  2,
+			wantChains:   1,
+			wantProblems: []HashChainProblemKind{HashChainModified},
+		},
+		{
+			name:    "unknown-key",
+			files:   [][]string{{strings.Replace(chain[0], w.KeyID(), "unknown", 1)}},
+			wantErr: `unable to get audit key "unknown"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			v, err := NewHashChainVerifier(testHashChainKeyFn(t, w))
+			require.NoError(err)
+			for i, f := range tt.files {
+				err = v.Verify(ctx, fmt.Sprintf("file-%d", i), strings.NewReader(strings.Join(f, "")))
+				if tt.wantErr != "" {
+					require.Error(err)
+					assert.Contains(err.Error(), tt.wantErr)
+					return
+				}
+				require.NoError(err)
+			}
+			assert.Equal(tt.wantRecords, v.Records)
+			assert.Equal(tt.wantChains, v.Chains)
+			var gotProblems []HashChainProblemKind
+			for _, p := range v.Problems {
+				gotProblems = append(gotProblems, p.Kind)
+			}
+			assert.Equal(tt.wantProblems, gotProblems)
+		})
+	}
+}
+
+func TestEventer_HashChain(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := testWrapper(t)
+	dir := t.TempDir()
+
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	e, err := NewEventer(testLogger, testLock, "TestEventer_HashChain", EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "chained",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					Path:     dir,
+					FileName: "chained.log",
+				},
+				AuditConfig: &AuditConfig{HashChain: true},
+			},
+			{
+				Name:       "unchained",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					Path:     dir,
+					FileName: "unchained.log",
+				},
+			},
+		},
+	}, WithAuditWrapper(w))
+	require.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		a, err := newAudit("TestEventer_HashChain", WithRequestInfo(TestRequestInfo(t)), WithFlush())
+		require.NoError(err)
+		require.NoError(e.writeAudit(ctx, a))
+	}
+	testError, err := newError("TestEventer_HashChain", fmt.Errorf("%s: no msg: test", ErrIo))
+	require.NoError(err)
+	require.NoError(e.writeError(ctx, testError))
+
+	unchained, err := os.ReadFile(filepath.Join(dir, "unchained.log"))
+	require.NoError(err)
+	assert.NotContains(string(unchained), "chain_id")
+
+	chained, err := os.ReadFile(filepath.Join(dir, "chained.log"))
+	require.NoError(err)
+	v, err := NewHashChainVerifier(testHashChainKeyFn(t, w))
+	require.NoError(err)
+	require.NoError(v.Verify(ctx, "chained.log", bytes.NewReader(chained)))
+	assert.Empty(v.Problems)
+	assert.Equal(3, v.Records)
+	assert.Equal(1, v.Chains)
+}
@@ -1,23 +1,28 @@
 package event
 
 import (
+	"crypto/x509"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 )
 
 // SinkConfig defines the configuration for a Eventer sink
 type SinkConfig struct {
-	Name           string                `hcl:"name"`             // Name defines a name for the sink.
-	Description    string                `hcl:"description"`      // Description defines a description for the sink.
-	EventTypes     []Type                `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
-	EventSourceUrl string                `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
-	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
-	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
-	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink or FileSink).
-	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
-	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
-	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	Name           string                 `hcl:"name"`             // Name defines a name for the sink.
+	Description    string                 `hcl:"description"`      // Description defines a description for the sink.
+	EventTypes     []Type                 `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
+	EventSourceUrl string                 `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
+	AllowFilters   []string               `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
+	DenyFilters    []string               `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
+	Format         SinkFormat             `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
+	Type           SinkType               `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, SyslogSink or WebhookSink).
+	StderrConfig   *StderrSinkTypeConfig  `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
+	FileConfig     *FileSinkTypeConfig    `hcl:"file"`             // FileConfig defines parameters for a file output.
+	SyslogConfig   *SyslogSinkTypeConfig  `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
+	WebhookConfig  *WebhookSinkTypeConfig `hcl:"webhook"`          // WebhookConfig defines parameters for a webhook output.
+	AuditConfig    *AuditConfig           `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -36,6 +41,12 @@ func (sc *SinkConfig) Validate() error {
 	if sc.FileConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.WebhookConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -56,6 +67,20 @@ func (sc *SinkConfig) Validate() error {
 		if sc.FileConfig.FileName == "" {
 			return fmt.Errorf("%s: missing file name: %w", op, ErrInvalidParameter)
 		}
+	case SyslogSink:
+		if sc.SyslogConfig == nil {
+			return fmt.Errorf(`%s: missing "syslog" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.SyslogConfig.validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case WebhookSink:
+		if sc.WebhookConfig == nil {
+			return fmt.Errorf(`%s: missing "webhook" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.WebhookConfig.validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -78,6 +103,13 @@ func (sc *SinkConfig) Validate() error {
 			}
 		}
 	}
+	if sc.AuditConfig != nil && sc.AuditConfig.HashChain {
+		switch sc.Format {
+		case JSONSinkFormat, JSONHclogSinkFormat:
+		default:
+			return fmt.Errorf("%s: invalid audit config: hash chain requires a json sink format: %w", op, ErrInvalidParameter)
+		}
+	}
 
 	return nil
 }
@@ -95,6 +127,96 @@ type FileSinkTypeConfig struct {
 	RotateMaxFiles    int           `hcl:"rotate_max_files" mapstructure:"rotate_max_files"` // RotateMaxFiles defines how may historical rotated files should be kept for a FileSink
 }
 
+// SyslogSinkTypeConfig contains configuration structures for syslog sink
+// types. Events are sent as RFC 5424 messages.
+type SyslogSinkTypeConfig struct {
+	Network       string `hcl:"network"         mapstructure:"network"`         // Network defines how to reach the syslog server: udp, tcp, tls, unix or unixgram. It defaults to udp when an address is set, otherwise the local syslog socket is used.
+	Address       string `hcl:"address"         mapstructure:"address"`         // Address defines the host:port or socket path of the syslog server
+	Facility      string `hcl:"facility"        mapstructure:"facility"`        // Facility defines the syslog facility of the messages (defaults to local0)
+	AppName       string `hcl:"app_name"        mapstructure:"app_name"`        // AppName defines the APP-NAME of the messages (defaults to boundary)
+	Hostname      string `hcl:"hostname"        mapstructure:"hostname"`        // Hostname defines the HOSTNAME of the messages (defaults to the host's name)
+	TlsCaCert     string `hcl:"tls_ca_cert"     mapstructure:"tls_ca_cert"`     // TlsCaCert defines an optional PEM-encoded CA certificate used to verify a tls syslog server
+	TlsServerName string `hcl:"tls_server_name" mapstructure:"tls_server_name"` // TlsServerName defines an optional server name used to verify a tls syslog server
+	TlsSkipVerify bool   `hcl:"tls_skip_verify" mapstructure:"tls_skip_verify"` // TlsSkipVerify disables verification of a tls syslog server's certificate
+}
+
+func (c *SyslogSinkTypeConfig) validate() error {
+	const op = "event.(SyslogSinkTypeConfig).validate"
+	switch c.Network {
+	case "":
+	case "udp", "tcp", "tls", "unix", "unixgram":
+		if c.Address == "" {
+			return fmt.Errorf("%s: missing syslog address: %w", op, ErrInvalidParameter)
+		}
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid syslog network: %w", op, c.Network, ErrInvalidParameter)
+	}
+	if c.Facility != "" {
+		if _, ok := syslogFacilities[strings.ToLower(c.Facility)]; !ok {
+			return fmt.Errorf("%s: '%s' is not a valid syslog facility: %w", op, c.Facility, ErrInvalidParameter)
+		}
+	}
+	if c.TlsCaCert != "" {
+		if c.Network != "tls" {
+			return fmt.Errorf("%s: a CA certificate can only be used with the tls network: %w", op, ErrInvalidParameter)
+		}
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(c.TlsCaCert)); !ok {
+			return fmt.Errorf("%s: unable to parse CA certificate: %w", op, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// WebhookSinkTypeConfig contains configuration structures for webhook sink
+// types. Events are sent in batches as the body of HTTP POST requests.
+type WebhookSinkTypeConfig struct {
+	Url               string            `hcl:"url"              mapstructure:"url"`                  // Url defines the http or https URL events are posted to
+	Headers           map[string]string `hcl:"headers"          mapstructure:"headers"`              // Headers defines additional headers sent with each request
+	HmacSecret        string            `hcl:"hmac_secret"      mapstructure:"hmac_secret"`          // HmacSecret defines an optional secret used to sign each request body with HMAC-SHA256
+	BatchSize         int               `hcl:"batch_size"       mapstructure:"batch_size"`           // BatchSize defines the number of events sent in one request (defaults to 1)
+	BatchTimeout      time.Duration     `mapstructure:"batch_timeout"`                               // BatchTimeout defines how long an incomplete batch is held before it is sent (defaults to 5s)
+	BatchTimeoutHCL   string            `hcl:"batch_timeout" json:"-"`                               // BatchTimeoutHCL defines hcl string version of BatchTimeout
+	RequestTimeout    time.Duration     `mapstructure:"request_timeout"`                             // RequestTimeout defines the timeout of each request (defaults to 10s)
+	RequestTimeoutHCL string            `hcl:"request_timeout" json:"-"`                             // RequestTimeoutHCL defines hcl string version of RequestTimeout
+	MaxRetries        int               `hcl:"max_retries"      mapstructure:"max_retries"`          // MaxRetries defines how many times a failed request is retried (defaults to 3)
+	TlsCaCert         string            `hcl:"tls_ca_cert"      mapstructure:"tls_ca_cert"`          // TlsCaCert defines an optional PEM-encoded CA certificate used to verify an https endpoint
+	TlsSkipVerify     bool              `hcl:"tls_skip_verify"  mapstructure:"tls_skip_verify"`      // TlsSkipVerify disables verification of an https endpoint's certificate
+	QueueSize         int               `hcl:"queue_size"       mapstructure:"queue_size"`           // QueueSize defines the number of batches waiting to be delivered which are held before the sink drops or waits (defaults to 100)
+	DeliveryGuarantee DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines whether batches are dropped (best-effort, the default) or waited for (enforced) when the queue is full
+}
+
+func (c *WebhookSinkTypeConfig) validate() error {
+	const op = "event.(WebhookSinkTypeConfig).validate"
+	if c.Url == "" {
+		return fmt.Errorf("%s: missing webhook url: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("%s: '%s' is not a valid http or https url: %w", op, c.Url, ErrInvalidParameter)
+	}
+	switch {
+	case c.BatchSize < 0:
+		return fmt.Errorf("%s: batch size must not be negative: %w", op, ErrInvalidParameter)
+	case c.BatchTimeout < 0:
+		return fmt.Errorf("%s: batch timeout must not be negative: %w", op, ErrInvalidParameter)
+	case c.RequestTimeout < 0:
+		return fmt.Errorf("%s: request timeout must not be negative: %w", op, ErrInvalidParameter)
+	case c.MaxRetries < 0:
+		return fmt.Errorf("%s: max retries must not be negative: %w", op, ErrInvalidParameter)
+	case c.QueueSize < 0:
+		return fmt.Errorf("%s: queue size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.TlsCaCert != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(c.TlsCaCert)); !ok {
+			return fmt.Errorf("%s: unable to parse CA certificate: %w", op, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
 // FilterType defines a type for filters (allow or deny)
 type FilterType string
 
@@ -180,6 +180,52 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `mismatch between sink type and sink configuration block`,
 		},
+		{
+			name: "type mismatch syslog type webhook config",
+			sc: SinkConfig{
+				EventTypes:    []Type{EveryType},
+				Type:          SyslogSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{Url: "https://localhost"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "syslog" block`,
+		},
+		{
+			name: "type mismatch webhook type syslog config",
+			sc: SinkConfig{
+				EventTypes:   []Type{EveryType},
+				Type:         WebhookSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "webhook" block`,
+		},
+		{
+			name: "syslog-sink-with-invalid-network",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog network",
+		},
+		{
+			name: "webhook-sink-with-no-url",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing webhook url",
+		},
 		{
 			name: "type mismatch both types file config",
 			sc: SinkConfig{
@@ -204,6 +250,21 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `too many sink type config blocks`,
 		},
+		{
+			name: "hash-chain-with-text-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format:      TextSinkFormat,
+				AuditConfig: &AuditConfig{HashChain: true},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "hash chain requires a json sink format",
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
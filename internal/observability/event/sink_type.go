@@ -5,16 +5,18 @@ import (
 )
 
 const (
-	StderrSink SinkType = "stderr" // StderrSink is written to stderr
-	FileSink   SinkType = "file"   // FileSink is written to a file
+	StderrSink  SinkType = "stderr"  // StderrSink is written to stderr
+	FileSink    SinkType = "file"    // FileSink is written to a file
+	SyslogSink  SinkType = "syslog"  // SyslogSink is sent to a syslog server
+	WebhookSink SinkType = "webhook" // WebhookSink is sent to an HTTP endpoint
 )
 
-type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr)
+type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, syslog, webhook)
 
 func (t SinkType) Validate() error {
 	const op = "event.(SinkType).validate"
 	switch t {
-	case StderrSink, FileSink:
+	case StderrSink, FileSink, SyslogSink, WebhookSink:
 		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink type: %w", op, t, ErrInvalidParameter)
@@ -0,0 +1,268 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	defaultSyslogFacility = "local0"
+	defaultSyslogAppName  = "boundary"
+
+	syslogSeverityErr  = 3
+	syslogSeverityInfo = 6
+
+	syslogDialTimeout  = 5 * time.Second
+	syslogWriteTimeout = 5 * time.Second
+)
+
+// syslogFacilities maps the facility names accepted in a config to their
+// RFC 5424 codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// localSyslogSockets are tried in order when no syslog address is configured.
+var localSyslogSockets = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// syslogSink is an eventlogger.Node which sends the formatted representation
+// of an event to a syslog server as an RFC 5424 message. Messages sent over
+// stream connections (tcp, tls and unix) are framed with octet counting as
+// described by RFC 6587.
+type syslogSink struct {
+	format    string
+	network   string
+	address   string
+	facility  int
+	appName   string
+	hostname  string
+	procId    string
+	tlsConfig *tls.Config
+
+	l    sync.Mutex
+	conn net.Conn
+	// connNetwork is the network of conn, which can differ from network when
+	// the local syslog socket is used.
+	connNetwork string
+}
+
+var _ eventlogger.Node = (*syslogSink)(nil)
+
+func newSyslogSink(format SinkFormat, c *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing syslog config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &syslogSink{
+		format:   string(format),
+		network:  c.Network,
+		address:  c.Address,
+		facility: syslogFacilities[defaultSyslogFacility],
+		appName:  defaultSyslogAppName,
+		hostname: c.Hostname,
+		procId:   fmt.Sprintf("%d", os.Getpid()),
+	}
+	if s.network == "" && s.address != "" {
+		s.network = "udp"
+	}
+	if c.Facility != "" {
+		s.facility = syslogFacilities[strings.ToLower(c.Facility)]
+	}
+	if c.AppName != "" {
+		s.appName = c.AppName
+	}
+	if s.hostname == "" {
+		s.hostname, _ = os.Hostname()
+	}
+	if s.network == "tls" {
+		s.tlsConfig = &tls.Config{
+			ServerName:         c.TlsServerName,
+			InsecureSkipVerify: c.TlsSkipVerify,
+			MinVersion:         tls.VersionTLS12,
+		}
+		if c.TlsCaCert != "" {
+			pool := x509.NewCertPool()
+			pool.AppendCertsFromPEM([]byte(c.TlsCaCert))
+			s.tlsConfig.RootCAs = pool
+		}
+	}
+	return s, nil
+}
+
+// Type defines the syslogSink as a NodeTypeSink
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// Reopen closes the connection to the syslog server, which is reestablished
+// when the next event is sent.
+func (s *syslogSink) Reopen() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.closeConn()
+}
+
+// Process sends the event to the syslog server. When the send fails, the
+// connection is reestablished and the send is retried once. Each write must
+// complete within syslogWriteTimeout or before the ctx deadline, so a stalled
+// server can't block the event pipeline.
+func (s *syslogSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+	msg := s.message(e, val)
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if err = s.connect(); err != nil {
+			continue
+		}
+		deadline := time.Now().Add(syslogWriteTimeout)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		if err = s.conn.SetWriteDeadline(deadline); err != nil {
+			_ = s.closeConn()
+			continue
+		}
+		if _, err = s.conn.Write(s.frame(msg)); err == nil {
+			// Sinks are leafs, so do not return the event, since nothing
+			// more can happen to it downstream.
+			return nil, nil
+		}
+		_ = s.closeConn()
+	}
+	return nil, fmt.Errorf("%s: unable to send event: %w", op, err)
+}
+
+// message builds the RFC 5424 message of an event:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (s *syslogSink) message(e *eventlogger.Event, val []byte) []byte {
+	severity := syslogSeverityInfo
+	if Type(e.Type) == ErrorType {
+		severity = syslogSeverityErr
+	}
+	ts := e.CreatedAt
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<%d>1 %s %s %s %s %s - ",
+		s.facility*8+severity,
+		ts.UTC().Format(time.RFC3339Nano),
+		syslogHeaderField(s.hostname),
+		syslogHeaderField(s.appName),
+		syslogHeaderField(s.procId),
+		syslogHeaderField(string(e.Type)),
+	)
+	b.Write(bytes.TrimRight(val, "\r\n"))
+	return b.Bytes()
+}
+
+// frame adds octet counting framing to a message sent over a stream
+// connection. Datagrams are sent as is.
+func (s *syslogSink) frame(msg []byte) []byte {
+	switch s.connNetwork {
+	case "udp", "unixgram":
+		return msg
+	default:
+		return append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+}
+
+// connect dials the syslog server if there's no open connection. It must be
+// called with the lock held.
+func (s *syslogSink) connect() error {
+	if s.conn != nil {
+		return nil
+	}
+	var err error
+	switch s.network {
+	case "":
+		for _, path := range localSyslogSockets {
+			for _, network := range []string{"unixgram", "unix"} {
+				if s.conn, err = net.DialTimeout(network, path, syslogDialTimeout); err == nil {
+					s.connNetwork = network
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("unable to connect to a local syslog socket: %w", err)
+	case "tls":
+		dialer := &net.Dialer{Timeout: syslogDialTimeout}
+		s.conn, err = tls.DialWithDialer(dialer, "tcp", s.address, s.tlsConfig)
+	default:
+		s.conn, err = net.DialTimeout(s.network, s.address, syslogDialTimeout)
+	}
+	if err != nil {
+		s.conn = nil
+		return err
+	}
+	s.connNetwork = s.network
+	return nil
+}
+
+// closeConn closes the connection to the syslog server. It must be called
+// with the lock held.
+func (s *syslogSink) closeConn() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// syslogHeaderField returns the NILVALUE for empty header fields and removes
+// the characters which are not allowed in them.
+func syslogHeaderField(v string) string {
+	v = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return -1
+		}
+		return r
+	}, v)
+	if v == "" {
+		return "-"
+	}
+	return v
+}
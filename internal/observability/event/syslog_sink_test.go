@@ -0,0 +1,265 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newSyslogSink(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		c               *SyslogSinkTypeConfig
+		wantNetwork     string
+		wantFacility    int
+		wantErrIs       error
+		wantErrContains string
+	}{
+		{
+			name:            "missing-config",
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing syslog config",
+		},
+		{
+			name:            "invalid-network",
+			c:               &SyslogSinkTypeConfig{Network: "http", Address: "localhost:514"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog network",
+		},
+		{
+			name:            "missing-address",
+			c:               &SyslogSinkTypeConfig{Network: "tcp"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing syslog address",
+		},
+		{
+			name:            "invalid-facility",
+			c:               &SyslogSinkTypeConfig{Network: "udp", Address: "localhost:514", Facility: "local9"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog facility",
+		},
+		{
+			name:            "ca-cert-without-tls",
+			c:               &SyslogSinkTypeConfig{Network: "tcp", Address: "localhost:514", TlsCaCert: "cert"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "can only be used with the tls network",
+		},
+		{
+			name:         "default-network",
+			c:            &SyslogSinkTypeConfig{Address: "localhost:514"},
+			wantNetwork:  "udp",
+			wantFacility: 16,
+		},
+		{
+			name:         "local-socket",
+			c:            &SyslogSinkTypeConfig{Facility: "AUTH"},
+			wantFacility: 4,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := newSyslogSink(JSONSinkFormat, tt.c)
+			if tt.wantErrIs != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tt.wantErrIs)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantNetwork, got.network)
+			assert.Equal(tt.wantFacility, got.facility)
+			assert.Equal(defaultSyslogAppName, got.appName)
+		})
+	}
+}
+
+func TestSyslogSink_Process(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2021, 11, 17, 20, 34, 58, 651387000, time.UTC)
+	newEvent := func(typ Type, payload string) *eventlogger.Event {
+		return &eventlogger.Event{
+			Type:      eventlogger.EventType(typ),
+			CreatedAt: createdAt,
+			Formatted: map[string][]byte{string(JSONSinkFormat): []byte(payload + "\n")},
+		}
+	}
+	wantMsg := func(pri int, msgId, payload string) string {
+		return fmt.Sprintf("<%d>1 2021-11-17T20:34:58.651387Z test-host boundary %d %s - %s", pri, os.Getpid(), msgId, payload)
+	}
+
+	t.Run("udp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(err)
+		defer pc.Close()
+
+		s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+			Network:  "udp",
+			Address:  pc.LocalAddr().String(),
+			Hostname: "test-host",
+		})
+		require.NoError(err)
+		defer s.Reopen()
+
+		_, err = s.Process(context.Background(), newEvent(AuditType, `{"id":"1"}`))
+		require.NoError(err)
+		_, err = s.Process(context.Background(), newEvent(ErrorType, `{"id":"2"}`))
+		require.NoError(err)
+
+		buf := make([]byte, 2048)
+		require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		n, _, err := pc.ReadFrom(buf)
+		require.NoError(err)
+		assert.Equal(wantMsg(16*8+6, "audit", `{"id":"1"}`), string(buf[:n]))
+		n, _, err = pc.ReadFrom(buf)
+		require.NoError(err)
+		assert.Equal(wantMsg(16*8+3, "error", `{"id":"2"}`), string(buf[:n]))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		defer l.Close()
+		received := make(chan string, 10)
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go readOctetCountedFrames(conn, received)
+			}
+		}()
+
+		s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+			Network:  "tcp",
+			Address:  l.Addr().String(),
+			Facility: "auth",
+			Hostname: "test-host",
+		})
+		require.NoError(err)
+		defer s.Reopen()
+
+		_, err = s.Process(context.Background(), newEvent(ObservationType, `{"id":"1"}`))
+		require.NoError(err)
+		assert.Equal(wantMsg(4*8+6, "observation", `{"id":"1"}`), receiveSyslogMessage(t, received))
+
+		// a reopened sink reconnects to the server
+		require.NoError(s.Reopen())
+		_, err = s.Process(context.Background(), newEvent(SystemType, `{"id":"2"}`))
+		require.NoError(err)
+		assert.Equal(wantMsg(4*8+6, "system", `{"id":"2"}`), receiveSyslogMessage(t, received))
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		addr := l.Addr().String()
+		require.NoError(l.Close())
+
+		s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{Network: "tcp", Address: addr})
+		require.NoError(err)
+		_, err = s.Process(context.Background(), newEvent(AuditType, `{"id":"1"}`))
+		require.Error(err)
+		assert.Contains(err.Error(), "unable to send event")
+	})
+
+	t.Run("stalled-server", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		defer l.Close()
+		// the server accepts connections but never reads from them
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+			}
+		}()
+
+		s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{Network: "tcp", Address: l.Addr().String()})
+		require.NoError(err)
+		defer s.Reopen()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		payload := `"` + strings.Repeat("x", 32*1024*1024) + `"`
+		start := time.Now()
+		_, err = s.Process(ctx, newEvent(AuditType, payload))
+		require.Error(err)
+		assert.Contains(err.Error(), "unable to send event")
+		assert.Less(time.Since(start), syslogWriteTimeout)
+	})
+
+	t.Run("not-formatted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := newSyslogSink(TextSinkFormat, &SyslogSinkTypeConfig{Network: "udp", Address: "127.0.0.1:514"})
+		require.NoError(err)
+		_, err = s.Process(context.Background(), newEvent(AuditType, `{"id":"1"}`))
+		require.Error(err)
+		assert.Contains(err.Error(), "event was not marshaled")
+	})
+}
+
+func Test_syslogHeaderField(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal("-", syslogHeaderField(""))
+	assert.Equal("-", syslogHeaderField(" \n"))
+	assert.Equal("myhost", syslogHeaderField("my host"))
+}
+
+// readOctetCountedFrames reads syslog messages framed with octet counting
+// from conn and sends them to received.
+func readOctetCountedFrames(conn net.Conn, received chan<- string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		l, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(l))
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		received <- string(msg)
+	}
+}
+
+func receiveSyslogMessage(t *testing.T, received <-chan string) string {
+	t.Helper()
+	select {
+	case msg := <-received:
+		require.Regexp(t, regexp.MustCompile(`^<\d+>1 `), msg)
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for syslog message")
+		return ""
+	}
+}
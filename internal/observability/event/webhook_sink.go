@@ -0,0 +1,314 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	defaultWebhookBatchSize      = 1
+	defaultWebhookBatchTimeout   = 5 * time.Second
+	defaultWebhookRequestTimeout = 10 * time.Second
+	defaultWebhookMaxRetries     = 3
+	defaultWebhookQueueSize      = 100
+
+	// WebhookSignatureHeader is the header which holds the hex encoded
+	// HMAC-SHA256 of a webhook request body when an HMAC secret is
+	// configured.
+	WebhookSignatureHeader = "X-Boundary-Signature"
+)
+
+// webhookSink is an eventlogger.Node which posts the formatted representation
+// of events to an HTTP endpoint. Events are collected into batches: a batch is
+// queued for delivery when it is full, when its batch timeout expires or when
+// the sink is flushed. For json formats the request body is a json array of
+// the events, otherwise it's the events separated by newlines.
+//
+// Queued batches are delivered in order by a single goroutine, so a slow or
+// unavailable endpoint never blocks the event pipeline while it's retried.
+// When the queue is full, a best effort sink drops the batch, while an
+// enforced sink waits for room in the queue until the context of the event
+// is done. Batches which can't be delivered are reported to the sink's error
+// handler rather than to the writers of unrelated events.
+type webhookSink struct {
+	format       string
+	url          string
+	headers      map[string]string
+	hmacSecret   []byte
+	batchSize    int
+	batchTimeout time.Duration
+	guarantee    DeliveryGuarantee
+	client       *retryablehttp.Client
+
+	// queue holds the batches waiting to be delivered and pending counts the
+	// batches queued but not yet delivered, so FlushAll can wait for them.
+	queue   chan [][]byte
+	pending sync.WaitGroup
+	onError func(error)
+
+	l     sync.Mutex
+	batch [][]byte
+	timer *time.Timer
+}
+
+var (
+	_ eventlogger.Node = (*webhookSink)(nil)
+	_ flushable        = (*webhookSink)(nil)
+)
+
+// newWebhookSink creates a webhook sink and starts its delivery goroutine.
+// Delivery failures are passed to onError, which may be nil.
+func newWebhookSink(format SinkFormat, c *WebhookSinkTypeConfig, onError func(error)) (*webhookSink, error) {
+	const op = "event.newWebhookSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing webhook config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &webhookSink{
+		format:       string(format),
+		url:          c.Url,
+		headers:      c.Headers,
+		batchSize:    defaultWebhookBatchSize,
+		batchTimeout: defaultWebhookBatchTimeout,
+		guarantee:    c.DeliveryGuarantee,
+		onError:      onError,
+	}
+	if s.guarantee == DefaultDeliveryGuarantee {
+		s.guarantee = BestEffort
+	}
+	if s.onError == nil {
+		s.onError = func(error) {}
+	}
+	queueSize := defaultWebhookQueueSize
+	if c.QueueSize > 0 {
+		queueSize = c.QueueSize
+	}
+	s.queue = make(chan [][]byte, queueSize)
+	if c.HmacSecret != "" {
+		s.hmacSecret = []byte(c.HmacSecret)
+	}
+	if c.BatchSize > 0 {
+		s.batchSize = c.BatchSize
+	}
+	if c.BatchTimeout > 0 {
+		s.batchTimeout = c.BatchTimeout
+	}
+	requestTimeout := defaultWebhookRequestTimeout
+	if c.RequestTimeout > 0 {
+		requestTimeout = c.RequestTimeout
+	}
+	maxRetries := defaultWebhookMaxRetries
+	if c.MaxRetries > 0 {
+		maxRetries = c.MaxRetries
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	if c.TlsCaCert != "" || c.TlsSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.TlsSkipVerify,
+			MinVersion:         tls.VersionTLS12,
+		}
+		if c.TlsCaCert != "" {
+			pool := x509.NewCertPool()
+			pool.AppendCertsFromPEM([]byte(c.TlsCaCert))
+			transport.TLSClientConfig.RootCAs = pool
+		}
+	}
+	s.client = &retryablehttp.Client{
+		HTTPClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		RetryWaitMin: 100 * time.Millisecond,
+		RetryWaitMax: 5 * time.Second,
+		RetryMax:     maxRetries,
+		CheckRetry:   retryablehttp.DefaultRetryPolicy,
+		Backoff:      retryablehttp.DefaultBackoff,
+		ErrorHandler: retryablehttp.PassthroughErrorHandler,
+	}
+	go s.deliver()
+	return s, nil
+}
+
+// Type defines the webhookSink as a NodeTypeSink
+func (s *webhookSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// Reopen does nothing for this type of Sink.
+func (s *webhookSink) Reopen() error { return nil }
+
+// Process adds the event to the current batch, and queues the batch for
+// delivery when it's full.
+func (s *webhookSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(webhookSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+
+	s.l.Lock()
+	s.batch = append(s.batch, bytes.TrimRight(val, "\r\n"))
+	var batch [][]byte
+	switch {
+	case len(s.batch) >= s.batchSize:
+		batch = s.takeBatch()
+	case s.timer == nil:
+		s.timer = time.AfterFunc(s.batchTimeout, s.flushOnTimeout)
+	}
+	s.l.Unlock()
+
+	if batch != nil {
+		if err := s.enqueue(ctx, batch); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	// Sinks are leafs, so do not return the event, since nothing more can
+	// happen to it downstream.
+	return nil, nil
+}
+
+// FlushAll queues the current batch, if any, and waits until every queued
+// batch has been delivered or ctx is done.
+func (s *webhookSink) FlushAll(ctx context.Context) error {
+	const op = "event.(webhookSink).FlushAll"
+	s.l.Lock()
+	batch := s.takeBatch()
+	s.l.Unlock()
+	if len(batch) > 0 {
+		if err := s.enqueue(ctx, batch); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+func (s *webhookSink) flushOnTimeout() {
+	const op = "event.(webhookSink).flushOnTimeout"
+	s.l.Lock()
+	batch := s.takeBatch()
+	s.l.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+	if err := s.enqueue(context.Background(), batch); err != nil {
+		s.onError(fmt.Errorf("%s: %w", op, err))
+	}
+}
+
+// enqueue queues a batch for delivery. When the queue is full, the batch is
+// dropped by a best effort sink, while an enforced sink waits for room until
+// ctx is done.
+func (s *webhookSink) enqueue(ctx context.Context, batch [][]byte) error {
+	const op = "event.(webhookSink).enqueue"
+	s.pending.Add(1)
+	select {
+	case s.queue <- batch:
+		return nil
+	default:
+	}
+	if s.guarantee != Enforced {
+		s.pending.Done()
+		s.onError(fmt.Errorf("%s: delivery queue is full, dropped %d events", op, len(batch)))
+		return nil
+	}
+	select {
+	case s.queue <- batch:
+		return nil
+	case <-ctx.Done():
+		s.pending.Done()
+		return fmt.Errorf("%s: delivery queue is full, unable to queue %d events: %w", op, len(batch), ctx.Err())
+	}
+}
+
+// deliver sends the queued batches in order. It runs for the lifetime of the
+// sink.
+func (s *webhookSink) deliver() {
+	for batch := range s.queue {
+		if err := s.send(context.Background(), batch); err != nil {
+			s.onError(err)
+		}
+		s.pending.Done()
+	}
+}
+
+// takeBatch returns the current batch and starts a new one. It must be called
+// with the lock held.
+func (s *webhookSink) takeBatch() [][]byte {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	batch := s.batch
+	s.batch = nil
+	return batch
+}
+
+func (s *webhookSink) send(ctx context.Context, batch [][]byte) error {
+	const op = "event.(webhookSink).send"
+	var body []byte
+	contentType := "text/plain; charset=utf-8"
+	switch SinkFormat(s.format) {
+	case JSONSinkFormat, JSONHclogSinkFormat:
+		contentType = "application/json"
+		body = append(append([]byte("["), bytes.Join(batch, []byte(","))...), ']')
+	default:
+		body = append(bytes.Join(batch, []byte("\n")), '\n')
+	}
+
+	req, err := retryablehttp.NewRequest(http.MethodPost, s.url, body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req = req.WithContext(ctx)
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if s.hmacSecret != nil {
+		mac := hmac.New(sha256.New, s.hmacSecret)
+		mac.Write(body)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: unable to send %d events: %w", op, len(batch), err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: unable to send %d events: unexpected status %s", op, len(batch), resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,422 @@
+package event
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWebhookServer is a stand-in webhook endpoint which records the requests
+// it receives.
+type testWebhookServer struct {
+	*httptest.Server
+
+	l        sync.Mutex
+	requests []*testWebhookRequest
+	failures int
+	gate     chan struct{}
+	waiting  int
+}
+
+type testWebhookRequest struct {
+	header http.Header
+	body   string
+}
+
+func newTestWebhookServer(t *testing.T) *testWebhookServer {
+	t.Helper()
+	s := &testWebhookServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		s.l.Lock()
+		if gate := s.gate; gate != nil {
+			s.waiting++
+			s.l.Unlock()
+			<-gate
+			s.l.Lock()
+			s.waiting--
+		}
+		defer s.l.Unlock()
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		s.requests = append(s.requests, &testWebhookRequest{header: r.Header, body: string(body)})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testWebhookServer) failNext(n int) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.failures = n
+}
+
+// block holds requests until unblock is called.
+func (s *testWebhookServer) block() {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.gate = make(chan struct{})
+}
+
+func (s *testWebhookServer) unblock() {
+	s.l.Lock()
+	defer s.l.Unlock()
+	close(s.gate)
+	s.gate = nil
+}
+
+// inFlight returns the number of requests held by block.
+func (s *testWebhookServer) inFlight() int {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.waiting
+}
+
+func (s *testWebhookServer) received() []*testWebhookRequest {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return append([]*testWebhookRequest(nil), s.requests...)
+}
+
+func Test_newWebhookSink(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		c               *WebhookSinkTypeConfig
+		wantErrIs       error
+		wantErrContains string
+	}{
+		{
+			name:            "missing-config",
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing webhook config",
+		},
+		{
+			name:            "missing-url",
+			c:               &WebhookSinkTypeConfig{},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing webhook url",
+		},
+		{
+			name:            "invalid-url",
+			c:               &WebhookSinkTypeConfig{Url: "tcp://localhost:8080"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid http or https url",
+		},
+		{
+			name:            "negative-batch-size",
+			c:               &WebhookSinkTypeConfig{Url: "https://localhost", BatchSize: -1},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "batch size must not be negative",
+		},
+		{
+			name:            "negative-max-retries",
+			c:               &WebhookSinkTypeConfig{Url: "https://localhost", MaxRetries: -1},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "max retries must not be negative",
+		},
+		{
+			name:            "negative-queue-size",
+			c:               &WebhookSinkTypeConfig{Url: "https://localhost", QueueSize: -1},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "queue size must not be negative",
+		},
+		{
+			name:            "invalid-delivery-guarantee",
+			c:               &WebhookSinkTypeConfig{Url: "https://localhost", DeliveryGuarantee: "sometimes"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name:            "invalid-ca-cert",
+			c:               &WebhookSinkTypeConfig{Url: "https://localhost", TlsCaCert: "not a cert"},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "unable to parse CA certificate",
+		},
+		{
+			name: "valid",
+			c:    &WebhookSinkTypeConfig{Url: "https://localhost"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := newWebhookSink(JSONSinkFormat, tt.c, nil)
+			if tt.wantErrIs != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tt.wantErrIs)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(defaultWebhookBatchSize, got.batchSize)
+			assert.Equal(defaultWebhookBatchTimeout, got.batchTimeout)
+			assert.Equal(defaultWebhookMaxRetries, got.client.RetryMax)
+			assert.Equal(defaultWebhookQueueSize, cap(got.queue))
+			assert.Equal(BestEffort, got.guarantee)
+		})
+	}
+}
+
+func TestWebhookSink_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newEvent := func(format SinkFormat, payload string) *eventlogger.Event {
+		return &eventlogger.Event{
+			Type:      eventlogger.EventType(AuditType),
+			CreatedAt: time.Now(),
+			Formatted: map[string][]byte{string(format): []byte(payload + "\n")},
+		}
+	}
+
+	t.Run("headers-and-signature", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(JSONSinkFormat, &WebhookSinkTypeConfig{
+			Url:        srv.URL,
+			Headers:    map[string]string{"Authorization": "Bearer token"},
+			HmacSecret: "secret",
+		}, nil)
+		require.NoError(err)
+
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"1"}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+
+		got := srv.received()
+		require.Len(got, 1)
+		assert.Equal(`[{"id":"1"}]`, got[0].body)
+		assert.Equal("application/json", got[0].header.Get("Content-Type"))
+		assert.Equal("Bearer token", got[0].header.Get("Authorization"))
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(got[0].body))
+		assert.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), got[0].header.Get(WebhookSignatureHeader))
+	})
+
+	t.Run("batch-size", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(TextSinkFormat, &WebhookSinkTypeConfig{
+			Url:          srv.URL,
+			BatchSize:    2,
+			BatchTimeout: time.Hour,
+		}, nil)
+		require.NoError(err)
+
+		for i := 0; i < 3; i++ {
+			_, err = s.Process(ctx, newEvent(TextSinkFormat, fmt.Sprintf("event %d", i)))
+			require.NoError(err)
+		}
+		require.Eventually(func() bool { return len(srv.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		got := srv.received()
+		assert.Equal("event 0\nevent 1\n", got[0].body)
+		assert.Equal("text/plain; charset=utf-8", got[0].header.Get("Content-Type"))
+
+		// flushing sends the incomplete batch
+		require.NoError(s.FlushAll(ctx))
+		got = srv.received()
+		require.Len(got, 2)
+		assert.Equal("event 2\n", got[1].body)
+		require.NoError(s.FlushAll(ctx))
+		assert.Len(srv.received(), 2)
+	})
+
+	t.Run("batch-timeout", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(JSONSinkFormat, &WebhookSinkTypeConfig{
+			Url:          srv.URL,
+			BatchSize:    10,
+			BatchTimeout: 10 * time.Millisecond,
+		}, nil)
+		require.NoError(err)
+
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"1"}`))
+		require.NoError(err)
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"2"}`))
+		require.NoError(err)
+		require.Eventually(func() bool { return len(srv.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(`[{"id":"1"},{"id":"2"}]`, srv.received()[0].body)
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		errs := &testErrorRecorder{}
+		s, err := newWebhookSink(JSONSinkFormat, &WebhookSinkTypeConfig{
+			Url:        srv.URL,
+			MaxRetries: 2,
+		}, errs.record)
+		require.NoError(err)
+		s.client.RetryWaitMin, s.client.RetryWaitMax = time.Millisecond, time.Millisecond
+
+		srv.failNext(2)
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"1"}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Len(srv.received(), 1)
+		assert.Empty(errs.get())
+
+		// a batch which can't be delivered is reported to the error handler,
+		// not to the writer of the event
+		srv.failNext(3)
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"2"}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Len(srv.received(), 1)
+		require.Len(errs.get(), 1)
+		assert.Contains(errs.get()[0].Error(), "unexpected status 503")
+
+		_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"3"}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Len(srv.received(), 2)
+		assert.Len(errs.get(), 1)
+	})
+
+	t.Run("queue-full", func(t *testing.T) {
+		for _, guarantee := range []DeliveryGuarantee{BestEffort, Enforced} {
+			guarantee := guarantee
+			t.Run(string(guarantee), func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+				srv := newTestWebhookServer(t)
+				srv.block()
+				errs := &testErrorRecorder{}
+				s, err := newWebhookSink(JSONSinkFormat, &WebhookSinkTypeConfig{
+					Url:               srv.URL,
+					QueueSize:         1,
+					DeliveryGuarantee: guarantee,
+				}, errs.record)
+				require.NoError(err)
+
+				// the first batch is being delivered and the second fills the
+				// queue
+				_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"1"}`))
+				require.NoError(err)
+				require.Eventually(func() bool { return srv.inFlight() == 1 }, 5*time.Second, 10*time.Millisecond)
+				_, err = s.Process(ctx, newEvent(JSONSinkFormat, `{"id":"2"}`))
+				require.NoError(err)
+
+				timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+				defer cancel()
+				_, err = s.Process(timeoutCtx, newEvent(JSONSinkFormat, `{"id":"3"}`))
+				switch guarantee {
+				case Enforced:
+					require.Error(err)
+					assert.ErrorIs(err, context.DeadlineExceeded)
+					assert.Empty(errs.get())
+				default:
+					require.NoError(err)
+					require.Len(errs.get(), 1)
+					assert.Contains(errs.get()[0].Error(), "dropped 1 events")
+				}
+
+				srv.unblock()
+				require.NoError(s.FlushAll(ctx))
+				got := srv.received()
+				require.Len(got, 2)
+				assert.Equal(`[{"id":"1"}]`, got[0].body)
+				assert.Equal(`[{"id":"2"}]`, got[1].body)
+			})
+		}
+	})
+}
+
+// testErrorRecorder records the errors passed to a sink's error handler.
+type testErrorRecorder struct {
+	l    sync.Mutex
+	errs []error
+}
+
+func (r *testErrorRecorder) record(err error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	r.errs = append(r.errs, err)
+}
+
+func (r *testErrorRecorder) get() []error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	return append([]error(nil), r.errs...)
+}
+
+func TestEventer_WebhookAndSyslogSinks(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	srv := newTestWebhookServer(t)
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	defer pc.Close()
+
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	e, err := NewEventer(testLogger, testLock, "TestEventer_WebhookAndSyslogSinks", EventerConfig{
+		Sinks: []*SinkConfig{
+			{
+				Name:       "webhook",
+				EventTypes: []Type{ErrorType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url:          srv.URL,
+					BatchSize:    10,
+					BatchTimeout: time.Hour,
+				},
+			},
+			{
+				Name:         "syslog",
+				EventTypes:   []Type{ErrorType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: pc.LocalAddr().String()},
+			},
+		},
+	})
+	require.NoError(err)
+
+	testError, err := newError("TestEventer_WebhookAndSyslogSinks", fmt.Errorf("%s: no msg: test", ErrIo))
+	require.NoError(err)
+	require.NoError(e.writeError(ctx, testError))
+
+	buf := make([]byte, 4096)
+	require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	n, _, err := pc.ReadFrom(buf)
+	require.NoError(err)
+	assert.Contains(string(buf[:n]), "TestEventer_WebhookAndSyslogSinks")
+
+	// the webhook batch isn't full, so it's sent when the eventer's nodes are
+	// flushed
+	assert.Empty(srv.received())
+	require.NoError(e.FlushNodes(ctx))
+	got := srv.received()
+	require.Len(got, 1)
+	var events []map[string]interface{}
+	require.NoError(json.Unmarshal([]byte(got[0].body), &events))
+	require.Len(events, 1)
+	assert.Equal(string(ErrorType), events[0]["type"])
+}
@@ -0,0 +1,134 @@
+// Package metric contains the helpers shared by the Prometheus metrics which
+// the controller and the worker expose on their ops listeners.
+package metric
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Namespace is the namespace of all of Boundary's metrics.
+	Namespace = "boundary"
+
+	LabelHttpPath    = "path"
+	LabelHttpMethod  = "method"
+	LabelHttpCode    = "code"
+	LabelGrpcService = "grpc_service"
+	LabelGrpcMethod  = "grpc_method"
+	LabelGrpcCode    = "grpc_code"
+)
+
+// HttpLabels are the labels of the histograms passed to InstrumentHttpHandler.
+var HttpLabels = []string{LabelHttpPath, LabelHttpMethod, LabelHttpCode}
+
+// GrpcLabels are the labels of the histograms passed to
+// NewGrpcServerInterceptor and NewGrpcClientInterceptor.
+var GrpcLabels = []string{LabelGrpcService, LabelGrpcMethod, LabelGrpcCode}
+
+// Handler returns the http.Handler which serves the metrics in the Prometheus
+// exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// InstrumentHttpHandler wraps h so that the latency of every request is
+// observed in v. The path of the request is reduced by pathLabel before it is
+// used as a label, which keeps the cardinality of v bounded.
+func InstrumentHttpHandler(h http.Handler, v *prometheus.HistogramVec, pathLabel func(string) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, code: http.StatusOK}
+		h.ServeHTTP(sw, r)
+		v.With(prometheus.Labels{
+			LabelHttpPath:   pathLabel(r.URL.Path),
+			LabelHttpMethod: strings.ToLower(r.Method),
+			LabelHttpCode:   strconv.Itoa(sw.code),
+		}).Observe(time.Since(start).Seconds())
+	})
+}
+
+// statusWriter records the status code written to the wrapped
+// http.ResponseWriter.
+type statusWriter struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.code = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher when the wrapped http.ResponseWriter does.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped http.ResponseWriter, which allows
+// http.ResponseController and websocket upgrades to reach it.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// NewGrpcServerInterceptor returns a unary server interceptor which observes
+// the latency of every request in v.
+func NewGrpcServerInterceptor(v *prometheus.HistogramVec) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		ObserveGrpc(v, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// NewGrpcClientInterceptor returns a unary client interceptor which observes
+// the latency of every request in v.
+func NewGrpcClientInterceptor(v *prometheus.HistogramVec) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		ObserveGrpc(v, method, err, time.Since(start))
+		return err
+	}
+}
+
+// ObserveGrpc observes the duration d of the call of fullMethod, which
+// returned err, in v.
+func ObserveGrpc(v *prometheus.HistogramVec, fullMethod string, err error, d time.Duration) {
+	service, method := SplitMethodName(fullMethod)
+	v.With(prometheus.Labels{
+		LabelGrpcService: service,
+		LabelGrpcMethod:  method,
+		LabelGrpcCode:    status.Code(err).String(),
+	}).Observe(d.Seconds())
+}
+
+// SplitMethodName splits a full gRPC method name of the form
+// "/package.service/method" into its service and method.
+func SplitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
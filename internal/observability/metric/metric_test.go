@@ -0,0 +1,82 @@
+package metric
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSplitMethodName(t *testing.T) {
+	tests := []struct {
+		in          string
+		wantService string
+		wantMethod  string
+	}{
+		{"/controller.api.services.v1.ScopeService/GetScope", "controller.api.services.v1.ScopeService", "GetScope"},
+		{"controller.api.services.v1.ScopeService/GetScope", "controller.api.services.v1.ScopeService", "GetScope"},
+		{"/nomethod", "unknown", "unknown"},
+		{"", "unknown", "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			service, method := SplitMethodName(tt.in)
+			assert.Equal(t, tt.wantService, service)
+			assert.Equal(t, tt.wantMethod, method)
+		})
+	}
+}
+
+func TestInstrumentHttpHandler(t *testing.T) {
+	v := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_http"}, HttpLabels)
+	h := InstrumentHttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}), v, func(string) string { return "/path" })
+
+	for _, p := range []string{"/a", "/b", "/missing"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, p, nil))
+	}
+	assert.Equal(t, 2, testutil.CollectAndCount(v))
+	assert.Equal(t, uint64(2), sampleCount(t, v.With(prometheus.Labels{LabelHttpPath: "/path", LabelHttpMethod: "get", LabelHttpCode: "200"})))
+	assert.Equal(t, uint64(1), sampleCount(t, v.With(prometheus.Labels{LabelHttpPath: "/path", LabelHttpMethod: "get", LabelHttpCode: "404"})))
+}
+
+func TestNewGrpcServerInterceptor(t *testing.T) {
+	v := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_grpc"}, GrpcLabels)
+	interceptor := NewGrpcServerInterceptor(v)
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	_, err = interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, uint64(1), sampleCount(t, v.With(prometheus.Labels{LabelGrpcService: "test.Service", LabelGrpcMethod: "Method", LabelGrpcCode: "OK"})))
+	assert.Equal(t, uint64(1), sampleCount(t, v.With(prometheus.Labels{LabelGrpcService: "test.Service", LabelGrpcMethod: "Method", LabelGrpcCode: "NotFound"})))
+}
+
+func sampleCount(t *testing.T, o prometheus.Observer) uint64 {
+	t.Helper()
+	c := make(chan prometheus.Metric, 1)
+	o.(prometheus.Histogram).Collect(c)
+	var m dto.Metric
+	require.NoError(t, (<-c).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
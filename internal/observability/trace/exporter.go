@@ -0,0 +1,167 @@
+package trace
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// newSpanExporter creates the exporter set out in c. The OTLP exporters are
+// the upstream OTLP/gRPC and OTLP/HTTP exporters, the file exporter writes the
+// same export requests to a file.
+func newSpanExporter(ctx context.Context, c *Config) (*otlptrace.Exporter, error) {
+	const op = "trace.newSpanExporter"
+	timeout := defaultTimeout
+	if c.Timeout > 0 {
+		timeout = c.Timeout
+	}
+	var client otlptrace.Client
+	var err error
+	switch c.Exporter {
+	case FileExporter:
+		client, err = newFileClient(c.Path)
+	case OtlpExporter:
+		if c.Protocol == HttpProtocol {
+			client, err = newOtlpHttpClient(c, timeout)
+		} else {
+			client, err = newOtlpGrpcClient(c, timeout)
+		}
+	default:
+		err = fmt.Errorf("'%s' is not a valid exporter: %w", c.Exporter, ErrInvalidParameter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// the gRPC connection is established in the background, so a collector
+	// which isn't up yet doesn't prevent the server from starting
+	exp, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return exp, nil
+}
+
+func newOtlpGrpcClient(c *Config, timeout time.Duration) (otlptrace.Client, error) {
+	const op = "trace.newOtlpGrpcClient"
+	endpoint := defaultGrpcAddr
+	if c.Endpoint != "" {
+		endpoint = c.Endpoint
+	}
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(endpoint),
+		otlptracegrpc.WithHeaders(c.Headers),
+		otlptracegrpc.WithTimeout(timeout),
+	}
+	if c.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	} else {
+		tlsConfig, err := clientTlsConfig(c.TlsCaCert)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	return otlptracegrpc.NewClient(opts...), nil
+}
+
+func newOtlpHttpClient(c *Config, timeout time.Duration) (otlptrace.Client, error) {
+	const op = "trace.newOtlpHttpClient"
+	endpoint := defaultHttpUrl
+	if c.Endpoint != "" {
+		endpoint = c.Endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to parse %s: %w", op, endpoint, err)
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(u.Host),
+		otlptracehttp.WithHeaders(c.Headers),
+		otlptracehttp.WithTimeout(timeout),
+	}
+	if u.Path != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	} else {
+		tlsConfig, err := clientTlsConfig(c.TlsCaCert)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+	}
+	return otlptracehttp.NewClient(opts...), nil
+}
+
+// fileClient writes each export request to a file as a line of OTLP/JSON,
+// which can be loaded for offline analysis, e.g. by the OpenTelemetry
+// Collector's file receiver.
+type fileClient struct {
+	l sync.Mutex
+	f *os.File
+}
+
+var _ otlptrace.Client = (*fileClient)(nil)
+
+func newFileClient(path string) (*fileClient, error) {
+	const op = "trace.newFileClient"
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to open %s: %w", op, path, err)
+	}
+	return &fileClient{f: f}, nil
+}
+
+// Start implements otlptrace.Client. The file is opened by newFileClient.
+func (c *fileClient) Start(context.Context) error {
+	return nil
+}
+
+// Stop implements otlptrace.Client and closes the file.
+func (c *fileClient) Stop(context.Context) error {
+	c.l.Lock()
+	defer c.l.Unlock()
+	return c.f.Close()
+}
+
+// UploadTraces implements otlptrace.Client and writes the spans as an export
+// request.
+func (c *fileClient) UploadTraces(_ context.Context, spans []*tracepb.ResourceSpans) error {
+	const op = "trace.(fileClient).UploadTraces"
+	b, err := protojson.Marshal(&coltracepb.ExportTraceServiceRequest{ResourceSpans: spans})
+	if err != nil {
+		return fmt.Errorf("%s: unable to marshal export request: %w", op, err)
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	if _, err := c.f.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("%s: unable to write export request: %w", op, err)
+	}
+	return nil
+}
+
+func clientTlsConfig(caCert string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM([]byte(caCert)); !ok {
+			return nil, fmt.Errorf("unable to parse CA certificate: %w", ErrInvalidParameter)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
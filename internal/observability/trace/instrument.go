@@ -0,0 +1,172 @@
+package trace
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// InstrumentHttpHandler wraps h so that every request is recorded in a
+// server span, which continues the trace of the request's trace context
+// headers. The span is named after the request's method and its path reduced
+// by pathName, which keeps the number of span names bounded.
+func InstrumentHttpHandler(h http.Handler, pathName func(string) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := otel.Tracer(TracerName).Start(ctx, r.Method+" "+pathName(r.URL.Path),
+			oteltrace.WithSpanKind(oteltrace.SpanKindServer),
+			oteltrace.WithAttributes(
+				attribute.String("http.method", r.Method),
+				attribute.String("http.target", r.URL.Path),
+				attribute.String("http.host", r.Host),
+				attribute.String("net.peer.addr", r.RemoteAddr),
+			),
+		)
+		defer span.End()
+
+		sw := &statusWriter{ResponseWriter: w, code: http.StatusOK}
+		h.ServeHTTP(sw, r.WithContext(ctx))
+		span.SetAttributes(attribute.Int("http.status_code", sw.code))
+		if sw.code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(sw.code))
+		}
+	})
+}
+
+// statusWriter records the status code written to the wrapped
+// http.ResponseWriter.
+type statusWriter struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.code = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher when the wrapped http.ResponseWriter does.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker when the wrapped http.ResponseWriter does,
+// which is needed by the worker's websocket upgrades.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not implement http.Hijacker")
+	}
+	w.wroteHeader = true
+	return h.Hijack()
+}
+
+// Unwrap returns the wrapped http.ResponseWriter, which allows
+// http.ResponseController to reach it.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// NewGrpcServerInterceptor returns a unary server interceptor which records
+// every request in a server span, which continues the trace of the request's
+// metadata.
+func NewGrpcServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+		ctx, span := otel.Tracer(TracerName).Start(ctx, strings.TrimPrefix(info.FullMethod, "/"),
+			oteltrace.WithSpanKind(oteltrace.SpanKindServer),
+			oteltrace.WithAttributes(grpcAttributes(info.FullMethod)...),
+		)
+		resp, err := handler(ctx, req)
+		endGrpcSpan(span, err)
+		return resp, err
+	}
+}
+
+// NewGrpcClientInterceptor returns a unary client interceptor which records
+// every request in a client span, and sends the span's trace context in the
+// request's metadata.
+func NewGrpcClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := otel.Tracer(TracerName).Start(ctx, strings.TrimPrefix(method, "/"),
+			oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+			oteltrace.WithAttributes(grpcAttributes(method)...),
+		)
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		propagator.Inject(ctx, metadataCarrier(md))
+		err := invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+		endGrpcSpan(span, err)
+		return err
+	}
+}
+
+// grpcAttributes returns the attributes of a span of the call of fullMethod,
+// which has the form "/package.service/method".
+func grpcAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "grpc")}
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		attrs = append(attrs,
+			attribute.String("rpc.service", fullMethod[:i]),
+			attribute.String("rpc.method", fullMethod[i+1:]),
+		)
+	}
+	return attrs
+}
+
+func endGrpcSpan(span oteltrace.Span, err error) {
+	s, _ := status.FromError(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(s.Code())))
+	End(span, err)
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,103 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func attributeMap(attrs []attribute.KeyValue) map[string]interface{} {
+	m := make(map[string]interface{}, len(attrs))
+	for _, kv := range attrs {
+		m[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	return m
+}
+
+func TestInstrumentHttpHandler(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	exp := testRecorder(t)
+
+	var handlerSpan oteltrace.SpanContext
+	h := InstrumentHttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = oteltrace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), func(string) string { return "/v1/targets/{id}" })
+
+	traceId := "4bf92f3577b34da6a3ce929d0e0e4736"
+	r := httptest.NewRequest(http.MethodPost, "/v1/targets/ttcp_1234567890:authorize-session", nil)
+	r.Header.Set("traceparent", "00-"+traceId+"-00f067aa0ba902b7-01")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	spans := exp.GetSpans()
+	require.Len(spans, 1)
+	got := spans[0]
+	assert.Equal("POST /v1/targets/{id}", got.Name)
+	assert.Equal(oteltrace.SpanKindServer, got.SpanKind)
+	assert.Equal(traceId, got.SpanContext.TraceID().String())
+	assert.Equal("00f067aa0ba902b7", got.Parent.SpanID().String())
+	assert.True(got.Parent.IsRemote())
+	assert.Equal(got.SpanContext.SpanID(), handlerSpan.SpanID())
+	assert.Equal(codes.Error, got.Status.Code)
+	attrs := attributeMap(got.Attributes)
+	assert.Equal("/v1/targets/ttcp_1234567890:authorize-session", attrs["http.target"])
+	assert.Equal(int64(http.StatusServiceUnavailable), attrs["http.status_code"])
+}
+
+func TestGrpcInterceptors(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	exp := testRecorder(t)
+	const method = "/controller.servers.services.v1.SessionService/AuthorizeConnection"
+
+	// the client's outgoing metadata is passed to the server as its incoming
+	// metadata
+	var serverSpan oteltrace.SpanContext
+	serverInterceptor := NewGrpcServerInterceptor()
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		require.True(ok)
+		assert.Equal([]string{"value"}, md.Get("existing"))
+		_, err := serverInterceptor(metadata.NewIncomingContext(ctx, md), req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverSpan = oteltrace.SpanContextFromContext(ctx)
+				return nil, status.Error(grpccodes.NotFound, "session not found")
+			})
+		return err
+	}
+
+	ctx, parent := Start(context.Background(), "worker.handleProxy")
+	ctx = metadata.AppendToOutgoingContext(ctx, "existing", "value")
+	err := NewGrpcClientInterceptor()(ctx, method, nil, nil, nil, invoker)
+	require.Error(err)
+	parent.End()
+
+	spans := exp.GetSpans()
+	require.Len(spans, 3)
+	server, client := spans[0], spans[1]
+	assert.Equal("controller.servers.services.v1.SessionService/AuthorizeConnection", server.Name)
+	assert.Equal(oteltrace.SpanKindServer, server.SpanKind)
+	assert.Equal(oteltrace.SpanKindClient, client.SpanKind)
+	assert.Equal(parent.SpanContext().SpanID(), client.Parent.SpanID())
+	assert.Equal(client.SpanContext.SpanID(), server.Parent.SpanID())
+	assert.Equal(client.SpanContext.TraceID(), server.SpanContext.TraceID())
+	assert.Equal(server.SpanContext.SpanID(), serverSpan.SpanID())
+	for _, got := range spans[:2] {
+		assert.Equal(codes.Error, got.Status.Code)
+		attrs := attributeMap(got.Attributes)
+		assert.Equal("grpc", attrs["rpc.system"])
+		assert.Equal("controller.servers.services.v1.SessionService", attrs["rpc.service"])
+		assert.Equal("AuthorizeConnection", attrs["rpc.method"])
+		assert.Equal(int64(grpccodes.NotFound), attrs["rpc.grpc.status_code"])
+	}
+}
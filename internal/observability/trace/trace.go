@@ -0,0 +1,204 @@
+// Package trace contains the OpenTelemetry tracing shared by the controller,
+// the worker and the CLI. The trace context is propagated between them with
+// the W3C traceparent and tracestate headers, and the spans recorded by a
+// server are exported to an OTLP endpoint or to a file.
+package trace
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer used for all of Boundary's spans.
+const TracerName = "github.com/hashicorp/boundary"
+
+const (
+	// EnvTraceParent is the environment variable which the CLI reads a W3C
+	// traceparent from, so that its requests are part of the caller's trace.
+	EnvTraceParent = "TRACEPARENT"
+	// EnvTraceState is the environment variable which the CLI reads a W3C
+	// tracestate from.
+	EnvTraceState = "TRACESTATE"
+
+	defaultServiceName = "boundary"
+	defaultTimeout     = 10 * time.Second
+	defaultGrpcAddr    = "localhost:4317"
+	defaultHttpUrl     = "http://localhost:4318/v1/traces"
+)
+
+// ErrInvalidParameter is returned when a tracing config or parameter is
+// invalid.
+var ErrInvalidParameter = errors.New("invalid parameter")
+
+// propagator is used to propagate the trace context. It's used directly
+// rather than through the global propagator, so the trace context is
+// propagated by processes which don't export spans, like the CLI.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// ExporterType defines the exporter which spans are sent to.
+type ExporterType string
+
+const (
+	OtlpExporter ExporterType = "otlp" // OtlpExporter sends spans to an OTLP endpoint
+	FileExporter ExporterType = "file" // FileExporter writes spans to a file
+)
+
+// Protocol defines the protocol used by the OTLP exporter.
+type Protocol string
+
+const (
+	GrpcProtocol Protocol = "grpc"          // GrpcProtocol sends spans with OTLP/gRPC
+	HttpProtocol Protocol = "http/protobuf" // HttpProtocol sends spans with OTLP/HTTP
+)
+
+// Config defines the tracing configuration of a server, which is set in the
+// "tracing" block of its "telemetry" stanza.
+type Config struct {
+	Exporter    ExporterType      `hcl:"exporter"`     // Exporter defines where spans are sent
+	Endpoint    string            `hcl:"endpoint"`     // Endpoint defines the host:port (grpc) or URL (http/protobuf) of the OTLP endpoint
+	Protocol    Protocol          `hcl:"protocol"`     // Protocol defines the OTLP protocol (defaults to grpc)
+	Insecure    bool              `hcl:"insecure"`     // Insecure disables TLS for the OTLP endpoint
+	TlsCaCert   string            `hcl:"tls_ca_cert"`  // TlsCaCert defines an optional PEM-encoded CA certificate used to verify the OTLP endpoint
+	Headers     map[string]string `hcl:"headers"`      // Headers defines additional headers sent with each export
+	Timeout     time.Duration     `hcl:"-"`            // Timeout defines the timeout of each export (defaults to 10s)
+	TimeoutHCL  string            `hcl:"timeout"`      // TimeoutHCL defines hcl string version of Timeout
+	Path        string            `hcl:"path"`         // Path defines the file written by the file exporter
+	SampleRatio *float64          `hcl:"sample_ratio"` // SampleRatio defines the ratio of new traces which are sampled (defaults to 1)
+	ServiceName string            `hcl:"service_name"` // ServiceName defines the service.name of the server's spans (defaults to boundary)
+}
+
+// Validate the tracing config.
+func (c *Config) Validate() error {
+	const op = "trace.(Config).Validate"
+	switch c.Exporter {
+	case OtlpExporter:
+		switch c.Protocol {
+		case "", GrpcProtocol:
+		case HttpProtocol:
+			if c.Endpoint != "" {
+				u, err := url.Parse(c.Endpoint)
+				if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+					return fmt.Errorf("%s: '%s' is not a valid http or https url: %w", op, c.Endpoint, ErrInvalidParameter)
+				}
+			}
+		default:
+			return fmt.Errorf("%s: '%s' is not a valid otlp protocol: %w", op, c.Protocol, ErrInvalidParameter)
+		}
+	case FileExporter:
+		if c.Path == "" {
+			return fmt.Errorf("%s: missing file exporter path: %w", op, ErrInvalidParameter)
+		}
+	case "":
+		return fmt.Errorf("%s: missing exporter: %w", op, ErrInvalidParameter)
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid exporter: %w", op, c.Exporter, ErrInvalidParameter)
+	}
+	switch {
+	case c.Timeout < 0:
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	case c.SampleRatio != nil && (*c.SampleRatio < 0 || *c.SampleRatio > 1):
+		return fmt.Errorf("%s: sample ratio must be between 0 and 1: %w", op, ErrInvalidParameter)
+	}
+	if c.TlsCaCert != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(c.TlsCaCert)); !ok {
+			return fmt.Errorf("%s: unable to parse CA certificate: %w", op, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// NewTracerProvider creates a tracer provider which exports the spans of the
+// server named serverName as set out in c. The provider must be shut down to
+// flush the spans which haven't been exported yet.
+func NewTracerProvider(ctx context.Context, c *Config, serverName string) (*sdktrace.TracerProvider, error) {
+	const op = "trace.NewTracerProvider"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	exp, err := newSpanExporter(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	serviceName := defaultServiceName
+	if c.ServiceName != "" {
+		serviceName = c.ServiceName
+	}
+	attrs := []attribute.KeyValue{attribute.String("service.name", serviceName)}
+	if serverName != "" {
+		attrs = append(attrs, attribute.String("service.instance.id", serverName))
+	}
+	if host, err := os.Hostname(); err == nil {
+		attrs = append(attrs, attribute.String("host.name", host))
+	}
+	ratio := 1.0
+	if c.SampleRatio != nil {
+		ratio = *c.SampleRatio
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewSchemaless(attrs...)),
+		// the sampling decision of a remote parent, like the CLI or the
+		// controller, is honored so traces aren't broken up
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	), nil
+}
+
+// Start starts a span which is a child of the span in ctx, if any. The span
+// must be ended, typically with End.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, oteltrace.WithAttributes(attrs...))
+}
+
+// End ends the span, recording err and setting the span's status to error
+// when err is not nil.
+func End(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ContextFromEnv returns a copy of ctx which carries the remote span context
+// set in the TRACEPARENT and TRACESTATE environment variables. ctx is
+// returned unchanged when they're not set or not valid.
+func ContextFromEnv(ctx context.Context) context.Context {
+	h := http.Header{}
+	if v := os.Getenv(EnvTraceParent); v != "" {
+		h.Set("traceparent", v)
+	}
+	if v := os.Getenv(EnvTraceState); v != "" {
+		h.Set("tracestate", v)
+	}
+	if len(h) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.HeaderCarrier(h))
+}
+
+// InjectHttpHeaders sets the trace context headers of the span in ctx in h.
+func InjectHttpHeaders(ctx context.Context, h http.Header) {
+	if h == nil {
+		return
+	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(h))
+}
@@ -0,0 +1,194 @@
+package trace
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// testRecorder installs a global tracer provider which records spans in
+// memory for the duration of the test. Tests which use it can't run in
+// parallel.
+func testRecorder(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+	return exp
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+	negative, tooLarge := -0.1, 1.5
+	tests := []struct {
+		name            string
+		c               *Config
+		wantErrContains string
+	}{
+		{
+			name:            "missing-exporter",
+			c:               &Config{},
+			wantErrContains: "missing exporter",
+		},
+		{
+			name:            "invalid-exporter",
+			c:               &Config{Exporter: "zipkin"},
+			wantErrContains: "not a valid exporter",
+		},
+		{
+			name:            "invalid-protocol",
+			c:               &Config{Exporter: OtlpExporter, Protocol: "http/json"},
+			wantErrContains: "not a valid otlp protocol",
+		},
+		{
+			name:            "invalid-http-endpoint",
+			c:               &Config{Exporter: OtlpExporter, Protocol: HttpProtocol, Endpoint: "localhost:4318"},
+			wantErrContains: "not a valid http or https url",
+		},
+		{
+			name:            "missing-file-path",
+			c:               &Config{Exporter: FileExporter},
+			wantErrContains: "missing file exporter path",
+		},
+		{
+			name:            "negative-timeout",
+			c:               &Config{Exporter: OtlpExporter, Timeout: -1},
+			wantErrContains: "timeout must not be negative",
+		},
+		{
+			name:            "negative-sample-ratio",
+			c:               &Config{Exporter: OtlpExporter, SampleRatio: &negative},
+			wantErrContains: "sample ratio must be between 0 and 1",
+		},
+		{
+			name:            "sample-ratio-too-large",
+			c:               &Config{Exporter: OtlpExporter, SampleRatio: &tooLarge},
+			wantErrContains: "sample ratio must be between 0 and 1",
+		},
+		{
+			name:            "invalid-ca-cert",
+			c:               &Config{Exporter: OtlpExporter, TlsCaCert: "not a cert"},
+			wantErrContains: "unable to parse CA certificate",
+		},
+		{
+			name: "valid-otlp-grpc",
+			c:    &Config{Exporter: OtlpExporter, Endpoint: "collector:4317", Insecure: true},
+		},
+		{
+			name: "valid-otlp-http",
+			c:    &Config{Exporter: OtlpExporter, Protocol: HttpProtocol, Endpoint: "https://collector:4318/v1/traces"},
+		},
+		{
+			name: "valid-file",
+			c:    &Config{Exporter: FileExporter, Path: "/var/log/boundary/traces.json"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			err := tt.c.Validate()
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.ErrorIs(err, ErrInvalidParameter)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestNewTracerProvider_File(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	tp, err := NewTracerProvider(ctx, &Config{Exporter: FileExporter, Path: path}, "controller-1")
+	require.NoError(err)
+	tracer := tp.Tracer(TracerName)
+	ctx, parent := tracer.Start(ctx, "parent")
+	_, child := tracer.Start(ctx, "child")
+	End(child, os.ErrNotExist)
+	parent.End()
+	require.NoError(tp.Shutdown(context.Background()))
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+	var spans []*tracepb.Span
+	var resourceAttrs map[string]string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var req coltracepb.ExportTraceServiceRequest
+		require.NoError(protojson.Unmarshal(sc.Bytes(), &req))
+		for _, rs := range req.ResourceSpans {
+			resourceAttrs = map[string]string{}
+			for _, kv := range rs.Resource.Attributes {
+				resourceAttrs[kv.Key] = kv.Value.GetStringValue()
+			}
+			for _, ils := range rs.InstrumentationLibrarySpans {
+				assert.Equal(TracerName, ils.InstrumentationLibrary.Name)
+				spans = append(spans, ils.Spans...)
+			}
+		}
+	}
+	require.NoError(sc.Err())
+	assert.Equal("boundary", resourceAttrs["service.name"])
+	assert.Equal("controller-1", resourceAttrs["service.instance.id"])
+
+	require.Len(spans, 2)
+	assert.Equal("child", spans[0].Name)
+	assert.Equal("parent", spans[1].Name)
+	assert.Equal(spans[1].TraceId, spans[0].TraceId)
+	assert.Equal(spans[1].SpanId, spans[0].ParentSpanId)
+	assert.Empty(spans[1].ParentSpanId)
+	assert.Equal(tracepb.Status_STATUS_CODE_ERROR, spans[0].Status.Code)
+	assert.Equal(os.ErrNotExist.Error(), spans[0].Status.Message)
+	require.Len(spans[0].Events, 1)
+	assert.Equal("exception", spans[0].Events[0].Name)
+}
+
+func TestContextFromEnv(t *testing.T) {
+	assert := assert.New(t)
+	exp := testRecorder(t)
+
+	t.Setenv(EnvTraceParent, "")
+	assert.Equal(context.Background(), ContextFromEnv(context.Background()))
+
+	traceId := "4bf92f3577b34da6a3ce929d0e0e4736"
+	t.Setenv(EnvTraceParent, "00-"+traceId+"-00f067aa0ba902b7-01")
+	t.Setenv(EnvTraceState, "vendor=value")
+	ctx := ContextFromEnv(context.Background())
+	h := http.Header{}
+	InjectHttpHeaders(ctx, h)
+	assert.Equal("00-"+traceId+"-00f067aa0ba902b7-01", h.Get("traceparent"))
+	assert.Equal("vendor=value", h.Get("tracestate"))
+
+	_, span := Start(ctx, "cli")
+	End(span, nil)
+	spans := exp.GetSpans()
+	assert.Len(spans, 1)
+	assert.Equal(traceId, spans[0].SpanContext.TraceID().String())
+	assert.Equal(codes.Unset, spans[0].Status.Code)
+}
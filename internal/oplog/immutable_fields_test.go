@@ -140,3 +140,37 @@ func testCloneEntry(e *Entry) *Entry {
 		Entry: cp.(*store.Entry),
 	}
 }
+
+func Test_RewrapData(t *testing.T) {
+	cleanup, db := setup(t)
+	defer testCleanup(t, cleanup, db)
+	cipherer := testWrapper(t)
+	assert, require := assert.New(t), require.New(t)
+
+	writer := &GormWriter{db}
+	u := oplog_test.TestUser{
+		Name: "foo-" + testId(t),
+	}
+
+	ticketer, err := NewGormTicketer(db, WithAggregateNames(true))
+	require.NoError(err)
+	ticket, err := ticketer.GetTicket("default")
+	require.NoError(err)
+
+	new, err := NewEntry("test-users", Metadata{"deployment": []string{"amex"}}, cipherer, ticketer)
+	require.NoError(err)
+	err = new.WriteEntryWith(context.Background(), writer, ticket,
+		&Message{Message: &u, TypeName: "user", OpType: OpType_OP_TYPE_CREATE})
+	require.NoError(err)
+
+	// The data can be updated when it is re-encrypted with another key version.
+	update := testCloneEntry(new)
+	update.CtData = []byte("Lorem Ipsum")
+	update.KeyId = "kopkv_rewrapped"
+	require.NoError(writer.Update(update, []string{"CtData", "KeyId"}, nil))
+
+	after := testCloneEntry(new)
+	require.NoError(db.First(&after).Error)
+	assert.Equal([]byte("Lorem Ipsum"), after.CtData)
+	assert.Equal("kopkv_rewrapped", after.KeyId)
+}
@@ -185,13 +185,15 @@ func (e *Entry) Write(ctx context.Context, tx Writer, ticket *store.Ticket) erro
 	return nil
 }
 
-// EncryptData the entry's data using its Cipherer (wrapping.Wrapper)
+// EncryptData the entry's data using its Cipherer (wrapping.Wrapper) and
+// records the id of the key used
 func (e *Entry) EncryptData(ctx context.Context) error {
 	const op = "oplog.(Entry).EncryptData"
 	// structwrapping doesn't support embedding, so we'll pass in the store.Entry directly
 	if err := structwrapping.WrapStruct(ctx, e.Cipherer, e.Entry, nil); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
 	}
+	e.KeyId = e.Cipherer.KeyID()
 	return nil
 }
 
@@ -14,6 +14,7 @@ construction is thus synthesizing something reasonable from a set of Grants.
 */
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -36,6 +37,15 @@ type ACLResults struct {
 	Authorized             bool
 	OutputFields           OutputFieldsMap
 
+	// DenialReasons lists, for each grant which matches the resource and
+	// action but was not applied, the condition the request did not meet. If
+	// a deny grant applies, it is listed instead.
+	DenialReasons []string
+
+	// Denied is true if a deny grant explicitly denies the action, as opposed
+	// to no grant allowing it.
+	Denied bool
+
 	// This is included but unexported for testing/debugging
 	scopeMap map[string][]Grant
 }
@@ -71,7 +81,11 @@ func NewACL(grants ...Grant) ACL {
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
-func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
+// The WithClientIp and WithNow options provide the context of the request
+// which grant conditions are evaluated against. A deny grant matching the
+// resource and action always takes precedence over grants allowing it.
+func (a ACL) Allowed(r Resource, aType action.Type, opt ...Option) (results ACLResults) {
+	opts := getOpts(opt...)
 	// First, get the grants within the specified scope
 	grants := a.scopeMap[r.ScopeId]
 	results.scopeMap = a.scopeMap
@@ -81,8 +95,26 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	if len(split) == 2 {
 		parentAction = action.Map[split[0]]
 	}
+
+	// Deny grants are checked first as they override anything allowed by
+	// other grants. A deny grant with unmet conditions doesn't apply.
+	for _, grant := range grants {
+		if !grant.deny ||
+			!grant.matchesAction(aType, parentAction) ||
+			!grant.matchesResource(r, aType) ||
+			grant.conditions.unmet(opts) != "" {
+			continue
+		}
+		results.Denied = true
+		results.DenialReasons = append(results.DenialReasons, fmt.Sprintf("grant %q in scope %s: denies the action", grant.CanonicalString(), grant.scope.Id))
+		return
+	}
+
 	// Now, go through and check the cases indicated above
 	for _, grant := range grants {
+		if grant.deny {
+			continue
+		}
 		var outputFieldsOnly bool
 		switch {
 		case len(grant.actions) == 0:
@@ -94,14 +126,7 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			} else {
 				continue
 			}
-		case grant.actions[aType]:
-			// We have this action
-		case grant.actions[parentAction]:
-			// We don't have this action, but it's a subaction and we have the
-			// parent action. As an example, if we are looking for "read:self"
-			// and have "read", this is sufficient.
-		case grant.actions[action.All]:
-			// All actions are allowed
+		case grant.matchesAction(aType, parentAction):
 		default:
 			// No actions in the grant match what we're looking for, so continue
 			// with the next grant
@@ -114,54 +139,15 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 		// If the action was not found above but we did find output fields in
 		// patterns that match, we do not authorize the request, but we do build
 		// up the output fields patterns.
-		var found bool
-		switch {
-		// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
-		// id=<resource.id>;output_fields=<fields> where fields cannot be a
-		// wildcard.
-		case grant.id == r.Id &&
-			grant.id != "" &&
-			grant.id != "*" &&
-			grant.typ == resource.Unknown &&
-			aType != action.List &&
-			aType != action.Create:
-
-			found = true
-
-		// type=<resource.type>;actions=<action> when action is list or create.
-		// Must be a top level collection, otherwise must be one of the two
-		// formats specified below. Or,
-		// type=resource.type;output_fields=<fields> and no action.
-		case grant.id == "" &&
-			r.Id == "" &&
-			grant.typ == r.Type &&
-			grant.typ != resource.Unknown &&
-			topLevelType(r.Type) &&
-			(aType == action.List ||
-				aType == action.Create):
-
-			found = true
-
-		// id=*;type=<resource.type>;actions=<action> where type cannot be
-		// unknown but can be a wildcard to allow any resource at all; or
-		// id=*;type=<resource.type>;output_fields=<fields> with no action.
-		case grant.id == "*" &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type ||
-				grant.typ == resource.All):
-
-			found = true
-
-		// id=<pin>;type=<resource.type>;actions=<action> where type can be a
-		// wildcard and this this is operating on a non-top-level type. Same for
-		// output fields only.
-		case grant.id != "" &&
-			grant.id == r.Pin &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type || grant.typ == resource.All) &&
-			!topLevelType(r.Type):
-
-			found = true
+		found := grant.matchesResource(r, aType)
+
+		if found && !grant.conditions.empty() {
+			if reason := grant.conditions.unmet(opts); reason != "" {
+				if !outputFieldsOnly {
+					results.DenialReasons = append(results.DenialReasons, fmt.Sprintf("grant %q in scope %s: %s", grant.CanonicalString(), grant.scope.Id, reason))
+				}
+				continue
+			}
 		}
 
 		if found {
@@ -176,6 +162,78 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	return
 }
 
+// matchesAction reports whether the actions of the grant include the action
+// being checked.
+func (g Grant) matchesAction(aType, parentAction action.Type) bool {
+	switch {
+	case g.actions[aType]:
+		// We have this action
+	case g.actions[parentAction]:
+		// We don't have this action, but it's a subaction and we have the
+		// parent action. As an example, if we are looking for "read:self"
+		// and have "read", this is sufficient.
+	case g.actions[action.All]:
+		// All actions are allowed
+	default:
+		return false
+	}
+	return true
+}
+
+// matchesResource reports whether the grant applies to the resource for the
+// action being checked.
+func (g Grant) matchesResource(r Resource, aType action.Type) bool {
+	switch {
+	// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
+	// id=<resource.id>;output_fields=<fields> where fields cannot be a
+	// wildcard.
+	case g.id == r.Id &&
+		g.id != "" &&
+		g.id != "*" &&
+		g.typ == resource.Unknown &&
+		aType != action.List &&
+		aType != action.Create:
+
+		return true
+
+	// type=<resource.type>;actions=<action> when action is list or create.
+	// Must be a top level collection, otherwise must be one of the two
+	// formats specified below. Or,
+	// type=resource.type;output_fields=<fields> and no action.
+	case g.id == "" &&
+		r.Id == "" &&
+		g.typ == r.Type &&
+		g.typ != resource.Unknown &&
+		topLevelType(r.Type) &&
+		(aType == action.List ||
+			aType == action.Create):
+
+		return true
+
+	// id=*;type=<resource.type>;actions=<action> where type cannot be
+	// unknown but can be a wildcard to allow any resource at all; or
+	// id=*;type=<resource.type>;output_fields=<fields> with no action.
+	case g.id == "*" &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type ||
+			g.typ == resource.All):
+
+		return true
+
+	// id=<pin>;type=<resource.type>;actions=<action> where type can be a
+	// wildcard and this this is operating on a non-top-level type. Same for
+	// output fields only.
+	case g.id != "" &&
+		g.id == r.Pin &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type || g.typ == resource.All) &&
+		!topLevelType(r.Type):
+
+		return true
+	}
+	return false
+}
+
 func topLevelType(typ resource.Type) bool {
 	switch typ {
 	case resource.AuthMethod,
@@ -186,8 +244,11 @@ func topLevelType(typ resource.Type) bool {
 		resource.Role,
 		resource.Scope,
 		resource.Session,
+		resource.SessionRecording,
+		resource.AccessRequest,
 		resource.Target,
-		resource.User:
+		resource.User,
+		resource.Worker:
 		return true
 	}
 	return false
@@ -337,6 +337,138 @@ func Test_ACLAllowed(t *testing.T) {
 	}
 }
 
+func Test_ACLAllowedDeny(t *testing.T) {
+	t.Parallel()
+
+	allowAll := "id=*;type=*;actions=*"
+	tests := []struct {
+		name          string
+		scope         string
+		grants        []string
+		resource      Resource
+		action        action.Type
+		opts          []Option
+		authorized    bool
+		denied        bool
+		denialReasons []string
+	}{
+		{
+			name:       "no deny grant",
+			grants:     []string{allowAll},
+			resource:   Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:     action.AuthorizeSession,
+			authorized: true,
+		},
+		{
+			name:     "deny overrides wildcard allow",
+			grants:   []string{allowAll, "id=*;type=target;actions=authorize-session;effect=deny"},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:   action.AuthorizeSession,
+			denied:   true,
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=authorize-session;effect=deny" in scope p_a: denies the action`,
+			},
+		},
+		{
+			name:       "deny of other action",
+			grants:     []string{allowAll, "id=*;type=target;actions=authorize-session;effect=deny"},
+			resource:   Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:     action.Read,
+			authorized: true,
+		},
+		{
+			name:     "deny by id regardless of grant order",
+			grants:   []string{"id=ttcp_1234567890;actions=delete;effect=deny", allowAll},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:   action.Delete,
+			denied:   true,
+			denialReasons: []string{
+				`grant "id=ttcp_1234567890;actions=delete;effect=deny" in scope p_a: denies the action`,
+			},
+		},
+		{
+			name:       "deny by id of other resource",
+			grants:     []string{"id=ttcp_1234567890;actions=delete;effect=deny", allowAll},
+			resource:   Resource{ScopeId: "p_a", Id: "ttcp_0987654321", Type: resource.Target},
+			action:     action.Delete,
+			authorized: true,
+		},
+		{
+			name:     "deny of parent action",
+			scope:    "o_a",
+			grants:   []string{allowAll, "id=*;type=account;actions=read;effect=deny"},
+			resource: Resource{ScopeId: "o_a", Id: "acctpw_1234567890", Type: resource.Account, Pin: "ampw_1234567890"},
+			action:   action.ReadSelf,
+			denied:   true,
+			denialReasons: []string{
+				`grant "id=*;type=account;actions=read;effect=deny" in scope o_a: denies the action`,
+			},
+		},
+		{
+			name:     "deny of collection action",
+			grants:   []string{allowAll, "type=target;actions=list;effect=deny"},
+			resource: Resource{ScopeId: "p_a", Type: resource.Target},
+			action:   action.List,
+			denied:   true,
+			denialReasons: []string{
+				`grant "type=target;actions=list;effect=deny" in scope p_a: denies the action`,
+			},
+		},
+		{
+			name:       "deny in other scope",
+			grants:     []string{allowAll, "id=*;type=target;actions=*;effect=deny"},
+			resource:   Resource{ScopeId: "p_b", Id: "ttcp_1234567890", Type: resource.Target},
+			action:     action.Read,
+			authorized: false,
+		},
+		{
+			name:     "deny with met conditions",
+			grants:   []string{allowAll, "id=*;type=target;actions=*;client_ip=203.0.113.0/24;effect=deny"},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:   action.Read,
+			opts:     []Option{WithClientIp("203.0.113.9")},
+			denied:   true,
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=*;effect=deny;client_ip=203.0.113.0/24" in scope p_a: denies the action`,
+			},
+		},
+		{
+			name:       "deny with unmet conditions",
+			grants:     []string{allowAll, "id=*;type=target;actions=*;client_ip=203.0.113.0/24;effect=deny"},
+			resource:   Resource{ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			action:     action.Read,
+			opts:       []Option{WithClientIp("10.0.0.1")},
+			authorized: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			scopeId := "p_a"
+			if test.scope != "" {
+				scopeId = test.scope
+			}
+			var grants []Grant
+			for _, g := range test.grants {
+				grant, err := Parse(scopeId, g)
+				require.NoError(err)
+				grants = append(grants, grant)
+			}
+			acl := NewACL(grants...)
+			result := acl.Allowed(test.resource, test.action, test.opts...)
+			assert.Equal(test.authorized, result.Authorized)
+			assert.Equal(test.denied, result.Denied)
+			assert.Equal(test.denialReasons, result.DenialReasons)
+			if test.denied {
+				assert.Empty(result.OutputFields)
+			}
+		})
+	}
+}
+
 func TestJsonMarshal(t *testing.T) {
 	res := &Resource{
 		ScopeId: "scope",
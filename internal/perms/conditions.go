@@ -0,0 +1,281 @@
+package perms
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// weekdays maps the day names accepted in grant strings to their weekday, in
+// the order of the week.
+var weekdays = []struct {
+	name string
+	day  time.Weekday
+}{
+	{"sun", time.Sunday},
+	{"mon", time.Monday},
+	{"tue", time.Tuesday},
+	{"wed", time.Wednesday},
+	{"thu", time.Thursday},
+	{"fri", time.Friday},
+	{"sat", time.Saturday},
+}
+
+// conditions restrict when a grant applies based on the context of the
+// request. A grant with no conditions always applies.
+type conditions struct {
+	// The networks the client IP of the request must be within, if any
+	clientIps []*net.IPNet
+
+	// The days of the week the request must be made on, if any
+	days map[time.Weekday]bool
+
+	// The time of day window the request must be made within, in minutes
+	// since midnight. The window ends at hoursEnd, exclusive, and wraps
+	// past midnight if hoursEnd is before hoursStart.
+	hasHours   bool
+	hoursStart int
+	hoursEnd   int
+
+	// The timezone days and hours are evaluated in; UTC if not set
+	timezone *time.Location
+}
+
+func (c conditions) empty() bool {
+	return len(c.clientIps) == 0 && len(c.days) == 0 && !c.hasHours
+}
+
+func (c conditions) clone() conditions {
+	ret := conditions{
+		hasHours:   c.hasHours,
+		hoursStart: c.hoursStart,
+		hoursEnd:   c.hoursEnd,
+		timezone:   c.timezone,
+	}
+	if c.clientIps != nil {
+		ret.clientIps = append(ret.clientIps, c.clientIps...)
+	}
+	if c.days != nil {
+		ret.days = make(map[time.Weekday]bool, len(c.days))
+		for d := range c.days {
+			ret.days[d] = true
+		}
+	}
+	return ret
+}
+
+// parseClientIps parses a list of CIDRs or single IP addresses.
+func (c *conditions) parseClientIps(values []string) error {
+	const op = "perms.(conditions).parseClientIps"
+	if len(values) == 0 {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "empty client_ip found")
+	}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return errors.NewDeprecated(errors.InvalidParameter, op, "empty client_ip found")
+		}
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as an IP address or CIDR", v))
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			c.clientIps = append(c.clientIps, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as an IP address or CIDR", v))
+		}
+		c.clientIps = append(c.clientIps, ipNet)
+	}
+	return nil
+}
+
+// parseDays parses a list of day names or ranges of day names, such as
+// "mon-fri". Ranges may wrap past the end of the week.
+func (c *conditions) parseDays(values []string) error {
+	const op = "perms.(conditions).parseDays"
+	if len(values) == 0 {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "empty days found")
+	}
+	dayIndex := func(name string) (int, error) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for i, wd := range weekdays {
+			if wd.name == name {
+				return i, nil
+			}
+		}
+		return 0, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown day %q", name))
+	}
+	if c.days == nil {
+		c.days = make(map[time.Weekday]bool, len(weekdays))
+	}
+	for _, v := range values {
+		bounds := strings.Split(v, "-")
+		if len(bounds) > 2 {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as a day or range of days", v))
+		}
+		start, err := dayIndex(bounds[0])
+		if err != nil {
+			return err
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = dayIndex(bounds[1]); err != nil {
+				return err
+			}
+		}
+		for i := start; ; i = (i + 1) % len(weekdays) {
+			c.days[weekdays[i].day] = true
+			if i == end {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// parseHours parses a time of day window such as "09:00-17:00".
+func (c *conditions) parseHours(value string) error {
+	const op = "perms.(conditions).parseHours"
+	bounds := strings.Split(value, "-")
+	if len(bounds) != 2 {
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as a time of day window", value))
+	}
+	minutes := make([]int, 0, 2)
+	for _, b := range bounds {
+		t, err := time.Parse("15:04", strings.TrimSpace(b))
+		if err != nil {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as a time of day window", value))
+		}
+		minutes = append(minutes, t.Hour()*60+t.Minute())
+	}
+	if minutes[0] == minutes[1] {
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("time of day window %q is empty", value))
+	}
+	c.hasHours, c.hoursStart, c.hoursEnd = true, minutes[0], minutes[1]
+	return nil
+}
+
+// parseTimezone parses a named timezone such as "America/New_York".
+func (c *conditions) parseTimezone(value string) error {
+	const op = "perms.(conditions).parseTimezone"
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown timezone %q", value))
+	}
+	c.timezone = loc
+	return nil
+}
+
+// validate checks that the conditions make sense together.
+func (c conditions) validate() error {
+	const op = "perms.(conditions).validate"
+	if c.timezone != nil && len(c.days) == 0 && !c.hasHours {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "timezone can only be specified with days or hours")
+	}
+	return nil
+}
+
+func (c conditions) clientIpStrings() []string {
+	ret := make([]string, 0, len(c.clientIps))
+	for _, n := range c.clientIps {
+		ret = append(ret, n.String())
+	}
+	return ret
+}
+
+func (c conditions) dayStrings() []string {
+	ret := make([]string, 0, len(c.days))
+	for _, wd := range weekdays {
+		if c.days[wd.day] {
+			ret = append(ret, wd.name)
+		}
+	}
+	return ret
+}
+
+func (c conditions) hoursString() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", c.hoursStart/60, c.hoursStart%60, c.hoursEnd/60, c.hoursEnd%60)
+}
+
+// canonicalSegments returns the canonical grant string segments of the
+// conditions.
+func (c conditions) canonicalSegments() []string {
+	var segments []string
+	if len(c.clientIps) > 0 {
+		ips := c.clientIpStrings()
+		sort.Strings(ips)
+		segments = append(segments, fmt.Sprintf("client_ip=%s", strings.Join(ips, ",")))
+	}
+	if len(c.days) > 0 {
+		segments = append(segments, fmt.Sprintf("days=%s", strings.Join(c.dayStrings(), ",")))
+	}
+	if c.hasHours {
+		segments = append(segments, fmt.Sprintf("hours=%s", c.hoursString()))
+	}
+	if c.timezone != nil {
+		segments = append(segments, fmt.Sprintf("timezone=%s", c.timezone.String()))
+	}
+	return segments
+}
+
+// unmet returns a reason the conditions are not met by the request, or an
+// empty string if they are.
+func (c conditions) unmet(opts options) string {
+	if len(c.clientIps) > 0 {
+		ip := net.ParseIP(opts.withClientIp)
+		var matched bool
+		for _, n := range c.clientIps {
+			if ip != nil && n.Contains(ip) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			clientIp := opts.withClientIp
+			if clientIp == "" {
+				clientIp = "unknown"
+			}
+			return fmt.Sprintf("client IP %s is not within %s", clientIp, strings.Join(c.clientIpStrings(), ","))
+		}
+	}
+	if len(c.days) == 0 && !c.hasHours {
+		return ""
+	}
+	now := opts.withNow
+	if now.IsZero() {
+		now = time.Now()
+	}
+	loc := c.timezone
+	if loc == nil {
+		loc = time.UTC
+	}
+	now = now.In(loc)
+	if len(c.days) > 0 && !c.days[now.Weekday()] {
+		return fmt.Sprintf("day %s in %s is not one of %s", strings.ToLower(now.Weekday().String()[:3]), loc, strings.Join(c.dayStrings(), ","))
+	}
+	if c.hasHours {
+		minute := now.Hour()*60 + now.Minute()
+		var within bool
+		switch {
+		case c.hoursStart < c.hoursEnd:
+			within = minute >= c.hoursStart && minute < c.hoursEnd
+		default:
+			within = minute >= c.hoursStart || minute < c.hoursEnd
+		}
+		if !within {
+			return fmt.Sprintf("time %s in %s is not within %s", now.Format("15:04"), loc, c.hoursString())
+		}
+	}
+	return ""
+}
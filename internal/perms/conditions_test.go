@@ -0,0 +1,221 @@
+package perms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseConditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		err       string
+		canonical string
+		json      string
+	}{
+		{
+			name:      "client ip cidr",
+			input:     "id=*;type=target;actions=read;client_ip=10.0.0.0/8",
+			canonical: "id=*;type=target;actions=read;client_ip=10.0.0.0/8",
+			json:      `{"actions":["read"],"client_ip":["10.0.0.0/8"],"id":"*","type":"target"}`,
+		},
+		{
+			name:      "client ip list is sorted and single addresses are widened",
+			input:     "id=*;type=target;actions=read;client_ip=192.168.1.7,10.1.2.3/8",
+			canonical: "id=*;type=target;actions=read;client_ip=10.0.0.0/8,192.168.1.7/32",
+		},
+		{
+			name:      "days and hours in timezone",
+			input:     "id=*;type=target;actions=authorize-session;days=mon-fri;hours=09:00-17:00;timezone=Europe/Berlin",
+			canonical: "id=*;type=target;actions=authorize-session;days=mon,tue,wed,thu,fri;hours=09:00-17:00;timezone=Europe/Berlin",
+			json:      `{"actions":["authorize-session"],"days":["mon","tue","wed","thu","fri"],"hours":"09:00-17:00","id":"*","timezone":"Europe/Berlin","type":"target"}`,
+		},
+		{
+			name:      "wrapping day range",
+			input:     "id=*;type=target;actions=read;days=fri-mon",
+			canonical: "id=*;type=target;actions=read;days=sun,mon,fri,sat",
+		},
+		{
+			name:      "json conditions",
+			input:     `{"id":"*","type":"target","actions":["read"],"client_ip":["10.0.0.0/8"],"days":["sat","sun"],"hours":"22:00-06:00"}`,
+			canonical: "id=*;type=target;actions=read;client_ip=10.0.0.0/8;days=sun,sat;hours=22:00-06:00",
+		},
+		{
+			name:  "bad client ip",
+			input: "id=*;type=target;actions=read;client_ip=10.0.0.300/8",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(conditions).parseClientIps: unable to parse "10.0.0.300/8" as an IP address or CIDR: parameter violation: error #100`,
+		},
+		{
+			name:  "bad day",
+			input: "id=*;type=target;actions=read;days=mon-fry",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(conditions).parseDays: unknown day "fry": parameter violation: error #100`,
+		},
+		{
+			name:  "bad hours",
+			input: "id=*;type=target;actions=read;hours=9-17",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(conditions).parseHours: unable to parse "9-17" as a time of day window: parameter violation: error #100`,
+		},
+		{
+			name:  "empty hours",
+			input: "id=*;type=target;actions=read;hours=09:00-09:00",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(conditions).parseHours: time of day window "09:00-09:00" is empty: parameter violation: error #100`,
+		},
+		{
+			name:  "bad timezone",
+			input: "id=*;type=target;actions=read;days=mon;timezone=Mars/Olympus_Mons",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(conditions).parseTimezone: unknown timezone "Mars/Olympus_Mons": parameter violation: error #100`,
+		},
+		{
+			name:  "timezone without days or hours",
+			input: "id=*;type=target;actions=read;timezone=UTC",
+			err:   `perms.Parse: perms.(conditions).validate: timezone can only be specified with days or hours: parameter violation: error #100`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			grant, err := Parse("o_scope", test.input)
+			if test.err != "" {
+				require.Error(err)
+				assert.Equal(test.err, err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.Equal(test.canonical, grant.CanonicalString())
+
+			out, err := grant.MarshalJSON()
+			require.NoError(err)
+			if test.json != "" {
+				assert.Equal(test.json, string(out))
+			}
+			reparsed, err := Parse("o_scope", string(out))
+			require.NoError(err)
+			assert.Equal(test.canonical, reparsed.CanonicalString())
+		})
+	}
+}
+
+func Test_GrantConditionAccessors(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	grant, err := Parse("o_scope", "id=*;type=target;actions=read;client_ip=10.0.0.0/8,192.168.1.1;days=tue,mon;hours=09:00-17:30;timezone=Europe/Berlin")
+	require.NoError(err)
+	assert.Equal([]string{"10.0.0.0/8", "192.168.1.1/32"}, grant.ClientIps())
+	assert.Equal([]string{"mon", "tue"}, grant.Days())
+	assert.Equal("09:00-17:30", grant.Hours())
+	assert.Equal("Europe/Berlin", grant.Timezone())
+
+	grant, err = Parse("o_scope", "id=*;type=target;actions=read")
+	require.NoError(err)
+	assert.Nil(grant.ClientIps())
+	assert.Nil(grant.Days())
+	assert.Empty(grant.Hours())
+	assert.Empty(grant.Timezone())
+}
+
+func Test_ACLAllowedConditions(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday 2021-06-16 at 10:30 in Berlin
+	workday := time.Date(2021, 6, 16, 8, 30, 0, 0, time.UTC)
+	// Saturday 2021-06-19 at 10:30 in Berlin
+	weekend := time.Date(2021, 6, 19, 8, 30, 0, 0, time.UTC)
+	// Wednesday 2021-06-16 at 19:30 in Berlin
+	evening := time.Date(2021, 6, 16, 17, 30, 0, 0, time.UTC)
+
+	target := Resource{ScopeId: "p_prod", Id: "ttcp_1234567890", Type: resource.Target}
+	vpnBusinessHours := "id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8;days=mon-fri;hours=09:00-17:00;timezone=Europe/Berlin"
+
+	tests := []struct {
+		name          string
+		grants        []string
+		opts          []Option
+		authorized    bool
+		denialReasons []string
+	}{
+		{
+			name:       "all conditions met",
+			grants:     []string{vpnBusinessHours},
+			opts:       []Option{WithClientIp("10.20.30.40"), WithNow(workday)},
+			authorized: true,
+		},
+		{
+			name:   "client ip outside range",
+			grants: []string{vpnBusinessHours},
+			opts:   []Option{WithClientIp("203.0.113.9"), WithNow(workday)},
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8;days=mon,tue,wed,thu,fri;hours=09:00-17:00;timezone=Europe/Berlin" in scope p_prod: client IP 203.0.113.9 is not within 10.0.0.0/8`,
+			},
+		},
+		{
+			name:   "client ip unknown",
+			grants: []string{"id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8"},
+			opts:   []Option{WithNow(workday)},
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8" in scope p_prod: client IP unknown is not within 10.0.0.0/8`,
+			},
+		},
+		{
+			name:   "weekend",
+			grants: []string{vpnBusinessHours},
+			opts:   []Option{WithClientIp("10.20.30.40"), WithNow(weekend)},
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8;days=mon,tue,wed,thu,fri;hours=09:00-17:00;timezone=Europe/Berlin" in scope p_prod: day sat in Europe/Berlin is not one of mon,tue,wed,thu,fri`,
+			},
+		},
+		{
+			name:   "outside hours",
+			grants: []string{vpnBusinessHours},
+			opts:   []Option{WithClientIp("10.20.30.40"), WithNow(evening)},
+			denialReasons: []string{
+				`grant "id=*;type=target;actions=authorize-session;client_ip=10.0.0.0/8;days=mon,tue,wed,thu,fri;hours=09:00-17:00;timezone=Europe/Berlin" in scope p_prod: time 19:30 in Europe/Berlin is not within 09:00-17:00`,
+			},
+		},
+		{
+			name:       "overnight window",
+			grants:     []string{"id=*;type=target;actions=authorize-session;hours=17:00-01:00"},
+			opts:       []Option{WithNow(evening)},
+			authorized: true,
+		},
+		{
+			name: "unconditioned grant still applies",
+			grants: []string{
+				vpnBusinessHours,
+				"id=ttcp_1234567890;actions=authorize-session",
+			},
+			opts:       []Option{WithClientIp("203.0.113.9"), WithNow(weekend)},
+			authorized: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			var grants []Grant
+			for _, g := range test.grants {
+				grant, err := Parse(target.ScopeId, g)
+				require.NoError(err)
+				grants = append(grants, grant)
+			}
+			acl := NewACL(grants...)
+			result := acl.Allowed(target, action.AuthorizeSession, test.opts...)
+			assert.Equal(test.authorized, result.Authorized)
+			if !test.authorized {
+				assert.Equal(test.denialReasons, result.DenialReasons)
+			}
+		})
+	}
+}
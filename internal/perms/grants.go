@@ -47,6 +47,12 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields OutputFieldsMap
 
+	// Whether the grant denies its actions rather than allowing them
+	deny bool
+
+	// The conditions on the request context under which the grant applies
+	conditions conditions
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -74,11 +80,52 @@ func (g Grant) Actions() (typs []action.Type, strs []string) {
 	return
 }
 
+// Deny returns whether the grant denies its actions rather than allowing them.
+func (g Grant) Deny() bool {
+	return g.deny
+}
+
+// ClientIps returns the client IP ranges of the grant's conditions, if any.
+func (g Grant) ClientIps() []string {
+	if len(g.conditions.clientIps) == 0 {
+		return nil
+	}
+	return g.conditions.clientIpStrings()
+}
+
+// Days returns the days of the week of the grant's conditions, if any.
+func (g Grant) Days() []string {
+	if len(g.conditions.days) == 0 {
+		return nil
+	}
+	return g.conditions.dayStrings()
+}
+
+// Hours returns the time of day range of the grant's conditions as
+// HH:MM-HH:MM, or an empty string if it has none.
+func (g Grant) Hours() string {
+	if !g.conditions.hasHours {
+		return ""
+	}
+	return g.conditions.hoursString()
+}
+
+// Timezone returns the time zone of the grant's conditions, or an empty
+// string if it has none.
+func (g Grant) Timezone() string {
+	if g.conditions.timezone == nil {
+		return ""
+	}
+	return g.conditions.timezone.String()
+}
+
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		typ:   g.typ,
+		scope:      g.scope,
+		id:         g.id,
+		typ:        g.typ,
+		deny:       g.deny,
+		conditions: g.conditions.clone(),
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -123,6 +170,12 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(g.OutputFields.Fields(), ",")))
 	}
 
+	if g.deny {
+		builder = append(builder, "effect=deny")
+	}
+
+	builder = append(builder, g.conditions.canonicalSegments()...)
+
 	return strings.Join(builder, ";")
 }
 
@@ -147,6 +200,21 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 	if len(g.OutputFields) > 0 {
 		res["output_fields"] = g.OutputFields.Fields()
 	}
+	if g.deny {
+		res["effect"] = "deny"
+	}
+	if len(g.conditions.clientIps) > 0 {
+		res["client_ip"] = g.conditions.clientIpStrings()
+	}
+	if len(g.conditions.days) > 0 {
+		res["days"] = g.conditions.dayStrings()
+	}
+	if g.conditions.hasHours {
+		res["hours"] = g.conditions.hoursString()
+	}
+	if g.conditions.timezone != nil {
+		res["timezone"] = g.conditions.timezone.String()
+	}
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.Encode))
@@ -220,6 +288,63 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 			}
 		}
 	}
+	if rawEffect, ok := raw["effect"]; ok {
+		effect, ok := rawEffect.(string)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", "effect"))
+		}
+		if err := g.parseEffect(effect); err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+	}
+	for _, key := range []string{"client_ip", "days"} {
+		rawValues, ok := raw[key]
+		if !ok {
+			continue
+		}
+		interfaceValues, ok := rawValues.([]interface{})
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as array", key))
+		}
+		values := make([]string, 0, len(interfaceValues))
+		for _, v := range interfaceValues {
+			value, ok := v.(string)
+			if !ok {
+				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %v in %s array as string", v, key))
+			}
+			values = append(values, value)
+		}
+		var err error
+		switch key {
+		case "client_ip":
+			err = g.conditions.parseClientIps(values)
+		case "days":
+			err = g.conditions.parseDays(values)
+		}
+		if err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+	}
+	for _, key := range []string{"hours", "timezone"} {
+		rawValue, ok := raw[key]
+		if !ok {
+			continue
+		}
+		value, ok := rawValue.(string)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", key))
+		}
+		var err error
+		switch key {
+		case "hours":
+			err = g.conditions.parseHours(value)
+		case "timezone":
+			err = g.conditions.parseTimezone(value)
+		}
+		if err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+	}
 	return nil
 }
 
@@ -264,6 +389,31 @@ func (g *Grant) unmarshalText(grantString string) error {
 
 		case "output_fields":
 			g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
+
+		case "effect":
+			if err := g.parseEffect(kv[1]); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+
+		case "client_ip":
+			if err := g.conditions.parseClientIps(strings.Split(kv[1], ",")); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+
+		case "days":
+			if err := g.conditions.parseDays(strings.Split(kv[1], ",")); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+
+		case "hours":
+			if err := g.conditions.parseHours(kv[1]); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+
+		case "timezone":
+			if err := g.conditions.parseTimezone(kv[1]); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
 		}
 	}
 
@@ -337,10 +487,25 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.WrapDeprecated(err, op)
 	}
 
+	if err := grant.conditions.validate(); err != nil {
+		return Grant{}, errors.WrapDeprecated(err, op)
+	}
+
 	if err := grant.parseAndValidateActions(); err != nil {
 		return Grant{}, errors.WrapDeprecated(err, op)
 	}
 
+	// Deny grants only ever take away actions, so output fields have no
+	// meaning on them
+	if grant.deny {
+		switch {
+		case len(grant.actions) == 0:
+			return Grant{}, errors.NewDeprecated(errors.InvalidParameter, op, "deny grant contains no actions")
+		case grant.OutputFields != nil:
+			return Grant{}, errors.NewDeprecated(errors.InvalidParameter, op, "deny grant cannot contain output fields")
+		}
+	}
+
 	if !opts.withSkipFinalValidation {
 		// Filter out some forms that don't make sense
 
@@ -396,8 +561,13 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		// This might be zero if output fields is populated
 		if len(grant.actions) > 0 {
 			// Create a dummy resource and pass it through Allowed and ensure that
-			// we get allowed.
-			acl := NewACL(grant)
+			// we get allowed. Conditions depend on the request, so they are
+			// left out of this check, and a deny grant is checked as if it
+			// allowed the actions it denies.
+			unconditioned := grant.clone()
+			unconditioned.conditions = conditions{}
+			unconditioned.deny = false
+			acl := NewACL(*unconditioned)
 			r := Resource{
 				ScopeId: scopeId,
 				Id:      grant.id,
@@ -422,6 +592,21 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 	return grant, nil
 }
 
+// parseEffect parses the effect of the grant, either "allow", the default, or
+// "deny".
+func (g *Grant) parseEffect(effect string) error {
+	const op = "perms.(Grant).parseEffect"
+	switch strings.ToLower(effect) {
+	case "allow":
+		g.deny = false
+	case "deny":
+		g.deny = true
+	default:
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown effect %q", effect))
+	}
+	return nil
+}
+
 // validateType ensures that we are not allowing access to disallowed resource
 // types. It does not explicitly check the resource string itself; that's the
 // job of the parsing functions to look up the string from the Map and ensure
@@ -429,7 +614,7 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 func (g Grant) validateType() error {
 	const op = "perms.(Grant).validateType"
 	switch g.typ {
-	case resource.Controller, resource.Worker:
+	case resource.Controller:
 		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown type specifier %q", g.typ))
 	}
 	return nil
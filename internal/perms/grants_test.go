@@ -108,9 +108,9 @@ func Test_ActionParsingValidation(t *testing.T) {
 func Test_ValidateType(t *testing.T) {
 	t.Parallel()
 	var g Grant
-	for i := resource.Unknown; i <= resource.CredentialLibrary; i++ {
+	for i := resource.Unknown; i <= resource.Credential; i++ {
 		g.typ = i
-		if i == resource.Controller || i == resource.Worker {
+		if i == resource.Controller {
 			assert.Error(t, g.validateType())
 		} else {
 			assert.NoError(t, g.validateType())
@@ -190,6 +190,23 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"id":"baz","output_fields":["id","name","version"],"type":"group"}`,
 			canonicalString: `id=baz;type=group;actions=create,read;output_fields=id,name,version`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				id: "*",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				actionsBeingParsed: []string{"authorize-session"},
+				deny:               true,
+			},
+			jsonOutput:      `{"actions":["authorize-session"],"effect":"deny","id":"*","type":"target"}`,
+			canonicalString: `id=*;type=target;actions=authorize-session;effect=deny`,
+		},
 	}
 
 	for _, test := range tests {
@@ -676,6 +693,71 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "deny",
+			input: `id=*;type=target;actions=authorize-session;effect=deny`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "deny json",
+			input: `{"type":"target","actions":["list"],"effect":"DENY"}`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.List: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "explicit allow",
+			input: `id=foobar;actions=read;effect=allow`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id: "foobar",
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+			},
+		},
+		{
+			name:  "bad effect",
+			input: `id=foobar;actions=read;effect=maybe`,
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(Grant).parseEffect: unknown effect "maybe": parameter violation: error #100`,
+		},
+		{
+			name:  "deny with only output fields",
+			input: `id=foobar;output_fields=id;effect=deny`,
+			err:   `perms.Parse: deny grant contains no actions: parameter violation: error #100`,
+		},
+		{
+			name:  "deny with output fields",
+			input: `id=foobar;actions=read;output_fields=id;effect=deny`,
+			err:   `perms.Parse: deny grant cannot contain output fields: parameter violation: error #100`,
+		},
+		{
+			name:  "deny in a format that does not allow the actions",
+			input: `id=foobar;actions=list;effect=deny`,
+			err:   `perms.Parse: parsed grant string contains create or list action in a format that does not allow these: parameter violation: error #100`,
+		},
 	}
 
 	_, err := Parse("", "")
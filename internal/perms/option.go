@@ -1,5 +1,7 @@
 package perms
 
+import "time"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -17,6 +19,8 @@ type options struct {
 	withUserId              string
 	withAccountId           string
 	withSkipFinalValidation bool
+	withClientIp            string
+	withNow                 time.Time
 }
 
 func getDefaultOptions() options {
@@ -45,3 +49,19 @@ func WithSkipFinalValidation(skipFinalValidation bool) Option {
 		o.withSkipFinalValidation = skipFinalValidation
 	}
 }
+
+// WithClientIp provides the IP address of the client making the request, which
+// is checked against the client_ip condition of grants
+func WithClientIp(clientIp string) Option {
+	return func(o *options) {
+		o.withClientIp = clientIp
+	}
+}
+
+// WithNow provides the time of the request, which is checked against the days
+// and hours conditions of grants. If not set, the current time is used.
+func WithNow(now time.Time) Option {
+	return func(o *options) {
+		o.withNow = now
+	}
+}
@@ -0,0 +1,273 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// AsciicastVersion is the version of the asciicast format written by Writer.
+const AsciicastVersion = 2
+
+// EventType is the type of an asciicast event.
+type EventType string
+
+const (
+	// OutputEvent is data written to the terminal.
+	OutputEvent EventType = "o"
+	// InputEvent is data read from the terminal.
+	InputEvent EventType = "i"
+	// ResizeEvent is a change of the terminal size, with data in the form
+	// "COLSxROWS".
+	ResizeEvent EventType = "r"
+)
+
+// Header is the first line of an asciicast v2 recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     uint32            `json:"width"`
+	Height    uint32            `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Event is a single event of an asciicast v2 recording. Time is the offset
+// of the event from the start of the recording.
+type Event struct {
+	Time time.Duration
+	Type EventType
+	Data string
+}
+
+// MarshalJSON encodes the event as the [time, type, data] array used by the
+// asciicast v2 format.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Time.Seconds(), e.Type, e.Data})
+}
+
+// UnmarshalJSON decodes an event from the [time, type, data] array used by
+// the asciicast v2 format.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var raw []interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("event has %d elements, expected 3", len(raw))
+	}
+	secs, ok := raw[0].(float64)
+	if !ok {
+		return fmt.Errorf("event time is %T, expected a number", raw[0])
+	}
+	typ, ok := raw[1].(string)
+	if !ok {
+		return fmt.Errorf("event type is %T, expected a string", raw[1])
+	}
+	data, ok := raw[2].(string)
+	if !ok {
+		return fmt.Errorf("event data is %T, expected a string", raw[2])
+	}
+	e.Time = time.Duration(secs * float64(time.Second))
+	e.Type = EventType(typ)
+	e.Data = data
+	return nil
+}
+
+// Writer writes an asciicast v2 recording. It is safe for concurrent use.
+type Writer struct {
+	mu      sync.Mutex
+	w       io.Writer
+	enc     *json.Encoder
+	start   time.Time
+	now     func() time.Time
+	partial []byte
+	err     error
+}
+
+// NewWriter writes the header h to w and returns a Writer for the events of
+// the recording. The start of the recording is the time NewWriter is called.
+func NewWriter(ctx context.Context, w io.Writer, h Header) (*Writer, error) {
+	const op = "recording.NewWriter"
+	if w == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	aw := &Writer{
+		w:   w,
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+	aw.start = aw.now()
+	h.Version = AsciicastVersion
+	if h.Timestamp == 0 {
+		h.Timestamp = aw.start.Unix()
+	}
+	if err := aw.enc.Encode(h); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to write header"))
+	}
+	return aw, nil
+}
+
+// Start returns the start time of the recording.
+func (w *Writer) Start() time.Time {
+	return w.start
+}
+
+// Output records data written to the terminal.
+func (w *Writer) Output(b []byte) error {
+	return w.event(OutputEvent, b)
+}
+
+// Input records data read from the terminal.
+func (w *Writer) Input(b []byte) error {
+	return w.event(InputEvent, b)
+}
+
+// Resize records a change of the terminal size.
+func (w *Writer) Resize(width, height uint32) error {
+	return w.event(ResizeEvent, []byte(fmt.Sprintf("%dx%d", width, height)))
+}
+
+func (w *Writer) event(t EventType, b []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	if t != ResizeEvent {
+		// Event data must be valid UTF-8 so hold back a multi-byte sequence
+		// that is split across writes until the rest of it arrives.
+		b = append(w.partial, b...)
+		w.partial = nil
+		if n := incompleteSuffix(b); n > 0 {
+			w.partial = append([]byte(nil), b[len(b)-n:]...)
+			b = b[:len(b)-n]
+		}
+		if len(b) == 0 {
+			return nil
+		}
+	}
+	w.err = w.enc.Encode(Event{
+		Time: w.now().Sub(w.start),
+		Type: t,
+		Data: string(b),
+	})
+	return w.err
+}
+
+// incompleteSuffix returns the length of a trailing incomplete UTF-8 sequence
+// in b.
+func incompleteSuffix(b []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if !utf8.RuneStart(c) {
+			continue
+		}
+		if !utf8.FullRune(b[len(b)-i:]) {
+			return i
+		}
+		return 0
+	}
+	return 0
+}
+
+// Reader reads an asciicast v2 recording.
+type Reader struct {
+	Header Header
+	s      *bufio.Scanner
+}
+
+// NewReader reads the header of the recording in r and returns a Reader for
+// its events.
+func NewReader(ctx context.Context, r io.Reader) (*Reader, error) {
+	const op = "recording.NewReader"
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to read header"))
+		}
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing header")
+	}
+	var h Header
+	if err := json.Unmarshal(s.Bytes(), &h); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to decode header"))
+	}
+	if h.Version != AsciicastVersion {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported asciicast version %d", h.Version))
+	}
+	return &Reader{Header: h, s: s}, nil
+}
+
+// Next returns the next event of the recording. It returns io.EOF when there
+// are no more events.
+func (r *Reader) Next(ctx context.Context) (*Event, error) {
+	const op = "recording.(Reader).Next"
+	for r.s.Scan() {
+		line := r.s.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to decode event"))
+		}
+		return &e, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return nil, io.EOF
+}
+
+// Play writes the output events of the recording in r to w, waiting between
+// events as long as the recorded session did. Waits longer than maxIdle are
+// shortened to maxIdle if maxIdle is greater than zero. Playback stops early
+// if ctx is done.
+func Play(ctx context.Context, w io.Writer, r io.Reader, maxIdle time.Duration) error {
+	const op = "recording.Play"
+	rd, err := NewReader(ctx, r)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var last time.Duration
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+	for {
+		e, err := rd.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if e.Type != OutputEvent {
+			continue
+		}
+		wait := e.Time - last
+		last = e.Time
+		if maxIdle > 0 && wait > maxIdle {
+			wait = maxIdle
+		}
+		if wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if _, err := io.WriteString(w, e.Data); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+		}
+	}
+}
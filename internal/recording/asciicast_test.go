@@ -0,0 +1,104 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(ctx, &buf, Header{Width: 80, Height: 24, Env: map[string]string{"TERM": "xterm"}})
+	require.NoError(err)
+	now := w.Start()
+	w.now = func() time.Time { return now }
+
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(w.Output([]byte("hello ")))
+	// A multi-byte rune split across writes is held back until complete.
+	now = now.Add(time.Second)
+	require.NoError(w.Output([]byte{0xe2, 0x82}))
+	require.NoError(w.Output([]byte{0xac, '\n'}))
+	require.NoError(w.Resize(120, 40))
+
+	r, err := NewReader(ctx, &buf)
+	require.NoError(err)
+	assert.Equal(AsciicastVersion, r.Header.Version)
+	assert.Equal(uint32(80), r.Header.Width)
+	assert.Equal(uint32(24), r.Header.Height)
+	assert.Equal("xterm", r.Header.Env["TERM"])
+	assert.NotZero(r.Header.Timestamp)
+
+	var got []Event
+	for {
+		e, err := r.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		got = append(got, *e)
+	}
+	assert.Equal([]Event{
+		{Time: 500 * time.Millisecond, Type: OutputEvent, Data: "hello "},
+		{Time: 1500 * time.Millisecond, Type: OutputEvent, Data: "€\n"},
+		{Time: 1500 * time.Millisecond, Type: ResizeEvent, Data: "120x40"},
+	}, got)
+}
+
+func TestNewWriter_MissingWriter(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter(context.Background(), nil, Header{})
+	assert.Error(t, err)
+}
+
+func TestNewReader_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "empty", in: ""},
+		{name: "not-json", in: "not json\n"},
+		{name: "wrong-version", in: `{"version": 1, "width": 80, "height": 24}` + "\n"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReader(context.Background(), strings.NewReader(tt.in))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	in := strings.Join([]string{
+		`{"version": 2, "width": 80, "height": 24}`,
+		`[0.001, "o", "$ "]`,
+		`[0.002, "i", "ls"]`,
+		`[0.003, "r", "100x30"]`,
+		`[10.0, "o", "file\r\n"]`,
+	}, "\n")
+
+	var out bytes.Buffer
+	start := time.Now()
+	require.NoError(t, Play(ctx, &out, strings.NewReader(in), 10*time.Millisecond))
+	assert.Equal(t, "$ file\r\n", out.String())
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second), "idle time was not limited")
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	err := Play(cctx, io.Discard, strings.NewReader(in), 0)
+	assert.ErrorIs(t, err, context.Canceled)
+}
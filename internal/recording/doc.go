@@ -0,0 +1,12 @@
+// Package recording provides the asciicast v2 writer and reader used to
+// record the terminal channels of ssh sessions proxied by a worker, and the
+// storage those recordings are written to.
+//
+// Recordings are written to a Storage created with NewStorage from a
+// location. A location without a URI scheme is a local directory and an
+// s3:// location is an S3 compatible object store; other backends can be
+// added with RegisterStorage. The URI returned when a recording is created
+// is stored by the controller in a session recording and is later read back
+// with the Open method of the controller's Storage, which refuses any URI
+// that is not under its location.
+package recording
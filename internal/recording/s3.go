@@ -0,0 +1,163 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// S3Scheme is the URI scheme of recordings held in an S3 compatible object
+// store. The location of the storage is s3://<bucket>/<prefix>, optionally
+// followed by the "region" and "endpoint" query parameters. Credentials are
+// taken from the default AWS credential chain.
+const S3Scheme = "s3"
+
+// s3Storage holds recordings as objects in an S3 bucket.
+type s3Storage struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+	root     *url.URL
+}
+
+func newS3Storage(ctx context.Context, location *url.URL) (Storage, error) {
+	const op = "recording.newS3Storage"
+	if location.Host == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing bucket")
+	}
+	prefix := strings.Trim(path.Clean("/"+location.Path), "/")
+	cfg := aws.NewConfig()
+	q := location.Query()
+	if region := q.Get("region"); region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	if endpoint := q.Get("endpoint"); endpoint != "" {
+		// S3 compatible stores addressed by endpoint do not generally support
+		// virtual host style bucket addressing.
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create s3 session"))
+	}
+	client := s3.New(sess)
+	return &s3Storage{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   location.Host,
+		prefix:   prefix,
+		root:     &url.URL{Scheme: S3Scheme, Host: location.Host, Path: "/" + prefix},
+	}, nil
+}
+
+// Create implements Storage. The object is uploaded as it is written and is
+// complete once the writer is closed without error. An existing recording
+// is never overwritten.
+func (s *s3Storage) Create(ctx context.Context, name string) (io.WriteCloser, string, error) {
+	const op = "recording.(s3Storage).Create"
+	if name == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	key := strings.TrimPrefix(path.Join("/", s.prefix, name), "/")
+	if !strings.HasPrefix("/"+key, strings.TrimSuffix(s.root.Path, "/")+"/") {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("name %q is outside of the recording prefix", name))
+	}
+	_, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	switch {
+	case err == nil:
+		return nil, "", errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("recording %q already exists", name))
+	case !isS3NotFound(err):
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(key),
+			Body:   pr,
+		})
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	u := url.URL{Scheme: S3Scheme, Host: s.bucket, Path: "/" + key}
+	return w, u.String(), nil
+}
+
+// Contains implements Storage.
+func (s *s3Storage) Contains(uri string) bool {
+	_, ok := contains(s.root, uri)
+	return ok
+}
+
+// Open implements Storage.
+func (s *s3Storage) Open(ctx context.Context, uri string) (io.ReadCloser, error) {
+	const op = "recording.(s3Storage).Open"
+	u, ok := contains(s.root, uri)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("uri %q is outside of the recording prefix", uri))
+	}
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.RecordNotFound))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return out.Body, nil
+}
+
+func isS3NotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, "NotFound":
+			return true
+		}
+	}
+	return false
+}
+
+// s3Writer streams a recording to an upload running in the background.
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done chan error
+
+	once sync.Once
+	err  error
+}
+
+// Write implements io.Writer.
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close implements io.Closer. It returns once the upload has completed.
+func (w *s3Writer) Close() error {
+	w.once.Do(func() {
+		_ = w.pw.Close()
+		w.err = <-w.done
+	})
+	return w.err
+}
@@ -0,0 +1,102 @@
+package recording
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testS3Server is a minimal path style S3 server which supports the head,
+// put and get object requests made by s3Storage.
+type testS3Server struct {
+	l       sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *testS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	switch r.Method {
+	case http.MethodHead:
+		if _, ok := s.objects[r.URL.Path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut:
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[r.URL.Path] = b
+	case http.MethodGet:
+		b, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>missing</Message></Error>`)
+			return
+		}
+		_, _ = w.Write(b)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret-key")
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	srv := &testS3Server{objects: map[string][]byte{}}
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	q := url.Values{"region": {"us-east-1"}, "endpoint": {ts.URL}}
+	s, err := NewStorage(ctx, "s3://recordings/boundary?"+q.Encode())
+	require.NoError(err)
+
+	w, uri, err := s.Create(ctx, "s_1234567890/sc_1234567890-0.cast")
+	require.NoError(err)
+	assert.Equal("s3://recordings/boundary/s_1234567890/sc_1234567890-0.cast", uri)
+	_, err = io.WriteString(w, "recording")
+	require.NoError(err)
+	require.NoError(w.Close())
+	assert.Equal("recording", string(srv.objects["/recordings/boundary/s_1234567890/sc_1234567890-0.cast"]))
+
+	_, _, err = s.Create(ctx, "s_1234567890/sc_1234567890-0.cast")
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "unexpected error %v", err)
+
+	_, _, err = s.Create(ctx, "../escape.cast")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	assert.True(s.Contains(uri))
+	r, err := s.Open(ctx, uri)
+	require.NoError(err)
+	got, err := io.ReadAll(r)
+	require.NoError(err)
+	require.NoError(r.Close())
+	assert.Equal("recording", string(got))
+
+	_, err = s.Open(ctx, uri+".missing")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+
+	for _, uri := range []string{
+		"s3://other/boundary/s_1234567890/sc_1234567890-0.cast",
+		"s3://recordings/other/s_1234567890/sc_1234567890-0.cast",
+		"s3://recordings/boundary/../other/sc_1234567890-0.cast",
+		"file:///recordings/boundary/s_1234567890/sc_1234567890-0.cast",
+	} {
+		assert.False(s.Contains(uri), uri)
+		_, err = s.Open(ctx, uri)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v for %s", err, uri)
+	}
+}
@@ -0,0 +1,185 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Storage holds session recordings. A recording is created under a name
+// relative to the storage and is afterwards addressed by the URI returned
+// when it was created.
+type Storage interface {
+	// Create returns a writer for a new recording with the given name and
+	// the URI the recording can be opened from once the writer is closed.
+	Create(ctx context.Context, name string) (io.WriteCloser, string, error)
+
+	// Contains reports whether uri addresses a recording held in the
+	// storage.
+	Contains(uri string) bool
+
+	// Open opens the recording at uri for reading. An error with the
+	// InvalidParameter code is returned if the storage does not contain uri.
+	Open(ctx context.Context, uri string) (io.ReadCloser, error)
+}
+
+// StorageFactory returns the Storage for a location. It is registered for a
+// URI scheme with RegisterStorage.
+type StorageFactory func(ctx context.Context, location *url.URL) (Storage, error)
+
+var storageSchemes sync.Map
+
+func init() {
+	if err := RegisterStorage(FileScheme, newFileStorage); err != nil {
+		panic(err)
+	}
+	if err := RegisterStorage(S3Scheme, newS3Storage); err != nil {
+		panic(err)
+	}
+}
+
+// FileScheme is the URI scheme of recordings held in a local directory.
+const FileScheme = "file"
+
+// RegisterStorage registers the storage factory for a URI scheme. This is
+// how object store backends are made available. It is an error to register
+// a scheme more than once.
+func RegisterStorage(scheme string, factory StorageFactory) error {
+	const op = "recording.RegisterStorage"
+	switch {
+	case scheme == "":
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing scheme")
+	case factory == nil:
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing storage factory")
+	}
+	if _, loaded := storageSchemes.LoadOrStore(strings.ToLower(scheme), factory); loaded {
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("storage scheme %q already registered", scheme))
+	}
+	return nil
+}
+
+// NewStorage returns the Storage for location. A location without a URI
+// scheme is a path to a local directory.
+func NewStorage(ctx context.Context, location string) (Storage, error) {
+	const op = "recording.NewStorage"
+	if location == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing location")
+	}
+	u, err := parseLocation(location)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	factory, ok := storageSchemes.Load(strings.ToLower(u.Scheme))
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported recording storage scheme %q", u.Scheme))
+	}
+	st, err := factory.(StorageFactory)(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return st, nil
+}
+
+func parseLocation(location string) (*url.URL, error) {
+	if !strings.Contains(location, "://") {
+		abs, err := filepath.Abs(location)
+		if err != nil {
+			return nil, err
+		}
+		return &url.URL{Scheme: FileScheme, Path: filepath.ToSlash(abs)}, nil
+	}
+	return url.Parse(location)
+}
+
+// contains reports whether uri has the scheme and host of root and a path
+// below the path of root. URIs with a query, a fragment or a path which is
+// not clean, such as one containing "..", are never contained.
+func contains(root *url.URL, uri string) (*url.URL, bool) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, false
+	}
+	switch {
+	case !strings.EqualFold(u.Scheme, root.Scheme),
+		u.Host != root.Host,
+		u.RawQuery != "", u.Fragment != "", u.User != nil,
+		u.Path != path.Clean(u.Path):
+		return nil, false
+	}
+	prefix := strings.TrimSuffix(path.Clean("/"+root.Path), "/") + "/"
+	if !strings.HasPrefix(u.Path, prefix) {
+		return nil, false
+	}
+	return u, true
+}
+
+// fileStorage holds recordings in a local directory.
+type fileStorage struct {
+	dir  string
+	root *url.URL
+}
+
+func newFileStorage(ctx context.Context, location *url.URL) (Storage, error) {
+	const op = "recording.newFileStorage"
+	dir := filepath.FromSlash(location.Path)
+	if dir == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing directory")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to create recording directory"))
+	}
+	return &fileStorage{dir: dir, root: &url.URL{Scheme: FileScheme, Path: filepath.ToSlash(filepath.Clean(dir))}}, nil
+}
+
+// Create implements Storage. The recording is created with exclusive
+// permissions and an existing recording is never overwritten.
+func (s *fileStorage) Create(ctx context.Context, name string) (io.WriteCloser, string, error) {
+	const op = "recording.(fileStorage).Create"
+	if name == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	p := filepath.Join(s.dir, filepath.FromSlash(name))
+	if !strings.HasPrefix(p, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("name %q is outside of the recording directory", name))
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	u := url.URL{Scheme: FileScheme, Path: filepath.ToSlash(p)}
+	return f, u.String(), nil
+}
+
+// Contains implements Storage.
+func (s *fileStorage) Contains(uri string) bool {
+	_, ok := contains(s.root, uri)
+	return ok
+}
+
+// Open implements Storage.
+func (s *fileStorage) Open(ctx context.Context, uri string) (io.ReadCloser, error) {
+	const op = "recording.(fileStorage).Open"
+	u, ok := contains(s.root, uri)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("uri %q is outside of the recording directory", uri))
+	}
+	f, err := os.Open(filepath.FromSlash(u.Path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.RecordNotFound))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return f, nil
+}
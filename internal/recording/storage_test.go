@@ -0,0 +1,87 @@
+package recording
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	dir := t.TempDir()
+
+	s, err := NewStorage(ctx, filepath.Join(dir, "recordings"))
+	require.NoError(err)
+
+	w, uri, err := s.Create(ctx, "s_1234567890/sc_1234567890-0.cast")
+	require.NoError(err)
+	assert.True(strings.HasPrefix(uri, "file://"), uri)
+	_, err = io.WriteString(w, "recording")
+	require.NoError(err)
+	require.NoError(w.Close())
+
+	_, _, err = s.Create(ctx, "s_1234567890/sc_1234567890-0.cast")
+	assert.Error(err, "existing recordings must not be overwritten")
+
+	_, _, err = s.Create(ctx, "../escape.cast")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	assert.True(s.Contains(uri))
+	r, err := s.Open(ctx, uri)
+	require.NoError(err)
+	got, err := io.ReadAll(r)
+	require.NoError(err)
+	require.NoError(r.Close())
+	assert.Equal("recording", string(got))
+
+	_, err = s.Open(ctx, uri+".missing")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+
+	outside := (&url.URL{Scheme: FileScheme, Path: filepath.ToSlash(filepath.Join(dir, "outside.cast"))}).String()
+	for _, uri := range []string{
+		outside,
+		(&url.URL{Scheme: FileScheme, Path: filepath.ToSlash(dir) + "/recordings/../outside.cast"}).String(),
+		(&url.URL{Scheme: FileScheme, Path: filepath.ToSlash(dir) + "/recordings-other/outside.cast"}).String(),
+		(&url.URL{Scheme: FileScheme, Host: "other", Path: filepath.ToSlash(dir) + "/recordings/outside.cast"}).String(),
+		"s3://bucket" + filepath.ToSlash(dir) + "/recordings/outside.cast",
+		"/etc/passwd",
+	} {
+		assert.False(s.Contains(uri), uri)
+		_, err = s.Open(ctx, uri)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v for %s", err, uri)
+	}
+}
+
+func TestNewStorage_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, err := NewStorage(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	_, err = NewStorage(ctx, "unknown://bucket/prefix")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	_, err = NewStorage(ctx, "s3:///prefix")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+}
+
+func TestRegisterStorage(t *testing.T) {
+	t.Parallel()
+	factory := func(context.Context, *url.URL) (Storage, error) { return nil, nil }
+
+	assert.Error(t, RegisterStorage("", factory))
+	assert.Error(t, RegisterStorage("test-missing", nil))
+	assert.Error(t, RegisterStorage(FileScheme, factory))
+	assert.Error(t, RegisterStorage(S3Scheme, factory))
+	require.NoError(t, RegisterStorage("test-register", factory))
+	assert.Error(t, RegisterStorage("test-register", factory))
+}
@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	subsystem = "controller_scheduler"
+
+	labelJobName = "job_name"
+	labelOutcome = "outcome"
+
+	outcomeSucceeded = "succeeded"
+	outcomeFailed    = "failed"
+	outcomeCanceled  = "canceled"
+)
+
+var (
+	// jobRuns counts the job runs which finished, by job name and outcome.
+	jobRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metric.Namespace,
+			Subsystem: subsystem,
+			Name:      "job_runs_total",
+			Help:      "Count of the job runs which finished, by outcome.",
+		},
+		[]string{labelJobName, labelOutcome},
+	)
+
+	// jobRunDuration observes how long job runs take, by job name.
+	jobRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metric.Namespace,
+			Subsystem: subsystem,
+			Name:      "job_run_duration_seconds",
+			Help:      "Histogram of the durations of job runs.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900},
+		},
+		[]string{labelJobName},
+	)
+
+	// activeJobRuns is the number of runs currently in progress on this
+	// controller, by job name.
+	activeJobRuns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metric.Namespace,
+			Subsystem: subsystem,
+			Name:      "active_job_runs",
+			Help:      "Number of job runs currently in progress.",
+		},
+		[]string{labelJobName},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(jobRuns, jobRunDuration, activeJobRuns)
+}
@@ -229,7 +229,11 @@ func (s *Scheduler) runJob(ctx context.Context, wg *sync.WaitGroup, r *job.Run)
 	go func() {
 		defer rj.cancelCtx()
 		defer wg.Done()
+		activeJobRuns.WithLabelValues(j.Name()).Inc()
+		start := time.Now()
 		runErr := j.Run(jobContext)
+		jobRunDuration.WithLabelValues(j.Name()).Observe(time.Since(start).Seconds())
+		activeJobRuns.WithLabelValues(j.Name()).Dec()
 
 		// Get final status report to update run progress with
 		status := j.Status()
@@ -237,13 +241,16 @@ func (s *Scheduler) runJob(ctx context.Context, wg *sync.WaitGroup, r *job.Run)
 		switch {
 		case ctx.Err() != nil:
 			// Base context is no longer valid, skip repo updates as they will fail and exit
+			jobRuns.WithLabelValues(j.Name(), outcomeCanceled).Inc()
 		case runErr == nil:
+			jobRuns.WithLabelValues(j.Name(), outcomeSucceeded).Inc()
 			nextRun, inner := j.NextRunIn()
 			if inner != nil {
 				event.WriteError(ctx, op, inner, event.WithInfoMsg("error getting next run time", "name", j.Name()))
 			}
 			_, updateErr = repo.CompleteRun(ctx, r.PrivateId, nextRun, status.Completed, status.Total)
 		default:
+			jobRuns.WithLabelValues(j.Name(), outcomeFailed).Inc()
 			event.WriteError(ctx, op, runErr, event.WithInfoMsg("job run failed", "run id", r.PrivateId, "name", j.Name()))
 			_, updateErr = repo.FailRun(ctx, r.PrivateId, status.Completed, status.Total)
 		}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// accessRequestExpirationJob defines a periodic job that revokes the access
+// granted by approved access requests once their access window ends.
+//
+// The roles created when the requests were approved are deleted and the
+// requests are marked as expired. Sessions already established through the
+// requests are not terminated.
+type accessRequestExpirationJob struct {
+	accessRequestRepoFn common.AccessRequestRepoFactory
+
+	// The total number of access requests expired in the last run.
+	totalExpired int
+}
+
+// newAccessRequestExpirationJob instantiates the access request expiration
+// job.
+func newAccessRequestExpirationJob(accessRequestRepoFn common.AccessRequestRepoFactory) (*accessRequestExpirationJob, error) {
+	const op = "controller.newAccessRequestExpirationJob"
+	if accessRequestRepoFn == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing accessRequestRepoFn")
+	}
+	return &accessRequestExpirationJob{
+		accessRequestRepoFn: accessRequestRepoFn,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *accessRequestExpirationJob) Name() string { return "access_request_expiration" }
+
+// Description returns the description for the job.
+func (j *accessRequestExpirationJob) Description() string {
+	return "Revoke the access granted by access requests whose access window has ended"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+//
+// The next run time is defined for accessRequestExpirationJob as one
+// second, so access is revoked as soon as possible after the end of an
+// access window.
+func (j *accessRequestExpirationJob) NextRunIn() (time.Duration, error) { return time.Second, nil }
+
+// Status returns the status of the running job.
+func (j *accessRequestExpirationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalExpired,
+		Total:     j.totalExpired,
+	}
+}
+
+// Run executes the job.
+func (j *accessRequestExpirationJob) Run(ctx context.Context) error {
+	const op = "controller.(accessRequestExpirationJob).Run"
+	j.totalExpired = 0
+
+	repo, err := j.accessRequestRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting access request repo"))
+	}
+	expired, err := repo.ExpireAccessRequests(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, ar := range expired {
+		details, err := structpb.NewStruct(map[string]interface{}{
+			"access_request_id": ar.PublicId,
+			"scope_id":          ar.ScopeId,
+			"target_id":         ar.TargetId,
+			"user_id":           ar.UserId,
+			"role_id":           ar.RoleId,
+		})
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error building audit event details", "access_request_id", ar.PublicId))
+			continue
+		}
+		if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{
+			Operation: "expire-access-request",
+			Details:   details,
+		})); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error writing audit event", "access_request_id", ar.PublicId))
+		}
+	}
+	j.totalExpired = len(expired)
+	return nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(accessRequestExpirationJob))
+
+func TestAccessRequestExpirationJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := accessrequest.NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	ctx := context.Background()
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(t, conn, prj.PublicId, "test target")
+	requester := iam.TestUser(t, iamRepo, org.PublicId)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+
+	ar := accessrequest.TestAccessRequest(t, conn, prj.PublicId, tar.PublicId, requester.PublicId)
+	ar, err = repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, ar.Version)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, "update access_request set valid_until = now() where public_id = ?", []interface{}{ar.PublicId})
+	require.NoError(err)
+
+	job, err := newAccessRequestExpirationJob(func() (*accessrequest.Repository, error) { return repo, nil })
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Completed)
+
+	got, err := repo.LookupAccessRequest(ctx, ar.PublicId)
+	require.NoError(err)
+	assert.Equal(accessrequest.StatusExpired.String(), got.Status)
+	role, _, _, err := iamRepo.LookupRole(ctx, ar.RoleId)
+	require.NoError(err)
+	assert.Nil(role)
+
+	// Nothing left to expire
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+}
+
+func TestNewAccessRequestExpirationJob(t *testing.T) {
+	t.Parallel()
+	_, err := newAccessRequestExpirationJob(nil)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -273,6 +273,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 			ea.UserInfo = &event.UserInfo{
 				UserId: ret.UserId,
 			}
+			ea.DenialReasons = authResults.DenialReasons
 			return
 		}
 	}
@@ -567,7 +568,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	}
 
 	retAcl = perms.NewACL(parsedGrants...)
-	aclResults = retAcl.Allowed(*v.res, v.act)
+	aclResults = retAcl.Allowed(*v.res, v.act, v.aclOptions()...)
 	// We don't set authenticated above because setting this but not authorized
 	// is used for further permissions checks, such as during recursive listing.
 	// So we want to make sure any code relying on that has the full set of
@@ -617,7 +618,7 @@ func (r *VerifyResults) fetchActions(id string, typ resource.Type, availableActi
 
 	ret := make(action.ActionSet, 0, len(availableActions))
 	for _, act := range availableActions {
-		if r.v.acl.Allowed(*res, act).Authorized {
+		if r.v.acl.Allowed(*res, act, r.v.aclOptions()...).Authorized {
 			ret = append(ret, act)
 		}
 	}
@@ -627,6 +628,18 @@ func (r *VerifyResults) fetchActions(id string, typ resource.Type, availableActi
 	return ret
 }
 
+// ActionDenied returns whether a deny grant explicitly denies the action on
+// the resource, as opposed to no grant allowing it.
+func (r *VerifyResults) ActionDenied(res perms.Resource, act action.Type) bool {
+	switch {
+	case r.v.requestInfo.TokenFormat == uint32(AuthTokenTypeRecoveryKms),
+		r.v.requestInfo.DisableAuthEntirely:
+		return false
+	}
+
+	return r.v.acl.Allowed(res, act, r.v.aclOptions()...).Denied
+}
+
 func (r *VerifyResults) FetchOutputFields(res perms.Resource, act action.Type) perms.OutputFieldsMap {
 	switch {
 	case r.v.requestInfo.TokenFormat == uint32(AuthTokenTypeRecoveryKms):
@@ -635,7 +648,15 @@ func (r *VerifyResults) FetchOutputFields(res perms.Resource, act action.Type) p
 		return nil
 	}
 
-	return r.v.acl.Allowed(res, act).OutputFields
+	return r.v.acl.Allowed(res, act, r.v.aclOptions()...).OutputFields
+}
+
+// aclOptions returns the context of the request which grant conditions are
+// evaluated against.
+func (v verifier) aclOptions() []perms.Option {
+	return []perms.Option{
+		perms.WithClientIp(v.requestInfo.GetClientIp()),
+	}
 }
 
 // GetTokenFromRequest pulls the token from either the Authorization header or
@@ -97,8 +97,9 @@ func GetListingScopeIds(
 		case 0:
 			// Defer until we've read all scopes. We do this because if the
 			// ordering coming back isn't in parent-first ording our map
-			// lookup might fail.
-			if !directOnly {
+			// lookup might fail. If listing is explicitly denied in this
+			// scope, list permissions in parent scopes don't apply.
+			if !directOnly && !authResults.ActionDenied(res, action.List) {
 				deferredScopes = append(deferredScopes, scp)
 			}
 		case 1:
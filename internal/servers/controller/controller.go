@@ -3,15 +3,19 @@ package controller
 import (
 	"context"
 	"crypto/rand"
+	stderrors "errors"
 	"fmt"
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
@@ -21,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/plugin/host"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
 	"github.com/hashicorp/boundary/internal/servers"
@@ -60,21 +65,28 @@ type Controller struct {
 	gatewayMux      *runtime.ServeMux
 
 	// Repo factory methods
-	AuthTokenRepoFn       common.AuthTokenRepoFactory
-	VaultCredentialRepoFn common.VaultCredentialRepoFactory
-	IamRepoFn             common.IamRepoFactory
-	OidcRepoFn            common.OidcAuthRepoFactory
-	PasswordAuthRepoFn    common.PasswordAuthRepoFactory
-	ServersRepoFn         common.ServersRepoFactory
-	SessionRepoFn         common.SessionRepoFactory
-	StaticHostRepoFn      common.StaticRepoFactory
-	PluginHostRepoFn      common.PluginHostRepoFactory
-	HostPluginRepoFn      common.HostPluginRepoFactory
-	TargetRepoFn          common.TargetRepoFactory
+	AccessRequestRepoFn    common.AccessRequestRepoFactory
+	AuthTokenRepoFn        common.AuthTokenRepoFactory
+	VaultCredentialRepoFn  common.VaultCredentialRepoFactory
+	StaticCredentialRepoFn common.StaticCredentialRepoFactory
+	IamRepoFn              common.IamRepoFactory
+	OidcRepoFn             common.OidcAuthRepoFactory
+	LdapRepoFn             common.LdapAuthRepoFactory
+	PasswordAuthRepoFn     common.PasswordAuthRepoFactory
+	ServersRepoFn          common.ServersRepoFactory
+	SessionRepoFn          common.SessionRepoFactory
+	StaticHostRepoFn       common.StaticRepoFactory
+	PluginHostRepoFn       common.PluginHostRepoFactory
+	HostPluginRepoFn       common.HostPluginRepoFactory
+	TargetRepoFn           common.TargetRepoFactory
 
 	scheduler *scheduler.Scheduler
 
 	kms *kms.Kms
+
+	// recordingStorage is where the session recordings reported by workers
+	// are read from. It is nil if no recording storage is configured.
+	recordingStorage recording.Storage
 }
 
 func New(ctx context.Context, conf *Config) (*Controller, error) {
@@ -103,6 +115,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error auto-generating controller name: %w", err)
 	}
 
+	if loc := conf.RawConfig.Controller.RecordingStorage; loc != "" {
+		if c.recordingStorage, err = recording.NewStorage(ctx, loc); err != nil {
+			return nil, fmt.Errorf("error setting up recording storage: %w", err)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -175,7 +193,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error getting audit wrapper from kms: %w", err)
 	}
-	if c.conf.Eventer.RotateAuditWrapper(ctx, auditWrapper); err != nil {
+	if err := c.conf.Eventer.RotateAuditWrapper(ctx, auditWrapper); err != nil {
 		return nil, fmt.Errorf("error rotating eventer audit wrapper: %w", err)
 	}
 	jobRepoFn := func() (*job.Repository, error) {
@@ -211,12 +229,18 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
 		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
 	}
+	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(dbase, dbase, c.kms)
+	}
 	c.ServersRepoFn = func() (*servers.Repository, error) {
 		return servers.NewRepository(dbase, dbase, c.kms)
 	}
 	c.OidcRepoFn = func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.LdapRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(dbase, dbase, c.kms)
 	}
@@ -226,6 +250,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.SessionRepoFn = func() (*session.Repository, error) {
 		return session.NewRepository(dbase, dbase, c.kms)
 	}
+	c.AccessRequestRepoFn = func() (*accessrequest.Repository, error) {
+		return accessrequest.NewRepository(dbase, dbase, c.kms)
+	}
 
 	return c, nil
 }
@@ -284,6 +311,15 @@ func (c *Controller) registerJobs() error {
 	if err := c.registerSessionCleanupJob(); err != nil {
 		return err
 	}
+	if err := c.registerKeyRewrapJob(); err != nil {
+		return err
+	}
+	if err := c.registerAccessRequestExpirationJob(); err != nil {
+		return err
+	}
+	if err := c.registerPrincipalRolePurgeJob(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -302,6 +338,48 @@ func (c *Controller) registerSessionCleanupJob() error {
 	return nil
 }
 
+// registerKeyRewrapJob is a helper method to abstract registering the key
+// rewrap job specifically.
+func (c *Controller) registerKeyRewrapJob() error {
+	keyRewrapJob, err := newKeyRewrapJob(c.kms)
+	if err != nil {
+		return fmt.Errorf("error creating key rewrap job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, keyRewrapJob); err != nil {
+		return fmt.Errorf("error registering key rewrap job: %w", err)
+	}
+
+	return nil
+}
+
+// registerAccessRequestExpirationJob is a helper method to abstract
+// registering the access request expiration job specifically.
+func (c *Controller) registerAccessRequestExpirationJob() error {
+	accessRequestExpirationJob, err := newAccessRequestExpirationJob(c.AccessRequestRepoFn)
+	if err != nil {
+		return fmt.Errorf("error creating access request expiration job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, accessRequestExpirationJob); err != nil {
+		return fmt.Errorf("error registering access request expiration job: %w", err)
+	}
+
+	return nil
+}
+
+// registerPrincipalRolePurgeJob is a helper method to abstract registering
+// the principal role purge job specifically.
+func (c *Controller) registerPrincipalRolePurgeJob() error {
+	principalRolePurgeJob, err := newPrincipalRolePurgeJob(c.IamRepoFn)
+	if err != nil {
+		return fmt.Errorf("error creating principal role purge job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, principalRolePurgeJob); err != nil {
+		return fmt.Errorf("error registering principal role purge job: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Controller) Shutdown(serversOnly bool) error {
 	const op = "controller.(Controller).Shutdown"
 	if !c.started.Load() {
@@ -322,6 +400,25 @@ func (c *Controller) Shutdown(serversOnly bool) error {
 	return nil
 }
 
+// HealthCheck returns an error when the controller isn't running or can't
+// reach its database.
+func (c *Controller) HealthCheck(ctx context.Context) error {
+	if !c.started.Load() {
+		return stderrors.New("controller is not running")
+	}
+	if c.conf.Database == nil {
+		return stderrors.New("controller has no database")
+	}
+	sqlDb, err := c.conf.Database.SqlDB(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting database: %w", err)
+	}
+	if err := sqlDb.PingContext(ctx); err != nil {
+		return fmt.Errorf("error reaching database: %w", err)
+	}
+	return nil
+}
+
 // WorkerStatusUpdateTimes returns the map, which specifically is held in _this_
 // controller, not the DB. It's used in tests to verify that a given controller
 // is receiving updates from an expected set of workers, to test out balancing
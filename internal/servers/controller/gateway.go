@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"google.golang.org/grpc"
@@ -37,6 +39,7 @@ func gatewayDialOptions(lis gatewayListener) []grpc.DialOption {
 		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
 			return lis.Dial()
 		}),
+		grpc.WithUnaryInterceptor(trace.NewGrpcClientInterceptor()),
 	}
 }
 
@@ -67,16 +70,19 @@ func newGatewayServer(
 	if err != nil {
 		return nil, "", err
 	}
+	latencyInterceptor := metric.NewGrpcServerInterceptor(apiGrpcRequestLatency)
 	return grpc.NewServer(
 		grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
-				requestCtxInterceptor,         // populated requestInfo from headers into the request ctx
-				auditRequestInterceptor(ctx),  // before we get started, audit the request
-				errorInterceptor(ctx),         // convert domain and api errors into headers for the http proxy
-				statusCodeInterceptor(ctx),    // convert grpc codes into http status codes for the http proxy (can modify the resp)
-				auditResponseInterceptor(ctx), // as we finish, audit the response
+				trace.NewGrpcServerInterceptor(), // record the request in a span
+				latencyInterceptor,               // observe the latency of the request
+				requestCtxInterceptor,            // populated requestInfo from headers into the request ctx
+				auditRequestInterceptor(ctx),     // before we get started, audit the request
+				errorInterceptor(ctx),            // convert domain and api errors into headers for the http proxy
+				statusCodeInterceptor(ctx),       // convert grpc codes into http status codes for the http proxy (can modify the resp)
+				auditResponseInterceptor(ctx),    // as we finish, audit the response
 			),
 		),
 	), ticket, nil
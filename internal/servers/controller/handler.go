@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/textproto"
 	"os"
@@ -18,12 +19,16 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/servers/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accounts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentialstores"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_catalogs"
@@ -32,9 +37,11 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessionrecordings"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
@@ -72,7 +79,8 @@ func (c *Controller) handler(props HandlerProperties) (http.Handler, error) {
 		return nil, err
 	}
 
-	return eventsHandler, nil
+	latencyHandler := metric.InstrumentHttpHandler(eventsHandler, apiHttpRequestLatency, apiPathLabel)
+	return trace.InstrumentHttpHandler(latencyHandler, apiPathLabel), nil
 }
 
 func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, error) {
@@ -113,7 +121,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn)
+		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create account handler service: %w", err)
 		}
@@ -123,7 +131,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
@@ -143,7 +151,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.ScopeService_ServiceDesc.ServiceName]; !ok {
-		os, err := scopes.NewService(c.IamRepoFn)
+		os, err := scopes.NewService(c.kms, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create scope handler service: %w", err)
 		}
@@ -153,7 +161,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.UserService_ServiceDesc.ServiceName]; !ok {
-		us, err := users.NewService(c.IamRepoFn)
+		us, err := users.NewService(c.IamRepoFn, c.AuthTokenRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create user handler service: %w", err)
 		}
@@ -172,7 +180,8 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			c.SessionRepoFn,
 			c.PluginHostRepoFn,
 			c.StaticHostRepoFn,
-			c.VaultCredentialRepoFn)
+			c.VaultCredentialRepoFn,
+			c.StaticCredentialRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create target handler service: %w", err)
 		}
@@ -211,8 +220,38 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			return nil, fmt.Errorf("failed to register session service handler: %w", err)
 		}
 	}
+	if _, ok := currentServices[services.SessionRecordingService_ServiceDesc.ServiceName]; !ok {
+		srs, err := sessionrecordings.NewService(c.SessionRepoFn, c.IamRepoFn, c.recordingStorage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session recording handler service: %w", err)
+		}
+		services.RegisterSessionRecordingServiceServer(c.gatewayServer, srs)
+		if err := services.RegisterSessionRecordingServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register session recording service handler: %w", err)
+		}
+	}
+	if _, ok := currentServices[services.AccessRequestService_ServiceDesc.ServiceName]; !ok {
+		ars, err := accessrequests.NewService(c.AccessRequestRepoFn, c.IamRepoFn, c.TargetRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create access request handler service: %w", err)
+		}
+		services.RegisterAccessRequestServiceServer(c.gatewayServer, ars)
+		if err := services.RegisterAccessRequestServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register access request service handler: %w", err)
+		}
+	}
+	if _, ok := currentServices[services.WorkerService_ServiceDesc.ServiceName]; !ok {
+		ws, err := workers.NewService(c.ServersRepoFn, c.IamRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create worker handler service: %w", err)
+		}
+		services.RegisterWorkerServiceServer(c.gatewayServer, ws)
+		if err := services.RegisterWorkerServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register worker service handler: %w", err)
+		}
+	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.OidcRepoFn)
+		mgs, err := managed_groups.NewService(c.OidcRepoFn, c.LdapRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -222,7 +261,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.CredentialStoreService_ServiceDesc.ServiceName]; !ok {
-		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
@@ -241,6 +280,16 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			return nil, fmt.Errorf("failed to register credential library service handler: %w", err)
 		}
 	}
+	if _, ok := currentServices[services.CredentialService_ServiceDesc.ServiceName]; !ok {
+		cr, err := credentials.NewService(c.StaticCredentialRepoFn, c.IamRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create credential handler service: %w", err)
+		}
+		services.RegisterCredentialServiceServer(c.gatewayServer, cr)
+		if err := services.RegisterCredentialServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register credential service handler: %w", err)
+		}
+	}
 
 	return c.gatewayMux, nil
 }
@@ -288,6 +337,12 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 
 		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
 
+		// The remote address has already been replaced with the one from the
+		// X-Forwarded-For header if the listener is configured to trust it
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			requestInfo.ClientIp = host
+		}
+
 		if info, ok := event.RequestInfoFromContext(ctx); ok {
 			// piggyback some eventing fields with the auth info proto message
 			requestInfo.EventId = info.EventId
@@ -0,0 +1,624 @@
+package accessrequests
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.ReadSelf,
+		action.Approve,
+		action.Deny,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.List,
+		action.Request,
+	}
+)
+
+// Service handles request as described by the pbs.AccessRequestServiceServer interface.
+type Service struct {
+	pbs.UnimplementedAccessRequestServiceServer
+
+	repoFn       common.AccessRequestRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	targetRepoFn common.TargetRepoFactory
+}
+
+// NewService returns an access request service which handles access request
+// related requests to boundary.
+func NewService(repoFn common.AccessRequestRepoFactory, iamRepoFn common.IamRepoFactory, targetRepoFn common.TargetRepoFactory) (Service, error) {
+	const op = "accessrequests.NewService"
+	if repoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing access request repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	if targetRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing target repository")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, targetRepoFn: targetRepoFn}, nil
+}
+
+var _ pbs.AccessRequestServiceServer = Service{}
+
+// GetAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) GetAccessRequest(ctx context.Context, req *pbs.GetAccessRequestRequest) (*pbs.GetAccessRequestResponse, error) {
+	const op = "accessrequests.(Service).GetAccessRequest"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var outputFields perms.OutputFieldsMap
+	authorizedActions := authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions)
+
+	// Check to see if we need to verify Read vs. just ReadSelf
+	if ar.UserId != authResults.UserId {
+		if !authorizedActions.HasAction(action.Read) {
+			return nil, handlers.ForbiddenError()
+		}
+		outputFields = authResults.FetchOutputFields(perms.Resource{
+			Id:      ar.GetPublicId(),
+			ScopeId: ar.ScopeId,
+			Type:    resource.AccessRequest,
+		}, action.Read).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ar, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetAccessRequestResponse{Item: item}, nil
+}
+
+// ListAccessRequests implements the interface pbs.AccessRequestServiceServer.
+func (s Service) ListAccessRequests(ctx context.Context, req *pbs.ListAccessRequestsRequest) (*pbs.ListAccessRequestsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(ctx,
+		s.iamRepoFn, authResults, req.GetScopeId(), resource.AccessRequest, req.GetRecursive(), false)
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListAccessRequestsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPaginator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.AccessRequest
+	res := perms.Resource{
+		Type: resource.AccessRequest,
+	}
+	for pager.Next() {
+		arList, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range arList {
+			pageKey := handlers.NewPageKey(item.CreateTime, item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.ScopeId
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
+		}
+	}
+
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListAccessRequestsResponse{Items: finalItems, ListToken: listToken}, nil
+}
+
+// RequestAccess implements the interface pbs.AccessRequestServiceServer.
+func (s Service) RequestAccess(ctx context.Context, req *pbs.RequestAccessRequest) (*pbs.RequestAccessResponse, error) {
+	if err := validateRequestAccessRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetTargetId(), action.Request)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if authResults.UserId == "" || authResults.UserId == auth.AnonymousUserId {
+		return nil, handlers.ForbiddenError()
+	}
+	ar, err := s.createInRepo(ctx, authResults.Scope.GetId(), authResults.UserId, req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, ar, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RequestAccessResponse{Item: item, Uri: fmt.Sprintf("access-requests/%s", item.GetId())}, nil
+}
+
+// ApproveAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) ApproveAccessRequest(ctx context.Context, req *pbs.ApproveAccessRequestRequest) (*pbs.ApproveAccessRequestResponse, error) {
+	if err := validateApproveRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.approveInRepo(ctx, req.GetId(), authResults.UserId, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.decisionToProto(ctx, ar, authResults, action.Approve)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveAccessRequestResponse{Item: item}, nil
+}
+
+// DenyAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) DenyAccessRequest(ctx context.Context, req *pbs.DenyAccessRequestRequest) (*pbs.DenyAccessRequestResponse, error) {
+	if err := validateDenyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Deny)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.denyInRepo(ctx, req.GetId(), authResults.UserId, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.decisionToProto(ctx, ar, authResults, action.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DenyAccessRequestResponse{Item: item}, nil
+}
+
+func (s Service) decisionToProto(ctx context.Context, ar *accessrequest.AccessRequest, authResults auth.VerifyResults, a action.Type) (*pb.AccessRequest, error) {
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      ar.GetPublicId(),
+		ScopeId: ar.ScopeId,
+		Type:    resource.AccessRequest,
+	}, a).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions).Strings()))
+	}
+	return toProto(ctx, ar, outputOpts...)
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*accessrequest.AccessRequest, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ar, err := repo.LookupAccessRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ar == nil {
+		return nil, handlers.NotFoundErrorf("Access request %q doesn't exist.", id)
+	}
+	return ar, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*accessrequest.AccessRequest, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	arList, err := repo.ListAccessRequests(ctx, accessrequest.WithScopeIds(scopeIds),
+		accessrequest.WithLimit(pager.Limit()), accessrequest.WithStartPageAfterItem(pager.StartAfter()))
+	if err != nil {
+		return nil, err
+	}
+	return arList, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, scopeId, userId string, item *pb.AccessRequest) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).createInRepo"
+	var opts []accessrequest.Option
+	if item.GetReason() != nil {
+		opts = append(opts, accessrequest.WithReason(item.GetReason().GetValue()))
+	}
+	ar, err := accessrequest.NewAccessRequest(scopeId, item.GetTargetId(), userId, item.GetDurationSeconds().GetValue(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build access request for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateAccessRequest(ctx, ar)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create access request"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create access request but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) approveInRepo(ctx context.Context, id, approverId string, version uint32) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).approveInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.ApproveAccessRequest(ctx, id, approverId, version)
+	if err != nil {
+		return nil, decisionError(ctx, err, id, op)
+	}
+	return out, nil
+}
+
+func (s Service) denyInRepo(ctx context.Context, id, approverId string, version uint32) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).denyInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.DenyAccessRequest(ctx, id, approverId, version)
+	if err != nil {
+		return nil, decisionError(ctx, err, id, op)
+	}
+	return out, nil
+}
+
+// decisionError maps the errors returned by the repository when approving or
+// denying an access request to API errors.
+func decisionError(ctx context.Context, err error, id string, op errors.Op) error {
+	switch {
+	case errors.IsNotFoundError(err):
+		return handlers.NotFoundErrorf("Access request %q doesn't exist.", id)
+	case errors.Match(errors.T(errors.InvalidAccessRequestState), err):
+		return handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Access request %q is no longer pending.", id)
+	case errors.Match(errors.T(errors.Forbidden), err):
+		return handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Users can't approve or deny their own access requests.")
+	}
+	return errors.Wrap(ctx, err, op)
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.AccessRequest), auth.WithAction(a)}
+	switch a {
+	case action.List:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	case action.Request:
+		// Access requests are made in the scope of the requested target.
+		repo, err := s.targetRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		t, _, _, err := repo.LookupTarget(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if t == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = t.GetScopeId()
+	case action.Read, action.ReadSelf, action.Approve, action.Deny:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		ar, err := repo.LookupAccessRequest(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if ar == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = ar.ScopeId
+		opts = append(opts, auth.WithId(id))
+	default:
+		res.Error = stderrors.New("unsupported action")
+		return res
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *accessrequest.AccessRequest, opt ...handlers.Option) (*pb.AccessRequest, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building access request proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.AccessRequest{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.ScopeId
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.TargetIdField) {
+		out.TargetId = in.TargetId
+	}
+	if outputFields.Has(globals.UserIdField) {
+		out.UserId = in.UserId
+	}
+	if outputFields.Has(globals.ReasonField) && in.Reason != "" {
+		out.Reason = wrapperspb.String(in.Reason)
+	}
+	if outputFields.Has(globals.DurationSecondsField) {
+		out.DurationSeconds = wrapperspb.UInt32(in.DurationSeconds)
+	}
+	if outputFields.Has(globals.StatusField) {
+		out.Status = in.Status
+	}
+	if outputFields.Has(globals.ApproverIdField) {
+		out.ApproverId = in.ApproverId
+	}
+	if outputFields.Has(globals.RoleIdField) {
+		out.RoleId = in.RoleId
+	}
+	if outputFields.Has(globals.ValidUntilField) && in.ValidUntil != nil {
+		out.ValidUntil = in.ValidUntil.GetTimestamp()
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.CreateTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.UpdateTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.Version
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetAccessRequestRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, accessrequest.AccessRequestPrefix)
+}
+
+func validateListRequest(req *pbs.ListAccessRequestsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the list operation must be recursive."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateRequestAccessRequest(req *pbs.RequestAccessRequest) error {
+	badFields := map[string]string{}
+	item := req.GetItem()
+	if item == nil {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{"item": "This field is required."})
+	}
+	if !handlers.ValidId(handlers.Id(item.GetTargetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
+		badFields[globals.TargetIdField] = "This field must be a valid target ID."
+	}
+	switch {
+	case item.GetDurationSeconds() == nil || item.GetDurationSeconds().GetValue() == 0:
+		badFields[globals.DurationSecondsField] = "This field is required."
+	case item.GetDurationSeconds().GetValue() > accessrequest.MaxDurationSeconds:
+		badFields[globals.DurationSecondsField] = fmt.Sprintf("This field must not be greater than %d.", accessrequest.MaxDurationSeconds)
+	}
+	if item.GetId() != "" {
+		badFields[globals.IdField] = "This is a read only field."
+	}
+	if item.GetScopeId() != "" {
+		badFields[globals.ScopeIdField] = "This is a read only field; the scope is that of the target."
+	}
+	if item.GetUserId() != "" {
+		badFields[globals.UserIdField] = "This is a read only field; access is requested for the authenticated user."
+	}
+	if item.GetStatus() != "" {
+		badFields[globals.StatusField] = "This is a read only field."
+	}
+	if item.GetApproverId() != "" {
+		badFields[globals.ApproverIdField] = "This is a read only field."
+	}
+	if item.GetRoleId() != "" {
+		badFields[globals.RoleIdField] = "This is a read only field."
+	}
+	if item.GetValidUntil() != nil {
+		badFields[globals.ValidUntilField] = "This is a read only field."
+	}
+	if item.GetCreatedTime() != nil {
+		badFields[globals.CreatedTimeField] = "This is a read only field."
+	}
+	if item.GetUpdatedTime() != nil {
+		badFields[globals.UpdatedTimeField] = "This is a read only field."
+	}
+	if item.GetVersion() != 0 {
+		badFields[globals.VersionField] = "This is a read only field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateApproveRequest(req *pbs.ApproveAccessRequestRequest) error {
+	return validateDecisionRequest(req)
+}
+
+func validateDenyRequest(req *pbs.DenyAccessRequestRequest) error {
+	return validateDecisionRequest(req)
+}
+
+func validateDecisionRequest(req interface {
+	GetId() string
+	GetVersion() uint32
+},
+) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), accessrequest.AccessRequestPrefix) {
+		badFields[globals.IdField] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
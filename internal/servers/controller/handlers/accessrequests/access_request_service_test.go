@@ -0,0 +1,311 @@
+package accessrequests_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "approve", "deny"}
+
+type testFixture struct {
+	iamRepo      *iam.Repository
+	iamRepoFn    func() (*iam.Repository, error)
+	repoFn       func() (*accessrequest.Repository, error)
+	targetRepoFn func() (*target.Repository, error)
+	org          *iam.Scope
+	prj          *iam.Scope
+	tar          *tcp.Target
+	requester    *iam.User
+	approver     *iam.User
+	conn         *db.DB
+}
+
+func testSetup(t *testing.T) *testFixture {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	rw := db.New(conn)
+	repo, err := accessrequest.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	targetRepo, err := target.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	org, prj := iam.TestScopes(t, iamRepo)
+	return &testFixture{
+		iamRepo:      iamRepo,
+		iamRepoFn:    func() (*iam.Repository, error) { return iamRepo, nil },
+		repoFn:       func() (*accessrequest.Repository, error) { return repo, nil },
+		targetRepoFn: func() (*target.Repository, error) { return targetRepo, nil },
+		org:          org,
+		prj:          prj,
+		tar:          tcp.TestTarget(t, conn, prj.PublicId, "test target"),
+		requester:    iam.TestUser(t, iamRepo, org.PublicId),
+		approver:     iam.TestUser(t, iamRepo, org.PublicId),
+		conn:         conn,
+	}
+}
+
+func TestGetAccessRequest(t *testing.T) {
+	f := testSetup(t)
+	ar := accessrequest.TestAccessRequest(t, f.conn, f.prj.PublicId, f.tar.PublicId, f.requester.PublicId, accessrequest.WithReason("incident 42"))
+
+	wireRequest := &pb.AccessRequest{
+		Id:                ar.GetPublicId(),
+		ScopeId:           f.prj.PublicId,
+		Scope:             &scopes.ScopeInfo{Id: f.prj.PublicId, Type: scope.Project.String(), ParentScopeId: f.org.PublicId},
+		TargetId:          f.tar.PublicId,
+		UserId:            f.requester.PublicId,
+		Reason:            wrapperspb.String("incident 42"),
+		DurationSeconds:   wrapperspb.UInt32(ar.DurationSeconds),
+		Status:            accessrequest.StatusPending.String(),
+		CreatedTime:       ar.CreateTime.GetTimestamp(),
+		UpdatedTime:       ar.UpdateTime.GetTimestamp(),
+		Version:           ar.Version,
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetAccessRequestRequest
+		res  *pbs.GetAccessRequestResponse
+		err  error
+	}{
+		{
+			name: "Get an access request",
+			req:  &pbs.GetAccessRequestRequest{Id: ar.GetPublicId()},
+			res:  &pbs.GetAccessRequestResponse{Item: wireRequest},
+		},
+		{
+			name: "Get a non existant access request",
+			req:  &pbs.GetAccessRequestRequest{Id: accessrequest.AccessRequestPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetAccessRequestRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := accessrequests.NewService(f.repoFn, f.iamRepoFn, f.targetRepoFn)
+			require.NoError(err, "Couldn't create new access request service.")
+
+			got, gErr := s.GetAccessRequest(auth.DisabledAuthTestContext(f.iamRepoFn, f.prj.PublicId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetAccessRequest(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetAccessRequest(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestListAccessRequests(t *testing.T) {
+	f := testSetup(t)
+	ar := accessrequest.TestAccessRequest(t, f.conn, f.prj.PublicId, f.tar.PublicId, f.requester.PublicId)
+
+	cases := []struct {
+		name    string
+		req     *pbs.ListAccessRequestsRequest
+		wantIds []string
+		err     error
+	}{
+		{
+			name:    "List access requests",
+			req:     &pbs.ListAccessRequestsRequest{ScopeId: f.prj.PublicId},
+			wantIds: []string{ar.GetPublicId()},
+		},
+		{
+			name:    "Filter access requests",
+			req:     &pbs.ListAccessRequestsRequest{ScopeId: f.prj.PublicId, Filter: `"/item/status"=="approved"`},
+			wantIds: nil,
+		},
+		{
+			name: "Invalid scope",
+			req:  &pbs.ListAccessRequestsRequest{ScopeId: "o_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := accessrequests.NewService(f.repoFn, f.iamRepoFn, f.targetRepoFn)
+			require.NoError(err, "Couldn't create new access request service.")
+
+			got, gErr := s.ListAccessRequests(auth.DisabledAuthTestContext(f.iamRepoFn, tc.req.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListAccessRequests(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			var ids []string
+			for _, item := range got.GetItems() {
+				ids = append(ids, item.GetId())
+			}
+			assert.Equal(tc.wantIds, ids)
+		})
+	}
+}
+
+func TestRequestAccess(t *testing.T) {
+	f := testSetup(t)
+
+	cases := []struct {
+		name string
+		req  *pbs.RequestAccessRequest
+		err  error
+	}{
+		{
+			name: "Request access",
+			req: &pbs.RequestAccessRequest{Item: &pb.AccessRequest{
+				TargetId:        f.tar.PublicId,
+				DurationSeconds: wrapperspb.UInt32(3600),
+				Reason:          wrapperspb.String("incident 42"),
+			}},
+		},
+		{
+			name: "Unknown target",
+			req: &pbs.RequestAccessRequest{Item: &pb.AccessRequest{
+				TargetId:        tcp.TargetPrefix + "_DoesntExis",
+				DurationSeconds: wrapperspb.UInt32(3600),
+			}},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Missing duration",
+			req: &pbs.RequestAccessRequest{Item: &pb.AccessRequest{
+				TargetId: f.tar.PublicId,
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Duration too long",
+			req: &pbs.RequestAccessRequest{Item: &pb.AccessRequest{
+				TargetId:        f.tar.PublicId,
+				DurationSeconds: wrapperspb.UInt32(accessrequest.MaxDurationSeconds + 1),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "User id set",
+			req: &pbs.RequestAccessRequest{Item: &pb.AccessRequest{
+				TargetId:        f.tar.PublicId,
+				UserId:          f.approver.PublicId,
+				DurationSeconds: wrapperspb.UInt32(3600),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := accessrequests.NewService(f.repoFn, f.iamRepoFn, f.targetRepoFn)
+			require.NoError(err, "Couldn't create new access request service.")
+
+			ctx := auth.DisabledAuthTestContext(f.iamRepoFn, f.prj.PublicId, auth.WithUserId(f.requester.PublicId))
+			got, gErr := s.RequestAccess(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RequestAccess(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal("access-requests/"+got.GetItem().GetId(), got.GetUri())
+			assert.Equal(f.prj.PublicId, got.GetItem().GetScopeId())
+			assert.Equal(f.requester.PublicId, got.GetItem().GetUserId())
+			assert.Equal(accessrequest.StatusPending.String(), got.GetItem().GetStatus())
+			assert.Equal(tc.req.GetItem().GetDurationSeconds().GetValue(), got.GetItem().GetDurationSeconds().GetValue())
+		})
+	}
+}
+
+func TestApproveAndDenyAccessRequest(t *testing.T) {
+	f := testSetup(t)
+	ctx := context.Background()
+	approverCtx := auth.DisabledAuthTestContext(f.iamRepoFn, f.prj.PublicId, auth.WithUserId(f.approver.PublicId))
+	requesterCtx := auth.DisabledAuthTestContext(f.iamRepoFn, f.prj.PublicId, auth.WithUserId(f.requester.PublicId))
+
+	s, err := accessrequests.NewService(f.repoFn, f.iamRepoFn, f.targetRepoFn)
+	require.NoError(t, err, "Couldn't create new access request service.")
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := accessrequest.TestAccessRequest(t, f.conn, f.prj.PublicId, f.tar.PublicId, f.requester.PublicId)
+
+		_, err := s.ApproveAccessRequest(requesterCtx, &pbs.ApproveAccessRequestRequest{Id: ar.PublicId, Version: ar.Version})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)), "got error %v", err)
+
+		got, err := s.ApproveAccessRequest(approverCtx, &pbs.ApproveAccessRequestRequest{Id: ar.PublicId, Version: ar.Version})
+		require.NoError(err)
+		assert.Equal(accessrequest.StatusApproved.String(), got.GetItem().GetStatus())
+		assert.Equal(f.approver.PublicId, got.GetItem().GetApproverId())
+		assert.NotEmpty(got.GetItem().GetRoleId())
+		assert.NotNil(got.GetItem().GetValidUntil())
+
+		role, principals, _, err := f.iamRepo.LookupRole(ctx, got.GetItem().GetRoleId())
+		require.NoError(err)
+		require.NotNil(role)
+		require.Len(principals, 1)
+		assert.Equal(f.requester.PublicId, principals[0].PrincipalId)
+
+		_, err = s.DenyAccessRequest(approverCtx, &pbs.DenyAccessRequestRequest{Id: ar.PublicId, Version: got.GetItem().GetVersion()})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+	})
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := accessrequest.TestAccessRequest(t, f.conn, f.prj.PublicId, f.tar.PublicId, f.requester.PublicId)
+
+		got, err := s.DenyAccessRequest(approverCtx, &pbs.DenyAccessRequestRequest{Id: ar.PublicId, Version: ar.Version})
+		require.NoError(err)
+		assert.Equal(accessrequest.StatusDenied.String(), got.GetItem().GetStatus())
+		assert.Equal(f.approver.PublicId, got.GetItem().GetApproverId())
+		assert.Empty(got.GetItem().GetRoleId())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.ApproveAccessRequest(approverCtx, &pbs.ApproveAccessRequestRequest{Id: "j_1234567890", Version: 1})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+
+		_, err = s.DenyAccessRequest(approverCtx, &pbs.DenyAccessRequestRequest{Id: accessrequest.AccessRequestPrefix + "_1234567890"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+
+		_, err = s.ApproveAccessRequest(approverCtx, &pbs.ApproveAccessRequestRequest{Id: accessrequest.AccessRequestPrefix + "_DoesntExis", Version: 1})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+	})
+}
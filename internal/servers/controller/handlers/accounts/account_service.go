@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
@@ -43,17 +45,25 @@ const (
 	loginNameKey         = "login_name"
 	newPasswordField     = "new_password"
 	currentPasswordField = "current_password"
+	totpCodeField        = "totp_code"
+	recoveryCodeField    = "recovery_code"
 
 	// oidc field names
 	issuerField     = "attributes.issuer"
 	subjectField    = "attributes.subject"
 	nameClaimField  = "attributes.full_name"
 	emailClaimField = "attributes.email"
+
+	// ldap field names
+	loginNameField      = "attributes.login_name"
+	dnField             = "attributes.dn"
+	memberOfGroupsField = "attributes.member_of_groups"
 )
 
 var (
 	pwMaskManager   handlers.MaskManager
 	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -65,6 +75,10 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.Unlock,
+			action.EnrollTotp,
+			action.ConfirmTotp,
+			action.RemoveTotp,
 		},
 		oidc.Subtype: {
 			action.NoOp,
@@ -72,6 +86,12 @@ var (
 			action.Update,
 			action.Delete,
 		},
+		ldap.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -90,6 +110,9 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&oidcstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.OidcAccountAttributes{}}); err != nil {
 		panic(err)
 	}
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.LdapAccountAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.AccountServiceServer interface.
@@ -98,10 +121,11 @@ type Service struct {
 
 	pwRepoFn   common.PasswordAuthRepoFactory
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
 }
 
 // NewService returns a account service which handles account related requests to boundary.
-func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory) (Service, error) {
+func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory) (Service, error) {
 	const op = "accounts.NewService"
 	if pwRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing password repository")
@@ -109,7 +133,10 @@ func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthR
 	if oidcRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidc repository provided")
 	}
-	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo}, nil
+	if ldapRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository provided")
+	}
+	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo}, nil
 }
 
 var _ pbs.AccountServiceServer = Service{}
@@ -123,54 +150,65 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(ul) == 0 {
-		return &pbs.ListAccountsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Account, 0, len(ul))
+	var finalItems []*pb.Account
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.Account,
 		Pin:     req.GetAuthMethodId(),
 	}
-	for _, acct := range ul {
-		res.Id = acct.GetPublicId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())], requestauth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, acct, outputOpts...)
+	for pager.Next() {
+		ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), pager)
 		if err != nil {
 			return nil, err
 		}
+		for _, acct := range ul {
+			pageKey := handlers.NewPageKey(acct.GetCreateTime(), acct.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = acct.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())], requestauth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, acct, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		// This comes last so that we can use item fields in the filter after
-		// the allowed fields are populated above
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			// This comes last so that we can use item fields in the filter after
+			// the allowed fields are populated above
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListAccountsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListAccountsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetAccount implements the interface pbs.AccountServiceServer.
@@ -387,6 +425,167 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: item}, nil
 }
 
+// Unlock implements the interface pbs.AccountServiceServer.
+func (s Service) Unlock(ctx context.Context, req *pbs.UnlockRequest) (*pbs.UnlockResponse, error) {
+	const op = "accounts.(Service).Unlock"
+
+	if err := validateUnlockRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Unlock)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, err := s.unlockInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UnlockResponse{Item: item}, nil
+}
+
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	const op = "accounts.(Service).EnrollTotp"
+
+	if err := validateEnrollTotpRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, enrollment, err := s.enrollTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetTotpCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.EnrollTotpResponse{
+		Item:          item,
+		TotpUri:       enrollment.Uri,
+		TotpSecret:    enrollment.Secret,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
+
+// ConfirmTotp implements the interface pbs.AccountServiceServer.
+func (s Service) ConfirmTotp(ctx context.Context, req *pbs.ConfirmTotpRequest) (*pbs.ConfirmTotpResponse, error) {
+	const op = "accounts.(Service).ConfirmTotp"
+
+	if err := validateConfirmTotpRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.ConfirmTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, err := s.confirmTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetTotpCode())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.ConfirmTotpResponse{Item: item}, nil
+}
+
+// RemoveTotp implements the interface pbs.AccountServiceServer.
+func (s Service) RemoveTotp(ctx context.Context, req *pbs.RemoveTotpRequest) (*pbs.RemoveTotpResponse, error) {
+	const op = "accounts.(Service).RemoveTotp"
+
+	if err := validateRemoveTotpRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, err := s.removeTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetTotpCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RemoveTotpResponse{Item: item}, nil
+}
+
 // getFromRepo returns the account and, if available, managed groups the account
 // belongs to within the auth method
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []string, error) {
@@ -426,6 +625,29 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			mgIds = append(mgIds, mg.GetManagedGroupId())
 		}
 		acct = a
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		if a == nil {
+			return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -464,6 +686,9 @@ func (s Service) createPwInRepo(ctx context.Context, am auth.AuthMethod, item *p
 	}
 	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a, createOpts...)
 	if err != nil {
+		if apiErr := passwordPolicyError(err, "attributes.password"); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -515,6 +740,42 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := &pb.LdapAccountAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	a, err := ldap.NewAccount(ctx, am.GetPublicId(), attrs.GetLoginName(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (auth.Account, error) {
 	const op = "accounts.(Service).createInRepo"
 	if item == nil {
@@ -540,6 +801,15 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -611,6 +881,40 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).updateLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := ldap.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateAccountRequest) (auth.Account, error) {
 	const op = "accounts.(Service).updateInRepo"
 	var out auth.Account
@@ -633,6 +937,15 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
 		}
 		out = a
+	case ldap.Subtype:
+		a, err := s.updateLdapInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
 	}
 	return out, nil
 }
@@ -654,6 +967,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -664,7 +983,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.Account, error) {
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, pager *handlers.Paginator) ([]auth.Account, error) {
 	const op = "accounts.(Service).listFromRepo"
 
 	var outUl []auth.Account
@@ -674,7 +993,7 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		pwl, err := pwRepo.ListAccounts(ctx, authMethodId)
+		pwl, err := pwRepo.ListAccounts(ctx, authMethodId, password.WithLimit(pager.Limit()), password.WithStartPageAfterItem(pager.StartAfter()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -686,13 +1005,25 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		oidcl, err := oidcRepo.ListAccounts(ctx, authMethodId)
+		oidcl, err := oidcRepo.ListAccounts(ctx, authMethodId, oidc.WithLimit(pager.Limit()), oidc.WithStartPageAfterItem(pager.StartAfter()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
 		for _, a := range oidcl {
 			outUl = append(outUl, a)
 		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ldapl, err := ldapRepo.ListAccounts(ctx, authMethodId, ldap.WithLimit(pager.Limit()), ldap.WithStartPageAfterItem(pager.StartAfter()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range ldapl {
+			outUl = append(outUl, a)
+		}
 	}
 	return outUl, nil
 }
@@ -715,6 +1046,9 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"new_password": "New password equal to current password."})
 		}
+		if apiErr := passwordPolicyError(err, "new_password"); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -739,11 +1073,142 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"password": "Password is too short."})
 		}
+		if apiErr := passwordPolicyError(err, "password"); apiErr != nil {
+			return nil, apiErr
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return out, nil
+}
+
+// passwordPolicyError returns the error to return for err if err results
+// from setting a password in the request field which does not meet the
+// password policy of the auth method. Otherwise nil is returned.
+func passwordPolicyError(err error, field string) error {
+	var msg string
+	switch {
+	case errors.Match(errors.T(errors.PasswordTooWeak), err):
+		msg = "Password does not contain the character classes required by the auth method."
+	case errors.Match(errors.T(errors.PasswordDisallowed), err):
+		msg = "Password is disallowed by the auth method."
+	case errors.Match(errors.T(errors.PasswordReused), err):
+		msg = "Password equals a recent password of the account."
+	default:
+		return nil
+	}
+	return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{field: msg})
+}
+
+func (s Service) unlockInRepo(ctx context.Context, id string) (auth.Account, error) {
+	const op = "accounts.(Service).unlockInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.UnlockAccount(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return out, nil
+}
+
+func (s Service) enrollTotpInRepo(ctx context.Context, scopeId, id, totpCode, recoveryCode string) (auth.Account, *password.TotpEnrollment, error) {
+	const op = "accounts.(Service).enrollTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	out, enrollment, err := repo.EnrollTotp(ctx, scopeId, id, secondFactorOpts(totpCode, recoveryCode)...)
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, nil, handlers.NotFoundErrorf("Account not found.")
+		case errors.Match(errors.T(errors.MfaRequired), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{totpCodeField: "A TOTP code or a recovery code is required to replace the confirmed TOTP enrollment."})
+		}
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if out == nil {
+		return nil, nil, invalidSecondFactorError(recoveryCode)
+	}
+	return out, enrollment, nil
+}
+
+func (s Service) confirmTotpInRepo(ctx context.Context, scopeId, id, code string) (auth.Account, error) {
+	const op = "accounts.(Service).confirmTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.ConfirmTotp(ctx, scopeId, id, code)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Account not found or has no pending TOTP enrollment.")
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if out == nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{totpCodeField: "TOTP code is not valid."})
+	}
+	return out, nil
+}
+
+func (s Service) removeTotpInRepo(ctx context.Context, scopeId, id, totpCode, recoveryCode string) (auth.Account, error) {
+	const op = "accounts.(Service).removeTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.RemoveTotp(ctx, scopeId, id, secondFactorOpts(totpCode, recoveryCode)...)
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		case errors.Match(errors.T(errors.MfaRequired), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{totpCodeField: "A TOTP code or a recovery code is required to remove the confirmed TOTP enrollment."})
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if out == nil {
+		return nil, invalidSecondFactorError(recoveryCode)
+	}
 	return out, nil
 }
 
+// secondFactorOpts returns the password options for the TOTP code or the
+// recovery code of a request changing the TOTP enrollment of an account.
+func secondFactorOpts(totpCode, recoveryCode string) []password.Option {
+	var opts []password.Option
+	if totpCode != "" {
+		opts = append(opts, password.WithTotpCode(totpCode))
+	}
+	if recoveryCode != "" {
+		opts = append(opts, password.WithRecoveryCode(recoveryCode))
+	}
+	return opts
+}
+
+// invalidSecondFactorError returns the error for a request changing the TOTP
+// enrollment of an account whose TOTP code or recovery code was not accepted.
+func invalidSecondFactorError(recoveryCode string) error {
+	if recoveryCode != "" {
+		return handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{recoveryCodeField: "Recovery code is not valid."})
+	}
+	return handlers.InvalidArgumentErrorf("Error in provided request.",
+		map[string]string{totpCodeField: "TOTP code is not valid."})
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, requestauth.VerifyResults) {
 	res := requestauth.VerifyResults{}
 	pwRepo, err := s.pwRepoFn()
@@ -756,6 +1221,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.Account), requestauth.WithAction(a)}
@@ -786,6 +1256,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case ldap.Subtype:
+			acct, err := ldapRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
 		}
 		opts = append(opts, requestauth.WithId(id))
 	}
@@ -814,6 +1295,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		authMeth = am
+	case ldap.Subtype:
+		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
 	}
 	opts = append(opts, requestauth.WithScopeId(authMeth.GetScopeId()), requestauth.WithPin(parentId))
 	return authMeth, requestauth.Verify(ctx, opts...)
@@ -910,6 +1402,28 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
 		}
 		out.Attributes = st
+	case *ldap.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		groups, err := i.GetGroups(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored member of groups"))
+		}
+		st, err := handlers.ProtoToStruct(&pb.LdapAccountAttributes{
+			LoginName:      i.GetLoginName(),
+			FullName:       i.GetFullName(),
+			Email:          i.GetEmail(),
+			Dn:             i.GetDn(),
+			MemberOfGroups: groups,
+		})
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+		}
+		out.Attributes = st
 	}
 	return &out, nil
 }
@@ -953,7 +1467,7 @@ func validateGetRequest(req *pbs.GetAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateAccountRequest) error {
@@ -1004,6 +1518,29 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 			if attrs.GetEmail() != "" {
 				badFields[emailClaimField] = "This is a read only field."
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := &pb.LdapAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			}
+			if attrs.GetLoginName() == "" {
+				badFields[loginNameField] = "This is a required field for this type."
+			}
+			if attrs.GetFullName() != "" {
+				badFields[nameClaimField] = "This is a read only field."
+			}
+			if attrs.GetEmail() != "" {
+				badFields[emailClaimField] = "This is a read only field."
+			}
+			if attrs.GetDn() != "" {
+				badFields[dnField] = "This is a read only field."
+			}
+			if len(attrs.GetMemberOfGroups()) > 0 {
+				badFields[memberOfGroupsField] = "This is a read only field."
+			}
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -1047,9 +1584,25 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), nameClaimField) {
 				badFields[nameClaimField] = "Field is read only."
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			attrs := &pb.LdapAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), loginNameField) {
+				badFields[loginNameField] = "Field cannot be updated."
+			}
+			for _, f := range []string{nameClaimField, emailClaimField, dnField, memberOfGroupsField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
 		}
 		return badFields
-	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
@@ -1057,7 +1610,7 @@ func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateListRequest(req *pbs.ListAccountsRequest) error {
@@ -1066,7 +1619,7 @@ func validateListRequest(req *pbs.ListAccountsRequest) error {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 		badFields[authMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -1119,3 +1672,72 @@ func validateSetPasswordRequest(req *pbs.SetPasswordRequest) error {
 	}
 	return nil
 }
+
+func validateUnlockRequest(req *pbs.UnlockRequest) error {
+	const op = "accounts.validateUnlockRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateEnrollTotpRequest(req *pbs.EnrollTotpRequest) error {
+	const op = "accounts.validateEnrollTotpRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetTotpCode() != "" && req.GetRecoveryCode() != "" {
+		badFields[recoveryCodeField] = "Only one of totp_code and recovery_code may be provided."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateConfirmTotpRequest(req *pbs.ConfirmTotpRequest) error {
+	const op = "accounts.validateConfirmTotpRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetTotpCode() == "" {
+		badFields[totpCodeField] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateRemoveTotpRequest(req *pbs.RemoveTotpRequest) error {
+	const op = "accounts.validateRemoveTotpRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetTotpCode() != "" && req.GetRecoveryCode() != "" {
+		badFields[recoveryCodeField] = "Only one of totp_code and recovery_code may be provided."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
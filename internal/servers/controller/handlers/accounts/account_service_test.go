@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -45,6 +46,10 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.Unlock.String(),
+		action.EnrollTotp.String(),
+		action.ConfirmTotp.String(),
+		action.RemoveTotp.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -66,11 +71,15 @@ func TestNewService(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name     string
 		pwRepo   common.PasswordAuthRepoFactory
 		oidcRepo common.OidcAuthRepoFactory
+		ldapRepo common.LdapAuthRepoFactory
 		wantErr  bool
 	}{
 		{
@@ -80,22 +89,31 @@ func TestNewService(t *testing.T) {
 		{
 			name:     "nil-pw-repo",
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
 			wantErr:  true,
 		},
 		{
-			name:    "nil-oidc-repo",
-			pwRepo:  pwRepoFn,
-			wantErr: true,
+			name:     "nil-oidc-repo",
+			pwRepo:   pwRepoFn,
+			ldapRepo: ldapRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-ldap-repo",
+			pwRepo:   pwRepoFn,
+			oidcRepo: oidcRepoFn,
+			wantErr:  true,
 		},
 		{
 			name:     "success",
 			pwRepo:   pwRepoFn,
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo)
+			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo, tc.ldapRepo)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -117,11 +135,14 @@ func TestGet(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -256,6 +277,9 @@ func TestListPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -347,7 +371,7 @@ func TestListPassword(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			// Test non-anon first
@@ -390,6 +414,9 @@ func TestListOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -497,7 +524,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -543,6 +570,9 @@ func TestDelete(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -562,7 +592,7 @@ func TestDelete(t *testing.T) {
 	)
 	oidcA := oidc.TestAccount(t, conn, oidcAm, "test-subject")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -639,6 +669,9 @@ func TestDelete_twice(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -647,7 +680,7 @@ func TestDelete_twice(t *testing.T) {
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccount(t, conn, am.GetPublicId(), "name1")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAccountRequest{
 		Id: ac.GetPublicId(),
@@ -671,11 +704,14 @@ func TestCreatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -873,11 +909,14 @@ func TestCreateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -1086,13 +1125,16 @@ func TestUpdatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new accounts service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1467,6 +1509,9 @@ func TestUpdateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -1482,7 +1527,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1850,12 +1895,15 @@ func TestSetPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -1989,12 +2037,15 @@ func TestChangePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
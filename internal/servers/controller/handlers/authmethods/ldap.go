@@ -0,0 +1,243 @@
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// ldap field names
+	urlsField             = "attributes.urls"
+	certificatesField     = "attributes.certificates"
+	bindDnField           = "attributes.bind_dn"
+	bindPasswordHmacField = "attributes.bind_password_hmac"
+	userFilterField       = "attributes.user_filter"
+	groupFilterField      = "attributes.group_filter"
+)
+
+var ldapMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.AuthMethod{}}, handlers.MaskSource{&pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}}); err != nil {
+		panic(err)
+	}
+
+	IdActions[ldap.Subtype] = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+	}
+}
+
+// createLdapInRepo creates an ldap auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetAttributes().GetFields()
+	tok, err := s.authenticateWithLdapRepo(ctx, req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+func (s Service) authenticateWithLdapRepo(ctx context.Context, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := ldapRepo.Authenticate(ctx, authMethodId, loginName, pw)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+
+	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		tok,
+	)
+}
+
+// validateLdapAttributes checks the attributes of an ldap auth method in a
+// create request, or in an update request with the given update mask.
+func validateLdapAttributes(ctx context.Context, attrs *pb.LdapAuthMethodAttributes, isCreate bool, mask []string, badFields map[string]string) {
+	if isCreate || handlers.MaskContains(mask, urlsField) {
+		if len(attrs.GetUrls()) == 0 {
+			badFields[urlsField] = "At least one URL is required."
+		}
+		for _, u := range attrs.GetUrls() {
+			parsed, err := url.Parse(u)
+			if err != nil || parsed.Host == "" || (!strings.EqualFold(parsed.Scheme, "ldap") && !strings.EqualFold(parsed.Scheme, "ldaps")) {
+				badFields[urlsField] = fmt.Sprintf("%q is not a valid ldap or ldaps url.", u)
+				break
+			}
+		}
+	}
+	if len(attrs.GetCertificates()) > 0 {
+		if _, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...); err != nil {
+			badFields[certificatesField] = fmt.Sprintf("Cannot parse CA certificates. %v", err.Error())
+		}
+	}
+	if attrs.GetBindPassword().GetValue() != "" && attrs.GetBindDn().GetValue() == "" && (isCreate || handlers.MaskContains(mask, bindDnField)) {
+		badFields[bindDnField] = "Field required when a bind password is set."
+	}
+	if f := attrs.GetUserFilter().GetValue(); f != "" {
+		if _, err := template.New("user_filter").Parse(f); err != nil {
+			badFields[userFilterField] = fmt.Sprintf("Cannot be parsed as a template. %v", err)
+		}
+	}
+	if f := attrs.GetGroupFilter().GetValue(); f != "" {
+		if _, err := template.New("group_filter").Parse(f); err != nil {
+			badFields[groupFilterField] = fmt.Sprintf("Cannot be parsed as a template. %v", err)
+		}
+	}
+	if attrs.GetBindPasswordHmac() != "" {
+		badFields[bindPasswordHmacField] = "Field is read only."
+	}
+}
+
+func toStorageLdapAuthMethod(scopeId string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	const op = "authmethod_service.toStorageLdapAuthMethod"
+	if item == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{attributesField: "Attribute fields do not match the expected format."})
+	}
+
+	u := ldap.AllocAuthMethod()
+	u.ScopeId = scopeId
+	u.Name = item.GetName().GetValue()
+	u.Description = item.GetDescription().GetValue()
+	u.Urls = attrs.GetUrls()
+	u.Certificates = attrs.GetCertificates()
+	u.StartTls = attrs.GetStartTls()
+	u.InsecureTls = attrs.GetInsecureTls()
+	u.DiscoverDn = attrs.GetDiscoverDn()
+	u.AnonGroupSearch = attrs.GetAnonGroupSearch()
+	u.UpnDomain = attrs.GetUpnDomain().GetValue()
+	u.UserDn = attrs.GetUserDn().GetValue()
+	u.UserAttr = attrs.GetUserAttr().GetValue()
+	u.UserFilter = attrs.GetUserFilter().GetValue()
+	u.GroupDn = attrs.GetGroupDn().GetValue()
+	u.GroupAttr = attrs.GetGroupAttr().GetValue()
+	u.GroupFilter = attrs.GetGroupFilter().GetValue()
+	u.BindDn = attrs.GetBindDn().GetValue()
+	u.BindPassword = attrs.GetBindPassword().GetValue()
+	u.AuthTokenTimeToLive = item.GetAuthTokenTimeToLive()
+	u.AuthTokenTimeToStale = item.GetAuthTokenTimeToStale()
+	return &u, nil
+}
+
+func toLdapAuthMethodAttributes(in *ldap.AuthMethod) *pb.LdapAuthMethodAttributes {
+	attrs := &pb.LdapAuthMethodAttributes{
+		Urls:             in.GetUrls(),
+		StartTls:         in.GetStartTls(),
+		InsecureTls:      in.GetInsecureTls(),
+		DiscoverDn:       in.GetDiscoverDn(),
+		AnonGroupSearch:  in.GetAnonGroupSearch(),
+		BindPasswordHmac: in.GetBindPasswordHmac(),
+		Certificates:     in.GetCertificates(),
+	}
+	for _, f := range []struct {
+		in  string
+		out **wrapperspb.StringValue
+	}{
+		{in.GetUpnDomain(), &attrs.UpnDomain},
+		{in.GetUserDn(), &attrs.UserDn},
+		{in.GetUserAttr(), &attrs.UserAttr},
+		{in.GetUserFilter(), &attrs.UserFilter},
+		{in.GetGroupDn(), &attrs.GroupDn},
+		{in.GetGroupAttr(), &attrs.GroupAttr},
+		{in.GetGroupFilter(), &attrs.GroupFilter},
+		{in.GetBindDn(), &attrs.BindDn},
+	} {
+		if f.in != "" {
+			*f.out = wrapperspb.String(f.in)
+		}
+	}
+	return attrs
+}
@@ -500,5 +500,7 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 	if err != nil {
 		return nil, false, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
 	}
+	u.AuthTokenTimeToLive = in.GetAuthTokenTimeToLive()
+	u.AuthTokenTimeToStale = in.GetAuthTokenTimeToStale()
 	return u, attrs.GetDryRun(), attrs.GetDisableDiscoveredConfigValidation(), nil
 }
@@ -15,13 +15,22 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
 	// password field names
-	loginNameField = "login_name"
-	passwordField  = "password"
-	loginCommand   = "login"
+	loginNameField     = "login_name"
+	passwordField      = "password"
+	newPasswordField   = "new_password"
+	totpCodeField      = "totp_code"
+	recoveryCodeField  = "recovery_code"
+	mfaRequiredField   = "mfa_required"
+	enrollTotpField    = "enroll_totp"
+	totpUriField       = "totp_uri"
+	totpSecretField    = "totp_secret"
+	recoveryCodesField = "recovery_codes"
+	loginCommand       = "login"
 )
 
 var pwMaskManager handlers.MaskManager
@@ -87,16 +96,86 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 	return out, nil
 }
 
+// authenticatePassword authenticates an account with its login name and
+// password. If the account is enrolled for TOTP and the request has no TOTP
+// code or recovery code, the response has no auth token and its mfa_required
+// attribute is true; the request must then be repeated with one of the codes.
+// If the password of the account has expired, the request must have a new
+// password which replaces it.
+//
+// If the enroll_totp attribute of the request is true, a TOTP secret is
+// enrolled for the account instead, which lets accounts of an auth method
+// requiring multi-factor authentication enroll before they can be
+// authenticated. The response has no auth token but the enrollment, and the
+// request must then be repeated with a TOTP code generated from the secret to
+// confirm it.
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetAttributes().GetFields()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if reqAttrs[enrollTotpField].GetBoolValue() {
+		return s.enrollTotpWithPassword(ctx, req, authResults)
+	}
+	var opts []password.Option
+	if code := reqAttrs[totpCodeField].GetStringValue(); code != "" {
+		opts = append(opts, password.WithTotpCode(code))
+	}
+	if code := reqAttrs[recoveryCodeField].GetStringValue(); code != "" {
+		opts = append(opts, password.WithRecoveryCode(code))
+	}
+	if pw := reqAttrs[newPasswordField].GetStringValue(); pw != "" {
+		opts = append(opts, password.WithNewPassword(pw))
+	}
+	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue(), opts...)
 	if err != nil {
+		if errors.Match(errors.T(errors.MfaRequired), err) {
+			return &pbs.AuthenticateResponse{
+				Command: req.GetCommand(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					mfaRequiredField: structpb.NewBoolValue(true),
+				}},
+			}, nil
+		}
 		return nil, err
 	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) enrollTotpWithPassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetAttributes().GetFields()
+	pwRepo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	acct, enrollment, err := pwRepo.EnrollTotpWithPassword(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if err != nil {
+		if errors.Match(errors.T(errors.MfaRequired), err) {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.enroll_totp": "The account has a confirmed TOTP enrollment, which can only be replaced by the account's enroll-totp action."})
+		}
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	recoveryCodes := make([]interface{}, 0, len(enrollment.RecoveryCodes))
+	for _, c := range enrollment.RecoveryCodes {
+		recoveryCodes = append(recoveryCodes, c)
+	}
+	attrs, err := structpb.NewStruct(map[string]interface{}{
+		mfaRequiredField:   true,
+		totpUriField:       enrollment.Uri,
+		totpSecretField:    enrollment.Secret,
+		recoveryCodesField: recoveryCodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.AuthenticateResponse{
+		Command:    req.GetCommand(),
+		Attributes: attrs,
+	}, nil
+}
+
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string, opt ...password.Option) (*pba.AuthToken, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
@@ -110,8 +189,29 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 		return nil, err
 	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw, opt...)
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.PasswordExpired), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Password has expired and must be changed; provide a new_password to replace it.")
+		case errors.Match(errors.T(errors.PasswordsEqual), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "New password equal to current password."})
+		case errors.Match(errors.T(errors.PasswordTooShort), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password does not contain the character classes required by the auth method."})
+		case errors.Match(errors.T(errors.PasswordDisallowed), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password is disallowed by the auth method."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password equals a recent password of the account."})
+		case errors.Match(errors.T(errors.MfaNotEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Multi-factor authentication is required and the account has no confirmed TOTP enrollment; set enroll_totp to enroll.")
+		}
 		return nil, err
 	}
 	if acct == nil {
@@ -149,6 +249,12 @@ func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	if _, ok := attrs[passwordField]; !ok {
 		badFields["attributes.password"] = "This is a required field."
 	}
+	if attrs[totpCodeField].GetStringValue() != "" && attrs[recoveryCodeField].GetStringValue() != "" {
+		badFields["attributes.recovery_code"] = "Only one of totp_code and recovery_code may be provided."
+	}
+	if attrs[enrollTotpField].GetBoolValue() && (attrs[totpCodeField].GetStringValue() != "" || attrs[recoveryCodeField].GetStringValue() != "") {
+		badFields["attributes.enroll_totp"] = "A TOTP code or a recovery code cannot be provided when enrolling."
+	}
 	if req.GetCommand() == "" {
 		// TODO: Eventually, require a command. For now, fall back to "login" for backwards compat.
 		req.Command = loginCommand
@@ -195,5 +301,18 @@ func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthM
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.MaxFailedAttempts = pwAttrs.GetMaxFailedAttempts()
+	u.LockoutDuration = pwAttrs.GetLockoutDuration()
+	u.FailedAttemptDelay = pwAttrs.GetFailedAttemptDelay()
+	u.RequireLowercase = pwAttrs.GetRequireLowercase()
+	u.RequireUppercase = pwAttrs.GetRequireUppercase()
+	u.RequireDigit = pwAttrs.GetRequireDigit()
+	u.RequireSymbol = pwAttrs.GetRequireSymbol()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeDays = pwAttrs.GetMaxPasswordAgeDays()
+	u.RequireMfa = pwAttrs.GetRequireMfa()
+	u.DisallowedPasswords = pwAttrs.GetDisallowedPasswords()
+	u.AuthTokenTimeToLive = item.GetAuthTokenTimeToLive()
+	u.AuthTokenTimeToStale = item.GetAuthTokenTimeToStale()
 	return u, nil
 }
@@ -90,55 +90,66 @@ func (s Service) ListAuthTokens(ctx context.Context, req *pbs.ListAuthTokensRequ
 		return &pbs.ListAuthTokensResponse{}, nil
 	}
 
-	ul, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(ul) == 0 {
-		return &pbs.ListAuthTokensResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.AuthToken, 0, len(ul))
+	var finalItems []*pb.AuthToken
 	res := perms.Resource{
 		Type: resource.AuthToken,
 	}
-	for _, at := range ul {
-		res.Id = at.GetPublicId()
-		res.ScopeId = at.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions, auth.WithResource(&res))
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		if authorizedActions.OnlySelf() && at.GetIamUserId() != authResults.UserId {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[at.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
-		}
-
-		item, err := toProto(ctx, at, outputOpts...)
+	for pager.Next() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager)
 		if err != nil {
 			return nil, err
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		for _, at := range ul {
+			pageKey := handlers.NewPageKey(at.GetCreateTime(), at.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = at.GetPublicId()
+			res.ScopeId = at.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			if authorizedActions.OnlySelf() && at.GetIamUserId() != authResults.UserId {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[at.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, at, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
 
-	return &pbs.ListAuthTokensResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListAuthTokensResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetAuthToken implements the interface pbs.AuthTokenServiceServer.
@@ -258,13 +269,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*authtoken.AuthToken, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*authtoken.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthTokens(ctx, scopeIds)
+	ul, err := repo.ListAuthTokens(ctx, scopeIds, authtoken.WithLimit(pager.Limit()), authtoken.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, err
 	}
@@ -90,51 +90,62 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 		return nil, authResults.Error
 	}
 
-	csl, err := s.listFromRepo(ctx, req.GetCredentialStoreId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(csl) == 0 {
-		return &pbs.ListCredentialLibrariesResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.CredentialLibrary, 0, len(csl))
+	var finalItems []*pb.CredentialLibrary
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.CredentialLibrary,
 		Pin:     req.GetCredentialStoreId(),
 	}
-	for _, item := range csl {
-		res.Id = item.GetPublicId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(item, outputOpts...)
+	for pager.Next() {
+		csl, err := s.listFromRepo(ctx, req.GetCredentialStoreId(), pager)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range csl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListCredentialLibrariesResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListCredentialLibrariesResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetCredentialLibrary implements the interface pbs.CredentialLibraryServiceServer.
@@ -270,13 +281,13 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, storeId string) ([]*vault.CredentialLibrary, error) {
+func (s Service) listFromRepo(ctx context.Context, storeId string, pager *handlers.Paginator) ([]*vault.CredentialLibrary, error) {
 	const op = "credentiallibraries.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	csl, err := repo.ListCredentialLibraries(ctx, storeId)
+	csl, err := repo.ListCredentialLibraries(ctx, storeId, vault.WithLimit(pager.Limit()), vault.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
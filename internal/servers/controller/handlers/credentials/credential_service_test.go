@@ -0,0 +1,515 @@
+package credentials
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+
+func TestList(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prjNoCreds := iam.TestScopes(t, iamRepo)
+	storeNoCreds := static.TestCredentialStore(t, conn, wrapper, prjNoCreds.GetPublicId())
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	var wantCreds []*pb.Credential
+	for _, c := range static.TestUsernamePasswordCredentials(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId(), 5) {
+		wantCreds = append(wantCreds, &pb.Credential{
+			Id:                c.GetPublicId(),
+			CredentialStoreId: c.GetStoreId(),
+			Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: prj.GetParentId()},
+			Name:              wrapperspb.String(c.GetName()),
+			CreatedTime:       c.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       c.GetUpdateTime().GetTimestamp(),
+			Version:           c.GetVersion(),
+			Type:              credential.UsernamePasswordType.String(),
+			AuthorizedActions: testAuthorizedActions,
+			Attributes: func() *structpb.Struct {
+				attrs, err := handlers.ProtoToStruct(&pb.UsernamePasswordAttributes{
+					Username:     wrapperspb.String(c.GetUsername()),
+					PasswordHmac: base64.RawURLEncoding.EncodeToString(c.GetPasswordHmac()),
+				})
+				require.NoError(t, err)
+				return attrs
+			}(),
+		})
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.ListCredentialsRequest
+		res  *pbs.ListCredentialsResponse
+		err  error
+	}{
+		{
+			name: "List Many Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId()},
+			res:  &pbs.ListCredentialsResponse{Items: wantCreds},
+		},
+		{
+			name: "List No Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: storeNoCreds.GetPublicId()},
+			res:  &pbs.ListCredentialsResponse{},
+		},
+		{
+			name: "Filter to One Credential",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: fmt.Sprintf(`"/item/id"==%q`, wantCreds[1].GetId())},
+			res:  &pbs.ListCredentialsResponse{Items: wantCreds[1:2]},
+		},
+		{
+			name: "Filter to No Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: `"/item/id"=="doesnt match"`},
+			res:  &pbs.ListCredentialsResponse{},
+		},
+		{
+			name: "Filter Bad Format",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: `"//id/"=="bad"`},
+			err:  handlers.InvalidArgumentErrorf("bad format", nil),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewService(repoFn, iamRepoFn)
+			require.NoError(t, err, "Couldn't create new credential service.")
+
+			// Test non-anonymous listing
+			got, gErr := s.ListCredentials(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err), "ListCredentials(%q) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(t, gErr)
+			sort.Slice(got.Items, func(i, j int) bool {
+				return got.Items[i].GetId() < got.Items[j].GetId()
+			})
+			want := proto.Clone(tc.res).(*pbs.ListCredentialsResponse)
+			sort.Slice(want.Items, func(i, j int) bool {
+				return want.Items[i].GetId() < want.Items[j].GetId()
+			})
+			assert.Empty(t, cmp.Diff(got, want, protocmp.Transform()))
+
+			// Test anonymous listing
+			got, gErr = s.ListCredentials(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId(), auth.WithUserId(auth.AnonymousUserId)), tc.req)
+			require.NoError(t, gErr)
+			assert.Len(t, got.Items, len(tc.res.Items))
+			for _, item := range got.GetItems() {
+				require.Nil(t, item.CreatedTime)
+				require.Nil(t, item.UpdatedTime)
+				require.Zero(t, item.Version)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	attrs := func(t *testing.T, m map[string]interface{}) *structpb.Struct {
+		t.Helper()
+		st, err := structpb.NewStruct(m)
+		require.NoError(t, err)
+		return st
+	}
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialRequest
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "Can't specify Id",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Id:                static.UsernamePasswordCredentialPrefix + "_notallowed",
+				Type:              credential.UsernamePasswordType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user", "password": "pass"}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid store id",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: "csvlt_1234567890",
+				Type:              credential.UsernamePasswordType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user", "password": "pass"}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown type",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              "unknown",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing password",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              credential.UsernamePasswordType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user"}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid private key",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              credential.SshPrivateKeyType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user", "private_key": "not a key"}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Empty json object",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              credential.JsonType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"object": map[string]interface{}{}}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create username password",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("up"),
+				Type:              credential.UsernamePasswordType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user", "password": "pass"}),
+			}},
+			idPrefix: static.UsernamePasswordCredentialPrefix + "_",
+		},
+		{
+			name: "Create ssh private key",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("spk"),
+				Type:              credential.SshPrivateKeyType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"username": "user", "private_key": static.TestSshPrivateKeyPem(t)}),
+			}},
+			idPrefix: static.SshPrivateKeyCredentialPrefix + "_",
+		},
+		{
+			name: "Create json",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("json"),
+				Type:              credential.JsonType.String(),
+				Attributes:        attrs(t, map[string]interface{}{"object": map[string]interface{}{"key": "value"}}),
+			}},
+			idPrefix: static.JsonCredentialPrefix + "_",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := NewService(repoFn, iamRepoFn)
+			require.NoError(err, "Error when getting new credential service.")
+
+			got, gErr := s.CreateCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredential(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.NotNil(got)
+			assert.Contains(got.GetUri(), got.GetItem().GetId())
+			assert.Contains(got.GetItem().GetId(), tc.idPrefix)
+			assert.Equal(tc.req.GetItem().GetType(), got.GetItem().GetType())
+			assert.Equal(tc.req.GetItem().GetName().GetValue(), got.GetItem().GetName().GetValue())
+			assert.Equal(store.GetPublicId(), got.GetItem().GetCredentialStoreId())
+			assert.EqualValues(1, got.GetItem().GetVersion())
+
+			// Secrets must never be returned.
+			attrs := got.GetItem().GetAttributes().GetFields()
+			assert.NotContains(attrs, "password")
+			assert.NotContains(attrs, "private_key")
+			assert.NotContains(attrs, "object")
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := static.TestJsonCredential(t, conn, wrapper, store.GetPublicId(), prj.GetPublicId(), map[string]interface{}{"key": "value"})
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		id   string
+		res  *pbs.GetCredentialResponse
+		err  error
+	}{
+		{
+			name: "success",
+			id:   cred.GetPublicId(),
+			res: &pbs.GetCredentialResponse{
+				Item: &pb.Credential{
+					Id:                cred.GetPublicId(),
+					CredentialStoreId: cred.GetStoreId(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: prj.GetParentId()},
+					Type:              credential.JsonType.String(),
+					AuthorizedActions: testAuthorizedActions,
+					CreatedTime:       cred.CreateTime.GetTimestamp(),
+					UpdatedTime:       cred.UpdateTime.GetTimestamp(),
+					Version:           1,
+					Attributes: func() *structpb.Struct {
+						attrs, err := handlers.ProtoToStruct(&pb.JsonAttributes{
+							ObjectHmac: base64.RawURLEncoding.EncodeToString(cred.GetObjectHmac()),
+						})
+						require.NoError(t, err)
+						return attrs
+					}(),
+				},
+			},
+		},
+		{
+			name: "not found error",
+			id:   fmt.Sprintf("%s_1234567890", static.JsonCredentialPrefix),
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "bad prefix",
+			id:   fmt.Sprintf("%s_1234567890", static.CredentialStorePrefix),
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &pbs.GetCredentialRequest{Id: tc.id}
+			// Test non-anonymous get
+			got, gErr := s.GetCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err))
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform()))
+
+			// Test anonymous get
+			got, gErr = s.GetCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId(), auth.WithUserId(auth.AnonymousUserId)), req)
+			require.NoError(t, gErr)
+			require.Nil(t, got.Item.CreatedTime)
+			require.Nil(t, got.Item.UpdatedTime)
+			require.Zero(t, got.Item.Version)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name  string
+		paths []string
+		item  *pb.Credential
+		check func(*testing.T, *pb.Credential)
+		err   error
+	}{
+		{
+			name:  "update name",
+			paths: []string{"name"},
+			item:  &pb.Credential{Name: wrapperspb.String("updated")},
+			check: func(t *testing.T, got *pb.Credential) {
+				assert.Equal(t, "updated", got.GetName().GetValue())
+			},
+		},
+		{
+			name:  "update username",
+			paths: []string{"attributes.username"},
+			item: &pb.Credential{Attributes: func() *structpb.Struct {
+				st, err := structpb.NewStruct(map[string]interface{}{"username": "new-user"})
+				require.NoError(t, err)
+				return st
+			}()},
+			check: func(t *testing.T, got *pb.Credential) {
+				assert.Equal(t, "new-user", got.GetAttributes().GetFields()["username"].GetStringValue())
+			},
+		},
+		{
+			name:  "empty password",
+			paths: []string{"attributes.password"},
+			item:  &pb.Credential{},
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "change type",
+			paths: []string{"name"},
+			item:  &pb.Credential{Name: wrapperspb.String("updated"), Type: credential.JsonType.String()},
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "no valid mask",
+			paths: []string{"attributes.private_key"},
+			item:  &pb.Credential{},
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
+			item := proto.Clone(tc.item).(*pb.Credential)
+			item.Version = cred.GetVersion()
+			req := &pbs.UpdateCredentialRequest{
+				Id:         cred.GetPublicId(),
+				Item:       item,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tc.paths},
+			}
+			got, gErr := s.UpdateCredential(ctx, req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err), "UpdateCredential(%+v) got error %v, wanted %v", req, gErr, tc.err)
+				return
+			}
+			require.NoError(t, gErr)
+			assert.EqualValues(t, cred.GetVersion()+1, got.GetItem().GetVersion())
+			tc.check(t, got.GetItem())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := static.TestSshPrivateKeyCredential(t, conn, wrapper, "user", static.TestSshPrivateKeyPem(t), store.GetPublicId(), prj.GetPublicId())
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		id   string
+		err  error
+	}{
+		{
+			name: "success",
+			id:   cred.GetPublicId(),
+		},
+		{
+			name: "not found error",
+			id:   fmt.Sprintf("%s_1234567890", static.SshPrivateKeyCredentialPrefix),
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "bad prefix",
+			id:   fmt.Sprintf("%s_1234567890", static.CredentialStorePrefix),
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, gErr := s.DeleteCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), &pbs.DeleteCredentialRequest{Id: tc.id})
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err))
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Nil(t, got)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -55,9 +57,13 @@ var (
 		action.List,
 	}
 
-	collectionTypeMap = map[resource.Type]action.ActionSet{
+	vaultCollectionTypeMap = map[resource.Type]action.ActionSet{
 		resource.CredentialLibrary: credentiallibraries.CollectionActions,
 	}
+
+	staticCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.Credential: credentials.CollectionActions,
+	}
 )
 
 func init() {
@@ -72,12 +78,13 @@ func init() {
 type Service struct {
 	pbs.UnimplementedCredentialStoreServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	repoFn       common.VaultCredentialRepoFactory
+	staticRepoFn common.StaticCredentialRepoFactory
 }
 
 // NewService returns a credential store service which handles credential store related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, staticRepo common.StaticCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "credentialstores.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -85,7 +92,10 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if staticRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, staticRepoFn: staticRepo}, nil
 }
 
 var _ pbs.CredentialStoreServiceServer = Service{}
@@ -119,57 +129,68 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 		return &pbs.ListCredentialStoresResponse{}, nil
 	}
 
-	csl, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(csl) == 0 {
-		return &pbs.ListCredentialStoresResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.CredentialStore, 0, len(csl))
+	var finalItems []*pb.CredentialStore
 	res := perms.Resource{
 		Type: resource.CredentialStore,
 	}
-	for _, item := range csl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for pager.Next() {
+		csl, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range csl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, item.GetPublicId())
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMapForId(item.GetPublicId()), authResults.Scope.Id, item.GetPublicId())
+				if err != nil {
+					return nil, err
+				}
+				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+			}
+
+			item, err := toProto(item, outputOpts...)
 			if err != nil {
 				return nil, err
 			}
-			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
-		}
-
-		item, err := toProto(item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListCredentialStoresResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListCredentialStoresResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetCredentialStore implements the interface pbs.CredentialStoreServiceServer.
@@ -202,7 +223,7 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMapForId(cs.GetPublicId()), authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -247,7 +268,7 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMapForId(cs.GetPublicId()), authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -295,7 +316,7 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMapForId(cs.GetPublicId()), authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -326,48 +347,113 @@ func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCrede
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*vault.CredentialStore, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]credential.Store, error) {
 	const op = "credentialstores.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	csl, err := repo.ListCredentialStores(ctx, scopeIds)
+	vaultStores, err := repo.ListCredentialStores(ctx, scopeIds, vault.WithLimit(pager.Limit()), vault.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	staticRepo, err := s.staticRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	staticStores, err := staticRepo.ListCredentialStores(ctx, scopeIds, static.WithLimit(pager.Limit()), static.WithStartPageAfterItem(pager.StartAfter()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	csl := make([]credential.Store, 0, len(vaultStores)+len(staticStores))
+	for _, cs := range vaultStores {
+		csl = append(csl, cs)
+	}
+	for _, cs := range staticStores {
+		csl = append(csl, cs)
+	}
+	// Each repository lists a batch of its own credential stores in order, so
+	// merge the batches and keep the first ones.
+	pager.Sort(csl, func(i int) handlers.PageKey {
+		return handlers.NewPageKey(csl[i].GetCreateTime(), csl[i].GetPublicId())
+	})
+	if limit := pager.Limit(); limit > 0 && len(csl) > limit {
+		csl = csl[:limit]
+	}
 	return csl, nil
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
 	const op = "credentialstores.(Service).getFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	cs, err := repo.LookupCredentialStore(ctx, id)
-	if err != nil && !errors.IsNotFoundError(err) {
-		return nil, errors.Wrap(ctx, err, op)
+	var cs credential.Store
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vcs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if vcs != nil {
+			cs = vcs
+		}
+	case static.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if scs != nil {
+			cs = scs
+		}
 	}
 	if cs == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential store %q not found", id))
 	}
-	return cs, err
+	return cs, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, error) {
 	const op = "credentialstores.(Service).createInRepo"
-	cs, err := toStorageVaultStore(projId, item)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	out, err := repo.CreateCredentialStore(ctx, cs)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+	var out credential.Store
+	switch credential.SubtypeFromType(item.GetType()) {
+	case static.Subtype:
+		cs, err := toStorageStaticStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if scs != nil {
+			out = scs
+		}
+	default:
+		cs, err := toStorageVaultStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vcs, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if vcs != nil {
+			out = vcs
+		}
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store but no error returned from repository.")
@@ -377,23 +463,44 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 
 func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
 	const op = "credentialstores.(Service).updateInRepo"
-	cs, err := toStorageVaultStore(projId, item)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	cs.PublicId = id
-
 	dbMask := maskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	out, rowsUpdated, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+
+	var out credential.Store
+	var rowsUpdated int
+	switch credential.SubtypeFromId(id) {
+	case static.Subtype:
+		cs, err := toStorageStaticStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, n, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		out, rowsUpdated = scs, n
+	default:
+		cs, err := toStorageVaultStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vcs, n, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		out, rowsUpdated = vcs, n
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
@@ -403,11 +510,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "credentialstores.(Service).deleteFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return false, err
+	var rows int
+	var err error
+	switch credential.SubtypeFromId(id) {
+	case static.Subtype:
+		repo, rerr := s.staticRepoFn()
+		if rerr != nil {
+			return false, rerr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+	default:
+		repo, rerr := s.repoFn()
+		if rerr != nil {
+			return false, rerr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
 	}
-	rows, err := repo.DeleteCredentialStore(ctx, id)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return false, nil
@@ -424,11 +542,6 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		res.Error = err
-		return res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -445,10 +558,36 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		cs, err := repo.LookupCredentialStore(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
+		var cs credential.Store
+		switch credential.SubtypeFromId(id) {
+		case static.Subtype:
+			repo, err := s.staticRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			scs, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if scs != nil {
+				cs = scs
+			}
+		default:
+			repo, err := s.repoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			vcs, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if vcs != nil {
+				cs = vcs
+			}
 		}
 		if cs == nil {
 			res.Error = handlers.NotFoundError()
@@ -545,6 +684,32 @@ func toProto(in credential.Store, opt ...handlers.Option) (*pb.CredentialStore,
 	return &out, nil
 }
 
+func toStorageStaticStore(scopeId string, in *pb.CredentialStore) (out *static.CredentialStore, err error) {
+	const op = "credentialstores.toStorageStaticStore"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	cs, err := static.NewCredentialStore(scopeId, opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
+// collectionTypeMapForId returns the collection types that are contained by
+// the credential store with the provided id.
+func collectionTypeMapForId(id string) map[resource.Type]action.ActionSet {
+	if credential.SubtypeFromId(id) == static.Subtype {
+		return staticCollectionTypeMap
+	}
+	return vaultCollectionTypeMap
+}
+
 func toStorageVaultStore(scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
@@ -603,11 +768,11 @@ func toStorageVaultStore(scopeId string, in *pb.CredentialStore) (out *vault.Cre
 
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
-//  * The path passed in is correctly formatted
-//  * All required parameters are set
-//  * There are no conflicting parameters provided
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
@@ -649,6 +814,10 @@ func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
 			if len(cs) > 0 && pk == nil {
 				badFields[clientCertField] = "Cannot set a client certificate without a private key."
 			}
+		case static.Subtype:
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Attributes are not supported for static credential stores."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -691,13 +860,20 @@ func validateUpdateRequest(req *pbs.UpdateCredentialStoreRequest) error {
 			if err != nil {
 				badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 			}
+		case static.Subtype:
+			if req.GetItem().GetType() != "" && credential.SubtypeFromType(req.GetItem().GetType()) != static.Subtype {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Attributes are not supported for static credential stores."
+			}
 		}
 		return badFields
-	}, vault.CredentialStorePrefix)
+	}, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
@@ -130,72 +130,83 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 		return &pbs.ListHostCatalogsResponse{}, nil
 	}
 
-	items, pluginInfoMap, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(items) == 0 {
-		return &pbs.ListHostCatalogsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.HostCatalog, 0, len(items))
+	var finalItems []*pb.HostCatalog
 	res := perms.Resource{
 		Type: resource.HostCatalog,
 	}
-	for _, item := range items {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for pager.Next() {
+		items, pluginInfoMap, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range items {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			var subtype subtypes.Subtype
-			switch item.(type) {
-			case *static.HostCatalog:
-				subtype = static.Subtype
-			case *plugin.HostCatalog:
-				subtype = plugin.Subtype
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
 			}
-			if subtype != "" {
-				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, item.GetPublicId())
-				if err != nil {
-					return nil, err
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				var subtype subtypes.Subtype
+				switch item.(type) {
+				case *static.HostCatalog:
+					subtype = static.Subtype
+				case *plugin.HostCatalog:
+					subtype = plugin.Subtype
+				}
+				if subtype != "" {
+					collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, item.GetPublicId())
+					if err != nil {
+						return nil, err
+					}
+					outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 				}
-				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 			}
-		}
-		switch hc := item.(type) {
-		case *plugin.HostCatalog:
-			if plgInfo, ok := pluginInfoMap[hc.GetPluginId()]; ok {
-				outputOpts = append(outputOpts, handlers.WithPlugin(plgInfo))
+			switch hc := item.(type) {
+			case *plugin.HostCatalog:
+				if plgInfo, ok := pluginInfoMap[hc.GetPluginId()]; ok {
+					outputOpts = append(outputOpts, handlers.WithPlugin(plgInfo))
+				}
 			}
-		}
 
-		item, err := toProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListHostCatalogsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListHostCatalogsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetHostCatalog implements the interface pbs.HostCatalogServiceServer.
@@ -414,12 +425,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plu
 	return cat, plg, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]host.Catalog, map[string]*plugins.PluginInfo, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]host.Catalog, map[string]*plugins.PluginInfo, error) {
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, nil, err
 	}
-	ul, err := repo.ListCatalogs(ctx, scopeIds)
+	ul, err := repo.ListCatalogs(ctx, scopeIds, static.WithLimit(pager.Limit()), static.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -431,13 +442,21 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]host.Ca
 	if err != nil {
 		return nil, nil, err
 	}
-	pl, plgs, err := pluginRepo.ListCatalogs(ctx, scopeIds)
+	pl, plgs, err := pluginRepo.ListCatalogs(ctx, scopeIds, host.WithLimit(pager.Limit()), host.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, nil, err
 	}
 	for _, c := range pl {
 		res = append(res, c)
 	}
+	// Each repository lists a batch of its own host catalogs in order, so
+	// merge the batches and keep the first ones.
+	pager.Sort(res, func(i int) handlers.PageKey {
+		return handlers.NewPageKey(res[i].GetCreateTime(), res[i].GetPublicId())
+	})
+	if limit := pager.Limit(); limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
 	pluginsMap := make(map[string]*plugins.PluginInfo, len(plgs))
 	for _, plg := range plgs {
 		pluginsMap[plg.GetPublicId()] = toPluginInfo(plg)
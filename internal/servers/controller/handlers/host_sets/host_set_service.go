@@ -106,56 +106,67 @@ func (s Service) ListHostSetsWithOptions(ctx context.Context, req *pbs.ListHostS
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), opt...)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(hl) == 0 {
-		return &pbs.ListHostSetsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.HostSet, 0, len(hl))
+	var finalItems []*pb.HostSet
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.HostSet,
 		Pin:     req.GetHostCatalogId(),
 	}
-	for _, item := range hl {
-		res.Id = item.GetPublicId()
-		idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if plg != nil {
-			outputOpts = append(outputOpts, handlers.WithPlugin(plg))
-		}
-
-		item, err := toProto(ctx, item, nil, outputOpts...)
+	for pager.Next() {
+		hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), pager, opt...)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range hl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if plg != nil {
+				outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+			}
+
+			item, err := toProto(ctx, item, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListHostSetsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListHostSetsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetHostSet implements the interface pbs.HostSetServiceServer.
@@ -612,7 +623,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host.Option) ([]host.Set, *plugins.PluginInfo, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, pager *handlers.Paginator, opt ...host.Option) ([]host.Set, *plugins.PluginInfo, error) {
 	const op = "host_sets.(Service).listFromRepo"
 	var plg *plugins.PluginInfo
 	var sets []host.Set
@@ -622,7 +633,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host
 		if err != nil {
 			return nil, nil, err
 		}
-		sl, err := repo.ListSets(ctx, catalogId)
+		sl, err := repo.ListSets(ctx, catalogId, static.WithLimit(pager.Limit()), static.WithStartPageAfterItem(pager.StartAfter()))
 		if err != nil {
 			return nil, nil, errors.Wrap(ctx, err, op)
 		}
@@ -634,7 +645,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host
 		if err != nil {
 			return nil, nil, err
 		}
-		sl, hsplg, err := repo.ListSets(ctx, catalogId, opt...)
+		sl, hsplg, err := repo.ListSets(ctx, catalogId, append(opt, host.WithLimit(pager.Limit()), host.WithStartPageAfterItem(pager.StartAfter()))...)
 		if err != nil {
 			return nil, nil, errors.Wrap(ctx, err, op)
 		}
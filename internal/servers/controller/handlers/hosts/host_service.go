@@ -91,61 +91,72 @@ func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(hl) == 0 {
-		return &pbs.ListHostsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Host, 0, len(hl))
+	var finalItems []*pb.Host
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.Host,
 		Pin:     req.GetHostCatalogId(),
 	}
-	for _, item := range hl {
-		res.Id = item.GetPublicId()
-		idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if plg != nil {
-			outputOpts = append(outputOpts, handlers.WithPlugin(plg))
-		}
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		var hostSetIds []string
-		switch h := item.(type) {
-		case *plugin.Host:
-			hostSetIds = h.SetIds
-		}
-		item, err := toProto(ctx, item, hostSetIds, outputOpts...)
+	for pager.Next() {
+		hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), pager)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range hl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if plg != nil {
+				outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+			}
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			var hostSetIds []string
+			switch h := item.(type) {
+			case *plugin.Host:
+				hostSetIds = h.SetIds
+			}
+			item, err := toProto(ctx, item, hostSetIds, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListHostsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListHostsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetHost implements the interface pbs.HostServiceServer.
@@ -413,7 +424,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Host, *plugins.PluginInfo, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, pager *handlers.Paginator) ([]host.Host, *plugins.PluginInfo, error) {
 	var hosts []host.Host
 	var plg *plugins.PluginInfo
 	switch host.SubtypeFromId(catalogId) {
@@ -422,7 +433,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Hos
 		if err != nil {
 			return nil, nil, err
 		}
-		hl, err := repo.ListHosts(ctx, catalogId)
+		hl, err := repo.ListHosts(ctx, catalogId, static.WithLimit(pager.Limit()), static.WithStartPageAfterItem(pager.StartAfter()))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -434,7 +445,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Hos
 		if err != nil {
 			return nil, nil, err
 		}
-		hl, hlPlg, err := repo.ListHostsByCatalogId(ctx, catalogId)
+		hl, hlPlg, err := repo.ListHostsByCatalogId(ctx, catalogId, plugin.WithLimit(pager.Limit()), plugin.WithStartPageAfterItem(pager.StartAfter()))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	pageSizeField  = "page_size"
+	listTokenField = "list_token"
+)
+
+// PageKey is the position of an item in a paginated listing. Items are
+// ordered by their creation time and then by their id, so an item keeps its
+// position when other items are added to the collection while it is being
+// paged through.
+type PageKey struct {
+	CreateTime time.Time
+	Id         string
+}
+
+// NewPageKey returns the PageKey for an item created at createTime with the
+// provided id.
+func NewPageKey(createTime *timestamp.Timestamp, id string) PageKey {
+	k := PageKey{Id: id}
+	if createTime.GetTimestamp() != nil {
+		k.CreateTime = createTime.AsTime()
+	}
+	return k
+}
+
+func (k PageKey) before(o PageKey) bool {
+	if !k.CreateTime.Equal(o.CreateTime) {
+		return k.CreateTime.Before(o.CreateTime)
+	}
+	return k.Id < o.Id
+}
+
+// listToken is the content of the opaque token handed out to clients.
+type listToken struct {
+	CreateTime  time.Time `json:"t"`
+	Id          string    `json:"i"`
+	Fingerprint string    `json:"f"`
+}
+
+// Paginator splits the items of a list request into pages. A page ends
+// either when it holds page size items or when the items run out; in the
+// first case a list token is handed out which the client passes back to get
+// the next page.
+//
+// The items are listed from the repository in batches, ordered by their
+// PageKey and starting after the last item seen. Items which are not
+// authorized or don't match the filter are dropped, so a page may need more
+// than one batch:
+//
+//	for pager.Next() {
+//		items := list(pager.StartAfter(), pager.Limit())
+//		for _, item := range items {
+//			pager.Seen(key(item))
+//			...
+//			if !pager.Add(key(item)) {
+//				break
+//			}
+//		}
+//	}
+type Paginator struct {
+	pageSize    uint32
+	fingerprint string
+	after       *PageKey
+	last        PageKey
+	count       uint32
+	more        bool
+	batches     int
+	seen        int
+}
+
+// ListRequest is a list request that supports pagination.
+type ListRequest interface {
+	proto.Message
+	GetPageSize() uint32
+	GetListToken() string
+}
+
+// NewPaginator returns a Paginator for req. A page size of 0 returns all items
+// in a single page. The token handed out for the next page is bound to the
+// rest of the request, for example its scope and filter, so that it can't be
+// used to continue a different listing.
+func NewPaginator(ctx context.Context, req ListRequest) (*Paginator, error) {
+	const op = "handlers.NewPaginator"
+	fp, err := fingerprint(req)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p := &Paginator{
+		pageSize:    req.GetPageSize(),
+		fingerprint: fp,
+	}
+	token := req.GetListToken()
+	if token == "" {
+		return p, nil
+	}
+	badToken := InvalidArgumentErrorf("Error in provided request.", map[string]string{listTokenField: "Invalid list token."})
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, badToken
+	}
+	var lt listToken
+	if err := json.Unmarshal(raw, &lt); err != nil {
+		return nil, badToken
+	}
+	if lt.Fingerprint != p.fingerprint {
+		return nil, InvalidArgumentErrorf("Error in provided request.", map[string]string{listTokenField: "List token was issued for a different list request."})
+	}
+	p.after = &PageKey{CreateTime: lt.CreateTime, Id: lt.Id}
+	return p, nil
+}
+
+// fingerprint hashes the type and content of req, ignoring its pagination
+// fields.
+func fingerprint(req ListRequest) (string, error) {
+	m := proto.Clone(req).ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{pageSizeField, listTokenField} {
+		if fd := fields.ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(m.Descriptor().FullName()))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
+
+// Next reports whether another batch of items must be listed to fill the
+// page. It returns false once the page is full and it is known whether more
+// items follow, or once the previous batch held fewer items than Limit. A page
+// size of 0 lists a single batch.
+func (p *Paginator) Next() bool {
+	if p.batches > 0 && (p.more || p.pageSize == 0 || p.seen < p.Limit()) {
+		return false
+	}
+	p.batches++
+	p.seen = 0
+	return true
+}
+
+// StartAfter returns the position after which the next batch of items starts.
+// It is passed to the repository together with Limit.
+func (p *Paginator) StartAfter() *db.PageItem {
+	if p.after == nil {
+		return &db.PageItem{}
+	}
+	return &db.PageItem{CreateTime: p.after.CreateTime, PublicId: p.after.Id}
+}
+
+// Limit returns the number of items to list in a batch: one more than the page
+// size, so that a full page is known to be followed by more items. It returns
+// 0, the default limit of the repository, if the page size is 0.
+func (p *Paginator) Limit() int {
+	if p.pageSize == 0 {
+		return 0
+	}
+	return int(p.pageSize) + 1
+}
+
+// Seen records that the item with key k was listed in the current batch. It
+// must be called for every listed item, whether it is returned or not, so the
+// next batch starts after it.
+func (p *Paginator) Seen(k PageKey) {
+	p.seen++
+	p.after = &k
+}
+
+// Sort orders items, which must be a slice, by the PageKey that key returns
+// for the item at index i. It is used to merge the batches listed from more
+// than one repository, which must then be truncated to Limit items.
+func (p *Paginator) Sort(items interface{}, key func(i int) PageKey) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(i).before(key(j))
+	})
+}
+
+// Add records that the item with key k is returned in this page. It returns
+// false if the page is already full, in which case the item must not be
+// returned and listing can stop.
+func (p *Paginator) Add(k PageKey) bool {
+	if p.pageSize > 0 && p.count >= p.pageSize {
+		p.more = true
+		return false
+	}
+	p.count++
+	p.last = k
+	return true
+}
+
+// ListToken returns the token for the page after this one, or an empty string
+// if this is the last page.
+func (p *Paginator) ListToken(ctx context.Context) (string, error) {
+	const op = "handlers.(Paginator).ListToken"
+	if !p.more {
+		return "", nil
+	}
+	raw, err := json.Marshal(listToken{
+		CreateTime:  p.last.CreateTime,
+		Id:          p.last.Id,
+		Fingerprint: p.fingerprint,
+	})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode list token"))
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	var keys []PageKey
+	for i := 0; i < 5; i++ {
+		keys = append(keys, NewPageKey(timestamp.New(now.Add(time.Duration(i)*time.Second)), fmt.Sprintf("id_%d", i)))
+	}
+
+	// list pages through items like a handler does, listing them from a
+	// repository which orders them and returns a batch of at most limit items
+	// after the given position. Items for which drop returns true are listed
+	// but not returned.
+	list := func(t *testing.T, items []PageKey, pageSize uint32, token string, drop func(PageKey) bool) ([]string, string, int) {
+		t.Helper()
+		p, err := NewPaginator(ctx, &pbs.ListSessionsRequest{ScopeId: "global", PageSize: pageSize, ListToken: token})
+		require.NoError(t, err)
+		sorted := append([]PageKey(nil), items...)
+		p.Sort(sorted, func(i int) PageKey { return sorted[i] })
+		var ids []string
+		var batches int
+		for p.Next() {
+			batches++
+			after, limit := p.StartAfter(), p.Limit()
+			var batch []PageKey
+			for _, k := range sorted {
+				if after.PublicId != "" && !(PageKey{CreateTime: after.CreateTime, Id: after.PublicId}).before(k) {
+					continue
+				}
+				if limit > 0 && len(batch) == limit {
+					break
+				}
+				batch = append(batch, k)
+			}
+			for _, k := range batch {
+				p.Seen(k)
+				if drop != nil && drop(k) {
+					continue
+				}
+				if !p.Add(k) {
+					break
+				}
+				ids = append(ids, k.Id)
+			}
+		}
+		next, err := p.ListToken(ctx)
+		require.NoError(t, err)
+		return ids, next, batches
+	}
+
+	t.Run("all", func(t *testing.T) {
+		ids, token, batches := list(t, []PageKey{keys[3], keys[1], keys[0], keys[4], keys[2]}, 0, "", nil)
+		assert.Equal(t, []string{"id_0", "id_1", "id_2", "id_3", "id_4"}, ids)
+		assert.Empty(t, token)
+		assert.Equal(t, 1, batches)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		items := []PageKey{keys[0], keys[1], keys[2], keys[3], keys[4]}
+		ids, token, _ := list(t, items, 2, "", nil)
+		assert.Equal(t, []string{"id_0", "id_1"}, ids)
+		require.NotEmpty(t, token)
+
+		// Items created while paging show up at the end and items listed
+		// before aren't repeated.
+		items = append(items, NewPageKey(timestamp.New(now.Add(time.Minute)), "id_new"))
+		ids, token, _ = list(t, items, 2, token, nil)
+		assert.Equal(t, []string{"id_2", "id_3"}, ids)
+		require.NotEmpty(t, token)
+
+		ids, token, _ = list(t, items, 2, token, nil)
+		assert.Equal(t, []string{"id_4", "id_new"}, ids)
+		assert.Empty(t, token)
+	})
+
+	t.Run("same create time", func(t *testing.T) {
+		items := []PageKey{
+			NewPageKey(timestamp.New(now), "id_b"),
+			NewPageKey(timestamp.New(now), "id_a"),
+			NewPageKey(timestamp.New(now), "id_c"),
+		}
+		ids, token, _ := list(t, items, 2, "", nil)
+		assert.Equal(t, []string{"id_a", "id_b"}, ids)
+		ids, token, _ = list(t, items, 2, token, nil)
+		assert.Equal(t, []string{"id_c"}, ids)
+		assert.Empty(t, token)
+	})
+
+	t.Run("dropped items", func(t *testing.T) {
+		// Dropping items takes more than one batch to fill a page, and the
+		// next page starts after the last returned item.
+		dropOdd := func(k PageKey) bool { return k.Id == "id_1" || k.Id == "id_3" }
+		ids, token, batches := list(t, keys, 2, "", dropOdd)
+		assert.Equal(t, []string{"id_0", "id_2"}, ids)
+		require.NotEmpty(t, token)
+		assert.Equal(t, 2, batches)
+
+		ids, token, batches = list(t, keys, 2, token, dropOdd)
+		assert.Equal(t, []string{"id_4"}, ids)
+		assert.Empty(t, token)
+		assert.Equal(t, 1, batches)
+	})
+
+	t.Run("last page is full", func(t *testing.T) {
+		ids, token, batches := list(t, keys[:4], 2, "", nil)
+		assert.Equal(t, []string{"id_0", "id_1"}, ids)
+		require.NotEmpty(t, token)
+		assert.Equal(t, 1, batches)
+
+		ids, token, _ = list(t, keys[:4], 2, token, nil)
+		assert.Equal(t, []string{"id_2", "id_3"}, ids)
+		assert.Empty(t, token)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := NewPaginator(ctx, &pbs.ListSessionsRequest{ScopeId: "global", PageSize: 2, ListToken: "not a token"})
+		assert.Error(t, err)
+
+		p, err := NewPaginator(ctx, &pbs.ListSessionsRequest{ScopeId: "global", PageSize: 1})
+		require.NoError(t, err)
+		p.Add(keys[0])
+		p.Add(keys[1])
+		token, err := p.ListToken(ctx)
+		require.NoError(t, err)
+		_, err = NewPaginator(ctx, &pbs.ListSessionsRequest{ScopeId: "global", PageSize: 1, ListToken: token})
+		assert.NoError(t, err)
+		_, err = NewPaginator(ctx, &pbs.ListSessionsRequest{ScopeId: "o_1234567890", PageSize: 1, ListToken: token})
+		assert.Error(t, err)
+		_, err = NewPaginator(ctx, &pbs.ListTargetsRequest{ScopeId: "global", PageSize: 1, ListToken: token})
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package roles
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -22,6 +23,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -105,50 +107,61 @@ func (s Service) ListRoles(ctx context.Context, req *pbs.ListRolesRequest) (*pbs
 		return &pbs.ListRolesResponse{}, nil
 	}
 
-	items, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(items) == 0 {
-		return &pbs.ListRolesResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Role, 0, len(items))
+	var finalItems []*pb.Role
 	res := perms.Resource{
 		Type: resource.Role,
 	}
-	for _, item := range items {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, nil, outputOpts...)
+	for pager.Next() {
+		items, err := s.listFromRepo(ctx, scopeIds, pager)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range items {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListRolesResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListRolesResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetRoles implements the interface pbs.RoleServiceServer.
@@ -292,7 +305,7 @@ func (s Service) AddRolePrincipals(ctx context.Context, req *pbs.AddRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), validityWindowOpts(req.GetValidFrom(), req.GetValidUntil())...)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +343,7 @@ func (s Service) SetRolePrincipals(ctx context.Context, req *pbs.SetRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), validityWindowOpts(req.GetValidFrom(), req.GetValidUntil())...)
 	if err != nil {
 		return nil, err
 	}
@@ -610,25 +623,25 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Role, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*iam.Role, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	rl, err := repo.ListRoles(ctx, scopeIds)
+	rl, err := repo.ListRoles(ctx, scopeIds, iam.WithLimit(pager.Limit()), iam.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, err
 	}
 	return rl, nil
 }
 
-func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32, opt ...iam.Option) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).addPrincpleInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), opt...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add principals to role: %v.", err)
@@ -643,13 +656,13 @@ func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, princip
 	return out, pr, roleGrants, nil
 }
 
-func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32, opt ...iam.Option) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).setPrinciplesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), opt...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set principals on role: %v.", err)
@@ -841,6 +854,12 @@ func toProto(ctx context.Context, in *iam.Role, principals []iam.PrincipalRole,
 				Type:    p.GetType(),
 				ScopeId: p.GetPrincipalScopeId(),
 			}
+			if p.GetValidFrom().GetTimestamp() != nil {
+				principal.ValidFrom = p.GetValidFrom().GetTimestamp()
+			}
+			if p.GetValidUntil().GetTimestamp() != nil {
+				principal.ValidUntil = p.GetValidUntil().GetTimestamp()
+			}
 			out.Principals = append(out.Principals, principal)
 		}
 	}
@@ -862,14 +881,22 @@ func toProto(ctx context.Context, in *iam.Role, principals []iam.PrincipalRole,
 				})
 			} else {
 				_, actions := parsed.Actions()
+				grantJson := &pb.GrantJson{
+					Id:       parsed.Id(),
+					Type:     parsed.Type().String(),
+					Actions:  actions,
+					ClientIp: parsed.ClientIps(),
+					Days:     parsed.Days(),
+					Hours:    parsed.Hours(),
+					Timezone: parsed.Timezone(),
+				}
+				if parsed.Deny() {
+					grantJson.Effect = "deny"
+				}
 				out.Grants = append(out.Grants, &pb.Grant{
 					Raw:       g.GetRawGrant(),
 					Canonical: g.GetCanonicalGrant(),
-					Json: &pb.GrantJson{
-						Id:      parsed.Id(),
-						Type:    parsed.Type().String(),
-						Actions: actions,
-					},
+					Json:      grantJson,
 				})
 			}
 		}
@@ -971,7 +998,7 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -980,6 +1007,7 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 			break
 		}
 	}
+	validateValidityWindow(req.GetValidFrom(), req.GetValidUntil(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -997,7 +1025,7 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1006,12 +1034,44 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 			break
 		}
 	}
+	validateValidityWindow(req.GetValidFrom(), req.GetValidUntil(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
+// validateValidityWindow checks the window in which principals are assigned
+// to a role and records any problems in badFields.
+func validateValidityWindow(validFrom, validUntil *timestamppb.Timestamp, badFields map[string]string) {
+	if validFrom != nil && validFrom.CheckValid() != nil {
+		badFields["valid_from"] = "This field could not be parsed."
+	}
+	if validUntil != nil {
+		switch {
+		case validUntil.CheckValid() != nil:
+			badFields["valid_until"] = "This field could not be parsed."
+		case !validUntil.AsTime().After(time.Now()):
+			badFields["valid_until"] = "Must be in the future."
+		case validFrom != nil && validFrom.CheckValid() == nil && !validFrom.AsTime().Before(validUntil.AsTime()):
+			badFields["valid_from"] = "Must be before valid_until."
+		}
+	}
+}
+
+// validityWindowOpts returns the options setting the window in which
+// principals are assigned to a role.
+func validityWindowOpts(validFrom, validUntil *timestamppb.Timestamp) []iam.Option {
+	var opts []iam.Option
+	if validFrom != nil {
+		opts = append(opts, iam.WithValidFrom(validFrom.AsTime()))
+	}
+	if validUntil != nil {
+		opts = append(opts, iam.WithValidUntil(validUntil.AsTime()))
+	}
+	return opts
+}
+
 func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), iam.RolePrefix) {
@@ -1026,7 +1086,7 @@ func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) e
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
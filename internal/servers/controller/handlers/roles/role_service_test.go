@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
@@ -24,6 +25,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -1156,6 +1158,26 @@ func TestAddPrincipal(t *testing.T) {
 		}
 	}
 
+	t.Run("Add user with validity window", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := iam.TestRole(t, conn, p.GetPublicId())
+		validFrom := time.Now().Add(time.Hour).Truncate(time.Second)
+		validUntil := validFrom.Add(time.Hour)
+		req := &pbs.AddRolePrincipalsRequest{
+			Id:           role.GetPublicId(),
+			Version:      role.GetVersion(),
+			PrincipalIds: []string{users[0].GetPublicId()},
+			ValidFrom:    timestamppb.New(validFrom),
+			ValidUntil:   timestamppb.New(validUntil),
+		}
+		got, err := s.AddRolePrincipals(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), req)
+		require.NoError(err)
+		require.Len(got.GetItem().GetPrincipals(), 1)
+		principal := got.GetItem().GetPrincipals()[0]
+		assert.True(validFrom.Equal(principal.GetValidFrom().AsTime()))
+		assert.True(validUntil.Equal(principal.GetValidUntil().AsTime()))
+	})
+
 	role := iam.TestRole(t, conn, p.GetPublicId())
 
 	failCases := []struct {
@@ -1189,6 +1211,27 @@ func TestAddPrincipal(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Valid Until In Past",
+			req: &pbs.AddRolePrincipalsRequest{
+				Id:           role.GetPublicId(),
+				Version:      role.GetVersion(),
+				PrincipalIds: []string{users[0].GetPublicId()},
+				ValidUntil:   timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Valid From After Valid Until",
+			req: &pbs.AddRolePrincipalsRequest{
+				Id:           role.GetPublicId(),
+				Version:      role.GetVersion(),
+				PrincipalIds: []string{users[0].GetPublicId()},
+				ValidFrom:    timestamppb.New(time.Now().Add(2 * time.Hour)),
+				ValidUntil:   timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1639,6 +1682,14 @@ func checkEqualGrants(t *testing.T, expected []string, got *pb.Role) {
 		assert.Equal(parsed.Type().String(), j.GetType())
 		_, acts := parsed.Actions()
 		assert.Equal(acts, j.GetActions())
+
+		// The JSON form of the grant must carry everything the grant's own
+		// JSON representation does, including its effect and conditions.
+		raw, err := parsed.MarshalJSON()
+		require.NoError(err)
+		want := &pb.GrantJson{}
+		require.NoError(protojson.Unmarshal(raw, want))
+		assert.Empty(cmp.Diff(want, j, protocmp.Transform(), protocmp.SortRepeatedFields(want, "actions")))
 	}
 }
 
@@ -1676,6 +1727,11 @@ func TestAddGrants(t *testing.T) {
 			add:      []string{"id=*;type=*;actions=delete", "id=*;type=*;actions=delete"},
 			result:   []string{"id=aA1;actions=read", "id=*;type=*;actions=delete"},
 		},
+		{
+			name:   "Add grant with effect and conditions",
+			add:    []string{"id=*;type=*;actions=read;effect=deny;client_ip=10.0.0.0/8;days=mon,tue;hours=09:00-17:00;timezone=Europe/Berlin"},
+			result: []string{"id=*;type=*;actions=read;effect=deny;client_ip=10.0.0.0/8;days=mon,tue;hours=09:00-17:00;timezone=Europe/Berlin"},
+		},
 		{
 			name:     "Add grant matching existing grant",
 			existing: []string{"id=1;actions=read", "id=*;type=*;actions=delete"},
@@ -0,0 +1,302 @@
+package scopes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/intglobals"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// resourceTypesByPrefix maps the prefixes of resource IDs to the type of the
+// resource, so the type doesn't need to be provided when explaining the
+// permissions on a resource. Workers have no prefix and always need their type
+// provided.
+var resourceTypesByPrefix = map[string]resource.Type{
+	scope.Org.Prefix():                          resource.Scope,
+	scope.Project.Prefix():                      resource.Scope,
+	iam.UserPrefix:                              resource.User,
+	iam.GroupPrefix:                             resource.Group,
+	iam.RolePrefix:                              resource.Role,
+	password.AuthMethodPrefix:                   resource.AuthMethod,
+	oidc.AuthMethodPrefix:                       resource.AuthMethod,
+	ldap.AuthMethodPrefix:                       resource.AuthMethod,
+	intglobals.OldPasswordAccountPrefix:         resource.Account,
+	intglobals.NewPasswordAccountPrefix:         resource.Account,
+	oidc.AccountPrefix:                          resource.Account,
+	ldap.AccountPrefix:                          resource.Account,
+	intglobals.OidcManagedGroupPrefix:           resource.ManagedGroup,
+	intglobals.LdapManagedGroupPrefix:           resource.ManagedGroup,
+	authtoken.AuthTokenPrefix:                   resource.AuthToken,
+	static.HostCatalogPrefix:                    resource.HostCatalog,
+	plugin.HostCatalogPrefix:                    resource.HostCatalog,
+	static.HostSetPrefix:                        resource.HostSet,
+	plugin.HostSetPrefix:                        resource.HostSet,
+	static.HostPrefix:                           resource.Host,
+	plugin.HostPrefix:                           resource.Host,
+	tcp.TargetPrefix:                            resource.Target,
+	ssh.TargetPrefix:                            resource.Target,
+	session.SessionPrefix:                       resource.Session,
+	session.RecordingPrefix:                     resource.SessionRecording,
+	accessrequest.AccessRequestPrefix:           resource.AccessRequest,
+	credstatic.CredentialStorePrefix:            resource.CredentialStore,
+	vault.CredentialStorePrefix:                 resource.CredentialStore,
+	vault.CredentialLibraryPrefix:               resource.CredentialLibrary,
+	credstatic.UsernamePasswordCredentialPrefix: resource.Credential,
+	credstatic.SshPrivateKeyCredentialPrefix:    resource.Credential,
+	credstatic.JsonCredentialPrefix:             resource.Credential,
+}
+
+// resourceTypeFromId returns the type of the resource with the provided ID,
+// or resource.Unknown if it can't be derived from the ID.
+func resourceTypeFromId(id string) resource.Type {
+	if id == scope.Global.String() {
+		return resource.Scope
+	}
+	i := strings.Index(id, "_")
+	if i < 0 {
+		return resource.Unknown
+	}
+	return resourceTypesByPrefix[id[:i]]
+}
+
+// ExplainPermissions implements the interface pbs.ScopeServiceServer.
+func (s Service) ExplainPermissions(ctx context.Context, req *pbs.ExplainPermissionsRequest) (*pbs.ExplainPermissionsResponse, error) {
+	const op = "scopes.(Service).ExplainPermissions"
+	if err := validateExplainPermissionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ExplainPermissions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	scp, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	u, _, err := repo.LookupUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, handlers.NotFoundErrorf("User %q not found.", req.GetUserId())
+	}
+	grantTuples, err := repo.GrantsForUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	// Only grants in the scope of the resource apply to it. As when
+	// authorizing requests, validation is skipped so that formats that have
+	// since been restricted don't error.
+	var grants []roleGrant
+	for _, gt := range grantTuples {
+		if gt.ScopeId != req.GetId() {
+			continue
+		}
+		parsed, err := perms.Parse(
+			gt.ScopeId,
+			gt.Grant,
+			perms.WithUserId(req.GetUserId()),
+			perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", gt.Grant)))
+		}
+		grants = append(grants, roleGrant{roleId: gt.RoleId, grant: parsed})
+	}
+
+	typ := resource.Map[req.GetResourceType()]
+	if typ == resource.Unknown && req.GetResourceId() != "" {
+		typ = resourceTypeFromId(req.GetResourceId())
+	}
+	res := perms.Resource{
+		ScopeId: req.GetId(),
+		Id:      req.GetResourceId(),
+		Type:    typ,
+		Pin:     req.GetPinId(),
+	}
+	item := explainPermissions(res, action.Map[req.GetAction()], grants, perms.WithClientIp(req.GetClientIp()))
+	item.UserId = req.GetUserId()
+	item.Scope = &pb.ScopeInfo{
+		Id:            scp.GetPublicId(),
+		Type:          scp.GetType(),
+		Name:          scp.GetName(),
+		Description:   scp.GetDescription(),
+		ParentScopeId: scp.GetParentId(),
+	}
+	return &pbs.ExplainPermissionsResponse{Item: item}, nil
+}
+
+// roleGrant is a parsed grant along with the ID of the role it belongs to.
+type roleGrant struct {
+	roleId string
+	grant  perms.Grant
+}
+
+// explainPermissions evaluates the grants against the resource. If the
+// resource has neither an ID nor a type, all the grants are returned without
+// evaluating them. If act is action.Unknown, no authorization decision is
+// made.
+func explainPermissions(res perms.Resource, act action.Type, grants []roleGrant, opt ...perms.Option) *pb.PermissionsExplanation {
+	out := &pb.PermissionsExplanation{
+		ResourceId: res.Id,
+		PinId:      res.Pin,
+	}
+	if res.Type != resource.Unknown {
+		out.ResourceType = res.Type.String()
+	}
+	sort.SliceStable(grants, func(i, j int) bool {
+		if grants[i].roleId != grants[j].roleId {
+			return grants[i].roleId < grants[j].roleId
+		}
+		return grants[i].grant.CanonicalString() < grants[j].grant.CanonicalString()
+	})
+
+	if res.Id == "" && res.Type == resource.Unknown {
+		for _, g := range grants {
+			out.Grants = append(out.Grants, &pb.ExplainedGrant{
+				RoleId: g.roleId,
+				Grant:  g.grant.CanonicalString(),
+			})
+		}
+		return out
+	}
+
+	all := make([]perms.Grant, 0, len(grants))
+	for _, g := range grants {
+		all = append(all, g.grant)
+	}
+	acl := perms.NewACL(all...)
+
+	// The only actions that can be authorized are the ones named in grants
+	candidates := make(map[action.Type]bool)
+	for _, g := range grants {
+		for _, t := range applicableActions(res, g.grant) {
+			candidates[t] = true
+		}
+	}
+	for t := range candidates {
+		if acl.Allowed(res, t, opt...).Authorized {
+			out.AuthorizedActions = append(out.AuthorizedActions, t.String())
+		}
+	}
+	sort.Strings(out.AuthorizedActions)
+
+	if act != action.Unknown {
+		out.Action = act.String()
+		results := acl.Allowed(res, act, opt...)
+		out.Authorized = wrapperspb.Bool(results.Authorized)
+		out.DenialReasons = results.DenialReasons
+	}
+
+	for _, g := range grants {
+		grantAcl := perms.NewACL(g.grant)
+		if act != action.Unknown && !grantAcl.Allowed(res, act, opt...).Authorized {
+			continue
+		}
+		var actions []string
+		for _, t := range applicableActions(res, g.grant) {
+			if grantAcl.Allowed(res, t, opt...).Authorized {
+				actions = append(actions, t.String())
+			}
+		}
+		if len(actions) == 0 {
+			continue
+		}
+		sort.Strings(actions)
+		out.Grants = append(out.Grants, &pb.ExplainedGrant{
+			RoleId:            g.roleId,
+			Grant:             g.grant.CanonicalString(),
+			AuthorizedActions: actions,
+		})
+	}
+	return out
+}
+
+// applicableActions returns the actions of the grant which can apply to the
+// resource: create and list for a collection, any other action otherwise.
+func applicableActions(res perms.Resource, g perms.Grant) []action.Type {
+	typs, _ := g.Actions()
+	ret := make([]action.Type, 0, len(typs))
+	for _, t := range typs {
+		if t == action.All && res.Id == "" {
+			ret = append(ret, action.Create, action.List)
+			continue
+		}
+		collectionAction := t == action.Create || t == action.List
+		if collectionAction == (res.Id == "") {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+func validateExplainPermissionsRequest(req *pbs.ExplainPermissionsRequest) error {
+	if err := validateKeysRequest(req.GetId()); err != nil {
+		return err
+	}
+	badFields := map[string]string{}
+	switch req.GetUserId() {
+	case auth.AnonymousUserId, "u_auth":
+	default:
+		if !handlers.ValidId(handlers.Id(req.GetUserId()), iam.UserPrefix) {
+			badFields["user_id"] = "Invalidly formatted user id."
+		}
+	}
+	if req.GetResourceType() != "" {
+		typ, ok := resource.Map[req.GetResourceType()]
+		if !ok || typ == resource.Unknown || typ == resource.All {
+			badFields["resource_type"] = "Unknown resource type."
+		}
+	}
+	if req.GetResourceId() != "" && req.GetResourceType() == "" && resourceTypeFromId(req.GetResourceId()) == resource.Unknown {
+		badFields["resource_type"] = "Unable to derive the resource type from the resource id, it must be provided."
+	}
+	if req.GetPinId() != "" && req.GetResourceId() == "" {
+		badFields["pin_id"] = "Can only be set along with resource_id."
+	}
+	if req.GetAction() != "" {
+		if _, ok := action.Map[req.GetAction()]; !ok {
+			badFields["action"] = "Unknown action."
+		}
+		if req.GetResourceId() == "" && req.GetResourceType() == "" {
+			badFields["action"] = "Requires resource_id or resource_type to be set."
+		}
+	}
+	if req.GetClientIp() != "" && net.ParseIP(req.GetClientIp()) == nil {
+		badFields["client_ip"] = "Invalidly formatted IP address."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
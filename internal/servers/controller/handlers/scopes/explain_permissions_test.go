@@ -0,0 +1,235 @@
+package scopes
+
+import (
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceTypeFromId(t *testing.T) {
+	tests := []struct {
+		id   string
+		want resource.Type
+	}{
+		{id: "global", want: resource.Scope},
+		{id: "p_1234567890", want: resource.Scope},
+		{id: "u_1234567890", want: resource.User},
+		{id: "ttcp_1234567890", want: resource.Target},
+		{id: "tssh_1234567890", want: resource.Target},
+		{id: "hst_1234567890", want: resource.Host},
+		{id: "acctpw_1234567890", want: resource.Account},
+		{id: "s_1234567890", want: resource.Session},
+		{id: "sr_1234567890", want: resource.SessionRecording},
+		{id: "zzz_1234567890", want: resource.Unknown},
+		{id: "myworker", want: resource.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, resourceTypeFromId(tt.id))
+		})
+	}
+}
+
+func TestExplainPermissions(t *testing.T) {
+	const scopeId = "p_1234567890"
+	parse := func(t *testing.T, roleId, grant string) roleGrant {
+		t.Helper()
+		g, err := perms.Parse(scopeId, grant, perms.WithUserId("u_1234567890"))
+		require.NoError(t, err)
+		return roleGrant{roleId: roleId, grant: g}
+	}
+	grants := func(t *testing.T) []roleGrant {
+		return []roleGrant{
+			parse(t, "r_2222222222", "id=*;type=target;actions=read,list"),
+			parse(t, "r_1111111111", "id=ttcp_1234567890;actions=authorize-session"),
+			parse(t, "r_1111111111", "id=*;type=host-catalog;actions=read"),
+			parse(t, "r_3333333333", "id=*;type=target;actions=update;client_ip=10.0.0.0/8"),
+			parse(t, "r_4444444444", "id=*;type=host-catalog;actions=*"),
+		}
+	}
+
+	tests := []struct {
+		name string
+		res  perms.Resource
+		act  action.Type
+		want *pb.PermissionsExplanation
+	}{
+		{
+			name: "no resource",
+			res:  perms.Resource{ScopeId: scopeId},
+			want: &pb.PermissionsExplanation{
+				Grants: []*pb.ExplainedGrant{
+					{RoleId: "r_1111111111", Grant: "id=*;type=host-catalog;actions=read"},
+					{RoleId: "r_1111111111", Grant: "id=ttcp_1234567890;actions=authorize-session"},
+					{RoleId: "r_2222222222", Grant: "id=*;type=target;actions=list,read"},
+					{RoleId: "r_3333333333", Grant: "id=*;type=target;actions=update;client_ip=10.0.0.0/8"},
+					{RoleId: "r_4444444444", Grant: "id=*;type=host-catalog;actions=*"},
+				},
+			},
+		},
+		{
+			name: "resource without action",
+			res:  perms.Resource{ScopeId: scopeId, Id: "ttcp_1234567890", Type: resource.Target},
+			want: &pb.PermissionsExplanation{
+				ResourceId:        "ttcp_1234567890",
+				ResourceType:      "target",
+				AuthorizedActions: []string{"authorize-session", "read"},
+				Grants: []*pb.ExplainedGrant{
+					{RoleId: "r_1111111111", Grant: "id=ttcp_1234567890;actions=authorize-session", AuthorizedActions: []string{"authorize-session"}},
+					{RoleId: "r_2222222222", Grant: "id=*;type=target;actions=list,read", AuthorizedActions: []string{"read"}},
+				},
+			},
+		},
+		{
+			name: "authorized action",
+			res:  perms.Resource{ScopeId: scopeId, Id: "ttcp_1234567890", Type: resource.Target},
+			act:  action.AuthorizeSession,
+			want: &pb.PermissionsExplanation{
+				ResourceId:        "ttcp_1234567890",
+				ResourceType:      "target",
+				Action:            "authorize-session",
+				Authorized:        wrapperspb.Bool(true),
+				AuthorizedActions: []string{"authorize-session", "read"},
+				Grants: []*pb.ExplainedGrant{
+					{RoleId: "r_1111111111", Grant: "id=ttcp_1234567890;actions=authorize-session", AuthorizedActions: []string{"authorize-session"}},
+				},
+			},
+		},
+		{
+			name: "denied action",
+			res:  perms.Resource{ScopeId: scopeId, Id: "ttcp_1234567890", Type: resource.Target},
+			act:  action.Update,
+			want: &pb.PermissionsExplanation{
+				ResourceId:        "ttcp_1234567890",
+				ResourceType:      "target",
+				Action:            "update",
+				Authorized:        wrapperspb.Bool(false),
+				AuthorizedActions: []string{"authorize-session", "read"},
+				DenialReasons: []string{
+					`grant "id=*;type=target;actions=update;client_ip=10.0.0.0/8" in scope p_1234567890: client IP unknown is not within 10.0.0.0/8`,
+				},
+			},
+		},
+		{
+			name: "collection",
+			res:  perms.Resource{ScopeId: scopeId, Type: resource.Target},
+			act:  action.List,
+			want: &pb.PermissionsExplanation{
+				ResourceType:      "target",
+				Action:            "list",
+				Authorized:        wrapperspb.Bool(true),
+				AuthorizedActions: []string{"list"},
+				Grants: []*pb.ExplainedGrant{
+					{RoleId: "r_2222222222", Grant: "id=*;type=target;actions=list,read", AuthorizedActions: []string{"list"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := explainPermissions(tt.res, tt.act, grants(t))
+			assert.Empty(t, cmp.Diff(tt.want, got, protocmp.Transform()))
+		})
+	}
+
+	t.Run("wildcard actions on a collection", func(t *testing.T) {
+		res := perms.Resource{ScopeId: scopeId, Type: resource.HostCatalog}
+		got := explainPermissions(res, action.Unknown, grants(t))
+		assert.Equal(t, []string{"create", "list"}, got.GetAuthorizedActions())
+	})
+
+	t.Run("client ip", func(t *testing.T) {
+		res := perms.Resource{ScopeId: scopeId, Id: "ttcp_1234567890", Type: resource.Target}
+		got := explainPermissions(res, action.Update, grants(t), perms.WithClientIp("10.1.2.3"))
+		assert.True(t, got.GetAuthorized().GetValue())
+		assert.Empty(t, got.GetDenialReasons())
+	})
+
+	t.Run("deny grant", func(t *testing.T) {
+		res := perms.Resource{ScopeId: scopeId, Id: "ttcp_1234567890", Type: resource.Target}
+		denied := append(grants(t), parse(t, "r_5555555555", "id=*;type=target;actions=read;effect=deny"))
+		got := explainPermissions(res, action.Read, denied)
+		assert.False(t, got.GetAuthorized().GetValue())
+		assert.Equal(t, []string{"authorize-session"}, got.GetAuthorizedActions())
+		assert.Equal(t, []string{`grant "id=*;type=target;actions=read;effect=deny" in scope p_1234567890: denies the action`}, got.GetDenialReasons())
+	})
+}
+
+func TestValidateExplainPermissionsRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pbs.ExplainPermissionsRequest
+		badFields []string
+	}{
+		{
+			name: "valid",
+			req:  &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "u_1234567890", ResourceId: "ttcp_1234567890", Action: "read"},
+		},
+		{
+			name: "anonymous user",
+			req:  &pbs.ExplainPermissionsRequest{Id: "global", UserId: "u_anon"},
+		},
+		{
+			name: "worker with type",
+			req:  &pbs.ExplainPermissionsRequest{Id: "global", UserId: "u_1234567890", ResourceId: "myworker", ResourceType: "worker"},
+		},
+		{
+			name:      "bad user",
+			req:       &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "ttcp_1234567890"},
+			badFields: []string{"user_id"},
+		},
+		{
+			name:      "underivable type",
+			req:       &pbs.ExplainPermissionsRequest{Id: "global", UserId: "u_1234567890", ResourceId: "myworker"},
+			badFields: []string{"resource_type"},
+		},
+		{
+			name:      "unknown type",
+			req:       &pbs.ExplainPermissionsRequest{Id: "global", UserId: "u_1234567890", ResourceType: "widget"},
+			badFields: []string{"resource_type"},
+		},
+		{
+			name:      "pin without resource",
+			req:       &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "u_1234567890", PinId: "hcst_1234567890"},
+			badFields: []string{"pin_id"},
+		},
+		{
+			name:      "action without resource",
+			req:       &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "u_1234567890", Action: "read"},
+			badFields: []string{"action"},
+		},
+		{
+			name:      "unknown action",
+			req:       &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "u_1234567890", ResourceType: "target", Action: "fly"},
+			badFields: []string{"action"},
+		},
+		{
+			name:      "bad client ip",
+			req:       &pbs.ExplainPermissionsRequest{Id: "p_1234567890", UserId: "u_1234567890", ClientIp: "10.0.0.0/8"},
+			badFields: []string{"client_ip"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExplainPermissionsRequest(tt.req)
+			if len(tt.badFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, f := range tt.badFields {
+				assert.Contains(t, err.Error(), f)
+			}
+		})
+	}
+}
@@ -7,32 +7,38 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentialstores"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessionrecordings"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -46,6 +52,10 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListKeys,
+		action.RotateKeys,
+		action.DestroyKeyVersion,
+		action.ExplainPermissions,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -63,6 +73,7 @@ var (
 			resource.Role:       roles.CollectionActions,
 			resource.Scope:      CollectionActions,
 			resource.User:       users.CollectionActions,
+			resource.Worker:     workers.CollectionActions,
 		},
 
 		scope.Org.String(): {
@@ -75,12 +86,14 @@ var (
 		},
 
 		scope.Project.String(): {
-			resource.CredentialStore: credentialstores.CollectionActions,
-			resource.Group:           groups.CollectionActions,
-			resource.HostCatalog:     host_catalogs.CollectionActions,
-			resource.Role:            roles.CollectionActions,
-			resource.Session:         sessions.CollectionActions,
-			resource.Target:          targets.CollectionActions,
+			resource.AccessRequest:    accessrequests.CollectionActions,
+			resource.CredentialStore:  credentialstores.CollectionActions,
+			resource.Group:            groups.CollectionActions,
+			resource.HostCatalog:      host_catalogs.CollectionActions,
+			resource.Role:             roles.CollectionActions,
+			resource.Session:          sessions.CollectionActions,
+			resource.SessionRecording: sessionrecordings.CollectionActions,
+			resource.Target:           targets.CollectionActions,
 		},
 	}
 )
@@ -96,16 +109,20 @@ func init() {
 type Service struct {
 	pbs.UnimplementedScopeServiceServer
 
+	kms    *kms.Kms
 	repoFn common.IamRepoFactory
 }
 
 // NewService returns a project service which handles project related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(kms *kms.Kms, repo common.IamRepoFactory) (Service, error) {
 	const op = "scopes.(Service).NewService"
+	if kms == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo}, nil
+	return Service{kms: kms, repoFn: repo}, nil
 }
 
 var _ pbs.ScopeServiceServer = Service{}
@@ -142,59 +159,70 @@ func (s Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*p
 		return &pbs.ListScopesResponse{}, nil
 	}
 
-	pl, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(pl) == 0 {
-		return &pbs.ListScopesResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Scope, 0, len(pl))
+	var finalItems []*pb.Scope
 	res := perms.Resource{
 		Type: resource.Scope,
 	}
-	for _, item := range pl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetParentId()
-
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for pager.Next() {
+		pl, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range pl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetParentId()
+
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetParentId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, scopeCollectionTypeMapMap[item.Type], item.GetPublicId(), "")
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetParentId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, scopeCollectionTypeMapMap[item.Type], item.GetPublicId(), "")
+				if err != nil {
+					return nil, err
+				}
+				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+			}
+
+			item, err := ToProto(ctx, item, outputOpts...)
 			if err != nil {
 				return nil, err
 			}
-			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
-		}
 
-		item, err := ToProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
 	SortScopes(finalItems)
-	return &pbs.ListScopesResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListScopesResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetScopes implements the interface pbs.ScopeServiceServer.
@@ -353,6 +381,90 @@ func (s Service) DeleteScope(ctx context.Context, req *pbs.DeleteScopeRequest) (
 	return nil, nil
 }
 
+// ListKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) ListKeys(ctx context.Context, req *pbs.ListKeysRequest) (*pbs.ListKeysResponse, error) {
+	if err := validateKeysRequest(req.GetId()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	scp, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.kms.ListKeys(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	scopeInfo := &pb.ScopeInfo{
+		Id:            scp.GetPublicId(),
+		Type:          scp.GetType(),
+		Name:          scp.GetName(),
+		Description:   scp.GetDescription(),
+		ParentScopeId: scp.GetParentId(),
+	}
+	items := make([]*pb.Key, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, keyToProto(k, scopeInfo))
+	}
+	return &pbs.ListKeysResponse{Items: items}, nil
+}
+
+// RotateKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) RotateKeys(ctx context.Context, req *pbs.RotateKeysRequest) (*pbs.RotateKeysResponse, error) {
+	if err := validateKeysRequest(req.GetId()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kms.RotateKeys(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pbs.RotateKeysResponse{}, nil
+}
+
+// DestroyKeyVersion implements the interface pbs.ScopeServiceServer.
+func (s Service) DestroyKeyVersion(ctx context.Context, req *pbs.DestroyKeyVersionRequest) (*pbs.DestroyKeyVersionResponse, error) {
+	if err := validateDestroyKeyVersionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DestroyKeyVersion)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kms.DestroyKeyVersion(ctx, req.GetId(), req.GetKeyVersionId()); err != nil {
+		return nil, err
+	}
+	return &pbs.DestroyKeyVersionResponse{}, nil
+}
+
+func keyToProto(in *kms.Key, scopeInfo *pb.ScopeInfo) *pb.Key {
+	out := &pb.Key{
+		Id:          in.Id,
+		Scope:       scopeInfo,
+		Purpose:     in.Purpose,
+		CreatedTime: timestamppb.New(in.CreateTime),
+		Type:        in.Type,
+	}
+	for i, v := range in.Versions {
+		state := "inactive"
+		if i == 0 {
+			state = "active"
+		}
+		out.Versions = append(out.Versions, &pb.KeyVersion{
+			Id:          v.Id,
+			Version:     v.Version,
+			CreatedTime: timestamppb.New(v.CreateTime),
+			State:       state,
+		})
+	}
+	return out
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -393,6 +505,8 @@ func (s Service) createInRepo(ctx context.Context, authResults auth.VerifyResult
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build new scope for creation: %v.", err)
 	}
+	iamScope.AuthTokenTimeToLive = item.GetAuthTokenTimeToLive()
+	iamScope.AuthTokenTimeToStale = item.GetAuthTokenTimeToStale()
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
@@ -420,7 +534,7 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		opts = append(opts, iam.WithName(scopeName))
 	}
 	if primaryAuthMethodId := item.GetPrimaryAuthMethodId(); primaryAuthMethodId != nil {
-		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"primary_auth_method_id": "Improperly formatted identifier"})
 		}
 		scopePrimaryAuthMethodId = primaryAuthMethodId.GetValue()
@@ -449,6 +563,8 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build scope for update: %v.", err)
 	}
 	iamScope.PublicId = scopeId
+	iamScope.AuthTokenTimeToLive = item.GetAuthTokenTimeToLive()
+	iamScope.AuthTokenTimeToStale = item.GetAuthTokenTimeToStale()
 	dbMask := maskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
@@ -488,12 +604,12 @@ func SortScopes(scps []*pb.Scope) {
 	})
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Scope, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	scps, err := repo.ListScopes(ctx, scopeIds)
+	scps, err := repo.ListScopes(ctx, scopeIds, iam.WithLimit(pager.Limit()), iam.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to list scopes: %v", err)
 	}
@@ -583,6 +699,12 @@ func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Sc
 	if outputFields.Has(globals.PrimaryAuthMethodIdField) && in.GetPrimaryAuthMethodId() != "" {
 		out.PrimaryAuthMethodId = &wrapperspb.StringValue{Value: in.GetPrimaryAuthMethodId()}
 	}
+	if outputFields.Has(globals.AuthTokenTimeToLiveField) {
+		out.AuthTokenTimeToLive = in.GetAuthTokenTimeToLive()
+	}
+	if outputFields.Has(globals.AuthTokenTimeToStaleField) {
+		out.AuthTokenTimeToStale = in.GetAuthTokenTimeToStale()
+	}
 
 	return &out, nil
 }
@@ -697,7 +819,7 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	if item.GetUpdatedTime() != nil {
 		badFields["updated_time"] = "This is a read only field and cannot be specified in an update request."
 	}
-	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 		badFields["primary_auth_method_id"] = "Improperly formatted identifier."
 	}
 	if len(badFields) > 0 {
@@ -730,6 +852,37 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateKeysRequest(id string) error {
+	badFields := map[string]string{}
+	switch {
+	case id == scope.Global.String():
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Project.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	default:
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateDestroyKeyVersionRequest(req *pbs.DestroyKeyVersionRequest) error {
+	if err := validateKeysRequest(req.GetId()); err != nil {
+		return err
+	}
+	if req.GetKeyVersionId() == "" {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"key_version_id": "Missing key version id."})
+	}
+	return nil
+}
+
 func validateListRequest(req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
@@ -30,12 +31,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "list-keys", "rotate-keys", "destroy-key-version", "explain-permissions"}
 
-func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error)) {
+func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
@@ -56,7 +58,7 @@ func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*
 	require.NoError(t, err)
 	pRes, _, err = repo.UpdateScope(context.Background(), pRes, 1, []string{"Name", "Description"})
 	require.NoError(t, err)
-	return oRes, pRes, repoFn
+	return oRes, pRes, repoFn, kmsCache
 }
 
 var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
@@ -95,6 +97,11 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"workers": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("list"),
+		},
+	},
 }
 
 var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
@@ -136,6 +143,12 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 }
 
 var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
+	"access-requests": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("list"),
+			structpb.NewStringValue("request"),
+		},
+	},
 	"credential-stores": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -165,6 +178,11 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"session-recordings": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("list"),
+		},
+	},
 	"targets": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -174,7 +192,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 }
 
 func TestGet(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	toMerge := &pbs.GetScopeRequest{
 		Id: proj.GetPublicId(),
 	}
@@ -262,7 +280,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetScopeRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(kmsCache, repoFn)
 			require.NoError(err, "Couldn't create new project service.")
 
 			got, gErr := s.GetScope(auth.DisabledAuthTestContext(repoFn, tc.scopeId), req)
@@ -278,6 +296,7 @@ func TestGet(t *testing.T) {
 func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
@@ -343,7 +362,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(kmsCache, repoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -457,7 +476,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(kmsCache, repoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -484,9 +503,9 @@ func TestList(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(kmsCache, repoFn)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	cases := []struct {
@@ -558,9 +577,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(kmsCache, repoFn)
 	require.NoError(err, "Error when getting new scopes service")
 	ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
 	req := &pbs.DeleteScopeRequest{
@@ -585,7 +604,7 @@ func TestDelete_twice(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
-	defaultOrg, defaultProj, repoFn := createDefaultScopesAndRepo(t)
+	defaultOrg, defaultProj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	defaultProjCreated := defaultProj.GetCreateTime().GetTimestamp().AsTime()
 	toMerge := &pbs.CreateScopeRequest{}
 
@@ -768,7 +787,7 @@ func TestCreate(t *testing.T) {
 				req := proto.Clone(toMerge).(*pbs.CreateScopeRequest)
 				proto.Merge(req, tc.req)
 
-				s, err := scopes.NewService(repoFn)
+				s, err := scopes.NewService(kmsCache, repoFn)
 				require.NoError(err, "Error when getting new project service.")
 
 				if name != "" {
@@ -842,8 +861,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
-	tested, err := scopes.NewService(repoFn)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+	tested, err := scopes.NewService(kmsCache, repoFn)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	iamRepo, err := repoFn()
@@ -859,7 +878,7 @@ func TestUpdate(t *testing.T) {
 		orgVersion++
 		repo, err := repoFn()
 		require.NoError(t, err, "Couldn't get a new repo")
-		org, _, err = repo.UpdateScope(context.Background(), org, orgVersion, []string{"Name", "Description"})
+		org, _, err = repo.UpdateScope(context.Background(), org, orgVersion, []string{"Name", "Description", "AuthTokenTimeToLive", "AuthTokenTimeToStale"})
 		require.NoError(t, err, "Failed to reset the org")
 		orgVersion++
 	}
@@ -957,6 +976,35 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "Update Auth Token Settings",
+			scopeId: scope.Global.String(),
+			req: &pbs.UpdateScopeRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"auth_token_time_to_live", "auth_token_time_to_stale"},
+				},
+				Item: &pb.Scope{
+					AuthTokenTimeToLive:  3600,
+					AuthTokenTimeToStale: 600,
+					Type:                 scope.Org.String(),
+				},
+			},
+			res: &pbs.UpdateScopeResponse{
+				Item: &pb.Scope{
+					Id:                          org.GetPublicId(),
+					ScopeId:                     scope.Global.String(),
+					Scope:                       &pb.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+					Name:                        &wrapperspb.StringValue{Value: "defaultOrg"},
+					Description:                 &wrapperspb.StringValue{Value: "defaultOrg"},
+					CreatedTime:                 org.GetCreateTime().GetTimestamp(),
+					Type:                        scope.Org.String(),
+					AuthTokenTimeToLive:         3600,
+					AuthTokenTimeToStale:        600,
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: orgAuthorizedCollectionActions,
+				},
+			},
+		},
 		{
 			name:    "Update global",
 			scopeId: scope.Global.String(),
@@ -1275,3 +1323,54 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestKeys(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+
+	s, err := scopes.NewService(kmsCache, repoFn)
+	require.NoError(err, "Error when getting new scopes service")
+	ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
+
+	got, err := s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	// The root key and the database, oplog, tokens, sessions, oidc and audit keys.
+	require.Len(got.GetItems(), 7)
+	root := got.GetItems()[0]
+	assert.Equal("root", root.GetPurpose())
+	assert.Equal("kek", root.GetType())
+	assert.Equal(proj.GetPublicId(), root.GetScope().GetId())
+	require.Len(root.GetVersions(), 1)
+	assert.Equal("active", root.GetVersions()[0].GetState())
+	oldRootVersion := root.GetVersions()[0].GetId()
+
+	_, err = s.RotateKeys(ctx, &pbs.RotateKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+
+	got, err = s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	for _, k := range got.GetItems() {
+		require.Len(k.GetVersions(), 2, k.GetPurpose())
+		assert.Equal("active", k.GetVersions()[0].GetState())
+		assert.Equal(uint32(2), k.GetVersions()[0].GetVersion())
+		assert.Equal("inactive", k.GetVersions()[1].GetState())
+	}
+	newRootVersion := got.GetItems()[0].GetVersions()[0].GetId()
+
+	// The current version can't be destroyed.
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: newRootVersion})
+	require.Error(err)
+
+	// The previous root key version no longer encrypts any key.
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: oldRootVersion})
+	require.NoError(err)
+	got, err = s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	assert.Len(got.GetItems()[0].GetVersions(), 1)
+
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: "krkv_doesntexist"})
+	assert.Error(err)
+
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId()})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Expected invalid argument, got %v", err)
+}
@@ -0,0 +1,389 @@
+package sessionrecordings
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessionrecordings"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.List,
+	}
+)
+
+// Service handles request as described by the pbs.SessionRecordingServiceServer interface.
+type Service struct {
+	pbs.UnimplementedSessionRecordingServiceServer
+
+	repoFn    common.SessionRepoFactory
+	iamRepoFn common.IamRepoFactory
+	storage   recording.Storage
+}
+
+// NewService returns a session recording service which handles session
+// recording related requests to boundary. The content of session recordings
+// is read from storage, which may be nil if the controller has no recording
+// storage configured.
+func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory, storage recording.Storage) (Service, error) {
+	const op = "sessionrecordings.NewService"
+	if repoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing session repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, storage: storage}, nil
+}
+
+var _ pbs.SessionRecordingServiceServer = Service{}
+
+// GetSessionRecording implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) GetSessionRecording(ctx context.Context, req *pbs.GetSessionRecordingRequest) (*pbs.GetSessionRecordingResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	r, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      r.GetPublicId(),
+		ScopeId: r.ScopeId,
+		Type:    resource.SessionRecording,
+	}, action.Read).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, r.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, r, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetSessionRecordingResponse{Item: item}, nil
+}
+
+// ListSessionRecordings implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) ListSessionRecordings(ctx context.Context, req *pbs.ListSessionRecordingsRequest) (*pbs.ListSessionRecordingsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(ctx,
+		s.iamRepoFn, authResults, req.GetScopeId(), resource.SessionRecording, req.GetRecursive(), false)
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListSessionRecordingsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPaginator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.SessionRecording
+	res := perms.Resource{
+		Type: resource.SessionRecording,
+	}
+	for pager.Next() {
+		rList, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range rList {
+			pageKey := handlers.NewPageKey(item.CreateTime, item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.ScopeId
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
+		}
+	}
+
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListSessionRecordingsResponse{Items: finalItems, ListToken: listToken}, nil
+}
+
+// DownloadSessionRecording implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) DownloadSessionRecording(ctx context.Context, req *pbs.DownloadSessionRecordingRequest) (*pbs.DownloadSessionRecordingResponse, error) {
+	const op = "sessionrecordings.(Service).DownloadSessionRecording"
+	if err := validateDownloadRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	r, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if s.storage == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The controller has no recording storage configured.")
+	}
+	rc, err := s.storage.Open(ctx, r.StorageUri)
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("The content of session recording %q is no longer available.", req.GetId())
+		case errors.Match(errors.T(errors.InvalidParameter), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The content of session recording %q is not in the controller's recording storage.", req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to open session recording"))
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to read session recording"))
+	}
+	return &pbs.DownloadSessionRecordingResponse{Content: content}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*session.Recording, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	r, err := repo.LookupRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, handlers.NotFoundErrorf("Session recording %q doesn't exist.", id)
+	}
+	return r, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*session.Recording, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	rList, err := repo.ListRecordings(ctx, session.WithScopeIds(scopeIds),
+		session.WithLimit(pager.Limit()), session.WithStartPageAfterItem(pager.StartAfter()))
+	if err != nil {
+		return nil, err
+	}
+	return rList, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.SessionRecording), auth.WithAction(a)}
+	switch a {
+	case action.List:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	case action.Read:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		r, err := repo.LookupRecording(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if r == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = r.ScopeId
+		opts = append(opts, auth.WithId(id))
+	default:
+		res.Error = stderrors.New("unsupported action")
+		return res
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *session.Recording, opt ...handlers.Option) (*pb.SessionRecording, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building session recording proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.SessionRecording{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.ScopeId
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.SessionIdField) {
+		out.SessionId = in.SessionId
+	}
+	if outputFields.Has(globals.ConnectionIdField) {
+		out.ConnectionId = in.ConnectionId
+	}
+	if outputFields.Has(globals.TargetIdField) {
+		out.TargetId = in.TargetId
+	}
+	if outputFields.Has(globals.UserIdField) {
+		out.UserId = in.UserId
+	}
+	if outputFields.Has(globals.WorkerIdField) {
+		out.WorkerId = in.WorkerId
+	}
+	if outputFields.Has(globals.BytesField) {
+		out.Bytes = in.Bytes
+	}
+	if outputFields.Has(globals.StartTimeField) {
+		out.StartTime = in.StartTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.EndTimeField) {
+		out.EndTime = in.EndTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.CreateTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.UpdateTime.GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.Version
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetSessionRecordingRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, session.RecordingPrefix)
+}
+
+func validateListRequest(req *pbs.ListSessionRecordingsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the list operation must be recursive."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDownloadRequest(req *pbs.DownloadSessionRecordingRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.RecordingPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package sessionrecordings_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessionrecordings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var testAuthorizedActions = []string{"no-op", "read"}
+
+const testRecordingContent = `{"version":2,"width":80,"height":24}
+[0.5,"o","hello"]
+`
+
+func testSetup(t *testing.T) (*iam.Repository, func() (*iam.Repository, error), func() (*session.Repository, error), *session.Session, *session.Recording, recording.Storage) {
+	t.Helper()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	storage, err := recording.NewStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+	w, uri, err := storage.Create(ctx, "test.cast")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testRecordingContent))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	c := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	r := session.TestRecording(t, conn, sess, c.PublicId, uri)
+	return iamRepo, iamRepoFn, sessRepoFn, sess, r, storage
+}
+
+func TestGetSessionRecording(t *testing.T) {
+	iamRepo, iamRepoFn, sessRepoFn, sess, r, storage := testSetup(t)
+	p, err := iamRepo.LookupScope(context.Background(), sess.ScopeId)
+	require.NoError(t, err)
+
+	wireRecording := &pb.SessionRecording{
+		Id:                r.GetPublicId(),
+		ScopeId:           sess.ScopeId,
+		Scope:             &scopes.ScopeInfo{Id: sess.ScopeId, Type: scope.Project.String(), ParentScopeId: p.GetParentId()},
+		SessionId:         sess.GetPublicId(),
+		ConnectionId:      r.ConnectionId,
+		TargetId:          sess.TargetId,
+		UserId:            sess.UserId,
+		WorkerId:          r.WorkerId,
+		Bytes:             r.Bytes,
+		StartTime:         r.StartTime.GetTimestamp(),
+		EndTime:           r.EndTime.GetTimestamp(),
+		CreatedTime:       r.CreateTime.GetTimestamp(),
+		UpdatedTime:       r.UpdateTime.GetTimestamp(),
+		Version:           r.Version,
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	cases := []struct {
+		name    string
+		scopeId string
+		req     *pbs.GetSessionRecordingRequest
+		res     *pbs.GetSessionRecordingResponse
+		err     error
+	}{
+		{
+			name:    "Get a session recording",
+			scopeId: sess.ScopeId,
+			req:     &pbs.GetSessionRecordingRequest{Id: r.GetPublicId()},
+			res:     &pbs.GetSessionRecordingResponse{Item: wireRecording},
+		},
+		{
+			name: "Get a non existant session recording",
+			req:  &pbs.GetSessionRecordingRequest{Id: session.RecordingPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetSessionRecordingRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "space in id",
+			req:  &pbs.GetSessionRecordingRequest{Id: session.RecordingPrefix + "_1 23456789"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessionrecordings.NewService(sessRepoFn, iamRepoFn, storage)
+			require.NoError(err, "Couldn't create new session recording service.")
+
+			got, gErr := s.GetSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, tc.scopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetSessionRecording(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestListSessionRecordings(t *testing.T) {
+	_, iamRepoFn, sessRepoFn, sess, r, storage := testSetup(t)
+
+	cases := []struct {
+		name    string
+		req     *pbs.ListSessionRecordingsRequest
+		wantIds []string
+		err     error
+	}{
+		{
+			name:    "List session recordings",
+			req:     &pbs.ListSessionRecordingsRequest{ScopeId: sess.ScopeId},
+			wantIds: []string{r.GetPublicId()},
+		},
+		{
+			name:    "Filter session recordings",
+			req:     &pbs.ListSessionRecordingsRequest{ScopeId: sess.ScopeId, Filter: `"/item/worker_id"=="other"`},
+			wantIds: nil,
+		},
+		{
+			name: "Invalid scope",
+			req:  &pbs.ListSessionRecordingsRequest{ScopeId: "o_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad filter",
+			req:  &pbs.ListSessionRecordingsRequest{ScopeId: sess.ScopeId, Filter: `"//id/"=="bad"`},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessionrecordings.NewService(sessRepoFn, iamRepoFn, storage)
+			require.NoError(err, "Couldn't create new session recording service.")
+
+			got, gErr := s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListSessionRecordings(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			var ids []string
+			for _, item := range got.GetItems() {
+				ids = append(ids, item.GetId())
+			}
+			assert.Equal(tc.wantIds, ids)
+		})
+	}
+}
+
+func TestDownloadSessionRecording(t *testing.T) {
+	_, iamRepoFn, sessRepoFn, sess, r, storage := testSetup(t)
+	otherStorage, err := recording.NewStorage(context.Background(), t.TempDir())
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		storage recording.Storage
+		req     *pbs.DownloadSessionRecordingRequest
+		res     *pbs.DownloadSessionRecordingResponse
+		err     error
+	}{
+		{
+			name:    "Download a session recording",
+			storage: storage,
+			req:     &pbs.DownloadSessionRecordingRequest{Id: r.GetPublicId()},
+			res:     &pbs.DownloadSessionRecordingResponse{Content: []byte(testRecordingContent)},
+		},
+		{
+			name:    "Download a non existant session recording",
+			storage: storage,
+			req:     &pbs.DownloadSessionRecordingRequest{Id: session.RecordingPrefix + "_DoesntExis"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Wrong id prefix",
+			storage: storage,
+			req:     &pbs.DownloadSessionRecordingRequest{Id: "s_1234567890"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "No recording storage",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: r.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:    "Recording outside of the recording storage",
+			storage: otherStorage,
+			req:     &pbs.DownloadSessionRecordingRequest{Id: r.GetPublicId()},
+			err:     handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessionrecordings.NewService(sessRepoFn, iamRepoFn, tc.storage)
+			require.NoError(err, "Couldn't create new session recording service.")
+
+			got, gErr := s.DownloadSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, sess.ScopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DownloadSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()))
+		})
+	}
+}
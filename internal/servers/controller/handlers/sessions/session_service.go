@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -32,6 +33,9 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.Extend,
+		action.ExtendSelf,
+		action.CloseConnection,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -113,6 +117,13 @@ func (s Service) GetSession(ctx context.Context, req *pbs.GetSessionRequest) (*p
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.ConnectionsField) {
+		conns, err := s.listConnectionsFromRepo(ctx, ses.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		item.Connections = connectionsToProto(conns)
+	}
 
 	return &pbs.GetSessionResponse{Item: item}, nil
 }
@@ -147,55 +158,66 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return &pbs.ListSessionsResponse{}, nil
 	}
 
-	sesList, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(sesList) == 0 {
-		return &pbs.ListSessionsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Session, 0, len(sesList))
+	var finalItems []*pb.Session
 	res := perms.Resource{
 		Type: resource.Session,
 	}
-	for _, item := range sesList {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.ScopeId
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
-		if len(authorizedActions) == 0 {
-			continue
+	for pager.Next() {
+		sesList, err := s.listFromRepo(ctx, scopeIds, pager)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range sesList {
+			pageKey := handlers.NewPageKey(item.CreateTime, item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.ScopeId
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
-			continue
-		}
+			if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
-		}
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
 
-		item, err := toProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
 
-	return &pbs.ListSessionsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListSessionsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // CancelSession implements the interface pbs.SessionServiceServer.
@@ -269,6 +291,111 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ExtendSession implements the interface pbs.SessionServiceServer.
+func (s Service) ExtendSession(ctx context.Context, req *pbs.ExtendSessionRequest) (*pbs.ExtendSessionResponse, error) {
+	const op = "sessions.(Service).ExtendSession"
+
+	if err := validateExtendRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ExtendSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var outputFields perms.OutputFieldsMap
+	authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+
+	// Check to see if we need to verify Extend vs. just ExtendSelf
+	if ses.UserId != authResults.UserId {
+		if !authorizedActions.HasAction(action.Extend) {
+			return nil, handlers.ForbiddenError()
+		}
+		outputFields = authResults.FetchOutputFields(perms.Resource{
+			Id:      ses.GetPublicId(),
+			ScopeId: ses.ScopeId,
+			Type:    resource.Session,
+		}, action.Extend).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	ses, err = s.extendInRepo(ctx, req.GetId(), req.GetVersion(), req.GetSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ExtendSessionResponse{Item: item}, nil
+}
+
+// CloseConnection implements the interface pbs.SessionServiceServer.
+func (s Service) CloseConnection(ctx context.Context, req *pbs.CloseConnectionRequest) (*pbs.CloseConnectionResponse, error) {
+	if err := validateCloseConnectionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CloseConnection)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	if err := s.closeConnectionInRepo(ctx, req.GetId(), req.GetConnectionId()); err != nil {
+		return nil, err
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      ses.GetPublicId(),
+		ScopeId: ses.ScopeId,
+		Type:    resource.Session,
+	}, action.CloseConnection).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.ConnectionsField) {
+		conns, err := s.listConnectionsFromRepo(ctx, ses.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		item.Connections = connectionsToProto(conns)
+	}
+	return &pbs.CloseConnectionResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -287,18 +414,31 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session,
 	return sess, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*session.Session, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	sesList, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds))
+	sesList, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds),
+		session.WithLimit(pager.Limit()), session.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, err
 	}
 	return sesList, nil
 }
 
+func (s Service) listConnectionsFromRepo(ctx context.Context, sessionId string) ([]*session.Connection, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	conns, err := repo.ListConnectionsBySessionId(ctx, sessionId, session.WithOrderByCreateTime(db.AscendingOrderBy))
+	if err != nil {
+		return nil, err
+	}
+	return conns, nil
+}
+
 func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*session.Session, error) {
 	const op = "sessions.(Service).cancelInRepo"
 	repo, err := s.repoFn()
@@ -312,6 +452,38 @@ func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*
 	return out, nil
 }
 
+func (s Service) extendInRepo(ctx context.Context, id string, version, seconds uint32) (*session.Session, error) {
+	const op = "sessions.(Service).extendInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.ExtendSession(ctx, id, version, seconds)
+	if err != nil {
+		if errors.Match(errors.T(errors.InvalidSessionState), err) {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+				"Session %q can't be extended: the version doesn't match, the session is no longer active or it has reached its maximum expiration time.", id)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to extend session"))
+	}
+	return out, nil
+}
+
+func (s Service) closeConnectionInRepo(ctx context.Context, id, connectionId string) error {
+	const op = "sessions.(Service).closeConnectionInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	if _, _, err := repo.CloseSessionConnection(ctx, id, connectionId); err != nil {
+		if errors.IsNotFoundError(err) {
+			return handlers.NotFoundErrorf("Connection %q doesn't exist on session %q.", connectionId, id)
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to close connection"))
+	}
+	return nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -334,7 +506,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.Extend, action.ExtendSelf, action.CloseConnection:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -409,6 +581,9 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	if outputFields.Has(globals.ExpirationTimeField) {
 		out.ExpirationTime = in.ExpirationTime.GetTimestamp()
 	}
+	if outputFields.Has(globals.MaxExpirationTimeField) {
+		out.MaxExpirationTime = in.MaxExpirationTime.GetTimestamp()
+	}
 	if outputFields.Has(globals.CertificateField) {
 		out.Certificate = in.Certificate
 	}
@@ -444,6 +619,27 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	return &out, nil
 }
 
+func connectionsToProto(in []*session.Connection) []*pb.Connection {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*pb.Connection, 0, len(in))
+	for _, c := range in {
+		out = append(out, &pb.Connection{
+			Id:                 c.GetPublicId(),
+			ClientTcpAddress:   c.ClientTcpAddress,
+			ClientTcpPort:      c.ClientTcpPort,
+			EndpointTcpAddress: c.EndpointTcpAddress,
+			EndpointTcpPort:    c.EndpointTcpPort,
+			BytesUp:            c.BytesUp,
+			BytesDown:          c.BytesDown,
+			ClosedReason:       c.ClosedReason,
+			CreatedTime:        c.CreateTime.GetTimestamp(),
+		})
+	}
+	return out
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -481,3 +677,34 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateExtendRequest(req *pbs.ExtendSessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if req.GetSeconds() == 0 {
+		badFields["seconds"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateCloseConnectionRequest(req *pbs.CloseConnectionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetConnectionId()), session.ConnectionPrefix) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -30,9 +31,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self"}
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self", "extend", "extend:self", "close-connection"}
 
 func TestGetSession(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -603,3 +605,184 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestExtend(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(t, conn, p.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	maxExp := exp.Add(time.Hour)
+	sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+		UserId:            uId,
+		HostId:            h.GetPublicId(),
+		TargetId:          tar.GetPublicId(),
+		HostSetId:         hs.GetPublicId(),
+		AuthTokenId:       at.GetPublicId(),
+		ScopeId:           p.GetPublicId(),
+		Endpoint:          "tcp://127.0.0.1:22",
+		ExpirationTime:    &timestamp.Timestamp{Timestamp: timestamppb.New(exp)},
+		MaxExpirationTime: &timestamp.Timestamp{Timestamp: timestamppb.New(maxExp)},
+	})
+
+	version := sess.Version
+
+	cases := []struct {
+		name    string
+		req     *pbs.ExtendSessionRequest
+		wantExp time.Time
+		err     error
+	}{
+		{
+			name:    "Extend a session",
+			req:     &pbs.ExtendSessionRequest{Id: sess.GetPublicId(), Seconds: 1800},
+			wantExp: exp.Add(30 * time.Minute),
+		},
+		{
+			name:    "Extend past the max expiration time",
+			req:     &pbs.ExtendSessionRequest{Id: sess.GetPublicId(), Seconds: 3600},
+			wantExp: maxExp,
+		},
+		{
+			name: "Already at the max expiration time",
+			req:  &pbs.ExtendSessionRequest{Id: sess.GetPublicId(), Seconds: 60},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name: "Extend a non existing Session",
+			req:  &pbs.ExtendSessionRequest{Id: session.SessionPrefix + "_DoesntExis", Seconds: 60},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ExtendSessionRequest{Id: "j_1234567890", Seconds: 60},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing seconds",
+			req:  &pbs.ExtendSessionRequest{Id: sess.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new session service.")
+
+			tc.req.Version = version
+
+			got, gErr := s.ExtendSession(auth.DisabledAuthTestContext(iamRepoFn, sess.ScopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ExtendSession(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.True(tc.wantExp.Equal(got.GetItem().GetExpirationTime().AsTime()), "got expiration %v, wanted %v", got.GetItem().GetExpirationTime().AsTime(), tc.wantExp)
+			assert.True(maxExp.Equal(got.GetItem().GetMaxExpirationTime().AsTime()))
+			assert.Greater(got.GetItem().GetVersion(), version)
+			version = got.GetItem().GetVersion()
+		})
+	}
+}
+
+func TestCloseConnection(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	sc := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 22, "127.0.0.1", 2222)
+	otherSess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+
+	cases := []struct {
+		name string
+		req  *pbs.CloseConnectionRequest
+		err  error
+	}{
+		{
+			name: "Close a connection",
+			req:  &pbs.CloseConnectionRequest{Id: sess.GetPublicId(), ConnectionId: sc.GetPublicId()},
+		},
+		{
+			name: "Close a closed connection",
+			req:  &pbs.CloseConnectionRequest{Id: sess.GetPublicId(), ConnectionId: sc.GetPublicId()},
+		},
+		{
+			name: "Connection of another session",
+			req:  &pbs.CloseConnectionRequest{Id: otherSess.GetPublicId(), ConnectionId: sc.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Non existing connection",
+			req:  &pbs.CloseConnectionRequest{Id: sess.GetPublicId(), ConnectionId: session.ConnectionPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong connection id prefix",
+			req:  &pbs.CloseConnectionRequest{Id: sess.GetPublicId(), ConnectionId: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.CloseConnectionRequest{Id: "j_1234567890", ConnectionId: sc.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new session service.")
+
+			got, gErr := s.CloseConnection(auth.DisabledAuthTestContext(iamRepoFn, sess.ScopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CloseConnection(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.Len(got.GetItem().GetConnections(), 1)
+			assert.Equal(sc.GetPublicId(), got.GetItem().GetConnections()[0].GetId())
+			assert.Equal(session.ConnectionClosedByAdmin.String(), got.GetItem().GetConnections()[0].GetClosedReason())
+		})
+	}
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -31,6 +33,8 @@ import (
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	sshStore "github.com/hashicorp/boundary/internal/target/ssh/store"
 	tcpStore "github.com/hashicorp/boundary/internal/target/tcp/store"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -48,7 +52,8 @@ import (
 )
 
 var (
-	maskManager handlers.MaskManager
+	tcpMaskManager handlers.MaskManager
+	sshMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -75,16 +80,31 @@ var (
 		action.Create,
 		action.List,
 	}
+
+	// credentialSourcePrefixes contains the id prefixes of the resources
+	// that can be attached to a target as a credential source.
+	credentialSourcePrefixes = []string{
+		vault.CredentialLibraryPrefix,
+		credstatic.UsernamePasswordCredentialPrefix,
+		credstatic.SshPrivateKeyCredentialPrefix,
+		credstatic.JsonCredentialPrefix,
+	}
 )
 
 func init() {
 	var err error
-	if maskManager, err = handlers.NewMaskManager(
+	if tcpMaskManager, err = handlers.NewMaskManager(
 		handlers.MaskDestination{&tcpStore.Target{}},
 		handlers.MaskSource{&pb.Target{}, &pb.TcpTargetAttributes{}},
 	); err != nil {
 		panic(err)
 	}
+	if sshMaskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&sshStore.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.SshTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.TargetServiceServer interface.
@@ -98,6 +118,7 @@ type Service struct {
 	pluginHostRepoFn common.PluginHostRepoFactory
 	staticHostRepoFn common.StaticRepoFactory
 	vaultCredRepoFn  common.VaultCredentialRepoFactory
+	staticCredRepoFn common.StaticCredentialRepoFactory
 	kmsCache         *kms.Kms
 }
 
@@ -111,7 +132,8 @@ func NewService(
 	sessionRepoFn common.SessionRepoFactory,
 	pluginHostRepoFn common.PluginHostRepoFactory,
 	staticHostRepoFn common.StaticRepoFactory,
-	vaultCredRepoFn common.VaultCredentialRepoFactory) (Service, error) {
+	vaultCredRepoFn common.VaultCredentialRepoFactory,
+	staticCredRepoFn common.StaticCredentialRepoFactory) (Service, error) {
 	const op = "targets.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
@@ -134,6 +156,9 @@ func NewService(
 	if vaultCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if staticCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
+	}
 	return Service{
 		repoFn:           repoFn,
 		iamRepoFn:        iamRepoFn,
@@ -142,6 +167,7 @@ func NewService(
 		pluginHostRepoFn: pluginHostRepoFn,
 		staticHostRepoFn: staticHostRepoFn,
 		vaultCredRepoFn:  vaultCredRepoFn,
+		staticCredRepoFn: staticCredRepoFn,
 		kmsCache:         kmsCache,
 	}, nil
 }
@@ -177,50 +203,61 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return &pbs.ListTargetsResponse{}, nil
 	}
 
-	tl, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(tl) == 0 {
-		return &pbs.ListTargetsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Target, 0, len(tl))
+	var finalItems []*pb.Target
 	res := perms.Resource{
 		Type: resource.Target,
 	}
-	for _, item := range tl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, nil, outputOpts...)
+	for pager.Next() {
+		tl, err := s.listFromRepo(ctx, scopeIds, pager)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range tl {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			item, err := toProto(ctx, item, nil, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListTargetsResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListTargetsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetTargets implements the interface pbs.TargetServiceServer.
@@ -265,7 +302,7 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest) (*pbs.CreateTargetResponse, error) {
 	const op = "targets.(Service).CreateTarget"
 
-	if err := validateCreateRequest(req); err != nil {
+	if err := validateCreateRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
@@ -303,7 +340,7 @@ func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest)
 func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest) (*pbs.UpdateTargetResponse, error) {
 	const op = "targets.(Service).UpdateTarget"
 
-	if err := validateUpdateRequest(req); err != nil {
+	if err := validateUpdateRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Update)
@@ -886,11 +923,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	var workers []*pb.WorkerInfo
 	var workerIds []string
 	hasWorkerFilter := len(t.GetWorkerFilter()) > 0
-	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	workerServers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
 	if err != nil {
 		return nil, err
 	}
-	for _, v := range servers {
+	for _, v := range workerServers {
+		// Workers which are shutting down are draining their connections
+		// and don't accept new ones
+		if v.GetOperationalState() == servers.ShutdownOperationalState.String() {
+			continue
+		}
 		if hasWorkerFilter {
 			workerIds = append(workerIds, v.GetPrivateId())
 		}
@@ -1012,10 +1054,15 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
 	}
 
-	// Generate the endpoint URL
+	// Generate the endpoint URL. Targets which inject egress credentials use
+	// their injection protocol as the scheme so that the worker proxies the
+	// connection with the handler registered for that protocol.
 	endpointUrl := &url.URL{
 		Scheme: t.GetType().String(),
 	}
+	if p := t.GetInjectionProtocol(); p != "" {
+		endpointUrl.Scheme = p
+	}
 	defaultPort := t.GetDefaultPort()
 	if defaultPort != 0 {
 		endpointUrl.Host = fmt.Sprintf("%s:%d", chosenEndpoint.Address, defaultPort)
@@ -1025,16 +1072,52 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	var reqs []credential.Request
 	var dynCreds []*session.DynamicCredential
+	var hasEgressSources bool
+	staticIdsByStore := make(map[string][]string)
+	staticPurposes := make(map[string][]credential.Purpose)
 	for _, l := range libs {
+		if credential.Purpose(l.CredentialPurpose()) == credential.EgressPurpose {
+			hasEgressSources = true
+		}
+		if credential.SubtypeFromId(l.Id()) == credstatic.Subtype {
+			staticIdsByStore[l.CredentialStoreId()] = append(staticIdsByStore[l.CredentialStoreId()], l.Id())
+			staticPurposes[l.Id()] = append(staticPurposes[l.Id()], credential.Purpose(l.CredentialPurpose()))
+			continue
+		}
 		reqs = append(reqs, credential.Request{
 			SourceId: l.Id(),
 			Purpose:  l.CredentialPurpose(),
 		})
 		dynCreds = append(dynCreds, session.NewDynamicCredential(l.Id(), l.CredentialPurpose()))
 	}
+	switch {
+	case t.GetType() == ssh.Subtype && !hasEgressSources:
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"SSH targets require at least one egress credential source.")
+	case t.GetInjectionProtocol() != "" && !hasEgressSources:
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"Target has an injection protocol but no egress credential sources.")
+	}
+	// The worker only injects egress credentials into an ssh connection to an
+	// endpoint which presents one of the host keys of the target.
+	var sshHostKeys []string
+	if endpointUrl.Scheme == tcp.InjectionProtocolSsh {
+		if t.GetSshHostKeys() == "" {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"Targets which inject egress credentials into SSH connections require SSH host keys.")
+		}
+		sshHostKeys, err = target.ParseSshHostKeys(ctx, t.GetSshHostKeys())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
+	maxExpTime := &timestamppb.Timestamp{Seconds: expTime.Seconds + int64(t.GetSessionMaxExtensionSeconds()), Nanos: expTime.Nanos}
 	sessionComposition := session.ComposedOf{
 		UserId:             authResults.UserId,
 		HostId:             chosenEndpoint.HostId,
@@ -1044,6 +1127,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		ScopeId:            authResults.Scope.Id,
 		Endpoint:           endpointUrl.String(),
 		ExpirationTime:     &timestamp.Timestamp{Timestamp: expTime},
+		MaxExpirationTime:  &timestamp.Timestamp{Timestamp: maxExpTime},
 		ConnectionLimit:    t.GetSessionConnectionLimit(),
 		WorkerFilter:       t.GetWorkerFilter(),
 		DynamicCredentials: dynCreds,
@@ -1075,9 +1159,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	}
 
 	var creds []*pb.SessionCredential
+	var egressCreds []session.Credential
 	for _, c := range cs {
 		l := c.Library()
 		secret := c.Secret()
+		if c.Purpose() == credential.EgressPurpose {
+			// Egress credentials are only sent to the worker.
+			ec, err := toEgressCredential(ctx, l.GetPublicId(), secret)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			egressCreds = append(egressCreds, ec)
+			continue
+		}
 		// TODO: Access the json directly from the vault response instead of re-marshalling it.
 		jSecret, err := json.Marshal(secret)
 		if err != nil {
@@ -1123,6 +1217,50 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		})
 	}
 
+	if len(staticIdsByStore) > 0 {
+		staticCreds, err := s.retrieveStaticCredentials(ctx, staticIdsByStore)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, c := range staticCreds {
+			for _, purpose := range staticPurposes[c.GetPublicId()] {
+				switch purpose {
+				case credential.ApplicationPurpose:
+					sc, err := toStaticSessionCredential(ctx, c)
+					if err != nil {
+						return nil, errors.Wrap(ctx, err, op)
+					}
+					creds = append(creds, sc)
+				case credential.EgressPurpose:
+					ec, err := toEgressCredential(ctx, c.GetPublicId(), c.Secret())
+					if err != nil {
+						return nil, errors.Wrap(ctx, err, op)
+					}
+					egressCreds = append(egressCreds, ec)
+				}
+			}
+		}
+	}
+
+	if len(sshHostKeys) > 0 {
+		hk, err := proto.Marshal(&serverpb.Credential{
+			CredentialSourceId: t.GetPublicId(),
+			Credential: &serverpb.Credential_SshHostKeys{
+				SshHostKeys: &serverpb.SshHostKeys{PublicKeys: sshHostKeys},
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling ssh host keys"))
+		}
+		egressCreds = append(egressCreds, hk)
+	}
+
+	if len(egressCreds) > 0 {
+		if err := sessionRepo.AddSessionCredentials(ctx, sess.ScopeId, sess.PublicId, egressCreds); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
 	sad := &pb.SessionAuthorizationData{
 		SessionId:       sess.PublicId,
 		TargetId:        t.GetPublicId(),
@@ -1135,6 +1273,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		Endpoint:        endpointUrl.String(),
 		WorkerInfo:      workers,
 		ConnectionLimit: t.GetSessionConnectionLimit(),
+		ExpirationTime:  sess.ExpirationTime.GetTimestamp(),
 	}
 	marshaledSad, err := proto.Marshal(sad)
 	if err != nil {
@@ -1158,6 +1297,114 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	return &pbs.AuthorizeSessionResponse{Item: ret}, nil
 }
 
+// retrieveStaticCredentials returns the decrypted static credentials for
+// the provided credential ids, which are keyed by the id of the credential
+// store they belong to.
+func (s Service) retrieveStaticCredentials(ctx context.Context, idsByStore map[string][]string) ([]credential.Static, error) {
+	const op = "targets.(Service).retrieveStaticCredentials"
+	repo, err := s.staticCredRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var creds []credential.Static
+	for storeId, ids := range idsByStore {
+		cs, err := repo.LookupCredentialStore(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %q not found", storeId))
+		}
+		c, err := repo.Retrieve(ctx, cs.GetScopeId(), strutil.RemoveDuplicates(ids, false))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c...)
+	}
+	return creds, nil
+}
+
+// toStaticSessionCredential converts a static credential into the session
+// credential returned to the user authorizing a session.
+func toStaticSessionCredential(ctx context.Context, c credential.Static) (*pb.SessionCredential, error) {
+	const op = "targets.toStaticSessionCredential"
+	jSecret, err := json.Marshal(c.Secret())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to json"))
+	}
+	var dSecret map[string]interface{}
+	if err := json.Unmarshal(jSecret, &dSecret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("decoding json for proto marshaling"))
+	}
+	sSecret, err := structpb.NewStruct(dSecret)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for secret"))
+	}
+	return &pb.SessionCredential{
+		CredentialSource: &pb.CredentialSource{
+			Id:                c.GetPublicId(),
+			Name:              c.GetName(),
+			Description:       c.GetDescription(),
+			CredentialStoreId: c.GetStoreId(),
+			Type:              credstatic.Subtype.String(),
+			CredentialType:    credstatic.CredentialTypeFromId(c.GetPublicId()).String(),
+		},
+		Secret: &pb.SessionSecret{
+			Raw:     base64.StdEncoding.EncodeToString(jSecret),
+			Decoded: sSecret,
+		},
+		Credential: sSecret,
+	}, nil
+}
+
+// toEgressCredential converts the secret of a credential with an egress
+// purpose into the marshaled form which is stored with the session and sent
+// to the worker. The secret must contain a username and either a password or
+// a private_key. Secrets read from a Vault KV version 2 engine nest these
+// under a "data" key.
+func toEgressCredential(ctx context.Context, sourceId string, secret credential.SecretData) (session.Credential, error) {
+	const op = "targets.toEgressCredential"
+	var m map[string]interface{}
+	jSecret, err := json.Marshal(secret)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to json"))
+	}
+	if err := json.Unmarshal(jSecret, &m); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("secret for credential source %q is not an object", sourceId))
+	}
+	if data, ok := m["data"].(map[string]interface{}); ok {
+		m = data
+	}
+	str := func(k string) string {
+		v, _ := m[k].(string)
+		return v
+	}
+	c := &serverpb.Credential{CredentialSourceId: sourceId}
+	switch {
+	case str("username") != "" && str("password") != "":
+		c.Credential = &serverpb.Credential_UsernamePassword{
+			UsernamePassword: &serverpb.UsernamePassword{
+				Username: str("username"),
+				Password: str("password"),
+			},
+		}
+	case str("username") != "" && str("private_key") != "":
+		c.Credential = &serverpb.Credential_SshPrivateKey{
+			SshPrivateKey: &serverpb.SshPrivateKey{
+				Username:   str("username"),
+				PrivateKey: str("private_key"),
+			},
+		}
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("secret for credential source %q cannot be injected; it must contain a username and either a password or a private_key", sourceId))
+	}
+	b, err := proto.Marshal(c)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling egress credential"))
+	}
+	return b, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1185,20 +1432,46 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetSessionMaxSeconds() != nil {
 		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
 	}
+	if item.GetSessionMaxExtensionSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxExtensionSeconds(item.GetSessionMaxExtensionSeconds().GetValue()))
+	}
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
 	if item.GetWorkerFilter() != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
-	tcpAttrs := &pb.TcpTargetAttributes{}
-	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
-		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
-	}
-	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
-		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
+	var u target.Target
+	var err error
+	switch target.SubtypeFromType(item.GetType()) {
+	case ssh.Subtype:
+		sshAttrs := &pb.SshTargetAttributes{}
+		if err := handlers.StructToProto(item.GetAttributes(), sshAttrs); err != nil {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+		}
+		if sshAttrs.GetDefaultPort().GetValue() != 0 {
+			opts = append(opts, target.WithDefaultPort(sshAttrs.GetDefaultPort().GetValue()))
+		}
+		if sshAttrs.GetSshHostKeys().GetValue() != "" {
+			opts = append(opts, target.WithSshHostKeys(sshAttrs.GetSshHostKeys().GetValue()))
+		}
+		u, err = ssh.New(item.GetScopeId(), opts...)
+	default:
+		tcpAttrs := &pb.TcpTargetAttributes{}
+		if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+		}
+		if tcpAttrs.GetDefaultPort().GetValue() != 0 {
+			opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
+		}
+		if tcpAttrs.GetInjectionProtocol().GetValue() != "" {
+			opts = append(opts, target.WithInjectionProtocol(tcpAttrs.GetInjectionProtocol().GetValue()))
+		}
+		if tcpAttrs.GetSshHostKeys().GetValue() != "" {
+			opts = append(opts, target.WithSshHostKeys(tcpAttrs.GetSshHostKeys().GetValue()))
+		}
+		u, err = tcp.New(item.GetScopeId(), opts...)
 	}
-	u, err := tcp.New(item.GetScopeId(), opts...)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for creation: %v.", err)
 	}
@@ -1228,26 +1501,56 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if item.GetSessionMaxSeconds() != nil {
 		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
 	}
+	if item.GetSessionMaxExtensionSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxExtensionSeconds(item.GetSessionMaxExtensionSeconds().GetValue()))
+	}
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
 	if filter := item.GetWorkerFilter(); filter != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
-	tcpAttrs := &pb.TcpTargetAttributes{}
-	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
-		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
-	}
-	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
-		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
-	}
 	version := item.GetVersion()
-	u, err := tcp.New(scopeId, opts...)
+	var u target.Target
+	var dbMask []string
+	var err error
+	switch target.SubtypeFromId(id) {
+	case ssh.Subtype:
+		sshAttrs := &pb.SshTargetAttributes{}
+		if err := handlers.StructToProto(item.GetAttributes(), sshAttrs); err != nil {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+		}
+		if sshAttrs.GetDefaultPort().GetValue() != 0 {
+			opts = append(opts, target.WithDefaultPort(sshAttrs.GetDefaultPort().GetValue()))
+		}
+		if sshAttrs.GetSshHostKeys().GetValue() != "" {
+			opts = append(opts, target.WithSshHostKeys(sshAttrs.GetSshHostKeys().GetValue()))
+		}
+		u, err = ssh.New(scopeId, opts...)
+		dbMask = sshMaskManager.Translate(mask)
+	default:
+		tcpAttrs := &pb.TcpTargetAttributes{}
+		if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+		}
+		if tcpAttrs.GetDefaultPort().GetValue() != 0 {
+			opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
+		}
+		if tcpAttrs.GetInjectionProtocol().GetValue() != "" {
+			opts = append(opts, target.WithInjectionProtocol(tcpAttrs.GetInjectionProtocol().GetValue()))
+		}
+		if tcpAttrs.GetSshHostKeys().GetValue() != "" {
+			opts = append(opts, target.WithSshHostKeys(tcpAttrs.GetSshHostKeys().GetValue()))
+		}
+		u, err = tcp.New(scopeId, opts...)
+		dbMask = tcpMaskManager.Translate(mask)
+	}
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for update: %v.", err)
 	}
-	u.PublicId = id
-	dbMask := maskManager.Translate(mask)
+	if err := u.SetPublicId(ctx, id); err != nil {
+		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for update: %v.", err)
+	}
 	if len(dbMask) == 0 {
 		return nil, nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid paths provided in the update mask."})
 	}
@@ -1281,12 +1584,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]target.Target, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]target.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds))
+	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds),
+		target.WithLimit(pager.Limit()), target.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, err
 	}
@@ -1357,12 +1661,12 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	credLibs, err := createCredLibs(targetId, applicationIds, egressIds, nil)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
 
-	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, credLibs)
+	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add credential sources to target: %v.", err)
@@ -1380,12 +1684,12 @@ func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string
 		return nil, nil, nil, err
 	}
 
-	credLibs, err := createCredLibs(targetId, applicationIds, egressIds, nil)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
 
-	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, credLibs)
+	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
@@ -1408,11 +1712,11 @@ func (s Service) removeCredentialSourcesInRepo(ctx context.Context, targetId str
 		return nil, nil, nil, err
 	}
 
-	credLibs, err := createCredLibs(targetId, applicationIds, egressIds, nil)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
-	_, err = repo.DeleteTargetCredentialSources(ctx, targetId, version, credLibs)
+	_, err = repo.DeleteTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to remove credential sources from target: %v.", err)
@@ -1516,6 +1820,9 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.SessionMaxSecondsField) {
 		out.SessionMaxSeconds = wrapperspb.UInt32(in.GetSessionMaxSeconds())
 	}
+	if outputFields.Has(globals.SessionMaxExtensionSecondsField) {
+		out.SessionMaxExtensionSeconds = wrapperspb.UInt32(in.GetSessionMaxExtensionSeconds())
+	}
 	if outputFields.Has(globals.SessionConnectionLimitField) {
 		out.SessionConnectionLimit = wrapperspb.Int32(in.GetSessionConnectionLimit())
 	}
@@ -1556,14 +1863,28 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	}
 	if outputFields.Has(globals.ApplicationCredentialLibraryIdsField) {
 		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.ApplicationPurpose {
+				continue
+			}
 			out.ApplicationCredentialLibraryIds = append(out.ApplicationCredentialLibraryIds, cs.Id())
 		}
 	}
 	if outputFields.Has(globals.ApplicationCredentialSourceIdsField) {
 		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.ApplicationPurpose {
+				continue
+			}
 			out.ApplicationCredentialSourceIds = append(out.ApplicationCredentialSourceIds, cs.Id())
 		}
 	}
+	if outputFields.Has(globals.EgressCredentialSourceIdsField) {
+		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.EgressPurpose {
+				continue
+			}
+			out.EgressCredentialSourceIds = append(out.EgressCredentialSourceIds, cs.Id())
+		}
+	}
 	if outputFields.Has(globals.ApplicationCredentialLibrariesField) {
 		for _, cs := range credSources {
 			switch credential.Purpose(cs.CredentialPurpose()) {
@@ -1579,25 +1900,56 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 			}
 		}
 	}
-	if outputFields.Has(globals.ApplicationCredentialSourcesField) {
+	if outputFields.Has(globals.ApplicationCredentialSourcesField) || outputFields.Has(globals.EgressCredentialSourcesField) {
 		for _, cs := range credSources {
+			source := &pb.CredentialSource{
+				Id:                cs.Id(),
+				CredentialStoreId: cs.CredentialStoreId(),
+			}
+			if credential.SubtypeFromId(cs.Id()) == credstatic.Subtype {
+				source.Type = credstatic.Subtype.String()
+				source.CredentialType = credstatic.CredentialTypeFromId(cs.Id()).String()
+			}
 			switch credential.Purpose(cs.CredentialPurpose()) {
 			case credential.ApplicationPurpose:
-				out.ApplicationCredentialSources = append(out.ApplicationCredentialSources, &pb.CredentialSource{
-					Id:                cs.Id(),
-					CredentialStoreId: cs.CredentialStoreId(),
-				})
-			case credential.IngressPurpose, credential.EgressPurpose:
-				// TODO: When we support other purposes add them to different fields here.
+				if outputFields.Has(globals.ApplicationCredentialSourcesField) {
+					out.ApplicationCredentialSources = append(out.ApplicationCredentialSources, source)
+				}
+			case credential.EgressPurpose:
+				if outputFields.Has(globals.EgressCredentialSourcesField) {
+					out.EgressCredentialSources = append(out.EgressCredentialSources, source)
+				}
+			case credential.IngressPurpose:
+				// TODO: When we support ingress credentials add them to a different field here.
 			default:
 				return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unrecognized purpose %q for credential source on target", cs.CredentialPurpose()))
 			}
 		}
 	}
 	if outputFields.Has(globals.AttributesField) {
-		attrs := &pb.TcpTargetAttributes{}
-		if in.GetDefaultPort() > 0 {
-			attrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+		var attrs proto.Message
+		switch in.GetType() {
+		case ssh.Subtype:
+			sshAttrs := &pb.SshTargetAttributes{}
+			if in.GetDefaultPort() > 0 {
+				sshAttrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+			}
+			if in.GetSshHostKeys() != "" {
+				sshAttrs.SshHostKeys = &wrappers.StringValue{Value: in.GetSshHostKeys()}
+			}
+			attrs = sshAttrs
+		default:
+			tcpAttrs := &pb.TcpTargetAttributes{}
+			if in.GetDefaultPort() > 0 {
+				tcpAttrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+			}
+			if in.GetInjectionProtocol() != "" {
+				tcpAttrs.InjectionProtocol = &wrappers.StringValue{Value: in.GetInjectionProtocol()}
+			}
+			if in.GetSshHostKeys() != "" {
+				tcpAttrs.SshHostKeys = &wrappers.StringValue{Value: in.GetSshHostKeys()}
+			}
+			attrs = tcpAttrs
 		}
 		st, err := handlers.ProtoToStruct(attrs)
 		if err != nil {
@@ -1610,14 +1962,14 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
-//  * The path passed in is correctly formatted
-//  * All required parameters are set
-//  * There are no conflicting parameters provided
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetTargetRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, tcp.TargetPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, tcp.TargetPrefix, ssh.TargetPrefix)
 }
 
-func validateCreateRequest(req *pbs.CreateTargetRequest) error {
+func validateCreateRequest(ctx context.Context, req *pbs.CreateTargetRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		if !handlers.ValidId(handlers.Id(req.GetItem().GetScopeId()), scope.Project.Prefix()) {
@@ -1647,6 +1999,27 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 			if tcpAttrs.GetDefaultPort() != nil && tcpAttrs.GetDefaultPort().GetValue() == 0 {
 				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
 			}
+			if p := tcpAttrs.GetInjectionProtocol(); p != nil && !tcp.ValidInjectionProtocol(p.GetValue()) {
+				badFields["attributes.injection_protocol"] = fmt.Sprintf("Unknown injection protocol; must be %q or %q.", tcp.InjectionProtocolSsh, tcp.InjectionProtocolPostgres)
+			}
+			if k := tcpAttrs.GetSshHostKeys(); k != nil {
+				if _, err := target.ParseSshHostKeys(ctx, k.GetValue()); err != nil {
+					badFields["attributes.ssh_host_keys"] = "Must contain one or more public keys in authorized_keys format."
+				}
+			}
+		case ssh.Subtype:
+			sshAttrs := &pb.SshTargetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), sshAttrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+			}
+			if sshAttrs.GetDefaultPort() != nil && sshAttrs.GetDefaultPort().GetValue() == 0 {
+				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+			}
+			if k := sshAttrs.GetSshHostKeys(); k != nil {
+				if _, err := target.ParseSshHostKeys(ctx, k.GetValue()); err != nil {
+					badFields["attributes.ssh_host_keys"] = "Must contain one or more public keys in authorized_keys format."
+				}
+			}
 		}
 		if req.GetItem().GetType() == "" {
 			badFields[globals.TypeField] = "This is a required field."
@@ -1662,7 +2035,7 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
+func validateUpdateRequest(ctx context.Context, req *pbs.UpdateTargetRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.NameField) && req.GetItem().GetName().GetValue() == "" {
@@ -1680,7 +2053,7 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
-		switch target.SubtypeFromId(req.GetItem().GetType()) {
+		switch target.SubtypeFromId(req.GetId()) {
 		case tcp.Subtype:
 			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != tcp.Subtype {
 				badFields[globals.TypeField] = "Cannot modify the resource type."
@@ -1692,6 +2065,30 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 			if tcpAttrs.GetDefaultPort() != nil && tcpAttrs.GetDefaultPort().GetValue() == 0 {
 				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
 			}
+			if p := tcpAttrs.GetInjectionProtocol(); p != nil && !tcp.ValidInjectionProtocol(p.GetValue()) {
+				badFields["attributes.injection_protocol"] = fmt.Sprintf("Unknown injection protocol; must be %q or %q.", tcp.InjectionProtocolSsh, tcp.InjectionProtocolPostgres)
+			}
+			if k := tcpAttrs.GetSshHostKeys(); k != nil {
+				if _, err := target.ParseSshHostKeys(ctx, k.GetValue()); err != nil {
+					badFields["attributes.ssh_host_keys"] = "Must contain one or more public keys in authorized_keys format."
+				}
+			}
+		case ssh.Subtype:
+			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != ssh.Subtype {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			sshAttrs := &pb.SshTargetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), sshAttrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+			}
+			if sshAttrs.GetDefaultPort() != nil && sshAttrs.GetDefaultPort().GetValue() == 0 {
+				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+			}
+			if k := sshAttrs.GetSshHostKeys(); k != nil {
+				if _, err := target.ParseSshHostKeys(ctx, k.GetValue()); err != nil {
+					badFields["attributes.ssh_host_keys"] = "Must contain one or more public keys in authorized_keys format."
+				}
+			}
 		}
 		if filter := req.GetItem().GetWorkerFilter(); filter != nil {
 			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
@@ -1699,11 +2096,11 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 			}
 		}
 		return badFields
-	}, tcp.TargetPrefix)
+	}, tcp.TargetPrefix, ssh.TargetPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteTargetRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, tcp.TargetPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, tcp.TargetPrefix, ssh.TargetPrefix)
 }
 
 func validateListRequest(req *pbs.ListTargetsRequest) error {
@@ -1723,7 +2120,7 @@ func validateListRequest(req *pbs.ListTargetsRequest) error {
 
 func validateAddSetsRequest(req *pbs.AddTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1747,7 +2144,7 @@ func validateAddSetsRequest(req *pbs.AddTargetHostSetsRequest) error {
 
 func validateSetSetsRequest(req *pbs.SetTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1768,7 +2165,7 @@ func validateSetSetsRequest(req *pbs.SetTargetHostSetsRequest) error {
 
 func validateRemoveSetsRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1792,7 +2189,7 @@ func validateRemoveSetsRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 
 func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1816,7 +2213,7 @@ func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
 
 func validateSetHostSourcesRequest(req *pbs.SetTargetHostSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1837,7 +2234,7 @@ func validateSetHostSourcesRequest(req *pbs.SetTargetHostSourcesRequest) error {
 
 func validateRemoveHostSourcesRequest(req *pbs.RemoveTargetHostSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1861,7 +2258,7 @@ func validateRemoveHostSourcesRequest(req *pbs.RemoveTargetHostSourcesRequest) e
 
 func validateAddLibrariesRequest(req *pbs.AddTargetCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1884,7 +2281,7 @@ func validateAddLibrariesRequest(req *pbs.AddTargetCredentialLibrariesRequest) e
 
 func validateSetLibrariesRequest(req *pbs.SetTargetCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1904,7 +2301,7 @@ func validateSetLibrariesRequest(req *pbs.SetTargetCredentialLibrariesRequest) e
 
 func validateRemoveLibrariesRequest(req *pbs.RemoveTargetCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1927,7 +2324,7 @@ func validateRemoveLibrariesRequest(req *pbs.RemoveTargetCredentialLibrariesRequ
 
 func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1938,13 +2335,13 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1957,20 +2354,20 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 
 func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
 		badFields[globals.VersionField] = "Required field."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1983,7 +2380,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 
 func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 		badFields[globals.IdField] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -1994,13 +2391,13 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -2017,7 +2414,7 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	scopeIdEmpty := req.GetScopeId() == ""
 	scopeNameEmpty := req.GetScopeName() == ""
 	if nameEmpty {
-		if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix) {
+		if !handlers.ValidId(handlers.Id(req.GetId()), tcp.TargetPrefix, ssh.TargetPrefix) {
 			badFields[globals.IdField] = "Incorrectly formatted identifier."
 		}
 		if !scopeIdEmpty {
@@ -2052,8 +2449,9 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	return nil
 }
 
-func createCredLibs(targetId string, applicationIds, ingressIds, egressIds []string) ([]*target.CredentialLibrary, error) {
-	credLibs := make([]*target.CredentialLibrary, 0, len(applicationIds)+len(ingressIds)+len(egressIds))
+func createCredSources(targetId string, applicationIds, ingressIds, egressIds []string) ([]*target.CredentialLibrary, []*target.StaticCredential, error) {
+	var credLibs []*target.CredentialLibrary
+	var staticCreds []*target.StaticCredential
 
 	byPurpose := map[credential.Purpose][]string{
 		credential.ApplicationPurpose: strutil.RemoveDuplicates(applicationIds, false),
@@ -2062,12 +2460,21 @@ func createCredLibs(targetId string, applicationIds, ingressIds, egressIds []str
 	}
 	for purpose, ids := range byPurpose {
 		for _, id := range ids {
-			l, err := target.NewCredentialLibrary(targetId, id, purpose)
-			if err != nil {
-				return nil, err
+			switch credential.SubtypeFromId(id) {
+			case credstatic.Subtype:
+				c, err := target.NewStaticCredential(targetId, id, purpose)
+				if err != nil {
+					return nil, nil, err
+				}
+				staticCreds = append(staticCreds, c)
+			default:
+				l, err := target.NewCredentialLibrary(targetId, id, purpose)
+				if err != nil {
+					return nil, nil, err
+				}
+				credLibs = append(credLibs, l)
 			}
-			credLibs = append(credLibs, l)
 		}
 	}
-	return credLibs, nil
+	return credLibs, staticCreds, nil
 }
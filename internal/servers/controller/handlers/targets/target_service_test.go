@@ -15,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -36,6 +37,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	credpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
@@ -72,6 +74,8 @@ var testAuthorizedActions = []string{
 	"authorize-session",
 }
 
+const testSshHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+
 func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapper) (targets.Service, error) {
 	rw := db.New(conn)
 	sche := scheduler.TestScheduler(t, conn, wrapper)
@@ -96,7 +100,10 @@ func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapp
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
-	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredRepoFn)
 }
 
 func TestGet(t *testing.T) {
@@ -118,19 +125,20 @@ func TestGet(t *testing.T) {
 	tar := tcp.TestTarget(t, conn, proj.GetPublicId(), "test", target.WithHostSources([]string{hs[0].GetPublicId(), hs[1].GetPublicId()}))
 
 	pTar := &pb.Target{
-		Id:                     tar.GetPublicId(),
-		ScopeId:                proj.GetPublicId(),
-		Name:                   wrapperspb.String("test"),
-		CreatedTime:            tar.CreateTime.GetTimestamp(),
-		UpdatedTime:            tar.UpdateTime.GetTimestamp(),
-		Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: o.GetPublicId()},
-		Type:                   tcp.Subtype.String(),
-		HostSetIds:             []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
-		HostSourceIds:          []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
-		Attributes:             new(structpb.Struct),
-		SessionMaxSeconds:      wrapperspb.UInt32(28800),
-		SessionConnectionLimit: wrapperspb.Int32(1),
-		AuthorizedActions:      testAuthorizedActions,
+		Id:                         tar.GetPublicId(),
+		ScopeId:                    proj.GetPublicId(),
+		Name:                       wrapperspb.String("test"),
+		CreatedTime:                tar.CreateTime.GetTimestamp(),
+		UpdatedTime:                tar.UpdateTime.GetTimestamp(),
+		Scope:                      &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: o.GetPublicId()},
+		Type:                       tcp.Subtype.String(),
+		HostSetIds:                 []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
+		HostSourceIds:              []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
+		Attributes:                 new(structpb.Struct),
+		SessionMaxSeconds:          wrapperspb.UInt32(28800),
+		SessionConnectionLimit:     wrapperspb.Int32(1),
+		SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+		AuthorizedActions:          testAuthorizedActions,
 	}
 	for _, ihs := range hs {
 		pTar.HostSets = append(pTar.HostSets, &pb.HostSet{Id: ihs.GetPublicId(), HostCatalogId: ihs.GetCatalogId()})
@@ -212,34 +220,36 @@ func TestList(t *testing.T) {
 		name := fmt.Sprintf("tar%d", i)
 		tar := tcp.TestTarget(t, conn, proj.GetPublicId(), name, target.WithHostSources([]string{hss[0].GetPublicId(), hss[1].GetPublicId()}))
 		wantTars = append(wantTars, &pb.Target{
-			Id:                     tar.GetPublicId(),
-			ScopeId:                proj.GetPublicId(),
-			Name:                   wrapperspb.String(name),
-			Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
-			CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-			UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
-			Version:                tar.GetVersion(),
-			Type:                   tcp.Subtype.String(),
-			Attributes:             new(structpb.Struct),
-			SessionMaxSeconds:      wrapperspb.UInt32(28800),
-			SessionConnectionLimit: wrapperspb.Int32(1),
-			AuthorizedActions:      testAuthorizedActions,
+			Id:                         tar.GetPublicId(),
+			ScopeId:                    proj.GetPublicId(),
+			Name:                       wrapperspb.String(name),
+			Scope:                      &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+			CreatedTime:                tar.GetCreateTime().GetTimestamp(),
+			UpdatedTime:                tar.GetUpdateTime().GetTimestamp(),
+			Version:                    tar.GetVersion(),
+			Type:                       tcp.Subtype.String(),
+			Attributes:                 new(structpb.Struct),
+			SessionMaxSeconds:          wrapperspb.UInt32(28800),
+			SessionConnectionLimit:     wrapperspb.Int32(1),
+			SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+			AuthorizedActions:          testAuthorizedActions,
 		})
 		totalTars = append(totalTars, wantTars[i])
 		tar = tcp.TestTarget(t, conn, otherProj.GetPublicId(), name, target.WithHostSources([]string{otherHss[0].GetPublicId(), otherHss[1].GetPublicId()}))
 		totalTars = append(totalTars, &pb.Target{
-			Id:                     tar.GetPublicId(),
-			ScopeId:                otherProj.GetPublicId(),
-			Name:                   wrapperspb.String(name),
-			Scope:                  &scopes.ScopeInfo{Id: otherProj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: otherOrg.GetPublicId()},
-			CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-			UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
-			Version:                tar.GetVersion(),
-			Type:                   tcp.Subtype.String(),
-			Attributes:             new(structpb.Struct),
-			SessionMaxSeconds:      wrapperspb.UInt32(28800),
-			SessionConnectionLimit: wrapperspb.Int32(1),
-			AuthorizedActions:      testAuthorizedActions,
+			Id:                         tar.GetPublicId(),
+			ScopeId:                    otherProj.GetPublicId(),
+			Name:                       wrapperspb.String(name),
+			Scope:                      &scopes.ScopeInfo{Id: otherProj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: otherOrg.GetPublicId()},
+			CreatedTime:                tar.GetCreateTime().GetTimestamp(),
+			UpdatedTime:                tar.GetUpdateTime().GetTimestamp(),
+			Version:                    tar.GetVersion(),
+			Type:                       tcp.Subtype.String(),
+			Attributes:                 new(structpb.Struct),
+			SessionMaxSeconds:          wrapperspb.UInt32(28800),
+			SessionConnectionLimit:     wrapperspb.Int32(1),
+			SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+			AuthorizedActions:          testAuthorizedActions,
 		})
 	}
 
@@ -454,13 +464,69 @@ func TestCreate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					SessionMaxSeconds:      wrapperspb.UInt32(28800),
-					SessionConnectionLimit: wrapperspb.Int32(1),
-					AuthorizedActions:      testAuthorizedActions,
-					WorkerFilter:           wrapperspb.String(`type == "bar"`),
+					SessionMaxSeconds:          wrapperspb.UInt32(28800),
+					SessionConnectionLimit:     wrapperspb.Int32(1),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
+					WorkerFilter:               wrapperspb.String(`type == "bar"`),
 				},
 			},
 		},
+		{
+			name: "Create a valid ssh target",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String("ssh name"),
+				Description: wrapperspb.String("desc"),
+				Type:        ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port":  structpb.NewNumberValue(22),
+					"ssh_host_keys": structpb.NewStringValue(testSshHostKey),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", ssh.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId:     proj.GetPublicId(),
+					Scope:       &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:        wrapperspb.String("ssh name"),
+					Description: wrapperspb.String("desc"),
+					Type:        ssh.Subtype.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port":  structpb.NewNumberValue(22),
+						"ssh_host_keys": structpb.NewStringValue(testSshHostKey),
+					}},
+					SessionMaxSeconds:          wrapperspb.UInt32(28800),
+					SessionConnectionLimit:     wrapperspb.Int32(1),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create ssh target with invalid host keys",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("ssh invalid host keys"),
+				Type:    ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"ssh_host_keys": structpb.NewStringValue("ssh-ed25519 invalid"),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create ssh target with injection protocol",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("ssh injection"),
+				Type:    ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"injection_protocol": structpb.NewStringValue("ssh"),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with default port 0",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -540,7 +606,7 @@ func TestCreate(t *testing.T) {
 
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.GetUri())
-				assert.True(strings.HasPrefix(got.GetItem().GetId(), tcp.TargetPrefix), got.GetItem().GetId())
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), strings.TrimPrefix(tc.res.GetUri(), "targets/")), got.GetItem().GetId())
 
 				// Clear all values which are hard to compare against.
 				got.Uri, tc.res.Uri = "", ""
@@ -645,14 +711,15 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					CreatedTime:                tar.GetCreateTime().GetTimestamp(),
+					HostSetIds:                 hsIds,
+					HostSets:                   hostSets,
+					HostSourceIds:              hostSourceIds,
+					HostSources:                hostSources,
+					SessionMaxSeconds:          wrapperspb.UInt32(3600),
+					SessionConnectionLimit:     wrapperspb.Int32(5),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
 				},
 			},
 		},
@@ -680,13 +747,14 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                 hsIds,
+					HostSets:                   hostSets,
+					HostSourceIds:              hostSourceIds,
+					HostSources:                hostSources,
+					SessionMaxSeconds:          wrapperspb.UInt32(3600),
+					SessionConnectionLimit:     wrapperspb.Int32(5),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
 				},
 			},
 		},
@@ -767,13 +835,14 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                 hsIds,
+					HostSets:                   hostSets,
+					HostSourceIds:              hostSourceIds,
+					HostSources:                hostSources,
+					SessionMaxSeconds:          wrapperspb.UInt32(3600),
+					SessionConnectionLimit:     wrapperspb.Int32(5),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
 				},
 			},
 		},
@@ -800,13 +869,14 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                 hsIds,
+					HostSets:                   hostSets,
+					HostSourceIds:              hostSourceIds,
+					HostSources:                hostSources,
+					SessionMaxSeconds:          wrapperspb.UInt32(3600),
+					SessionConnectionLimit:     wrapperspb.Int32(5),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
 				},
 			},
 		},
@@ -832,14 +902,15 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					Type:                   tcp.Subtype.String(),
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					Type:                       tcp.Subtype.String(),
+					HostSetIds:                 hsIds,
+					HostSets:                   hostSets,
+					HostSourceIds:              hostSourceIds,
+					HostSources:                hostSources,
+					SessionMaxSeconds:          wrapperspb.UInt32(3600),
+					SessionConnectionLimit:     wrapperspb.Int32(5),
+					SessionMaxExtensionSeconds: wrapperspb.UInt32(0),
+					AuthorizedActions:          testAuthorizedActions,
 				},
 			},
 		},
@@ -2309,6 +2380,54 @@ func TestAddTargetSources(t *testing.T) {
 	}
 }
 
+func TestAddTargetSources_StaticCredentials(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+
+	vaultStore := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	cl := vault.TestCredentialLibraries(t, conn, wrapper, vaultStore.GetPublicId(), 1)[0]
+	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	upCred := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", staticStore.GetPublicId(), proj.GetPublicId())
+	jsonCred := credstatic.TestJsonCredential(t, conn, wrapper, staticStore.GetPublicId(), proj.GetPublicId(), map[string]interface{}{"key": "value"})
+
+	tar := tcp.TestTarget(t, conn, proj.GetPublicId(), "static sources")
+	got, err := s.AddTargetCredentialSources(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.AddTargetCredentialSourcesRequest{
+		Id:                             tar.GetPublicId(),
+		Version:                        tar.GetVersion(),
+		ApplicationCredentialSourceIds: []string{cl.GetPublicId(), upCred.GetPublicId(), jsonCred.GetPublicId()},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{cl.GetPublicId(), upCred.GetPublicId(), jsonCred.GetPublicId()}, got.GetItem().GetApplicationCredentialSourceIds())
+
+	want := []*pb.CredentialSource{
+		{Id: cl.GetPublicId(), CredentialStoreId: vaultStore.GetPublicId()},
+		{Id: upCred.GetPublicId(), CredentialStoreId: staticStore.GetPublicId(), Type: credstatic.Subtype.String(), CredentialType: credential.UsernamePasswordType.String()},
+		{Id: jsonCred.GetPublicId(), CredentialStoreId: staticStore.GetPublicId(), Type: credstatic.Subtype.String(), CredentialType: credential.JsonType.String()},
+	}
+	assert.Empty(t, cmp.Diff(want, got.GetItem().GetApplicationCredentialSources(), protocmp.Transform(), protocmp.SortRepeated(func(a, b *pb.CredentialSource) bool {
+		return a.GetId() < b.GetId()
+	})))
+
+	got2, err := s.RemoveTargetCredentialSources(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.RemoveTargetCredentialSourcesRequest{
+		Id:                             tar.GetPublicId(),
+		Version:                        got.GetItem().GetVersion(),
+		ApplicationCredentialSourceIds: []string{upCred.GetPublicId()},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{cl.GetPublicId(), jsonCred.GetPublicId()}, got2.GetItem().GetApplicationCredentialSourceIds())
+}
+
 func TestSetTargetCredentialSources(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrapper := db.TestWrapper(t)
@@ -2608,6 +2727,9 @@ func TestAuthorizeSession(t *testing.T) {
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -2656,7 +2778,7 @@ func TestAuthorizeSession(t *testing.T) {
 	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
 	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredRepoFn)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2730,7 +2852,7 @@ func TestAuthorizeSession(t *testing.T) {
 			require.NoError(t, err)
 
 			// Tell our DB that there is a worker ready to serve the data
-			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms, nil)
 			_, err = workerService.Status(ctx, &spbs.StatusRequest{
 				Worker: &spb.Server{
 					PrivateId: "testworker",
@@ -2849,12 +2971,15 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	org, proj := iam.TestScopes(t, iamRepo)
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredRepoFn)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -2879,7 +3004,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	store := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
 
 	workerExists := func(tar *tcp.Target) (version uint32) {
-		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms, nil)
 		_, err := workerService.Status(context.Background(), &spbs.StatusRequest{
 			Worker: &spb.Server{
 				PrivateId: "testworker",
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -39,6 +41,7 @@ var (
 		action.AddAccounts,
 		action.SetAccounts,
 		action.RemoveAccounts,
+		action.RevokeTokens,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -60,16 +63,20 @@ func init() {
 type Service struct {
 	pbs.UnimplementedUserServiceServer
 
-	repoFn common.IamRepoFactory
+	repoFn   common.IamRepoFactory
+	atRepoFn common.AuthTokenRepoFactory
 }
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory) (Service, error) {
 	const op = "users.NewService"
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo}, nil
+	if atRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing auth token repository")
+	}
+	return Service{repoFn: repo, atRepoFn: atRepoFn}, nil
 }
 
 var _ pbs.UserServiceServer = Service{}
@@ -103,50 +110,61 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 		return &pbs.ListUsersResponse{}, nil
 	}
 
-	ul, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(ul) == 0 {
-		return &pbs.ListUsersResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pager, err := handlers.NewPaginator(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.User, 0, len(ul))
+	var finalItems []*pb.User
 	res := perms.Resource{
 		Type: resource.User,
 	}
-	for _, item := range ul {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, outputOpts...)
+	for pager.Next() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager)
 		if err != nil {
 			return nil, err
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		for _, item := range ul {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPublicId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
 		}
 	}
-	return &pbs.ListUsersResponse{Items: finalItems}, nil
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListUsersResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetUsers implements the interface pbs.UserServiceServer.
@@ -392,6 +410,48 @@ func (s Service) RemoveUserAccounts(ctx context.Context, req *pbs.RemoveUserAcco
 	return &pbs.RemoveUserAccountsResponse{Item: item}, nil
 }
 
+// RevokeUserTokens implements the interface pbs.UserServiceServer.
+func (s Service) RevokeUserTokens(ctx context.Context, req *pbs.RevokeUserTokensRequest) (*pbs.RevokeUserTokensResponse, error) {
+	const op = "users.(Service).RevokeUserTokens"
+
+	if err := validateRevokeUserTokensRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RevokeTokens)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	u, accts, tokenIds, sessionIds, err := s.revokeTokensInRepo(ctx, req.GetId(), req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, u.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, u, accts, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RevokeUserTokensResponse{
+		Item:               item,
+		AuthTokenIds:       tokenIds,
+		CanceledSessionIds: sessionIds,
+	}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.User, []string, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -486,12 +546,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.User, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pager *handlers.Paginator) ([]*iam.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListUsers(ctx, scopeIds)
+	ul, err := repo.ListUsers(ctx, scopeIds, iam.WithLimit(pager.Limit()), iam.WithStartPageAfterItem(pager.StartAfter()))
 	if err != nil {
 		return nil, err
 	}
@@ -558,6 +618,38 @@ func (s Service) removeInRepo(ctx context.Context, userId string, accountIds []s
 	return out, accts, nil
 }
 
+func (s Service) revokeTokensInRepo(ctx context.Context, userId, accountId string) (*iam.User, []string, []string, []string, error) {
+	const op = "users.(Service).revokeTokensInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	u, accts, err := repo.LookupUser(ctx, userId)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up user"))
+	}
+	if u == nil {
+		return nil, nil, nil, nil, handlers.NotFoundErrorf("User %q doesn't exist.", userId)
+	}
+	var opts []authtoken.Option
+	if accountId != "" {
+		if !strutil.StrListContains(accts, accountId) {
+			return nil, nil, nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"account_id": "Account is not associated with the user."})
+		}
+		opts = append(opts, authtoken.WithAuthAccountId(accountId))
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	tokenIds, sessionIds, err := atRepo.RevokeAuthTokens(ctx, userId, opts...)
+	if err != nil {
+		return nil, nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to revoke tokens of user: %v.", err)
+	}
+	return u, accts, tokenIds, sessionIds, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -719,6 +811,7 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -744,6 +837,7 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -772,6 +866,7 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -782,3 +877,22 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 	}
 	return nil
 }
+
+func validateRevokeUserTokensRequest(req *pbs.RevokeUserTokensRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), iam.UserPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetAccountId() != "" && !handlers.ValidId(handlers.Id(req.GetAccountId()),
+		intglobals.OldPasswordAccountPrefix,
+		intglobals.NewPasswordAccountPrefix,
+		oidc.AccountPrefix,
+		ldap.AccountPrefix,
+	) {
+		badFields["account_id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -32,9 +33,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts", "revoke-tokens"}
 
-func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error)) {
+func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error), func() (*authtoken.Repository, error)) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -42,16 +43,20 @@ func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string
 	repoFn := func() (*iam.Repository, error) {
 		return repo, nil
 	}
+	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
 	o, _ := iam.TestScopes(t, repo)
 	u := iam.TestUser(t, repo, o.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"))
 
 	switch withAccts {
 	case false:
-		return u, nil, repoFn
+		return u, nil, repoFn, atRepoFn
 	default:
 		require := require.New(t)
 		ctx := context.Background()
-		kmsCache := kms.TestKms(t, conn, wrap)
 		databaseWrap, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
 		require.NoError(err)
 		primaryAm := oidc.TestAuthMethod(t, conn, databaseWrap, o.PublicId, oidc.ActivePublicState, "alice-rp", "fido",
@@ -74,12 +79,12 @@ func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string
 		// reload the user with their accounts
 		u, accts, err := repo.LookupUser(ctx, u.PublicId)
 		require.NoError(err)
-		return u, accts, repoFn
+		return u, accts, repoFn, atRepoFn
 	}
 }
 
 func TestGet(t *testing.T) {
-	u, uAccts, repoFn := createDefaultUserAndRepo(t, true)
+	u, uAccts, repoFn, atRepoFn := createDefaultUserAndRepo(t, true)
 
 	toMerge := &pbs.GetUserRequest{
 		Id: u.GetPublicId(),
@@ -139,7 +144,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetUserRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := users.NewService(repoFn)
+			s, err := users.NewService(repoFn, atRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.GetUser(auth.DisabledAuthTestContext(repoFn, u.GetScopeId()), req)
@@ -166,6 +171,10 @@ func TestList(t *testing.T) {
 	oWithUsers, _ := iam.TestScopes(t, repo)
 
 	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
 	databaseWrap, err := kmsCache.GetWrapper(context.Background(), oWithUsers.PublicId, kms.KeyPurposeDatabase)
 	require.NoError(t, err)
 	primaryAm := oidc.TestAuthMethod(t, conn, databaseWrap, oWithUsers.PublicId, oidc.ActivePublicState, "alice-rp", "fido",
@@ -178,7 +187,7 @@ func TestList(t *testing.T) {
 	secondaryAm := password.TestAuthMethods(t, conn, oWithUsers.PublicId, 1)
 	require.Len(t, secondaryAm, 1)
 
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err)
 
 	var wantUsers []*pb.User
@@ -325,9 +334,9 @@ func (s sortableUsers) Less(i, j int) bool { return s.users[i].GetId() < s.users
 func (s sortableUsers) Swap(i, j int)      { s.users[i], s.users[j] = s.users[j], s.users[i] }
 
 func TestDelete(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -372,9 +381,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteUserRequest{
 		Id: u.GetPublicId(),
@@ -388,7 +397,7 @@ func TestDelete_twice(t *testing.T) {
 }
 
 func TestCreate(t *testing.T) {
-	defaultUser, _, repoFn := createDefaultUserAndRepo(t, false)
+	defaultUser, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 	defaultCreated := defaultUser.GetCreateTime().GetTimestamp().AsTime()
 
 	cases := []struct {
@@ -466,7 +475,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := users.NewService(repoFn)
+			s, err := users.NewService(repoFn, atRepoFn)
 			require.NoError(err, "Error when getting new user service.")
 
 			got, gErr := s.CreateUser(auth.DisabledAuthTestContext(repoFn, tc.req.GetItem().GetScopeId()), tc.req)
@@ -494,8 +503,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
-	tested, err := users.NewService(repoFn)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
+	tested, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	created := u.GetCreateTime().GetTimestamp().AsTime()
@@ -767,11 +776,15 @@ func TestAddAccount(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
 	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -912,11 +925,15 @@ func TestSetAccount(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
 	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1059,11 +1076,15 @@ func TestRemoveAccount(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
 	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(repoFn)
+	s, err := users.NewService(repoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1216,3 +1237,92 @@ func TestRemoveAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestRevokeTokens(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		rw := db.New(conn)
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := users.NewService(repoFn, atRepoFn)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	amId := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0].GetPublicId()
+	accts := password.TestMultipleAccounts(t, conn, amId, 3)
+	usr := iam.TestUser(t, iamRepo, o.GetPublicId(), iam.WithAccountIds(accts[0].GetPublicId(), accts[1].GetPublicId()))
+	otherUsr := iam.TestUser(t, iamRepo, o.GetPublicId(), iam.WithAccountIds(accts[2].GetPublicId()))
+
+	atRepo, err := atRepoFn()
+	require.NoError(t, err)
+	createToken := func(u *iam.User, acctId string) string {
+		at, err := atRepo.CreateAuthToken(context.Background(), u, acctId)
+		require.NoError(t, err)
+		return at.GetPublicId()
+	}
+	acct0Token := createToken(usr, accts[0].GetPublicId())
+	acct1Token := createToken(usr, accts[1].GetPublicId())
+	otherToken := createToken(otherUsr, accts[2].GetPublicId())
+
+	cases := []struct {
+		name       string
+		req        *pbs.RevokeUserTokensRequest
+		wantTokens []string
+		err        error
+	}{
+		{
+			name: "Bad user id",
+			req:  &pbs.RevokeUserTokensRequest{Id: "bad_id"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown user",
+			req:  &pbs.RevokeUserTokensRequest{Id: iam.UserPrefix + "_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Account of other user",
+			req:  &pbs.RevokeUserTokensRequest{Id: usr.GetPublicId(), AccountId: accts[2].GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:       "Account",
+			req:        &pbs.RevokeUserTokensRequest{Id: usr.GetPublicId(), AccountId: accts[0].GetPublicId()},
+			wantTokens: []string{acct0Token},
+		},
+		{
+			name:       "User",
+			req:        &pbs.RevokeUserTokensRequest{Id: usr.GetPublicId()},
+			wantTokens: []string{acct1Token},
+		},
+		{
+			name: "User without tokens",
+			req:  &pbs.RevokeUserTokensRequest{Id: usr.GetPublicId()},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := s.RevokeUserTokens(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "RevokeUserTokens(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(usr.GetPublicId(), got.GetItem().GetId())
+			assert.ElementsMatch(tc.wantTokens, got.GetAuthTokenIds())
+			assert.Empty(got.GetCanceledSessionIds())
+		})
+	}
+
+	at, err := atRepo.LookupAuthToken(context.Background(), otherToken)
+	require.NoError(t, err)
+	assert.NotNil(t, at)
+}
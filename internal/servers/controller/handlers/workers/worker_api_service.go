@@ -0,0 +1,624 @@
+package workers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.AddWorkerTags,
+		action.SetWorkerTags,
+		action.RemoveWorkerTags,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.List,
+	}
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&servers.Server{}}, handlers.MaskSource{&pb.Worker{}}); err != nil {
+		panic(err)
+	}
+}
+
+// Service handles request as described by the pbs.WorkerServiceServer interface.
+type Service struct {
+	pbs.UnimplementedWorkerServiceServer
+
+	repoFn    common.ServersRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+// NewService returns a worker service which handles worker related requests
+// to boundary.
+func NewService(repoFn common.ServersRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+	const op = "workers.NewService"
+	if repoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing servers repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+}
+
+var _ pbs.WorkerServiceServer = Service{}
+
+// GetWorker implements the interface pbs.WorkerServiceServer.
+func (s Service) GetWorker(ctx context.Context, req *pbs.GetWorkerRequest) (*pbs.GetWorkerResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	w, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      w.GetPrivateId(),
+		ScopeId: scope.Global.String(),
+		Type:    resource.Worker,
+	}, action.Read).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, w.GetPrivateId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, w, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetWorkerResponse{Item: item}, nil
+}
+
+// ListWorkers implements the interface pbs.WorkerServiceServer.
+func (s Service) ListWorkers(ctx context.Context, req *pbs.ListWorkersRequest) (*pbs.ListWorkersResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPaginator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.Worker
+	res := perms.Resource{
+		ScopeId: scope.Global.String(),
+		Type:    resource.Worker,
+	}
+	for pager.Next() {
+		wList, err := s.listFromRepo(ctx, pager)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range wList {
+			pageKey := handlers.NewPageKey(item.GetCreateTime(), item.GetPrivateId())
+			pager.Seen(pageKey)
+			res.Id = item.GetPrivateId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPrivateId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				if !pager.Add(pageKey) {
+					break
+				}
+				finalItems = append(finalItems, item)
+			}
+		}
+	}
+	listToken, err := pager.ListToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListWorkersResponse{Items: finalItems, ListToken: listToken}, nil
+}
+
+// UpdateWorker implements the interface pbs.WorkerServiceServer.
+func (s Service) UpdateWorker(ctx context.Context, req *pbs.UpdateWorkerRequest) (*pbs.UpdateWorkerResponse, error) {
+	const op = "workers.(Service).UpdateWorker"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	w, err := s.updateInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.mutationResult(ctx, op, authResults, w)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.UpdateWorkerResponse{Item: item}, nil
+}
+
+// DeleteWorker implements the interface pbs.WorkerServiceServer.
+func (s Service) DeleteWorker(ctx context.Context, req *pbs.DeleteWorkerRequest) (*pbs.DeleteWorkerResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// AddWorkerTags implements the interface pbs.WorkerServiceServer.
+func (s Service) AddWorkerTags(ctx context.Context, req *pbs.AddWorkerTagsRequest) (*pbs.AddWorkerTagsResponse, error) {
+	const op = "workers.(Service).AddWorkerTags"
+
+	tags, err := validateTagsRequest(req, true)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.AddWorkerTags)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.AddWorkerTags(ctx, req.GetId(), req.GetVersion(), tags)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to add tags to worker"))
+	}
+	item, err := s.mutationResult(ctx, op, authResults, w)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.AddWorkerTagsResponse{Item: item}, nil
+}
+
+// SetWorkerTags implements the interface pbs.WorkerServiceServer.
+func (s Service) SetWorkerTags(ctx context.Context, req *pbs.SetWorkerTagsRequest) (*pbs.SetWorkerTagsResponse, error) {
+	const op = "workers.(Service).SetWorkerTags"
+
+	tags, err := validateTagsRequest(req, false)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetWorkerTags)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.SetWorkerTags(ctx, req.GetId(), req.GetVersion(), tags)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set tags on worker"))
+	}
+	item, err := s.mutationResult(ctx, op, authResults, w)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.SetWorkerTagsResponse{Item: item}, nil
+}
+
+// RemoveWorkerTags implements the interface pbs.WorkerServiceServer.
+func (s Service) RemoveWorkerTags(ctx context.Context, req *pbs.RemoveWorkerTagsRequest) (*pbs.RemoveWorkerTagsResponse, error) {
+	const op = "workers.(Service).RemoveWorkerTags"
+
+	tags, err := validateTagsRequest(req, true)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RemoveWorkerTags)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.DeleteWorkerTags(ctx, req.GetId(), req.GetVersion(), tags)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove tags from worker"))
+	}
+	item, err := s.mutationResult(ctx, op, authResults, w)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RemoveWorkerTagsResponse{Item: item}, nil
+}
+
+// mutationResult builds the proto returned by the requests changing a worker.
+func (s Service) mutationResult(ctx context.Context, op errors.Op, authResults auth.VerifyResults, w *servers.Server) (*pb.Worker, error) {
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, w.GetPrivateId(), IdActions).Strings()))
+	}
+	return toProto(ctx, w, outputOpts...)
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*servers.Server, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.LookupWorker(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return nil, handlers.NotFoundErrorf("Worker %q doesn't exist.", id)
+	}
+	return w, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, pager *handlers.Paginator) ([]*servers.Server, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	wList, err := repo.ListWorkers(ctx, servers.WithLimit(pager.Limit()), servers.WithStartPageAfterItem(pager.StartAfter()))
+	if err != nil {
+		return nil, err
+	}
+	return wList, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, req *pbs.UpdateWorkerRequest) (*servers.Server, error) {
+	const op = "workers.(Service).updateInRepo"
+	dbMask := maskManager.Translate(req.GetUpdateMask().GetPaths())
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, rowsUpdated, err := repo.UpdateWorker(ctx, req.GetId(), req.GetItem().GetVersion(), req.GetItem().GetDescription().GetValue())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update worker"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Worker %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	return w, nil
+}
+
+// deleteFromRepo deletes the worker with the given id. Workers which are
+// still sending status updates are not deleted since they would add
+// themselves back with their next update.
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "workers.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	w, err := repo.LookupWorker(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if w == nil {
+		return false, handlers.NotFoundErrorf("Worker %q doesn't exist.", id)
+	}
+	if time.Since(w.GetLastStatusTime().AsTime()) < servers.DefaultLiveness {
+		return false, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Worker %q is still sending status updates and cannot be deleted.", id)
+	}
+	rows, err := repo.DeleteWorker(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete worker"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	opts := []auth.Option{auth.WithType(resource.Worker), auth.WithAction(a), auth.WithScopeId(scope.Global.String())}
+	switch a {
+	case action.List:
+	case action.Read, action.Update, action.Delete, action.AddWorkerTags, action.SetWorkerTags, action.RemoveWorkerTags:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		w, err := repo.LookupWorker(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if w == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithId(id))
+	default:
+		res.Error = stderrors.New("unsupported action")
+		return res
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *servers.Server, opt ...handlers.Option) (*pb.Worker, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building worker proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.Worker{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPrivateId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = scope.Global.String()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.NameField) {
+		out.Name = in.GetPrivateId()
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.AddressField) {
+		out.Address = in.GetAddress()
+	}
+	if outputFields.Has(globals.ConfigTagsField) {
+		out.ConfigTags = tagsToProto(in.GetTags())
+	}
+	if outputFields.Has(globals.ApiTagsField) {
+		out.ApiTags = tagsToProto(in.GetApiTags())
+	}
+	if outputFields.Has(globals.CanonicalTagsField) {
+		out.CanonicalTags = tagsToProto(in.GetTags(), in.GetApiTags())
+	}
+	if outputFields.Has(globals.LastStatusTimeField) {
+		out.LastStatusTime = in.GetLastStatusTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// tagsToProto merges the provided tag maps into the map used in the worker
+// proto, removing duplicate values.
+func tagsToProto(tagMaps ...map[string]*servers.TagValues) map[string]*structpb.ListValue {
+	merged := make(map[string][]string)
+	for _, tags := range tagMaps {
+		for k, v := range tags {
+			merged[k] = append(merged[k], v.GetValues()...)
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	ret := make(map[string]*structpb.ListValue, len(merged))
+	for k, vals := range merged {
+		sort.Strings(vals)
+		lv := &structpb.ListValue{}
+		for i, v := range vals {
+			if i > 0 && vals[i-1] == v {
+				continue
+			}
+			lv.Values = append(lv.Values, structpb.NewStringValue(v))
+		}
+		ret[k] = lv
+	}
+	return ret
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetWorkerRequest) error {
+	return validateId(req.GetId())
+}
+
+func validateDeleteRequest(req *pbs.DeleteWorkerRequest) error {
+	return validateId(req.GetId())
+}
+
+func validateListRequest(req *pbs.ListWorkersRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Workers only exist in the global scope."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields[globals.FilterField] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateUpdateRequest(req *pbs.UpdateWorkerRequest) error {
+	badFields := map[string]string{}
+	if strings.TrimSpace(req.GetId()) == "" {
+		badFields[globals.IdField] = "Improperly formatted path identifier."
+	}
+	if req.GetUpdateMask() == nil {
+		badFields["update_mask"] = "UpdateMask not provided but is required to update this resource."
+	}
+	item := req.GetItem()
+	if item.GetDescription() != nil {
+		trimmed := strings.TrimSpace(item.GetDescription().GetValue())
+		switch {
+		case trimmed == "":
+			badFields[globals.DescriptionField] = "Cannot set empty string as description"
+		case !handlers.ValidNameDescription(trimmed):
+			badFields[globals.DescriptionField] = "Description contains unprintable characters"
+		default:
+			item.GetDescription().Value = trimmed
+		}
+	}
+	if item.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Existing resource version is required for an update."
+	}
+	readOnly := map[string]bool{
+		globals.IdField:             item.GetId() != "",
+		globals.ScopeIdField:        item.GetScopeId() != "",
+		globals.NameField:           item.GetName() != "",
+		globals.AddressField:        item.GetAddress() != "",
+		globals.ConfigTagsField:     item.GetConfigTags() != nil,
+		globals.ApiTagsField:        item.GetApiTags() != nil,
+		globals.CanonicalTagsField:  item.GetCanonicalTags() != nil,
+		globals.LastStatusTimeField: item.GetLastStatusTime() != nil,
+		globals.CreatedTimeField:    item.GetCreatedTime() != nil,
+		globals.UpdatedTimeField:    item.GetUpdatedTime() != nil,
+	}
+	for f, set := range readOnly {
+		if set {
+			badFields[f] = "This is a read only field and cannot be specified in an update request."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+type tagsRequest interface {
+	GetId() string
+	GetVersion() uint32
+	GetApiTags() map[string]*structpb.ListValue
+}
+
+// validateTagsRequest validates the requests changing the API tags of a
+// worker and returns the provided tags.
+func validateTagsRequest(req tagsRequest, requireTags bool) (map[string][]string, error) {
+	badFields := map[string]string{}
+	if strings.TrimSpace(req.GetId()) == "" {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if requireTags && len(req.GetApiTags()) == 0 {
+		badFields[globals.ApiTagsField] = "Must be non-empty."
+	}
+	tags := make(map[string][]string, len(req.GetApiTags()))
+	for k, lv := range req.GetApiTags() {
+		if strings.TrimSpace(k) == "" {
+			badFields[globals.ApiTagsField] = "Tag keys cannot be empty."
+			continue
+		}
+		if len(lv.GetValues()) == 0 {
+			badFields[globals.ApiTagsField] = fmt.Sprintf("Tag %q has no values.", k)
+			continue
+		}
+		for _, v := range lv.GetValues() {
+			s, ok := v.GetKind().(*structpb.Value_StringValue)
+			if !ok || strings.TrimSpace(s.StringValue) == "" {
+				badFields[globals.ApiTagsField] = fmt.Sprintf("Values of tag %q must be non-empty strings.", k)
+				continue
+			}
+			tags[k] = append(tags[k], s.StringValue)
+		}
+	}
+	if len(badFields) > 0 {
+		return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return tags, nil
+}
+
+func validateId(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return handlers.InvalidArgumentErrorf("Invalid formatted identifier.", map[string]string{globals.IdField: "Invalid formatted identifier."})
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+package workers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags"}
+
+func testSetup(t *testing.T) (*db.Db, func() (*iam.Repository, error), func() (*servers.Repository, error)) {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	rw := db.New(conn)
+	serversRepo, err := servers.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return serversRepo, nil
+	}
+	return rw, iamRepoFn, serversRepoFn
+}
+
+func testWorker(t *testing.T, repoFn func() (*servers.Repository, error), name string, tags map[string]*servers.TagValues) *servers.Server {
+	t.Helper()
+	repo, err := repoFn()
+	require.NoError(t, err)
+	_, _, err = repo.UpsertServer(context.Background(), &servers.Server{
+		PrivateId: name,
+		Type:      servers.ServerTypeWorker.String(),
+		Address:   "127.0.0.1",
+		Tags:      tags,
+	}, servers.WithUpdateTags(true))
+	require.NoError(t, err)
+	w, err := repo.LookupWorker(context.Background(), name)
+	require.NoError(t, err)
+	return w
+}
+
+func stringList(vals ...string) *structpb.ListValue {
+	lv := &structpb.ListValue{}
+	for _, v := range vals {
+		lv.Values = append(lv.Values, structpb.NewStringValue(v))
+	}
+	return lv
+}
+
+func TestGetWorker(t *testing.T) {
+	_, iamRepoFn, serversRepoFn := testSetup(t)
+	w := testWorker(t, serversRepoFn, "test-worker", map[string]*servers.TagValues{"region": {Values: []string{"east"}}})
+
+	wireWorker := &pb.Worker{
+		Id:                w.GetPrivateId(),
+		ScopeId:           scope.Global.String(),
+		Scope:             &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+		Name:              w.GetPrivateId(),
+		Address:           "127.0.0.1",
+		ConfigTags:        map[string]*structpb.ListValue{"region": stringList("east")},
+		CanonicalTags:     map[string]*structpb.ListValue{"region": stringList("east")},
+		LastStatusTime:    w.GetLastStatusTime().GetTimestamp(),
+		CreatedTime:       w.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       w.GetUpdateTime().GetTimestamp(),
+		Version:           w.GetVersion(),
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetWorkerRequest
+		res  *pbs.GetWorkerResponse
+		err  error
+	}{
+		{
+			name: "Get a worker",
+			req:  &pbs.GetWorkerRequest{Id: w.GetPrivateId()},
+			res:  &pbs.GetWorkerResponse{Item: wireWorker},
+		},
+		{
+			name: "Get a non existant worker",
+			req:  &pbs.GetWorkerRequest{Id: "doesnt-exist"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Empty id",
+			req:  &pbs.GetWorkerRequest{Id: " "},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := workers.NewService(serversRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new worker service.")
+
+			got, gErr := s.GetWorker(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetWorker(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetWorker(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestListWorkers(t *testing.T) {
+	_, iamRepoFn, serversRepoFn := testSetup(t)
+	testWorker(t, serversRepoFn, "test-east", map[string]*servers.TagValues{"region": {Values: []string{"east"}}})
+	testWorker(t, serversRepoFn, "test-west", map[string]*servers.TagValues{"region": {Values: []string{"west"}}})
+
+	cases := []struct {
+		name    string
+		req     *pbs.ListWorkersRequest
+		wantIds []string
+		err     error
+	}{
+		{
+			name:    "List workers",
+			req:     &pbs.ListWorkersRequest{ScopeId: scope.Global.String()},
+			wantIds: []string{"test-east", "test-west"},
+		},
+		{
+			name:    "Filter workers by tag",
+			req:     &pbs.ListWorkersRequest{ScopeId: scope.Global.String(), Filter: `"west" in "/item/canonical_tags/region"`},
+			wantIds: []string{"test-west"},
+		},
+		{
+			name: "Non global scope",
+			req:  &pbs.ListWorkersRequest{ScopeId: "o_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad filter",
+			req:  &pbs.ListWorkersRequest{ScopeId: scope.Global.String(), Filter: `"//id/"=="bad"`},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := workers.NewService(serversRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new worker service.")
+
+			got, gErr := s.ListWorkers(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListWorkers(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			var ids []string
+			for _, item := range got.GetItems() {
+				ids = append(ids, item.GetId())
+			}
+			assert.ElementsMatch(tc.wantIds, ids)
+		})
+	}
+}
+
+func TestUpdateWorker(t *testing.T) {
+	_, iamRepoFn, serversRepoFn := testSetup(t)
+	w := testWorker(t, serversRepoFn, "test-worker", nil)
+
+	s, err := workers.NewService(serversRepoFn, iamRepoFn)
+	require.NoError(t, err, "Couldn't create new worker service.")
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	cases := []struct {
+		name         string
+		req          *pbs.UpdateWorkerRequest
+		wantDesc     string
+		wantMismatch bool
+		err          error
+	}{
+		{
+			name: "Update description",
+			req: &pbs.UpdateWorkerRequest{
+				Id:         w.GetPrivateId(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+				Item:       &pb.Worker{Description: wrapperspb.String("desc"), Version: w.GetVersion()},
+			},
+			wantDesc: "desc",
+		},
+		{
+			name: "Stale version",
+			req: &pbs.UpdateWorkerRequest{
+				Id:         w.GetPrivateId(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+				Item:       &pb.Worker{Description: wrapperspb.String("other"), Version: w.GetVersion()},
+			},
+			wantMismatch: true,
+		},
+		{
+			name: "Read only field",
+			req: &pbs.UpdateWorkerRequest{
+				Id:         w.GetPrivateId(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"address"}},
+				Item:       &pb.Worker{Address: "10.0.0.1", Version: w.GetVersion() + 1},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing mask",
+			req: &pbs.UpdateWorkerRequest{
+				Id:   w.GetPrivateId(),
+				Item: &pb.Worker{Description: wrapperspb.String("other"), Version: w.GetVersion() + 1},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Non existant worker",
+			req: &pbs.UpdateWorkerRequest{
+				Id:         "doesnt-exist",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+				Item:       &pb.Worker{Description: wrapperspb.String("other"), Version: 1},
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.UpdateWorker(ctx, tc.req)
+			if tc.wantMismatch {
+				assert.Truef(errors.Match(errors.T(errors.VersionMismatch), gErr), "UpdateWorker(%+v) got error %v, wanted a version mismatch", tc.req, gErr)
+				return
+			}
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "UpdateWorker(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.wantDesc, got.GetItem().GetDescription().GetValue())
+			assert.Equal(tc.req.GetItem().GetVersion()+1, got.GetItem().GetVersion())
+		})
+	}
+}
+
+func TestWorkerTags(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	_, iamRepoFn, serversRepoFn := testSetup(t)
+	w := testWorker(t, serversRepoFn, "test-worker", map[string]*servers.TagValues{"region": {Values: []string{"east"}}})
+
+	s, err := workers.NewService(serversRepoFn, iamRepoFn)
+	require.NoError(err, "Couldn't create new worker service.")
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	added, err := s.AddWorkerTags(ctx, &pbs.AddWorkerTagsRequest{
+		Id:      w.GetPrivateId(),
+		Version: w.GetVersion(),
+		ApiTags: map[string]*structpb.ListValue{"region": stringList("east", "west"), "type": stringList("dev")},
+	})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(map[string]*structpb.ListValue{
+		"region": stringList("east", "west"),
+		"type":   stringList("dev"),
+	}, added.GetItem().GetApiTags(), protocmp.Transform()))
+	assert.Empty(cmp.Diff(map[string]*structpb.ListValue{
+		"region": stringList("east", "west"),
+		"type":   stringList("dev"),
+	}, added.GetItem().GetCanonicalTags(), protocmp.Transform()))
+	assert.Empty(cmp.Diff(map[string]*structpb.ListValue{
+		"region": stringList("east"),
+	}, added.GetItem().GetConfigTags(), protocmp.Transform()))
+
+	removed, err := s.RemoveWorkerTags(ctx, &pbs.RemoveWorkerTagsRequest{
+		Id:      w.GetPrivateId(),
+		Version: added.GetItem().GetVersion(),
+		ApiTags: map[string]*structpb.ListValue{"region": stringList("east")},
+	})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(map[string]*structpb.ListValue{
+		"region": stringList("west"),
+		"type":   stringList("dev"),
+	}, removed.GetItem().GetApiTags(), protocmp.Transform()))
+
+	set, err := s.SetWorkerTags(ctx, &pbs.SetWorkerTagsRequest{
+		Id:      w.GetPrivateId(),
+		Version: removed.GetItem().GetVersion(),
+	})
+	require.NoError(err)
+	assert.Empty(set.GetItem().GetApiTags())
+
+	_, err = s.AddWorkerTags(ctx, &pbs.AddWorkerTagsRequest{
+		Id:      w.GetPrivateId(),
+		Version: set.GetItem().GetVersion(),
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+
+	_, err = s.AddWorkerTags(ctx, &pbs.AddWorkerTagsRequest{
+		Id:      w.GetPrivateId(),
+		Version: set.GetItem().GetVersion(),
+		ApiTags: map[string]*structpb.ListValue{"count": {Values: []*structpb.Value{structpb.NewNumberValue(1)}}},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+}
+
+func TestDeleteWorker(t *testing.T) {
+	rw, iamRepoFn, serversRepoFn := testSetup(t)
+	testWorker(t, serversRepoFn, "test-live", nil)
+	testWorker(t, serversRepoFn, "test-stale", nil)
+	_, err := rw.Exec(context.Background(), "update server set last_status_time = now() - interval '1 hour' where private_id = ?", []interface{}{"test-stale"})
+	require.NoError(t, err)
+
+	s, err := workers.NewService(serversRepoFn, iamRepoFn)
+	require.NoError(t, err, "Couldn't create new worker service.")
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	cases := []struct {
+		name string
+		req  *pbs.DeleteWorkerRequest
+		err  error
+	}{
+		{
+			name: "Delete a stale worker",
+			req:  &pbs.DeleteWorkerRequest{Id: "test-stale"},
+		},
+		{
+			name: "Delete a live worker",
+			req:  &pbs.DeleteWorkerRequest{Id: "test-live"},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name: "Delete a non existant worker",
+			req:  &pbs.DeleteWorkerRequest{Id: "test-stale"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.DeleteWorker(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DeleteWorker(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Nil(got)
+		})
+	}
+}
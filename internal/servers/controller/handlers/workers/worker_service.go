@@ -7,9 +7,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type workerServiceServer struct {
@@ -29,18 +32,24 @@ type workerServiceServer struct {
 	sessionRepoFn common.SessionRepoFactory
 	updateTimes   *sync.Map
 	kms           *kms.Kms
+
+	// recordingStorage is the controller's recording storage. Workers may
+	// only report session recordings held in it.
+	recordingStorage recording.Storage
 }
 
 func NewWorkerServiceServer(
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
 	updateTimes *sync.Map,
-	kms *kms.Kms) *workerServiceServer {
+	kms *kms.Kms,
+	recordingStorage recording.Storage) *workerServiceServer {
 	return &workerServiceServer{
-		serversRepoFn: serversRepoFn,
-		sessionRepoFn: sessionRepoFn,
-		updateTimes:   updateTimes,
-		kms:           kms,
+		serversRepoFn:    serversRepoFn,
+		sessionRepoFn:    sessionRepoFn,
+		updateTimes:      updateTimes,
+		kms:              kms,
+		recordingStorage: recordingStorage,
 	}
 }
 
@@ -159,6 +168,27 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 					// Log the session ID so we don't add a duplicate change
 					// request on connection normalization.
 					requestedSessionCancelIds = append(requestedSessionCancelIds, sessionId)
+					continue
+				}
+			}
+			// If the session has been extended since the worker learned of
+			// its expiration, send the new expiration so the worker doesn't
+			// close its connections at the old one. The status is left
+			// unspecified so the worker doesn't change it.
+			if si.GetExpiration() != nil {
+				if exp := sessionInfo.ExpirationTime.GetTimestamp(); exp.AsTime().After(si.GetExpiration().AsTime()) {
+					ret.JobsRequests = append(ret.JobsRequests, &pbs.JobChangeRequest{
+						Job: &pbs.Job{
+							Type: pbs.JOBTYPE_JOBTYPE_SESSION,
+							JobInfo: &pbs.Job_SessionInfo{
+								SessionInfo: &pbs.SessionJobInfo{
+									SessionId:  sessionId,
+									Expiration: exp,
+								},
+							},
+						},
+						RequestType: pbs.CHANGETYPE_CHANGETYPE_UPDATE_STATE,
+					})
 				}
 			}
 		}
@@ -310,6 +340,18 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, status.Errorf(codes.Internal, "Error deriving session key: %v", err)
 	}
 
+	creds, err := sessRepo.ListSessionCredentials(ctx, sessionInfo.ScopeId, sessionInfo.GetPublicId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error retrieving session credentials: %v", err)
+	}
+	for _, c := range creds {
+		m := &pbs.Credential{}
+		if err := proto.Unmarshal(c, m); err != nil {
+			return nil, status.Errorf(codes.Internal, "Error unmarshaling session credential: %v", err)
+		}
+		resp.Credentials = append(resp.Credentials, m)
+	}
+
 	return resp, nil
 }
 
@@ -474,3 +516,36 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 
 	return ret, nil
 }
+
+func (ws *workerServiceServer) CreateSessionRecording(ctx context.Context, req *pbs.CreateSessionRecordingRequest) (*pbs.CreateSessionRecordingResponse, error) {
+	const op = "workers.(workerServiceServer).CreateSessionRecording"
+	if ws.recordingStorage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "controller has no recording storage configured")
+	}
+	if !ws.recordingStorage.Contains(req.GetStorageUri()) {
+		return nil, status.Errorf(codes.InvalidArgument, "storage uri %q is not in the controller's recording storage", req.GetStorageUri())
+	}
+	rec, err := session.NewRecording(
+		req.GetSessionId(),
+		req.GetConnectionId(),
+		req.GetWorkerId(),
+		req.GetStorageUri(),
+		req.GetBytes(),
+		&timestamp.Timestamp{Timestamp: req.GetStartTime()},
+		&timestamp.Timestamp{Timestamp: req.GetEndTime()},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session recording: %v", err)
+	}
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+	rec, err = sessRepo.CreateRecording(ctx, rec)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating session recording: %v", err)
+	}
+	return &pbs.CreateSessionRecordingResponse{
+		RecordingId: rec.GetPublicId(),
+	}, nil
+}
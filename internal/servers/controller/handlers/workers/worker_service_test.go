@@ -0,0 +1,68 @@
+package workers_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateSessionRecording_StorageUri(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := recording.NewStorage(ctx, filepath.Join(dir, "recordings"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		storage recording.Storage
+		uri     string
+		code    codes.Code
+	}{
+		{
+			name: "no recording storage",
+			uri:  "file://" + filepath.ToSlash(filepath.Join(dir, "recordings", "s_1234567890.cast")),
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "outside of the recording storage",
+			storage: storage,
+			uri:     "file://" + filepath.ToSlash(filepath.Join(dir, "s_1234567890.cast")),
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "relative path out of the recording storage",
+			storage: storage,
+			uri:     "file://" + filepath.ToSlash(dir) + "/recordings/../../etc/passwd",
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "other scheme",
+			storage: storage,
+			uri:     "s3://bucket" + filepath.ToSlash(filepath.Join(dir, "recordings", "s_1234567890.cast")),
+			code:    codes.InvalidArgument,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The storage uri is checked before any repository is used.
+			ws := workers.NewWorkerServiceServer(nil, nil, &sync.Map{}, nil, tc.storage)
+			_, err := ws.CreateSessionRecording(ctx, &pbs.CreateSessionRecordingRequest{
+				SessionId:    "s_1234567890",
+				ConnectionId: "sc_1234567890",
+				WorkerId:     "w_1234567890",
+				StorageUri:   tc.uri,
+			})
+			require.Error(t, err)
+			assert.Equal(t, tc.code, status.Code(err), err.Error())
+		})
+	}
+}
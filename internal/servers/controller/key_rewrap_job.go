@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// keyRewrapJob defines a periodic job that re-encrypts data encrypted with
+// previous versions of the database keys with the current versions, once a
+// scope's keys have been rotated. When it's done, the previous key versions
+// no longer encrypt anything and can be destroyed.
+type keyRewrapJob struct {
+	kms *kms.Kms
+
+	// Whether the last run completed.
+	completed bool
+}
+
+// newKeyRewrapJob instantiates the key rewrap job.
+func newKeyRewrapJob(kmsCache *kms.Kms) (*keyRewrapJob, error) {
+	const op = "controller.newKeyRewrapJob"
+	if kmsCache == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+	return &keyRewrapJob{
+		kms: kmsCache,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *keyRewrapJob) Name() string { return "key_rewrap" }
+
+// Description returns the description for the job.
+func (j *keyRewrapJob) Description() string {
+	return "Re-encrypt data encrypted with previous versions of rotated keys"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+func (j *keyRewrapJob) NextRunIn() (time.Duration, error) { return 5 * time.Minute, nil }
+
+// Status returns the status of the running job.
+func (j *keyRewrapJob) Status() scheduler.JobStatus {
+	var done int
+	if j.completed {
+		done = 1
+	}
+	return scheduler.JobStatus{
+		Completed: done,
+		Total:     1,
+	}
+}
+
+// Run executes the job.
+func (j *keyRewrapJob) Run(ctx context.Context) error {
+	const op = "controller.(keyRewrapJob).Run"
+	j.completed = false
+	if err := j.kms.RewrapData(ctx); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.completed = true
+	return nil
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/go-multierror"
 	"google.golang.org/grpc"
@@ -133,13 +135,15 @@ func (c *Controller) startListeners(ctx context.Context) error {
 			grpc.MaxSendMsgSize(math.MaxInt32),
 			grpc.UnaryInterceptor(
 				grpc_middleware.ChainUnaryServer(
+					trace.NewGrpcServerInterceptor(),
+					metric.NewGrpcServerInterceptor(clusterGrpcRequestLatency),
 					workerReqInterceptor,
 					auditRequestInterceptor(ctx),  // before we get started, audit the request
 					auditResponseInterceptor(ctx), // as we finish, audit the response
 				),
 			),
 		)
-		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.workerStatusUpdateTimes, c.kms)
+		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.workerStatusUpdateTimes, c.kms, c.recordingStorage)
 		pbs.RegisterServerCoordinationServiceServer(workerServer, workerService)
 		pbs.RegisterSessionServiceServer(workerServer, workerService)
 
@@ -168,6 +172,8 @@ func (c *Controller) startListeners(ctx context.Context) error {
 				err = configureForCluster(ln)
 			case "proxy":
 				// Do nothing, in a dev mode we might see it here
+			case "ops":
+				// Served by the ops server of the process
 			default:
 				err = fmt.Errorf("unknown listener purpose %q", purpose)
 			}
@@ -187,6 +193,9 @@ func (c *Controller) startListeners(ctx context.Context) error {
 func (c *Controller) stopListeners(serversOnly bool) error {
 	serverWg := new(sync.WaitGroup)
 	for _, ln := range c.conf.Listeners {
+		if isOpsListener(ln) {
+			continue
+		}
 		localLn := ln
 		serverWg.Add(1)
 		go func() {
@@ -229,6 +238,9 @@ func (c *Controller) stopListeners(serversOnly bool) error {
 	}
 	var retErr *multierror.Error
 	for _, ln := range c.conf.Listeners {
+		if isOpsListener(ln) {
+			continue
+		}
 		if err := ln.Mux.Close(); err != nil {
 			if _, ok := err.(*os.PathError); ok && ln.Config.Type == "unix" {
 				// The rmListener probably tried to remove the file but it
@@ -242,3 +254,10 @@ func (c *Controller) stopListeners(serversOnly bool) error {
 	}
 	return retErr.ErrorOrNil()
 }
+
+// isOpsListener returns whether ln is an ops listener. Ops listeners are
+// started and stopped by the ops server of the process rather than by the
+// controller.
+func isOpsListener(ln *base.ServerListener) bool {
+	return len(ln.Config.Purpose) == 1 && ln.Config.Purpose[0] == "ops"
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	apiSubsystem     = "controller_api"
+	clusterSubsystem = "controller_cluster"
+
+	// unknownPathLabel is used for the requests whose path doesn't belong to
+	// the API, to keep the cardinality of the path label bounded.
+	unknownPathLabel = "unknown"
+)
+
+var (
+	// apiHttpRequestLatency observes the latency of the requests received on
+	// the api listeners.
+	apiHttpRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metric.Namespace,
+			Subsystem: apiSubsystem,
+			Name:      "http_request_duration_seconds",
+			Help:      "Histogram of latencies for HTTP requests received on the api listeners.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		metric.HttpLabels,
+	)
+
+	// apiGrpcRequestLatency observes the latency of the requests handled by
+	// the gRPC services behind the API's gRPC gateway.
+	apiGrpcRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metric.Namespace,
+			Subsystem: apiSubsystem,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Histogram of latencies for gRPC requests handled by the API services.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		metric.GrpcLabels,
+	)
+
+	// clusterGrpcRequestLatency observes the latency of the requests workers
+	// send to the cluster listeners, which include their status reports.
+	clusterGrpcRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metric.Namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Histogram of latencies for gRPC requests received from workers on the cluster listeners.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		metric.GrpcLabels,
+	)
+)
+
+// apiCollections are the collections served by the API.
+var apiCollections = map[string]bool{
+	"access-requests":      true,
+	"accounts":             true,
+	"auth-methods":         true,
+	"auth-tokens":          true,
+	"credential-libraries": true,
+	"credential-stores":    true,
+	"credentials":          true,
+	"groups":               true,
+	"host-catalogs":        true,
+	"host-sets":            true,
+	"hosts":                true,
+	"managed-groups":       true,
+	"roles":                true,
+	"scopes":               true,
+	"session-recordings":   true,
+	"sessions":             true,
+	"targets":              true,
+	"users":                true,
+	"workers":              true,
+}
+
+func init() {
+	prometheus.MustRegister(apiHttpRequestLatency, apiGrpcRequestLatency, clusterGrpcRequestLatency)
+}
+
+// apiPathLabel reduces the path of an API request to the collection and the
+// action it addresses, replacing the resource id with "{id}". For example
+// "/v1/scopes/o_1234567890:list-keys" becomes "/v1/scopes/{id}:list-keys".
+// Paths outside of the API are all reported as "unknown".
+func apiPathLabel(path string) string {
+	if !strings.HasPrefix(path, "/v1/") {
+		return unknownPathLabel
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/v1/"), "/")
+	if len(segments) > 2 {
+		return unknownPathLabel
+	}
+	collection, act := splitAction(segments[0])
+	if !apiCollections[collection] {
+		return unknownPathLabel
+	}
+	label := "/v1/" + collection
+	if len(segments) == 2 {
+		if act != "" {
+			return unknownPathLabel
+		}
+		var id string
+		id, act = splitAction(segments[1])
+		if id == "" {
+			return unknownPathLabel
+		}
+		label += "/{id}"
+	}
+	if act != "" {
+		if _, ok := action.Map[act]; !ok {
+			return unknownPathLabel
+		}
+		label += ":" + act
+	}
+	return label
+}
+
+// splitAction splits a path segment of the form "name:action".
+func splitAction(segment string) (string, string) {
+	if i := strings.Index(segment, ":"); i >= 0 {
+		return segment[:i], segment[i+1:]
+	}
+	return segment, ""
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiPathLabel(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/v1/scopes", "/v1/scopes"},
+		{"/v1/scopes/o_1234567890", "/v1/scopes/{id}"},
+		{"/v1/scopes/o_1234567890:list-keys", "/v1/scopes/{id}:list-keys"},
+		{"/v1/auth-methods/ampw_1234567890:authenticate", "/v1/auth-methods/{id}:authenticate"},
+		{"/v1/scopes:list", "/v1/scopes:list"},
+		{"/v1/scopes/o_1234567890:not-an-action", "unknown"},
+		{"/v1/not-a-collection/o_1234567890", "unknown"},
+		{"/v1/scopes/o_1234567890/extra", "unknown"},
+		{"/v1/scopes/:read", "unknown"},
+		{"/", "unknown"},
+		{"/favicon.png", "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, apiPathLabel(tt.path))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+// principalRolePurgeJob defines a periodic job that removes principals from
+// roles once the validity window of their assignment has ended.
+//
+// The grants of a role already stop applying to a principal at the end of the
+// window, so this job only keeps the roles tidy and records the removals with
+// audit events.
+type principalRolePurgeJob struct {
+	iamRepoFn common.IamRepoFactory
+
+	// The total number of principal roles purged in the last run.
+	totalPurged int
+}
+
+// newPrincipalRolePurgeJob instantiates the principal role purge job.
+func newPrincipalRolePurgeJob(iamRepoFn common.IamRepoFactory) (*principalRolePurgeJob, error) {
+	const op = "controller.newPrincipalRolePurgeJob"
+	if iamRepoFn == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing iamRepoFn")
+	}
+	return &principalRolePurgeJob{
+		iamRepoFn: iamRepoFn,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *principalRolePurgeJob) Name() string { return "principal_role_purge" }
+
+// Description returns the description for the job.
+func (j *principalRolePurgeJob) Description() string {
+	return "Remove principals from roles when the validity window of their assignment has ended"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+//
+// The next run time is defined for principalRolePurgeJob as one minute.
+func (j *principalRolePurgeJob) NextRunIn() (time.Duration, error) { return time.Minute, nil }
+
+// Status returns the status of the running job.
+func (j *principalRolePurgeJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalPurged,
+		Total:     j.totalPurged,
+	}
+}
+
+// Run executes the job.
+//
+// Expired principals are deleted role by role with the version of the role
+// they were found in, so a principal added again with a new window while the
+// job runs is never deleted. Roles which fail to be purged are retried on the
+// next run.
+func (j *principalRolePurgeJob) Run(ctx context.Context) error {
+	const op = "controller.(principalRolePurgeJob).Run"
+	j.totalPurged = 0
+
+	repo, err := j.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting iam repo"))
+	}
+	expired, err := repo.ListExpiredPrincipalRoles(ctx, iam.WithLimit(-1))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	roleIds := make(map[string]struct{}, len(expired))
+	for _, pr := range expired {
+		roleIds[pr.GetRoleId()] = struct{}{}
+	}
+	for roleId := range roleIds {
+		purged, err := purgeExpiredPrincipals(ctx, repo, roleId)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error purging expired principals", "role_id", roleId))
+			continue
+		}
+		for _, pr := range purged {
+			if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{
+				Operation: "purge-expired-principal-role",
+				Details:   pr.PrincipalRoleView,
+			})); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error writing audit event", "role_id", roleId, "principal_id", pr.GetPrincipalId()))
+			}
+		}
+		j.totalPurged += len(purged)
+	}
+	return nil
+}
+
+// purgeExpiredPrincipals deletes the principals of the role whose validity
+// window has ended and returns them.
+func purgeExpiredPrincipals(ctx context.Context, repo *iam.Repository, roleId string) ([]iam.PrincipalRole, error) {
+	const op = "controller.purgeExpiredPrincipals"
+	role, principals, _, err := repo.LookupRole(ctx, roleId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if role == nil {
+		// the role was deleted since the expired principals were listed
+		return nil, nil
+	}
+	now := time.Now()
+	var purged []iam.PrincipalRole
+	var principalIds []string
+	for _, pr := range principals {
+		if pr.GetValidUntil().GetTimestamp() != nil && !pr.GetValidUntil().AsTime().After(now) {
+			purged = append(purged, pr)
+			principalIds = append(principalIds, pr.GetPrincipalId())
+		}
+	}
+	if len(principalIds) == 0 {
+		return nil, nil
+	}
+	if _, err := repo.DeletePrincipalRoles(ctx, roleId, role.GetVersion(), principalIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete %d principals", len(principalIds))))
+	}
+	return purged, nil
+}
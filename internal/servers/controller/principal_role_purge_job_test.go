@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(principalRolePurgeJob))
+
+func TestPrincipalRolePurgeJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	role := iam.TestRole(t, conn, org.PublicId)
+	expired := iam.TestUser(t, iamRepo, org.PublicId)
+	active := iam.TestUser(t, iamRepo, org.PublicId)
+	group := iam.TestGroup(t, conn, org.PublicId)
+	_, err := iamRepo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{active.PublicId}, iam.WithValidUntil(time.Now().Add(time.Hour)))
+	require.NoError(err)
+	iam.TestUserRole(t, conn, role.PublicId, expired.PublicId, iam.WithValidUntil(time.Now().Add(-time.Minute)))
+	iam.TestGroupRole(t, conn, role.PublicId, group.PublicId, iam.WithValidUntil(time.Now().Add(-time.Minute)))
+
+	job, err := newPrincipalRolePurgeJob(func() (*iam.Repository, error) { return iamRepo, nil })
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+	assert.Equal(2, job.Status().Completed)
+
+	got, principals, _, err := iamRepo.LookupRole(ctx, role.PublicId)
+	require.NoError(err)
+	assert.Greater(got.Version, role.Version+1)
+	require.Len(principals, 1)
+	assert.Equal(active.PublicId, principals[0].GetPrincipalId())
+
+	// Nothing left to purge
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+}
+
+func TestNewPrincipalRolePurgeJob(t *testing.T) {
+	t.Parallel()
+	_, err := newPrincipalRolePurgeJob(nil)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -1,6 +1,10 @@
 package servers
 
-import "time"
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -16,9 +20,10 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withLimit      int
-	withLiveness   time.Duration
-	withUpdateTags bool
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withLiveness           time.Duration
+	withUpdateTags         bool
 }
 
 func getDefaultOptions() options {
@@ -37,6 +42,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithLiveness indicates how far back we want to search for server entries.
 // Use 0 for the default liveness (15 seconds). A liveness value of -1 removes
 // the liveliness condition.
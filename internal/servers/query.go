@@ -3,4 +3,31 @@ package servers
 const (
 	deleteWhereCreateTimeSql = `create_time < ?`
 	deleteTagsSql            = `server_id = ?`
+
+	updateWorkerDescriptionSql = `
+update server
+   set description = ?,
+       version = version + 1
+ where private_id = ?
+   and type = 'worker'
+   and version = ?;
+`
+	updateWorkerVersionSql = `
+update server
+   set version = version + 1
+ where private_id = ?
+   and type = 'worker'
+   and version = ?;
+`
+	deleteApiTagSql = `
+delete from server_api_tag
+ where server_id = ?
+   and key = ?
+   and value = ?;
+`
+	deleteWorkerSql = `
+delete from server
+ where private_id = ?
+   and type = 'worker';
+`
 )
@@ -35,6 +35,21 @@ func (s ServerType) String() string {
 	return string(s)
 }
 
+// OperationalState is the operational state a server reports in its status
+// updates.
+type OperationalState string
+
+const (
+	ActiveOperationalState OperationalState = "active"
+	// ShutdownOperationalState is reported by workers which are shutting
+	// down. They must not be given new sessions.
+	ShutdownOperationalState OperationalState = "shutdown"
+)
+
+func (s OperationalState) String() string {
+	return string(s)
+}
+
 // Repository is the servers database repository
 type Repository struct {
 	reader db.Reader
@@ -80,18 +95,33 @@ func (r *Repository) listServersWithReader(ctx context.Context, reader db.Reader
 
 	var where string
 	if liveness > 0 {
-		where = fmt.Sprintf("type = ? and update_time > now() - interval '%d seconds'", uint32(liveness.Seconds()))
+		where = fmt.Sprintf("type = ? and last_status_time > now() - interval '%d seconds'", uint32(liveness.Seconds()))
 	} else {
 		where = "type = ?"
 	}
+	args := []interface{}{serverType}
+
+	limit := -1
+	if opts.withLimit != 0 {
+		limit = opts.withLimit
+	}
+	dbOpts := []db.Option{db.WithLimit(limit)}
+	// Servers are identified by their private id, so page through them by it
+	// rather than by a public id.
+	if item := opts.withStartPageAfterItem; item != nil {
+		dbOpts = append(dbOpts, db.WithOrder("create_time asc, private_id asc"))
+		if item.PublicId != "" {
+			where, args = where+" and (create_time, private_id) > (?, ?)", append(args, item.CreateTime, item.PublicId)
+		}
+	}
 
 	var servers []*Server
 	if err := reader.SearchWhere(
 		ctx,
 		&servers,
 		where,
-		[]interface{}{serverType},
-		db.WithLimit(-1),
+		args,
+		dbOpts...,
 	); err != nil {
 		return nil, errors.Wrap(ctx, err, "servers.listServersWithReader")
 	}
@@ -112,8 +142,10 @@ func (ServerTag) TableName() string {
 }
 
 // ListTagsForServers pulls out tag tuples into ServerTag structs for the
-// given server ID values.
+// given server ID values. The tags from the servers' configuration are
+// followed by the tags managed through the API.
 func (r *Repository) ListTagsForServers(ctx context.Context, serverIds []string, opt ...Option) ([]*ServerTag, error) {
+	const op = "servers.ListTagsForServers"
 	var serverTags []*ServerTag
 	if err := r.reader.SearchWhere(
 		ctx,
@@ -122,7 +154,18 @@ func (r *Repository) ListTagsForServers(ctx context.Context, serverIds []string,
 		[]interface{}{serverIds},
 		db.WithLimit(-1),
 	); err != nil {
-		return nil, errors.Wrap(ctx, err, "servers.ListTagsForServers", errors.WithMsg(fmt.Sprintf("server IDs %v", serverIds)))
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("server IDs %v", serverIds)))
+	}
+	apiTags, err := listApiTagsWithReader(ctx, r.reader, serverIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("server IDs %v", serverIds)))
+	}
+	for _, t := range apiTags {
+		serverTags = append(serverTags, &ServerTag{
+			ServerId: t.ServerId,
+			Key:      t.Key,
+			Value:    t.Value,
+		})
 	}
 	return serverTags, nil
 }
@@ -137,6 +180,10 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 
 	opts := getOpts(opt...)
 
+	if server.OperationalState == "" {
+		server.OperationalState = ActiveOperationalState.String()
+	}
+
 	var rowsUpdated int64
 	var controllers []*Server
 	_, err := r.writer.DoTx(
@@ -147,7 +194,13 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 			var err error
 			onConflict := &db.OnConflict{
 				Target: db.Constraint("server_pkey"),
-				Action: append(db.SetColumns([]string{"type", "description", "address"}), db.SetColumnValues(map[string]interface{}{"update_time": "now()"})...),
+				Action: append(db.SetColumns([]string{"type", "address", "operational_state"}), db.SetColumnValues(map[string]interface{}{
+					// A description set through the API is kept unless the
+					// server has one in its configuration.
+					"description":      db.Expr("coalesce(nullif(excluded.description, ''), server.description)"),
+					"update_time":      "now()",
+					"last_status_time": "now()",
+				})...),
 			}
 			err = w.Create(ctx, server, db.WithOnConflict(onConflict), db.WithReturnRowsAffected(&rowsUpdated))
 			if err != nil {
@@ -261,3 +261,47 @@ func TestListServersWithLiveness(t *testing.T) {
 	require.Len(result, 3)
 	requireIds([]string{server1.PrivateId, server2.PrivateId, server3.PrivateId}, result)
 }
+
+func TestUpsertServerOperationalState(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	serversRepo, err := servers.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	listState := func() string {
+		result, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		return result[0].OperationalState
+	}
+
+	// A status update without a state marks the server as active
+	_, _, err = serversRepo.UpsertServer(ctx, &servers.Server{
+		PrivateId: "test1",
+		Type:      "worker",
+		Address:   "127.0.0.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, servers.ActiveOperationalState.String(), listState())
+
+	_, _, err = serversRepo.UpsertServer(ctx, &servers.Server{
+		PrivateId:        "test1",
+		Type:             "worker",
+		Address:          "127.0.0.1",
+		OperationalState: servers.ShutdownOperationalState.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, servers.ShutdownOperationalState.String(), listState())
+
+	_, _, err = serversRepo.UpsertServer(ctx, &servers.Server{
+		PrivateId:        "test1",
+		Type:             "worker",
+		Address:          "127.0.0.1",
+		OperationalState: "unknown",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,377 @@
+package servers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ServerApiTag holds the information for the server_api_tag table for Gorm.
+type ServerApiTag struct {
+	ServerId string
+	Key      string
+	Value    string
+}
+
+// TableName overrides the table name used by ServerApiTag to `server_api_tag`
+func (ServerApiTag) TableName() string {
+	return "server_api_tag"
+}
+
+// LookupWorker returns the worker with the given name, including the tags
+// from its configuration and the tags managed through the API. If the worker
+// is not found, it will return nil, nil. No options are currently supported.
+func (r *Repository) LookupWorker(ctx context.Context, name string, _ ...Option) (*Server, error) {
+	const op = "servers.(Repository).LookupWorker"
+	if name == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	w, err := lookupWorkerWithReader(ctx, r.reader, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return w, nil
+}
+
+// ListWorkers returns all workers known to the controller, including the
+// ones which have stopped sending status updates, with their tags. Supports
+// the WithLiveness option; by default no liveness condition is applied. Also
+// supports the WithLimit and WithStartPageAfterItem options, where the id of
+// the item is the private id of the worker.
+func (r *Repository) ListWorkers(ctx context.Context, opt ...Option) ([]*Server, error) {
+	const op = "servers.(Repository).ListWorkers"
+	opts := getOpts(opt...)
+	liveness := opts.withLiveness
+	if liveness == 0 {
+		liveness = -1
+	}
+	workers, err := r.listServersWithReader(ctx, r.reader, ServerTypeWorker, WithLiveness(liveness),
+		WithLimit(opts.withLimit), WithStartPageAfterItem(opts.withStartPageAfterItem))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := populateWorkerTags(ctx, r.reader, workers); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return workers, nil
+}
+
+// UpdateWorker updates the description of a worker through the API. The
+// version must match the current version of the worker. The only field which
+// can be updated is Description; a description set in the worker's
+// configuration overrides it on the worker's next status update. It returns
+// the updated worker and the number of rows updated. No options are currently
+// supported.
+func (r *Repository) UpdateWorker(ctx context.Context, name string, version uint32, description string, _ ...Option) (*Server, int, error) {
+	const op = "servers.(Repository).UpdateWorker"
+	if name == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	var desc interface{}
+	if description != "" {
+		desc = description
+	}
+	var returnedWorker *Server
+	var rowsUpdated int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			var err error
+			rowsUpdated, err = w.Exec(ctx, updateWorkerDescriptionSql, []interface{}{desc, name, version})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := checkWorkerUpdated(ctx, read, name, version, rowsUpdated); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			returnedWorker, err = lookupWorkerWithReader(ctx, read, name)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", name)))
+	}
+	return returnedWorker, rowsUpdated, nil
+}
+
+// AddWorkerTags adds tags managed through the API to a worker. The version
+// must match the current version of the worker. Tags which the worker already
+// has are ignored. It returns the updated worker. No options are currently
+// supported.
+func (r *Repository) AddWorkerTags(ctx context.Context, name string, version uint32, tags map[string][]string, _ ...Option) (*Server, error) {
+	const op = "servers.(Repository).AddWorkerTags"
+	if len(tags) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing tags")
+	}
+	w, err := r.changeWorkerTags(ctx, op, name, version, func(existing map[ServerApiTag]bool) ([]ServerApiTag, []ServerApiTag) {
+		return tagsNotIn(name, tags, existing), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// SetWorkerTags replaces the tags managed through the API of a worker. The
+// version must match the current version of the worker. Setting no tags
+// removes all of them. It returns the updated worker. No options are
+// currently supported.
+func (r *Repository) SetWorkerTags(ctx context.Context, name string, version uint32, tags map[string][]string, _ ...Option) (*Server, error) {
+	const op = "servers.(Repository).SetWorkerTags"
+	w, err := r.changeWorkerTags(ctx, op, name, version, func(existing map[ServerApiTag]bool) ([]ServerApiTag, []ServerApiTag) {
+		wanted := tagSet(name, tags)
+		var deletes []ServerApiTag
+		for t := range existing {
+			if !wanted[t] {
+				deletes = append(deletes, t)
+			}
+		}
+		return tagsNotIn(name, tags, existing), deletes
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// DeleteWorkerTags removes tags managed through the API from a worker. The
+// version must match the current version of the worker. Tags which the worker
+// does not have are ignored. Tags from the worker's configuration cannot be
+// removed. It returns the updated worker. No options are currently supported.
+func (r *Repository) DeleteWorkerTags(ctx context.Context, name string, version uint32, tags map[string][]string, _ ...Option) (*Server, error) {
+	const op = "servers.(Repository).DeleteWorkerTags"
+	if len(tags) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing tags")
+	}
+	w, err := r.changeWorkerTags(ctx, op, name, version, func(existing map[ServerApiTag]bool) ([]ServerApiTag, []ServerApiTag) {
+		var deletes []ServerApiTag
+		for t := range tagSet(name, tags) {
+			if existing[t] {
+				deletes = append(deletes, t)
+			}
+		}
+		return nil, deletes
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// changeWorkerTags increments the version of the worker and applies the
+// inserts and deletes of API tags returned by change, which is given the
+// worker's current API tags.
+func (r *Repository) changeWorkerTags(
+	ctx context.Context,
+	op errors.Op,
+	name string,
+	version uint32,
+	change func(existing map[ServerApiTag]bool) (inserts, deletes []ServerApiTag),
+) (*Server, error) {
+	if name == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	if version == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	var returnedWorker *Server
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Exec(ctx, updateWorkerVersionSql, []interface{}{name, version})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := checkWorkerUpdated(ctx, read, name, version, rowsUpdated); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			current, err := listApiTagsWithReader(ctx, read, []string{name})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			existing := make(map[ServerApiTag]bool, len(current))
+			for _, t := range current {
+				existing[*t] = true
+			}
+			inserts, deletes := change(existing)
+			for _, t := range deletes {
+				if _, err := w.Exec(ctx, deleteApiTagSql, []interface{}{t.ServerId, t.Key, t.Value}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			if len(inserts) > 0 {
+				items := make([]interface{}, 0, len(inserts))
+				for _, t := range inserts {
+					items = append(items, t)
+				}
+				if err := w.CreateItems(ctx, items); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			returnedWorker, err = lookupWorkerWithReader(ctx, read, name)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", name)))
+	}
+	return returnedWorker, nil
+}
+
+// DeleteWorker deletes the worker with the given name and its tags. A worker
+// which is still running adds itself back with its next status update. It
+// returns the number of rows deleted. No options are currently supported.
+func (r *Repository) DeleteWorker(ctx context.Context, name string, _ ...Option) (int, error) {
+	const op = "servers.(Repository).DeleteWorker"
+	if name == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Exec(ctx, deleteWorkerSql, []interface{}{name})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 worker would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", name)))
+	}
+	return rowsDeleted, nil
+}
+
+// checkWorkerUpdated returns a RecordNotFound error if the worker doesn't
+// exist and a VersionMismatch error if it does but was not updated.
+func checkWorkerUpdated(ctx context.Context, reader db.Reader, name string, version uint32, rowsUpdated int) error {
+	const op = "servers.checkWorkerUpdated"
+	switch {
+	case rowsUpdated == 1:
+		return nil
+	case rowsUpdated > 1:
+		return errors.New(ctx, errors.MultipleRecords, op, "more than 1 worker would have been updated")
+	}
+	w, err := lookupWorkerWithReader(ctx, reader, name)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if w == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("worker %s not found", name))
+	}
+	return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("version %d does not match the worker's version %d", version, w.Version))
+}
+
+func lookupWorkerWithReader(ctx context.Context, reader db.Reader, name string) (*Server, error) {
+	const op = "servers.lookupWorkerWithReader"
+	var workers []*Server
+	if err := reader.SearchWhere(ctx, &workers, "private_id = ? and type = ?", []interface{}{name, ServerTypeWorker.String()}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(workers) == 0 {
+		return nil, nil
+	}
+	if err := populateWorkerTags(ctx, reader, workers); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return workers[0], nil
+}
+
+// populateWorkerTags sets the Tags and ApiTags of the workers.
+func populateWorkerTags(ctx context.Context, reader db.Reader, workers []*Server) error {
+	const op = "servers.populateWorkerTags"
+	if len(workers) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(workers))
+	byId := make(map[string]*Server, len(workers))
+	for _, w := range workers {
+		ids = append(ids, w.PrivateId)
+		byId[w.PrivateId] = w
+	}
+	var configTags []*ServerTag
+	if err := reader.SearchWhere(ctx, &configTags, "server_id in (?)", []interface{}{ids}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, t := range configTags {
+		w := byId[t.ServerId]
+		if w.Tags == nil {
+			w.Tags = make(map[string]*TagValues)
+		}
+		addTagValue(w.Tags, t.Key, t.Value)
+	}
+	apiTags, err := listApiTagsWithReader(ctx, reader, ids)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, t := range apiTags {
+		w := byId[t.ServerId]
+		if w.ApiTags == nil {
+			w.ApiTags = make(map[string]*TagValues)
+		}
+		addTagValue(w.ApiTags, t.Key, t.Value)
+	}
+	return nil
+}
+
+func listApiTagsWithReader(ctx context.Context, reader db.Reader, serverIds []string) ([]*ServerApiTag, error) {
+	const op = "servers.listApiTagsWithReader"
+	var tags []*ServerApiTag
+	if err := reader.SearchWhere(ctx, &tags, "server_id in (?)", []interface{}{serverIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tags, nil
+}
+
+func addTagValue(m map[string]*TagValues, key, value string) {
+	tv := m[key]
+	if tv == nil {
+		tv = &TagValues{}
+		m[key] = tv
+	}
+	tv.Values = append(tv.Values, value)
+	sort.Strings(tv.Values)
+}
+
+func tagSet(serverId string, tags map[string][]string) map[ServerApiTag]bool {
+	set := make(map[ServerApiTag]bool)
+	for k, vals := range tags {
+		for _, v := range vals {
+			set[ServerApiTag{ServerId: serverId, Key: k, Value: v}] = true
+		}
+	}
+	return set
+}
+
+func tagsNotIn(serverId string, tags map[string][]string, existing map[ServerApiTag]bool) []ServerApiTag {
+	var ret []ServerApiTag
+	for t := range tagSet(serverId, tags) {
+		if !existing[t] {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,165 @@
+package servers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWorkerRepo(t *testing.T) *servers.Repository {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := servers.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	return repo
+}
+
+func testWorker(t *testing.T, repo *servers.Repository, name string, tags map[string]*servers.TagValues) {
+	t.Helper()
+	_, _, err := repo.UpsertServer(context.Background(), &servers.Server{
+		PrivateId: name,
+		Type:      servers.ServerTypeWorker.String(),
+		Address:   "127.0.0.1",
+		Tags:      tags,
+	}, servers.WithUpdateTags(true))
+	require.NoError(t, err)
+}
+
+func TestRepository_LookupListWorkers(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo := testWorkerRepo(t)
+
+	testWorker(t, repo, "test1", map[string]*servers.TagValues{"region": {Values: []string{"east"}}})
+	testWorker(t, repo, "test2", nil)
+
+	w, err := repo.LookupWorker(ctx, "test1")
+	require.NoError(err)
+	require.NotNil(w)
+	assert.Equal(uint32(1), w.Version)
+	assert.NotNil(w.LastStatusTime)
+	assert.Equal([]string{"east"}, w.Tags["region"].GetValues())
+	assert.Empty(w.ApiTags)
+
+	w, err = repo.LookupWorker(ctx, "unknown")
+	require.NoError(err)
+	assert.Nil(w)
+
+	_, err = repo.LookupWorker(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	workers, err := repo.ListWorkers(ctx)
+	require.NoError(err)
+	assert.Len(workers, 2)
+}
+
+func TestRepository_UpdateWorker(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo := testWorkerRepo(t)
+	testWorker(t, repo, "test1", nil)
+
+	w, n, err := repo.UpdateWorker(ctx, "test1", 1, "the first worker")
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("the first worker", w.Description)
+	assert.Equal(uint32(2), w.Version)
+
+	_, _, err = repo.UpdateWorker(ctx, "test1", 1, "stale")
+	assert.Truef(errors.Match(errors.T(errors.VersionMismatch), err), "unexpected error %v", err)
+
+	_, _, err = repo.UpdateWorker(ctx, "unknown", 1, "missing")
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+
+	// A status update without a configured description keeps the one set
+	// through the API and doesn't change the version.
+	testWorker(t, repo, "test1", nil)
+	w, err = repo.LookupWorker(ctx, "test1")
+	require.NoError(err)
+	assert.Equal("the first worker", w.Description)
+	assert.Equal(uint32(2), w.Version)
+}
+
+func TestRepository_WorkerTags(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo := testWorkerRepo(t)
+	testWorker(t, repo, "test1", map[string]*servers.TagValues{"region": {Values: []string{"east"}}})
+
+	w, err := repo.AddWorkerTags(ctx, "test1", 1, map[string][]string{"type": {"dev", "prod"}})
+	require.NoError(err)
+	assert.Equal(uint32(2), w.Version)
+	assert.Equal([]string{"dev", "prod"}, w.ApiTags["type"].GetValues())
+
+	// Adding an existing tag is ignored.
+	w, err = repo.AddWorkerTags(ctx, "test1", 2, map[string][]string{"type": {"dev"}, "team": {"ops"}})
+	require.NoError(err)
+	assert.Equal([]string{"dev", "prod"}, w.ApiTags["type"].GetValues())
+	assert.Equal([]string{"ops"}, w.ApiTags["team"].GetValues())
+
+	w, err = repo.DeleteWorkerTags(ctx, "test1", 3, map[string][]string{"type": {"prod", "unknown"}})
+	require.NoError(err)
+	assert.Equal([]string{"dev"}, w.ApiTags["type"].GetValues())
+
+	_, err = repo.DeleteWorkerTags(ctx, "test1", 3, map[string][]string{"type": {"dev"}})
+	assert.Truef(errors.Match(errors.T(errors.VersionMismatch), err), "unexpected error %v", err)
+
+	// Config tags and API tags are both used for filtering.
+	tags, err := repo.ListTagsForServers(ctx, []string{"test1"})
+	require.NoError(err)
+	assert.ElementsMatch([]*servers.ServerTag{
+		{ServerId: "test1", Key: "region", Value: "east"},
+		{ServerId: "test1", Key: "type", Value: "dev"},
+		{ServerId: "test1", Key: "team", Value: "ops"},
+	}, tags)
+
+	// Config tag updates from the worker leave the API tags alone.
+	testWorker(t, repo, "test1", map[string]*servers.TagValues{"region": {Values: []string{"west"}}})
+	w, err = repo.LookupWorker(ctx, "test1")
+	require.NoError(err)
+	assert.Equal([]string{"west"}, w.Tags["region"].GetValues())
+	assert.Equal([]string{"dev"}, w.ApiTags["type"].GetValues())
+
+	w, err = repo.SetWorkerTags(ctx, "test1", 4, map[string][]string{"type": {"staging"}})
+	require.NoError(err)
+	assert.Len(w.ApiTags, 1)
+	assert.Equal([]string{"staging"}, w.ApiTags["type"].GetValues())
+
+	w, err = repo.SetWorkerTags(ctx, "test1", 5, nil)
+	require.NoError(err)
+	assert.Empty(w.ApiTags)
+}
+
+func TestRepository_DeleteWorker(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo := testWorkerRepo(t)
+	testWorker(t, repo, "test1", nil)
+	_, err := repo.AddWorkerTags(ctx, "test1", 1, map[string][]string{"type": {"dev"}})
+	require.NoError(err)
+
+	n, err := repo.DeleteWorker(ctx, "test1")
+	require.NoError(err)
+	assert.Equal(1, n)
+
+	w, err := repo.LookupWorker(ctx, "test1")
+	require.NoError(err)
+	assert.Nil(w)
+
+	n, err = repo.DeleteWorker(ctx, "test1")
+	require.NoError(err)
+	assert.Equal(0, n)
+}
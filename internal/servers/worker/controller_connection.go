@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/resolver"
@@ -118,6 +120,10 @@ func (w *Worker) createClientConn(addr string) error {
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
 		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)),
 		grpc.WithContextDialer(w.controllerDialerFunc()),
+		grpc.WithChainUnaryInterceptor(
+			trace.NewGrpcClientInterceptor(),
+			metric.NewGrpcClientInterceptor(clusterGrpcRequestLatency),
+		),
 		grpc.WithInsecure(),
 		grpc.WithDefaultServiceConfig(defServiceConfig),
 		// Don't have the resolver reach out for a service config from the
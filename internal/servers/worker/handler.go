@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/trace"
 	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/boundary/internal/servers"
 	proxyHandlers "github.com/hashicorp/boundary/internal/servers/worker/proxy"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -34,13 +36,29 @@ func (w *Worker) handler(props HandlerProperties) http.Handler {
 
 	genericWrappedHandler := w.wrapGenericHandler(mux, props)
 
-	return genericWrappedHandler
+	// The span of a proxy request lasts as long as the proxied connection,
+	// and is the parent of the session RPCs sent to the controller.
+	return trace.InstrumentHttpHandler(genericWrappedHandler, proxyPathName)
+}
+
+// proxyPathName returns the path used to name the span of a request, which is
+// only kept for the proxy endpoint so the number of span names is bounded.
+func proxyPathName(path string) string {
+	if path == "/v1/proxy" {
+		return path
+	}
+	return "unknown"
 }
 
 func (w *Worker) handleProxy() http.HandlerFunc {
 	const op = "worker.(Worker).handleProxy"
 	return func(wr http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
+		if w.operationalState.Load().(servers.OperationalState) == servers.ShutdownOperationalState {
+			event.WriteSysEvent(ctx, op, "worker is shutting down, refusing connection")
+			wr.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
 		if r.TLS == nil {
 			event.WriteError(ctx, op, errors.New("no request TLS information found"))
 			wr.WriteHeader(http.StatusInternalServerError)
@@ -77,6 +95,7 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		tofuToken := si.LookupSessionResponse.GetTofuToken()
 		version := si.LookupSessionResponse.GetVersion()
 		endpoint := si.LookupSessionResponse.GetEndpoint()
+		credentials := si.LookupSessionResponse.GetCredentials()
 		sessStatus := si.Status
 		si.RUnlock()
 
@@ -92,7 +111,11 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		// Later calls will cause this to noop if they return a different status
 		defer conn.Close(websocket.StatusNormalClosure, "done")
 
-		connCtx, connCancel := context.WithDeadline(ctx, expiration.AsTime())
+		// The connection isn't given a deadline at the session's expiration
+		// since the session can be extended. Instead it's canceled when the
+		// session's expiration, which is kept up to date through the status
+		// updates, has passed.
+		connCtx, connCancel := context.WithCancel(ctx)
 		defer connCancel()
 
 		sessClient, err := w.ControllerSessionConn()
@@ -232,7 +255,26 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 			return
 		}
 
-		if err = handleProxyFn(connCtx, conf); err != nil {
+		egressCreds, err := proxyHandlers.EgressCredentials(credentials)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error converting egress credentials"))
+			if err = conn.Close(websocket.StatusInternalError, "unable to read egress credentials"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
+		proxyOpts := []proxyHandlers.Option{
+			proxyHandlers.WithEgressCredentials(egressCreds),
+			proxyHandlers.WithSshHostKeys(proxyHandlers.SshHostKeys(credentials)),
+			proxyHandlers.WithWorkerId(w.conf.RawConfig.Worker.Name),
+		}
+		if w.recordingStorage != nil {
+			proxyOpts = append(proxyOpts, proxyHandlers.WithRecordingStorage(w.recordingStorage))
+		}
+		activeConnections.Inc()
+		defer activeConnections.Dec()
+		if err = handleProxyFn(connCtx, conf, proxyOpts...); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error handling proxy", "session_id", sessionId, "endpoint", endpoint))
 			if err = conn.Close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/go-multierror"
@@ -34,6 +35,10 @@ func (w *Worker) startListeners() error {
 				// We may have this in dev mode; ignore
 				continue
 
+			case "ops":
+				// Served by the ops server of the process
+				continue
+
 			case "proxy":
 				// Do nothing; handle below
 
@@ -100,6 +105,9 @@ func (w *Worker) startListeners() error {
 func (w *Worker) stopListeners() error {
 	serverWg := new(sync.WaitGroup)
 	for _, ln := range w.conf.Listeners {
+		if isOpsListener(ln) {
+			continue
+		}
 		localLn := ln
 		serverWg.Add(1)
 		go func() {
@@ -118,6 +126,9 @@ func (w *Worker) stopListeners() error {
 	var retErr *multierror.Error
 	if !w.conf.RawConfig.DevController {
 		for _, ln := range w.conf.Listeners {
+			if isOpsListener(ln) {
+				continue
+			}
 			if err := ln.Mux.Close(); err != nil {
 				if _, ok := err.(*os.PathError); ok && ln.Config.Type == "unix" {
 					// The rmListener probably tried to remove the file but it
@@ -132,3 +143,10 @@ func (w *Worker) stopListeners() error {
 	}
 	return retErr.ErrorOrNil()
 }
+
+// isOpsListener returns whether ln is an ops listener. Ops listeners are
+// started and stopped by the ops server of the process rather than by the
+// worker.
+func isOpsListener(ln *base.ServerListener) bool {
+	return len(ln.Config.Purpose) == 1 && ln.Config.Purpose[0] == "ops"
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	clusterSubsystem = "worker_cluster"
+	proxySubsystem   = "worker_proxy"
+)
+
+var (
+	// clusterGrpcRequestLatency observes the latency of the requests the
+	// worker sends to the controllers, which include its status reports.
+	clusterGrpcRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metric.Namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Histogram of latencies for gRPC requests sent to the controllers.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		metric.GrpcLabels,
+	)
+
+	// activeSessions is the number of sessions the worker is tracking, as of
+	// its last status report.
+	activeSessions = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metric.Namespace,
+			Subsystem: proxySubsystem,
+			Name:      "active_sessions",
+			Help:      "Number of sessions the worker is proxying.",
+		},
+	)
+
+	// activeConnections is the number of connections the worker is currently
+	// proxying.
+	activeConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metric.Namespace,
+			Subsystem: proxySubsystem,
+			Name:      "active_connections",
+			Help:      "Number of connections the worker is proxying.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(clusterGrpcRequestLatency, activeSessions, activeConnections)
+}
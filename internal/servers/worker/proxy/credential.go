@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+type usernamePassword struct {
+	id       string
+	username string
+	password credential.Password
+}
+
+var _ credential.UserPassword = (*usernamePassword)(nil)
+
+func (c *usernamePassword) GetPublicId() string           { return c.id }
+func (c *usernamePassword) Secret() credential.SecretData { return c.password }
+func (c *usernamePassword) Username() string              { return c.username }
+func (c *usernamePassword) Password() credential.Password { return c.password }
+
+type keyPair struct {
+	id         string
+	username   string
+	privateKey credential.PrivateKey
+}
+
+var _ credential.KeyPair = (*keyPair)(nil)
+
+func (c *keyPair) GetPublicId() string            { return c.id }
+func (c *keyPair) Secret() credential.SecretData  { return c.privateKey }
+func (c *keyPair) Username() string               { return c.username }
+func (c *keyPair) Private() credential.PrivateKey { return c.privateKey }
+
+// EgressCredentials converts the credentials the controller returned when
+// the session was looked up into credentials which can be passed to a
+// Handler with WithEgressCredentials. Each returned credential implements
+// either credential.UserPassword or credential.KeyPair. The ssh host keys
+// sent with the credentials are skipped; see SshHostKeys.
+func EgressCredentials(creds []*pbs.Credential) ([]credential.Credential, error) {
+	var ret []credential.Credential
+	for _, c := range creds {
+		switch v := c.GetCredential().(type) {
+		case *pbs.Credential_UsernamePassword:
+			ret = append(ret, &usernamePassword{
+				id:       c.GetCredentialSourceId(),
+				username: v.UsernamePassword.GetUsername(),
+				password: credential.Password(v.UsernamePassword.GetPassword()),
+			})
+		case *pbs.Credential_SshPrivateKey:
+			ret = append(ret, &keyPair{
+				id:         c.GetCredentialSourceId(),
+				username:   v.SshPrivateKey.GetUsername(),
+				privateKey: credential.PrivateKey(v.SshPrivateKey.GetPrivateKey()),
+			})
+		case *pbs.Credential_SshHostKeys:
+		default:
+			return nil, fmt.Errorf("unsupported egress credential type %T for credential source %q", v, c.GetCredentialSourceId())
+		}
+	}
+	return ret, nil
+}
+
+// SshHostKeys returns the public keys, in authorized_keys format, that the
+// controller sent with the egress credentials of the session. They can be
+// passed to a Handler with WithSshHostKeys.
+func SshHostKeys(creds []*pbs.Credential) []string {
+	var ret []string
+	for _, c := range creds {
+		ret = append(ret, c.GetSshHostKeys().GetPublicKeys()...)
+	}
+	return ret
+}
@@ -0,0 +1,65 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgressCredentials(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		in := []*pbs.Credential{
+			{
+				CredentialSourceId: "csst_up",
+				Credential: &pbs.Credential_UsernamePassword{
+					UsernamePassword: &pbs.UsernamePassword{Username: "user", Password: "pass"},
+				},
+			},
+			{
+				CredentialSourceId: "csst_pk",
+				Credential: &pbs.Credential_SshPrivateKey{
+					SshPrivateKey: &pbs.SshPrivateKey{Username: "user", PrivateKey: "key"},
+				},
+			},
+			{
+				CredentialSourceId: "tssh_1234567890",
+				Credential: &pbs.Credential_SshHostKeys{
+					SshHostKeys: &pbs.SshHostKeys{PublicKeys: []string{"ssh-ed25519 AAAA"}},
+				},
+			},
+		}
+		got, err := EgressCredentials(in)
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal([]string{"ssh-ed25519 AAAA"}, SshHostKeys(in))
+
+		up, ok := got[0].(credential.UserPassword)
+		require.True(ok)
+		assert.Equal("csst_up", up.GetPublicId())
+		assert.Equal("user", up.Username())
+		assert.Equal(credential.Password("pass"), up.Password())
+
+		kp, ok := got[1].(credential.KeyPair)
+		require.True(ok)
+		assert.Equal("csst_pk", kp.GetPublicId())
+		assert.Equal("user", kp.Username())
+		assert.Equal(credential.PrivateKey("key"), kp.Private())
+	})
+	t.Run("empty", func(t *testing.T) {
+		got, err := EgressCredentials(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Empty(t, SshHostKeys(nil))
+	})
+	t.Run("unknown-type", func(t *testing.T) {
+		got, err := EgressCredentials([]*pbs.Credential{{CredentialSourceId: "csst_none"}})
+		require.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
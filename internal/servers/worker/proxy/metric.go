@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/prometheus/client_golang/prometheus"
+	"nhooyr.io/websocket"
+)
+
+const (
+	labelDirection = "direction"
+
+	// directionSent is the direction of the bytes sent to the client.
+	directionSent = "sent"
+	// directionReceived is the direction of the bytes received from the
+	// client.
+	directionReceived = "received"
+)
+
+// proxiedBytes counts the bytes proxied between clients and endpoints, by
+// direction from the client's point of view.
+var proxiedBytes = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metric.Namespace,
+		Subsystem: "worker_proxy",
+		Name:      "client_bytes_total",
+		Help:      "Count of the bytes proxied to and from clients.",
+	},
+	[]string{labelDirection},
+)
+
+func init() {
+	prometheus.MustRegister(proxiedBytes)
+}
+
+// ClientNetConn wraps the client's websocket connection in a net.Conn. The
+// bytes read from and written to it are counted in the worker's metrics.
+func (c Config) ClientNetConn(ctx context.Context) net.Conn {
+	return &countingConn{
+		Conn:     websocket.NetConn(ctx, c.ClientConn, websocket.MessageBinary),
+		sent:     proxiedBytes.WithLabelValues(directionSent),
+		received: proxiedBytes.WithLabelValues(directionReceived),
+	}
+}
+
+type countingConn struct {
+	net.Conn
+	sent     prometheus.Counter
+	received prometheus.Counter
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.received.Add(float64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.sent.Add(float64(n))
+	return n, err
+}
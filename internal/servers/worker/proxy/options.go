@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/recording"
 )
 
 // Option - how Options are passed as arguments.
@@ -19,11 +20,17 @@ func GetOpts(opt ...Option) Options {
 // Options = how options are represented
 type Options struct {
 	WithEgressCredentials []credential.Credential
+	WithSshHostKeys       []string
+	WithRecordingStorage  recording.Storage
+	WithWorkerId          string
 }
 
 func getDefaultOptions() Options {
 	return Options{
 		WithEgressCredentials: nil,
+		WithSshHostKeys:       nil,
+		WithRecordingStorage:  nil,
+		WithWorkerId:          "",
 	}
 }
 
@@ -33,3 +40,27 @@ func WithEgressCredentials(creds []credential.Credential) Option {
 		o.WithEgressCredentials = creds
 	}
 }
+
+// WithSshHostKeys provides the optional public keys, in authorized_keys
+// format, that the endpoint must present when a proxy establishes an ssh
+// connection to it
+func WithSshHostKeys(keys []string) Option {
+	return func(o *Options) {
+		o.WithSshHostKeys = keys
+	}
+}
+
+// WithRecordingStorage provides an optional storage that proxies which
+// support session recording write their recordings to.
+func WithRecordingStorage(s recording.Storage) Option {
+	return func(o *Options) {
+		o.WithRecordingStorage = s
+	}
+}
+
+// WithWorkerId provides the optional id of the worker running the proxy
+func WithWorkerId(id string) Option {
+	return func(o *Options) {
+		o.WithWorkerId = id
+	}
+}
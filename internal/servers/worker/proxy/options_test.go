@@ -1,10 +1,13 @@
 package proxy
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type cred struct {
@@ -27,4 +30,30 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithEgressCredentials = []credential.Credential{c}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithSshHostKeys", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSshHostKeys([]string{"ssh-ed25519 AAAA"}))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithSshHostKeys = []string{"ssh-ed25519 AAAA"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRecordingStorage", func(t *testing.T) {
+		assert := assert.New(t)
+		s, err := recording.NewStorage(context.Background(), t.TempDir())
+		require.NoError(t, err)
+		opts := GetOpts(WithRecordingStorage(s))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithRecordingStorage = s
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWorkerId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithWorkerId("worker"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithWorkerId = "worker"
+		assert.Equal(opts, testOpts)
+	})
 }
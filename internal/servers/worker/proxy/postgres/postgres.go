@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+)
+
+func init() {
+	err := proxy.RegisterHandler("postgres", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy accepts the startup message of a PostgreSQL client without
+// asking it to authenticate, connects to the remote endpoint as the user of
+// the username/password egress credential provided with
+// proxy.WithEgressCredentials, and then relays the server's session
+// parameters to the client. From then on bytes are copied between the two
+// connections. handleProxy sets the connectionId as connected in the
+// repository.
+//
+// The database and runtime parameters requested by the client are used for
+// the upstream connection; the user it requested is ignored. The client must
+// not require TLS to the worker, since the connection to the worker is
+// already carried over the session's TLS tunnel.
+//
+// handleProxy blocks until an error (EOF on happy path) is received on either
+// connection.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "postgres" {
+		return fmt.Errorf("invalid scheme for postgres proxy: %v", sessionUrl.Scheme)
+	}
+	opts := proxy.GetOpts(opt...)
+	var cred credential.UserPassword
+	for _, c := range opts.WithEgressCredentials {
+		if up, ok := c.(credential.UserPassword); ok {
+			cred = up
+			break
+		}
+	}
+	if cred == nil {
+		return errors.New("no username/password egress credential for postgres authentication")
+	}
+
+	// Get a wrapped net.Conn so we can use io.Copy
+	netConn := conf.ClientNetConn(ctx)
+	backend := pgproto3.NewBackend(pgproto3.NewChunkReader(netConn), netConn)
+
+	var startup *pgproto3.StartupMessage
+	for startup == nil {
+		msg, err := backend.ReceiveStartupMessage()
+		if err != nil {
+			return fmt.Errorf("error receiving startup message: %w", err)
+		}
+		switch m := msg.(type) {
+		case *pgproto3.SSLRequest, *pgproto3.GSSEncRequest:
+			// Decline; the client will continue in plaintext.
+			if _, err := netConn.Write([]byte("N")); err != nil {
+				return fmt.Errorf("error declining encryption request: %w", err)
+			}
+		case *pgproto3.CancelRequest:
+			return cancelRequest(ctx, sessionUrl.Host, m)
+		case *pgproto3.StartupMessage:
+			startup = m
+		default:
+			return fmt.Errorf("unexpected startup message %T", m)
+		}
+	}
+
+	pgConf, err := pgconn.ParseConfig(fmt.Sprintf("postgres://%s/?sslmode=prefer", sessionUrl.Host))
+	if err != nil {
+		return fmt.Errorf("error building postgres configuration: %w", err)
+	}
+	pgConf.User = cred.Username()
+	pgConf.Password = string(cred.Password())
+	for k, v := range startup.Parameters {
+		switch k {
+		case "user":
+		case "database":
+			pgConf.Database = v
+		default:
+			pgConf.RuntimeParams[k] = v
+		}
+	}
+	pgConn, err := pgconn.ConnectConfig(ctx, pgConf)
+	if err != nil {
+		_ = backend.Send(&pgproto3.ErrorResponse{
+			Severity: "FATAL",
+			Code:     "08001",
+			Message:  "boundary worker unable to connect to endpoint",
+		})
+		return fmt.Errorf("error connecting to endpoint: %w", err)
+	}
+	hijacked, err := pgConn.Hijack()
+	if err != nil {
+		return fmt.Errorf("error taking over endpoint connection: %w", err)
+	}
+	remoteConn := hijacked.Conn
+
+	endpointAddr, ok := remoteConn.RemoteAddr().(*net.TCPAddr)
+	if !ok {
+		_ = remoteConn.Close()
+		return fmt.Errorf("unexpected endpoint address type %T", remoteConn.RemoteAddr())
+	}
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "tcp",
+	}
+
+	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
+	if err != nil {
+		_ = remoteConn.Close()
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Update connection info to set connection status
+	conf.SessionInfo.Lock()
+	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
+	conf.SessionInfo.Unlock()
+
+	// Complete the client's startup as the server would have.
+	msgs := []pgproto3.BackendMessage{&pgproto3.AuthenticationOk{}}
+	for k, v := range hijacked.ParameterStatuses {
+		msgs = append(msgs, &pgproto3.ParameterStatus{Name: k, Value: v})
+	}
+	msgs = append(msgs,
+		&pgproto3.BackendKeyData{ProcessID: hijacked.PID, SecretKey: hijacked.SecretKey},
+		&pgproto3.ReadyForQuery{TxStatus: hijacked.TxStatus},
+	)
+	for _, m := range msgs {
+		if err := backend.Send(m); err != nil {
+			_ = remoteConn.Close()
+			return fmt.Errorf("error completing client startup: %w", err)
+		}
+	}
+
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(netConn, remoteConn)
+		_ = netConn.Close()
+		_ = remoteConn.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(remoteConn, netConn)
+		_ = remoteConn.Close()
+		_ = netConn.Close()
+	}()
+	connWg.Wait()
+	return nil
+}
+
+// cancelRequest forwards a query cancellation to the endpoint. The process id
+// and secret key were handed to the client by the endpoint when its
+// connection was established, so they can be sent as is.
+func cancelRequest(ctx context.Context, host string, req *pgproto3.CancelRequest) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write(req.Encode(nil)); err != nil {
+		return fmt.Errorf("error sending cancel request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// connectionRecorder records the terminal channels of one proxied ssh
+// connection. Each session channel on which the client requests a pty is
+// written to its own asciicast recording.
+type connectionRecorder struct {
+	storage      recording.Storage
+	client       pbs.SessionServiceClient
+	sessionId    string
+	connectionId string
+	workerId     string
+
+	mu       sync.Mutex
+	channels int
+}
+
+func newConnectionRecorder(conf proxy.Config, opts proxy.Options) *connectionRecorder {
+	if opts.WithRecordingStorage == nil {
+		return nil
+	}
+	return &connectionRecorder{
+		storage:      opts.WithRecordingStorage,
+		client:       conf.SessionClient,
+		sessionId:    conf.SessionInfo.LookupSessionResponse.GetAuthorization().GetSessionId(),
+		connectionId: conf.ConnectionId,
+		workerId:     opts.WithWorkerId,
+	}
+}
+
+// channel returns the recorder for a new channel of the connection. It is
+// safe to call on a nil connectionRecorder, in which case nothing is
+// recorded.
+func (r *connectionRecorder) channel(ctx context.Context, channelType string) *channelRecorder {
+	if r == nil || channelType != "session" {
+		return nil
+	}
+	r.mu.Lock()
+	n := r.channels
+	r.channels++
+	r.mu.Unlock()
+	return &channelRecorder{
+		ctx:  ctx,
+		conn: r,
+		name: fmt.Sprintf("%s/%s-%d.cast", r.sessionId, r.connectionId, n),
+	}
+}
+
+// ptyRequest is the payload of a "pty-req" channel request, RFC 4254 6.2.
+type ptyRequest struct {
+	Term     string
+	Columns  uint32
+	Rows     uint32
+	WidthPx  uint32
+	HeightPx uint32
+	Modes    string
+}
+
+// windowChange is the payload of a "window-change" channel request, RFC
+// 4254 6.7.
+type windowChange struct {
+	Columns  uint32
+	Rows     uint32
+	WidthPx  uint32
+	HeightPx uint32
+}
+
+// channelRecorder records the output of one session channel once the client
+// has requested a pty for it. Failing to record never interrupts the
+// proxied channel; errors are written as events and recording stops.
+type channelRecorder struct {
+	ctx  context.Context
+	conn *connectionRecorder
+	name string
+
+	mu     sync.Mutex
+	file   io.WriteCloser
+	uri    string
+	bytes  uint64
+	w      *recording.Writer
+	failed bool
+}
+
+// observeRequest starts the recording on a "pty-req" request and records
+// terminal size changes.
+func (c *channelRecorder) observeRequest(req *ssh.Request) {
+	const op = "ssh.(channelRecorder).observeRequest"
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failed {
+		return
+	}
+	switch req.Type {
+	case "pty-req":
+		if c.w != nil {
+			return
+		}
+		var pty ptyRequest
+		if err := ssh.Unmarshal(req.Payload, &pty); err != nil {
+			c.fail(op, fmt.Errorf("error parsing pty request: %w", err))
+			return
+		}
+		f, uri, err := c.conn.storage.Create(c.ctx, c.name)
+		if err != nil {
+			c.fail(op, err)
+			return
+		}
+		c.file, c.uri = f, uri
+		hdr := recording.Header{
+			Width:  pty.Columns,
+			Height: pty.Rows,
+		}
+		if pty.Term != "" {
+			hdr.Env = map[string]string{"TERM": pty.Term}
+		}
+		if c.w, err = recording.NewWriter(c.ctx, c, hdr); err != nil {
+			c.fail(op, err)
+		}
+	case "window-change":
+		if c.w == nil {
+			return
+		}
+		var wc windowChange
+		if err := ssh.Unmarshal(req.Payload, &wc); err != nil {
+			return
+		}
+		if err := c.w.Resize(wc.Columns, wc.Rows); err != nil {
+			c.fail(op, err)
+		}
+	}
+}
+
+// Output returns a writer which records everything written to it as
+// terminal output.
+func (c *channelRecorder) Output() io.Writer {
+	return outputWriter{c}
+}
+
+type outputWriter struct {
+	c *channelRecorder
+}
+
+func (o outputWriter) Write(p []byte) (int, error) {
+	const op = "ssh.outputWriter.Write"
+	c := o.c
+	if c == nil {
+		return len(p), nil
+	}
+	c.mu.Lock()
+	w, failed := c.w, c.failed
+	c.mu.Unlock()
+	if w == nil || failed {
+		return len(p), nil
+	}
+	if err := w.Output(p); err != nil {
+		c.mu.Lock()
+		c.fail(op, err)
+		c.mu.Unlock()
+	}
+	return len(p), nil
+}
+
+// Write implements io.Writer for the recording.Writer; it writes to the
+// recording file and counts the bytes written. Calls are serialized by the
+// recording.Writer.
+func (c *channelRecorder) Write(p []byte) (int, error) {
+	n, err := c.file.Write(p)
+	c.bytes += uint64(n)
+	return n, err
+}
+
+// fail stops the recording. It must be called with mu held.
+func (c *channelRecorder) fail(op event.Op, err error) {
+	event.WriteError(c.ctx, op, err, event.WithInfoMsg("error recording ssh channel", "session_id", c.conn.sessionId, "connection_id", c.conn.connectionId))
+	c.failed = true
+}
+
+// close finishes the recording and records it on the controller.
+func (c *channelRecorder) close() {
+	const op = "ssh.(channelRecorder).close"
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return
+	}
+	if err := c.file.Close(); err != nil {
+		c.fail(op, err)
+	}
+	if c.w == nil {
+		return
+	}
+	start := c.w.Start()
+	end := time.Now()
+	// The channel is closed but the connection's context may be canceled
+	// at the same time, so don't tie the controller call to it.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := session.CreateRecording(ctx, c.conn.client, &pbs.CreateSessionRecordingRequest{
+		SessionId:    c.conn.sessionId,
+		ConnectionId: c.conn.connectionId,
+		WorkerId:     c.conn.workerId,
+		StorageUri:   c.uri,
+		Bytes:        c.bytes,
+		StartTime:    timestamppb.New(start),
+		EndTime:      timestamppb.New(end),
+	}); err != nil {
+		c.fail(op, fmt.Errorf("error recording session recording on controller: %w", err))
+	}
+}
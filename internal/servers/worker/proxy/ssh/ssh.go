@@ -0,0 +1,318 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"golang.org/x/crypto/ssh"
+)
+
+func init() {
+	err := proxy.RegisterHandler("ssh", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+var (
+	hostKeyOnce   sync.Once
+	hostKeySigner ssh.Signer
+	hostKeyErr    error
+)
+
+// hostKey returns the ephemeral host key the worker presents to ssh clients.
+// It is generated once per worker process; clients are expected to connect to
+// the worker through the local listener created by boundary connect, so the
+// key is not meant to be pinned.
+func hostKey() (ssh.Signer, error) {
+	hostKeyOnce.Do(func() {
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			hostKeyErr = fmt.Errorf("error generating host key: %w", err)
+			return
+		}
+		hostKeySigner, hostKeyErr = ssh.NewSignerFromKey(priv)
+	})
+	return hostKeySigner, hostKeyErr
+}
+
+// handleProxy terminates the ssh connection from the client on the worker and
+// establishes a second ssh connection to the remote endpoint, authenticating
+// with the egress credentials provided with proxy.WithEgressCredentials. The
+// endpoint must present one of the host keys provided with
+// proxy.WithSshHostKeys, otherwise the credentials are not sent to it. The
+// client is not asked to authenticate. Channels and requests are then bridged
+// between the two connections. handleProxy sets the connectionId as connected
+// in the repository.
+//
+// If a recording storage is provided with proxy.WithRecordingStorage, the
+// output of every session channel the client requests a pty for is recorded
+// in asciicast format and the recording is registered with the controller
+// when the channel closes.
+//
+// handleProxy blocks until either ssh connection is closed.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "ssh" {
+		return fmt.Errorf("invalid scheme for ssh proxy: %v", sessionUrl.Scheme)
+	}
+	opts := proxy.GetOpts(opt...)
+	clientConf, err := clientConfig(opts.WithEgressCredentials, opts.WithSshHostKeys)
+	if err != nil {
+		return err
+	}
+	signer, err := hostKey()
+	if err != nil {
+		return err
+	}
+
+	remoteConn, err := (&net.Dialer{}).DialContext(ctx, "tcp", sessionUrl.Host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	upstream, upstreamChans, upstreamReqs, err := ssh.NewClientConn(remoteConn, sessionUrl.Host, clientConf)
+	if err != nil {
+		_ = remoteConn.Close()
+		return fmt.Errorf("error establishing ssh connection to endpoint: %w", err)
+	}
+	defer upstream.Close()
+
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "tcp",
+	}
+
+	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
+	if err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Update connection info to set connection status
+	conf.SessionInfo.Lock()
+	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
+	conf.SessionInfo.Unlock()
+
+	serverConf := &ssh.ServerConfig{
+		NoClientAuth: true,
+	}
+	serverConf.AddHostKey(signer)
+
+	// Get a wrapped net.Conn so the ssh server can use it
+	netConn := conf.ClientNetConn(ctx)
+	downstream, downstreamChans, downstreamReqs, err := ssh.NewServerConn(netConn, serverConf)
+	if err != nil {
+		_ = netConn.Close()
+		return fmt.Errorf("error establishing ssh connection with client: %w", err)
+	}
+	defer downstream.Close()
+
+	go forwardGlobalRequests(downstreamReqs, upstream)
+	go forwardGlobalRequests(upstreamReqs, downstream)
+	rec := newConnectionRecorder(conf, opts)
+	go forwardChannels(ctx, downstreamChans, upstream, rec)
+	go forwardChannels(ctx, upstreamChans, downstream, nil)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_ = downstream.Wait()
+		done <- struct{}{}
+	}()
+	go func() {
+		_ = upstream.Wait()
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// clientConfig builds the configuration used to authenticate to the remote
+// endpoint. Private keys are tried before passwords. The endpoint must present
+// one of hostKeys, which are in authorized_keys format.
+func clientConfig(creds []credential.Credential, hostKeys []string) (*ssh.ClientConfig, error) {
+	var username string
+	var keys []ssh.Signer
+	var passwords []string
+	for _, c := range creds {
+		switch v := c.(type) {
+		case credential.KeyPair:
+			signer, err := ssh.ParsePrivateKey(v.Private())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing private key for credential %q: %w", v.GetPublicId(), err)
+			}
+			keys = append(keys, signer)
+			if username == "" {
+				username = v.Username()
+			}
+		case credential.UserPassword:
+			passwords = append(passwords, string(v.Password()))
+			if username == "" {
+				username = v.Username()
+			}
+		}
+	}
+	if len(keys) == 0 && len(passwords) == 0 {
+		return nil, errors.New("no egress credentials usable for ssh authentication")
+	}
+	callback, algos, err := hostKeyCallback(hostKeys)
+	if err != nil {
+		return nil, err
+	}
+	var auth []ssh.AuthMethod
+	if len(keys) > 0 {
+		auth = append(auth, ssh.PublicKeys(keys...))
+	}
+	for _, p := range passwords {
+		auth = append(auth, ssh.Password(p))
+	}
+	return &ssh.ClientConfig{
+		User:              username,
+		Auth:              auth,
+		HostKeyCallback:   callback,
+		HostKeyAlgorithms: algos,
+	}, nil
+}
+
+// hostKeyCallback returns a callback which accepts only the keys in
+// hostKeys, and the algorithms of these keys so that the endpoint presents
+// one of them.
+func hostKeyCallback(hostKeys []string) (ssh.HostKeyCallback, []string, error) {
+	if len(hostKeys) == 0 {
+		return nil, nil, errors.New("no ssh host keys to verify the endpoint with")
+	}
+	var keys []ssh.PublicKey
+	var algos []string
+	seen := make(map[string]bool)
+	for _, hk := range hostKeys {
+		k, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hk))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing ssh host key: %w", err)
+		}
+		keys = append(keys, k)
+		if !seen[k.Type()] {
+			seen[k.Type()] = true
+			algos = append(algos, k.Type())
+		}
+	}
+	callback := func(_ string, _ net.Addr, presented ssh.PublicKey) error {
+		for _, k := range keys {
+			if bytes.Equal(k.Marshal(), presented.Marshal()) {
+				return nil
+			}
+		}
+		return fmt.Errorf("ssh host key %s of endpoint does not match the host keys of the target", ssh.FingerprintSHA256(presented))
+	}
+	return callback, algos, nil
+}
+
+// forwardGlobalRequests sends every request received on in to dst, relaying
+// the reply if one is wanted.
+func forwardGlobalRequests(in <-chan *ssh.Request, dst ssh.Conn) {
+	for req := range in {
+		ok, payload, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok = false
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, payload)
+		}
+	}
+}
+
+// forwardChannels opens a matching channel on dst for every channel opened on
+// the other connection and bridges the two. Channels are recorded with rec,
+// which may be nil.
+func forwardChannels(ctx context.Context, in <-chan ssh.NewChannel, dst ssh.Conn, rec *connectionRecorder) {
+	for newCh := range in {
+		go bridgeChannel(newCh, dst, rec.channel(ctx, newCh.ChannelType()))
+	}
+}
+
+func bridgeChannel(newCh ssh.NewChannel, dst ssh.Conn, rec *channelRecorder) {
+	dstCh, dstReqs, err := dst.OpenChannel(newCh.ChannelType(), newCh.ExtraData())
+	if err != nil {
+		var openErr *ssh.OpenChannelError
+		if errors.As(err, &openErr) {
+			_ = newCh.Reject(openErr.Reason, openErr.Message)
+			return
+		}
+		_ = newCh.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	srcCh, srcReqs, err := newCh.Accept()
+	if err != nil {
+		_ = dstCh.Close()
+		return
+	}
+
+	// The side that opened the channel closing it closes the other side.
+	go func() {
+		forwardChannelRequests(srcReqs, dstCh, rec.observeRequest)
+		_ = dstCh.Close()
+	}()
+	go func() {
+		_, _ = io.Copy(dstCh, srcCh)
+		_ = dstCh.CloseWrite()
+	}()
+	go func() {
+		_, _ = io.Copy(dstCh.Stderr(), srcCh.Stderr())
+	}()
+
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(io.MultiWriter(srcCh, rec.Output()), dstCh)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(io.MultiWriter(srcCh.Stderr(), rec.Output()), dstCh.Stderr())
+	}()
+	// Requests such as exit-status are sent before the channel is closed, so
+	// relay them all before closing the originating side.
+	forwardChannelRequests(dstReqs, srcCh, nil)
+	wg.Wait()
+	_ = srcCh.CloseWrite()
+	_ = srcCh.Close()
+	rec.close()
+}
+
+// forwardChannelRequests sends every request received on in to dst, relaying
+// the reply if one is wanted. If observe is not nil it is called with each
+// request before it is sent.
+func forwardChannelRequests(in <-chan *ssh.Request, dst ssh.Channel, observe func(*ssh.Request)) {
+	for req := range in {
+		if observe != nil {
+			observe(req)
+		}
+		ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok = false
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, nil)
+		}
+	}
+}
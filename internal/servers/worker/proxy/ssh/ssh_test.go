@@ -0,0 +1,343 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/hashicorp/boundary/sdk/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"nhooyr.io/websocket"
+)
+
+type testUserPassword struct {
+	username, password string
+}
+
+func (c testUserPassword) GetPublicId() string           { return "csst_test" }
+func (c testUserPassword) Secret() credential.SecretData { return c.password }
+func (c testUserPassword) Username() string              { return c.username }
+func (c testUserPassword) Password() credential.Password { return credential.Password(c.password) }
+
+// testSshServer starts an ssh server which only accepts the provided username
+// and password and answers every exec request by writing the command back and
+// exiting with status 0. Pty and window change requests are accepted. It
+// returns the port of the server and its host key in authorized_keys format.
+func testSshServer(t *testing.T, ctx context.Context, username, password string) (int, string) {
+	t.Helper()
+	require := require.New(t)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(err)
+	conf := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, p []byte) (*ssh.Permissions, error) {
+			if c.User() == username && string(p) == password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid credentials")
+		},
+	}
+	conf.AddHostKey(signer)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	require.NoError(err)
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		_, chans, reqs, err := ssh.NewServerConn(conn, conf)
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(reqs)
+		for newCh := range chans {
+			ch, chReqs, err := newCh.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				for req := range chReqs {
+					switch req.Type {
+					case "exec":
+					case "pty-req", "window-change":
+						_ = req.Reply(true, nil)
+						continue
+					default:
+						_ = req.Reply(false, nil)
+						continue
+					}
+					_ = req.Reply(true, nil)
+					// The payload is the length prefixed command.
+					_, _ = ch.Write(req.Payload[4:])
+					_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+					_ = ch.Close()
+				}
+			}()
+		}
+	}()
+	return port, string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+}
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port, hostKey := testSshServer(t, ctx, "alice", "secret")
+
+	clientAddr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 50000,
+	}
+	si := &session.Info{
+		Id: "one",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId: "mock-session",
+			},
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"mock-connection": {},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress:  clientAddr,
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("ssh://localhost:%d", port),
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo:    si,
+		ConnectionId:   "mock-connection",
+	}
+
+	proxyErr := make(chan error, 1)
+	go func() {
+		proxyErr <- handleProxy(ctx, conf,
+			proxy.WithEgressCredentials([]credential.Credential{
+				testUserPassword{username: "alice", password: "secret"},
+			}),
+			proxy.WithSshHostKeys([]string{hostKey}),
+		)
+	}()
+
+	// The client does not provide any credentials; the worker injects them.
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, chans, reqs, err := ssh.NewClientConn(netConn, "worker", &ssh.ClientConfig{
+		User:            "ignored",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(err)
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+
+	sess, err := client.NewSession()
+	require.NoError(err)
+	out, err := sess.Output("echo hello")
+	require.NoError(err)
+	assert.Equal("echo hello", string(out))
+
+	require.NoError(client.Close())
+	assert.NoError(<-proxyErr)
+}
+
+func TestHandleProxy_HostKey(t *testing.T) {
+	t.Parallel()
+
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherSigner, err := ssh.NewSignerFromKey(otherPriv)
+	require.NoError(t, err)
+	otherKey := string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(otherSigner.PublicKey())))
+
+	tests := []struct {
+		name     string
+		hostKeys []string
+		wantErr  string
+	}{
+		{
+			name:    "no-host-keys",
+			wantErr: "no ssh host keys",
+		},
+		{
+			name:     "unknown-host-key",
+			hostKeys: []string{otherKey},
+			wantErr:  "does not match the host keys of the target",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+			_, proxyConn := proxy.TestWsConn(t, ctx)
+			port, _ := testSshServer(t, ctx, "alice", "secret")
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				ClientConn:     proxyConn,
+				RemoteEndpoint: fmt.Sprintf("ssh://localhost:%d", port),
+				SessionClient:  pbs.NewMockSessionServiceClient(),
+				SessionInfo: &session.Info{
+					Id: "one",
+					ConnInfoMap: map[string]*session.ConnInfo{
+						"mock-connection": {},
+					},
+				},
+				ConnectionId: "mock-connection",
+			}
+			err := handleProxy(ctx, conf,
+				proxy.WithEgressCredentials([]credential.Credential{
+					testUserPassword{username: "alice", password: "secret"},
+				}),
+				proxy.WithSshHostKeys(tt.hostKeys),
+			)
+			require.Error(err)
+			require.Contains(err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+
+	port, hostKey := testSshServer(t, ctx, "alice", "secret")
+	dir := t.TempDir()
+	storage, err := recording.NewStorage(ctx, dir)
+	require.NoError(err)
+
+	conf := proxy.Config{
+		ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("ssh://localhost:%d", port),
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo: &session.Info{
+			Id: "one",
+			LookupSessionResponse: &pbs.LookupSessionResponse{
+				Authorization: &targets.SessionAuthorizationData{
+					SessionId: "mock-session",
+				},
+			},
+			ConnInfoMap: map[string]*session.ConnInfo{
+				"mock-connection": {},
+			},
+		},
+		ConnectionId: "mock-connection",
+	}
+
+	proxyErr := make(chan error, 1)
+	go func() {
+		proxyErr <- handleProxy(ctx, conf,
+			proxy.WithEgressCredentials([]credential.Credential{
+				testUserPassword{username: "alice", password: "secret"},
+			}),
+			proxy.WithSshHostKeys([]string{hostKey}),
+			proxy.WithRecordingStorage(storage),
+			proxy.WithWorkerId("worker"),
+		)
+	}()
+
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, chans, reqs, err := ssh.NewClientConn(netConn, "worker", &ssh.ClientConfig{
+		User:            "ignored",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(err)
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+
+	sess, err := client.NewSession()
+	require.NoError(err)
+	require.NoError(sess.RequestPty("xterm", 24, 80, ssh.TerminalModes{}))
+	require.NoError(sess.WindowChange(40, 120))
+	out, err := sess.Output("echo hello")
+	require.NoError(err)
+	assert.Equal("echo hello", string(out))
+
+	require.NoError(client.Close())
+	assert.NoError(<-proxyErr)
+
+	// The recording is finished asynchronously once the channel closes.
+	var events []*recording.Event
+	var hdr recording.Header
+	require.Eventually(func() bool {
+		f, err := os.Open(filepath.Join(dir, "mock-session", "mock-connection-0.cast"))
+		if err != nil {
+			return false
+		}
+		defer f.Close()
+		r, err := recording.NewReader(ctx, f)
+		if err != nil {
+			return false
+		}
+		hdr, events = r.Header, nil
+		for {
+			e, err := r.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return false
+			}
+			events = append(events, e)
+		}
+		return len(events) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(uint32(80), hdr.Width)
+	assert.Equal(uint32(24), hdr.Height)
+	assert.Equal("xterm", hdr.Env["TERM"])
+	assert.Equal(recording.ResizeEvent, events[0].Type)
+	assert.Equal("120x40", events[0].Data)
+	assert.Equal(recording.OutputEvent, events[1].Type)
+	assert.Equal("echo hello", events[1].Data)
+}
+
+func TestHandleProxy_NoCredentials(t *testing.T) {
+	t.Parallel()
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	_, proxyConn := proxy.TestWsConn(t, ctx)
+	conf := proxy.Config{
+		ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: "ssh://localhost:22",
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo:    &session.Info{},
+		ConnectionId:   "mock-connection",
+	}
+	err := handleProxy(ctx, conf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no egress credentials")
+}
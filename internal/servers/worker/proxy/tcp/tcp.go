@@ -11,7 +11,6 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
-	"nhooyr.io/websocket"
 )
 
 func init() {
@@ -30,7 +29,6 @@ func init() {
 //
 // All options are ignored.
 func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) error {
-	conn := conf.ClientConn
 	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
 	if err != nil {
 		return fmt.Errorf("error parsing endpoint information: %w", err)
@@ -66,7 +64,7 @@ func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) erro
 	conf.SessionInfo.Unlock()
 
 	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	netConn := conf.ClientNetConn(ctx)
 
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
@@ -100,6 +100,17 @@ func ConnectConnection(ctx context.Context, sessClient pbs.SessionServiceClient,
 	return resp.GetStatus(), nil
 }
 
+// CreateRecording is a helper worker function that records on the controller
+// that a recording of a connection has been written to the worker's
+// recording storage. It returns the id of the new session recording.
+func CreateRecording(ctx context.Context, sessClient pbs.SessionServiceClient, req *pbs.CreateSessionRecordingRequest) (string, error) {
+	resp, err := sessClient.CreateSessionRecording(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.GetRecordingId(), nil
+}
+
 func closeConnection(ctx context.Context, sessClient pbs.SessionServiceClient, req *pbs.CloseConnectionRequest) (*pbs.CloseConnectionResponse, error) {
 	const op = "session.closeConnection"
 	resp, err := sessClient.CloseConnection(ctx, req)
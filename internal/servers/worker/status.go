@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -56,6 +57,25 @@ func (w *Worker) LastStatusSuccess() *LastStatusInformation {
 	return w.lastStatusSuccess.Load().(*LastStatusInformation)
 }
 
+// HealthCheck returns an error when the worker isn't running, hasn't reported
+// its status to a controller yet, or when its last successful report is older
+// than the status grace period.
+func (w *Worker) HealthCheck() error {
+	if !w.started.Load() {
+		return errors.New("worker is not running")
+	}
+	if w.operationalState.Load().(servers.OperationalState) == servers.ShutdownOperationalState {
+		return errors.New("worker is shutting down")
+	}
+	if w.LastStatusSuccess() == nil {
+		return errors.New("worker has not reported its status to a controller yet")
+	}
+	if isPastGrace, lastStatusTime, gracePeriod := w.isPastGrace(); isPastGrace {
+		return fmt.Errorf("worker has not reached a controller since %s, longer than the grace period of %s", lastStatusTime.Format(time.RFC3339), gracePeriod)
+	}
+	return nil
+}
+
 // WaitForNextSuccessfulStatusUpdate waits for the next successful status. It's
 // used by testing (and in the future, shutdown) in place of a more opaque and
 // possibly unnecessarily long sleep for things like initial controller
@@ -64,9 +84,15 @@ func (w *Worker) LastStatusSuccess() *LastStatusInformation {
 // The timeout is aligned with the worker's status grace period. A nil error
 // means the status was sent successfully.
 func (w *Worker) WaitForNextSuccessfulStatusUpdate() error {
+	return w.waitForNextSuccessfulStatusUpdate(w.baseContext)
+}
+
+// waitForNextSuccessfulStatusUpdate waits for the next successful status until
+// ctx is done or the worker's status grace period has passed.
+func (w *Worker) waitForNextSuccessfulStatusUpdate(ctx context.Context) error {
 	const op = "worker.(Worker).WaitForNextSuccessfulStatusUpdate"
 	waitStatusStart := time.Now()
-	ctx, cancel := context.WithTimeout(w.baseContext, w.conf.StatusGracePeriodDuration)
+	ctx, cancel := context.WithTimeout(ctx, w.conf.StatusGracePeriodDuration)
 	defer cancel()
 	event.WriteSysEvent(ctx, op, "waiting for next status report to controller")
 	for {
@@ -101,6 +127,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 		si := value.(*session.Info)
 		si.RLock()
 		status := si.Status
+		expiration := si.LookupSessionResponse.GetExpiration()
 		connections := make([]*pbs.Connection, 0, len(si.ConnInfoMap))
 		for k, v := range si.ConnInfoMap {
 			connections = append(connections, &pbs.Connection{
@@ -118,12 +145,14 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 						SessionId:   sessionId,
 						Status:      status,
 						Connections: connections,
+						Expiration:  expiration,
 					},
 				},
 			},
 		})
 		return true
 	})
+	activeSessions.Set(float64(len(activeJobs)))
 
 	// Send status information
 	client := w.controllerStatusConn.Load().(pbs.ServerCoordinationServiceClient)
@@ -143,6 +172,8 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 			Description: w.conf.RawConfig.Worker.Description,
 			Address:     w.conf.RawConfig.Worker.PublicAddr,
 			Tags:        tags,
+			// Controllers don't select workers which are shutting down
+			OperationalState: w.operationalState.Load().(servers.OperationalState).String(),
 		},
 		UpdateTags: w.updateTags.Load(),
 	})
@@ -197,7 +228,17 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 					}
 					si := siRaw.(*session.Info)
 					si.Lock()
-					si.Status = sessInfo.GetStatus()
+					// An unspecified status means the request only updates
+					// other information about the session, such as its
+					// expiration.
+					if sessInfo.GetStatus() != pbs.SESSIONSTATUS_SESSIONSTATUS_UNSPECIFIED {
+						si.Status = sessInfo.GetStatus()
+					}
+					// The expiration is only ever pushed out, when the session
+					// has been extended.
+					if exp := sessInfo.GetExpiration(); exp != nil && exp.AsTime().After(si.LookupSessionResponse.GetExpiration().AsTime()) {
+						si.LookupSessionResponse.Expiration = exp
+					}
 					// Update connection state if there are any connections in
 					// the request.
 					for _, conn := range sessInfo.GetConnections() {
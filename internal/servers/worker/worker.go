@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
 	"github.com/hashicorp/go-hclog"
@@ -45,6 +46,15 @@ type Worker struct {
 	controllerSessionConn *atomic.Value
 	sessionInfoMap        *sync.Map
 
+	// operationalState is the servers.OperationalState reported to the
+	// controllers. Once the worker is shutting down it refuses new
+	// connections.
+	operationalState *atomic.Value
+
+	// recordingStorage is where proxies that support session recording
+	// write their recordings. It is nil if recording is not configured.
+	recordingStorage recording.Storage
+
 	// We store the current set in an atomic value so that we can add
 	// reload-on-sighup behavior later
 	tags *atomic.Value
@@ -68,11 +78,13 @@ func New(conf *Config) (*Worker, error) {
 		controllerResolver:    new(atomic.Value),
 		controllerSessionConn: new(atomic.Value),
 		sessionInfoMap:        new(sync.Map),
+		operationalState:      new(atomic.Value),
 		tags:                  new(atomic.Value),
 	}
 
 	w.lastStatusSuccess.Store((*LastStatusInformation)(nil))
 	w.controllerResolver.Store((*manual.Resolver)(nil))
+	w.operationalState.Store(servers.ActiveOperationalState)
 
 	if conf.RawConfig.Worker == nil {
 		conf.RawConfig.Worker = new(config.Worker)
@@ -89,6 +101,12 @@ func New(conf *Config) (*Worker, error) {
 		return nil, fmt.Errorf("error auto-generating worker name: %w", err)
 	}
 
+	if loc := conf.RawConfig.Worker.RecordingStorage; loc != "" {
+		if w.recordingStorage, err = recording.NewStorage(context.Background(), loc); err != nil {
+			return nil, fmt.Errorf("error setting up recording storage: %w", err)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -116,6 +134,7 @@ func (w *Worker) Start() error {
 	}
 
 	w.baseContext, w.baseCancel = context.WithCancel(context.Background())
+	w.operationalState.Store(servers.ActiveOperationalState)
 
 	scheme := strconv.FormatInt(time.Now().UnixNano(), 36)
 	controllerResolver := manual.NewBuilderWithScheme(scheme)
@@ -151,6 +170,14 @@ func (w *Worker) Shutdown(skipListeners bool) error {
 		return nil
 	}
 
+	// Report the worker as shutting down, so that the controllers stop
+	// giving it new sessions, and let the connections it proxies finish if
+	// configured to do so.
+	w.operationalState.Store(servers.ShutdownOperationalState)
+	if timeout := w.conf.RawConfig.Worker.ShutdownDrainTimeoutDuration; timeout > 0 {
+		w.drainConnections(timeout)
+	}
+
 	// Stop listeners first to prevent new connections to the
 	// controller.
 	defer w.started.Store(false)
@@ -199,6 +226,57 @@ func (w *Worker) Shutdown(skipListeners bool) error {
 	return nil
 }
 
+// drainConnections waits until the controllers know that the worker is
+// shutting down, then until the connections it proxies are closed. Both waits
+// end when the timeout expires.
+func (w *Worker) drainConnections(timeout time.Duration) {
+	const op = "worker.(Worker).drainConnections"
+	ctx := w.baseContext
+	event.WriteSysEvent(ctx, op, "draining connections", "timeout", timeout.String())
+	deadline := time.Now().Add(timeout)
+
+	// The status update tells the controllers to stop selecting this worker
+	statusCtx, statusCancel := context.WithDeadline(ctx, deadline)
+	err := w.waitForNextSuccessfulStatusUpdate(statusCtx)
+	statusCancel()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reporting shutdown to controller"))
+	}
+
+	for {
+		n := w.activeConnectionCount()
+		if n == 0 {
+			event.WriteSysEvent(ctx, op, "all connections drained")
+			return
+		}
+		if time.Now().After(deadline) {
+			event.WriteSysEvent(ctx, op, "drain timeout expired, closing remaining connections", "connections", n)
+			return
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// activeConnectionCount returns the number of connections which are
+// authorized or connected.
+func (w *Worker) activeConnectionCount() int {
+	var n int
+	w.sessionInfoMap.Range(func(_, value interface{}) bool {
+		si := value.(*session.Info)
+		si.RLock()
+		defer si.RUnlock()
+		for _, ci := range si.ConnInfoMap {
+			switch ci.Status {
+			case pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+				pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED:
+				n++
+			}
+		}
+		return true
+	})
+	return n
+}
+
 func (w *Worker) Resolver() *manual.Resolver {
 	raw := w.controllerResolver.Load()
 	if raw == nil {
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerActiveConnectionCount(t *testing.T) {
+	w := &Worker{
+		sessionInfoMap: new(sync.Map),
+	}
+	assert.Equal(t, 0, w.activeConnectionCount())
+
+	w.sessionInfoMap.Store("s_1", &session.Info{
+		Id: "s_1",
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_1": {Id: "sc_1", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED},
+			"sc_2": {Id: "sc_2", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED},
+			"sc_3": {Id: "sc_3", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED},
+		},
+	})
+	w.sessionInfoMap.Store("s_2", &session.Info{
+		Id: "s_2",
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_4": {Id: "sc_4", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED},
+		},
+	})
+	assert.Equal(t, 3, w.activeConnectionCount())
+}
+
+func TestWorkerHandleProxyShuttingDown(t *testing.T) {
+	w := &Worker{
+		operationalState: new(atomic.Value),
+	}
+	w.operationalState.Store(servers.ShutdownOperationalState)
+
+	rec := httptest.NewRecorder()
+	w.handleProxy()(rec, httptest.NewRequest(http.MethodGet, "/v1/proxy", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
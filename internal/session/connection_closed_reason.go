@@ -10,12 +10,13 @@ import (
 type ClosedReason string
 
 const (
-	UnknownReason          ClosedReason = "unknown"
-	ConnectionTimedOut     ClosedReason = "timed out"
-	ConnectionClosedByUser ClosedReason = "closed by end-user"
-	ConnectionCanceled     ClosedReason = "canceled"
-	ConnectionNetworkError ClosedReason = "network error"
-	ConnectionSystemError  ClosedReason = "system error"
+	UnknownReason           ClosedReason = "unknown"
+	ConnectionTimedOut      ClosedReason = "timed out"
+	ConnectionClosedByUser  ClosedReason = "closed by end-user"
+	ConnectionCanceled      ClosedReason = "canceled"
+	ConnectionNetworkError  ClosedReason = "network error"
+	ConnectionSystemError   ClosedReason = "system error"
+	ConnectionClosedByAdmin ClosedReason = "closed by admin"
 )
 
 // String representation of the termination reason
@@ -38,6 +39,8 @@ func convertToClosedReason(s string) (ClosedReason, error) {
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionClosedByAdmin.String():
+		return ConnectionClosedByAdmin, nil
 	default:
 		return "", errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
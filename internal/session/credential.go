@@ -0,0 +1,77 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+const (
+	defaultSessionCredentialTableName = "session_credential"
+)
+
+// Credential represents the credential data which is sent to the worker.
+// The data is opaque to the session package and is encrypted before it is
+// stored in the repository.
+type Credential []byte
+
+// sessionCredential is an egress credential for a session which is stored
+// in the repository.
+type sessionCredential struct {
+	SessionId        string `json:"session_id,omitempty" gorm:"primary_key"`
+	Credential       []byte `json:"credential,omitempty" gorm:"-" wrapping:"pt,credential"`
+	CtCredential     []byte `json:"ct_credential,omitempty" gorm:"column:credential;not_null" wrapping:"ct,credential"`
+	CredentialSha256 []byte `json:"credential_sha256,omitempty" gorm:"primary_key"`
+	KeyId            string `json:"key_id,omitempty" gorm:"not_null"`
+
+	tableName string `gorm:"-"`
+}
+
+func newSessionCredential(ctx context.Context, sessionId string, c Credential) (*sessionCredential, error) {
+	const op = "session.newSessionCredential"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if len(c) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	sum := sha256.Sum256(c)
+	return &sessionCredential{
+		SessionId:        sessionId,
+		Credential:       c,
+		CredentialSha256: sum[:],
+	}, nil
+}
+
+// TableName returns the table name.
+func (c *sessionCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return defaultSessionCredentialTableName
+}
+
+// SetTableName sets the table name.
+func (c *sessionCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *sessionCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(sessionCredential).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, c, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (c *sessionCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(sessionCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
@@ -17,6 +17,9 @@ const (
 
 	// ConnectionStatePrefix for connection state PK ids
 	ConnectionStatePrefix = "scs"
+
+	// RecordingPrefix for recording PK ids
+	RecordingPrefix = "sr"
 )
 
 func newId() (string, error) {
@@ -54,3 +57,12 @@ func newConnectionStateId() (string, error) {
 	}
 	return id, nil
 }
+
+func newRecordingId() (string, error) {
+	const op = "session.newRecordingId"
+	id, err := db.NewPublicId(RecordingPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
@@ -50,13 +50,13 @@ func TestSession_ImmutableFields(t *testing.T) {
 			fieldMask: []string{"Certificate"},
 		},
 		{
-			name: "expiration time",
+			name: "max expiration time",
 			update: func() *Session {
 				s := new.Clone().(*Session)
-				s.ExpirationTime = &ts
+				s.MaxExpirationTime = &ts
 				return s
 			}(),
-			fieldMask: []string{"ExpirationTime"},
+			fieldMask: []string{"MaxExpirationTime"},
 		},
 		{
 			name: "create time",
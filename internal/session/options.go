@@ -19,16 +19,17 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withLimit             int
-	withOrderByCreateTime db.OrderBy
-	withScopeIds          []string
-	withUserId            string
-	withExpirationTime    *timestamp.Timestamp
-	withTestTofu          []byte
-	withListingConvert    bool
-	withSessionIds        []string
-	withServerId          string
-	withDbOpts            []db.Option
+	withLimit              int
+	withStartPageAfterItem *db.PageItem
+	withOrderByCreateTime  db.OrderBy
+	withScopeIds           []string
+	withUserId             string
+	withExpirationTime     *timestamp.Timestamp
+	withTestTofu           []byte
+	withListingConvert     bool
+	withSessionIds         []string
+	withServerId           string
+	withDbOpts             []db.Option
 }
 
 func getDefaultOptions() options {
@@ -44,6 +45,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
 // WithOrderByCreateTime provides an option to specify ordering by the
 // CreateTime field.
 func WithOrderByCreateTime(orderBy db.OrderBy) Option {
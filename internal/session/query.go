@@ -150,15 +150,50 @@ session_connection_limit(expiration_time, connection_limit) as (
 select expiration_time, connection_limit, current_connection_count 
 from  
 	session_connection_limit, session_connection_count;	
+`
+	// extendSession extends the expiration time of a session by @seconds, up
+	// to its max expiration time. Only sessions which are pending or active and
+	// haven't expired yet can be extended.
+	extendSession = `
+update session
+set
+	expiration_time = least(expiration_time + make_interval(secs => @seconds), max_expiration_time)
+where
+	public_id = @session_id and
+	version = @version and
+	expiration_time > now() and
+	expiration_time < max_expiration_time and
+	public_id in (
+		select
+			session_id
+		from
+			session_state
+		where
+			session_id = @session_id and
+			state in ('pending', 'active') and
+			-- if there's no end_time, then this is the current state.
+			end_time is null
+	);
+`
+	// closeSessionConnection records @closed_reason for a connection of a
+	// session which isn't closed yet, which moves the connection to the closed
+	// state.
+	closeSessionConnection = `
+update session_connection
+set
+	closed_reason = @closed_reason
+where
+	public_id = @connection_id and
+	session_id = @session_id and
+	closed_reason is null;
 `
 	sessionList = `
 select * 
 from
-	(select public_id from session %s) s,
+	(select public_id from session s %s %s %s) s,
 	session_with_state ss
 where 
 	s.public_id = ss.public_id 
-	%s
 %s
 `
 
@@ -350,6 +385,20 @@ with
     -- Below fmt arg is filled in if there are session IDs to filter against
     %s
   `
+
+	listSessionCredentialsByKeyIdQuery = `
+select session_id, credential, credential_sha256
+  from session_credential
+ where key_id = ?;
+`
+
+	rewrapSessionCredentialQuery = `
+update session_credential
+   set credential = ?,
+       key_id     = ?
+ where session_id        = ?
+   and credential_sha256 = ?;
+`
 )
 
 const (
@@ -0,0 +1,184 @@
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultRecordingTableName = "session_recording"
+)
+
+// Recording contains information about a recording of a terminal channel of
+// a session's connection. The recording itself is held in the worker's
+// recording storage at StorageUri.
+type Recording struct {
+	// PublicId is used to access the recording via an API
+	PublicId string `json:"public_id,omitempty" gorm:"primary_key"`
+	// SessionId of the recording
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// ConnectionId of the recording
+	ConnectionId string `json:"connection_id,omitempty" gorm:"default:null"`
+	// ScopeId of the session the recording was made for
+	ScopeId string `json:"scope_id,omitempty" gorm:"default:null"`
+	// TargetId of the session the recording was made for
+	TargetId string `json:"target_id,omitempty" gorm:"default:null"`
+	// UserId of the session the recording was made for
+	UserId string `json:"user_id,omitempty" gorm:"default:null"`
+	// WorkerId of the worker which made the recording
+	WorkerId string `json:"worker_id,omitempty" gorm:"default:null"`
+	// StorageUri is the location of the recording
+	StorageUri string `json:"storage_uri,omitempty" gorm:"default:null"`
+	// Bytes is the size of the recording
+	Bytes uint64 `json:"bytes,omitempty" gorm:"default:null"`
+	// StartTime of the recording
+	StartTime *timestamp.Timestamp `json:"start_time,omitempty" gorm:"default:null"`
+	// EndTime of the recording
+	EndTime *timestamp.Timestamp `json:"end_time,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// UpdateTime from the RDBMS
+	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	// Version of the recording
+	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+
+	tableName string `gorm:"-"`
+}
+
+func (r *Recording) GetPublicId() string {
+	return r.PublicId
+}
+
+var (
+	_ Cloneable       = (*Recording)(nil)
+	_ db.VetForWriter = (*Recording)(nil)
+)
+
+// NewRecording creates a new in memory recording of a connection of
+// sessionId. The scope, target and user of the recording are set from the
+// session when the recording is created in the repository. No options are
+// currently supported.
+func NewRecording(sessionId, connectionId, workerId, storageUri string, bytes uint64, startTime, endTime *timestamp.Timestamp, _ ...Option) (*Recording, error) {
+	const op = "session.NewRecording"
+	r := Recording{
+		SessionId:    sessionId,
+		ConnectionId: connectionId,
+		WorkerId:     workerId,
+		StorageUri:   storageUri,
+		Bytes:        bytes,
+		StartTime:    startTime,
+		EndTime:      endTime,
+	}
+	if err := r.validateNewRecording(); err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
+	return &r, nil
+}
+
+// AllocRecording will allocate a Recording.
+func AllocRecording() Recording {
+	return Recording{}
+}
+
+// Clone creates a clone of the Recording.
+func (r *Recording) Clone() interface{} {
+	clone := &Recording{
+		PublicId:     r.PublicId,
+		SessionId:    r.SessionId,
+		ConnectionId: r.ConnectionId,
+		ScopeId:      r.ScopeId,
+		TargetId:     r.TargetId,
+		UserId:       r.UserId,
+		WorkerId:     r.WorkerId,
+		StorageUri:   r.StorageUri,
+		Bytes:        r.Bytes,
+		Version:      r.Version,
+	}
+	if r.StartTime != nil {
+		clone.StartTime = proto.Clone(r.StartTime).(*timestamp.Timestamp)
+	}
+	if r.EndTime != nil {
+		clone.EndTime = proto.Clone(r.EndTime).(*timestamp.Timestamp)
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = proto.Clone(r.CreateTime).(*timestamp.Timestamp)
+	}
+	if r.UpdateTime != nil {
+		clone.UpdateTime = proto.Clone(r.UpdateTime).(*timestamp.Timestamp)
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// recording before it's written. Recordings cannot be updated.
+func (r *Recording) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "session.(Recording).VetForWrite"
+	if r.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	switch opType {
+	case db.CreateOp:
+		if err := r.validateNewRecording(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if r.ScopeId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+		}
+		if r.TargetId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+		}
+		if r.UserId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+		}
+	case db.UpdateOp:
+		return errors.New(ctx, errors.InvalidParameter, op, "recordings are immutable")
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *Recording) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultRecordingTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *Recording) SetTableName(n string) {
+	r.tableName = n
+}
+
+// validateNewRecording checks everything but the recording's PublicId and
+// the fields copied from the session.
+func (r *Recording) validateNewRecording() error {
+	const op = "session.(Recording).validateNewRecording"
+	if r.SessionId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing session id")
+	}
+	if r.ConnectionId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing connection id")
+	}
+	if r.WorkerId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing worker id")
+	}
+	if r.StorageUri == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing storage uri")
+	}
+	if r.StartTime.GetTimestamp() == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing start time")
+	}
+	if r.EndTime.GetTimestamp() == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing end time")
+	}
+	if r.EndTime.GetTimestamp().AsTime().Before(r.StartTime.GetTimestamp().AsTime()) {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "end time is before start time")
+	}
+	return nil
+}
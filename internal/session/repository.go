@@ -51,7 +51,8 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 }
 
 // list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit.  Supports WithOrder option.
+// repo defaultLimit.  Supports WithOrder and
+// WithStartPageAfterItem options.
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	const op = "session.(Repository).list"
 	opts := getOpts(opt...)
@@ -62,6 +63,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		limit = opts.withLimit
 	}
 	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithStartPageAfterItem(opts.withStartPageAfterItem))
 	switch opts.withOrderByCreateTime {
 	case db.AscendingOrderBy:
 		dbOpts = append(dbOpts, db.WithOrder("create_time asc"))
@@ -105,6 +107,7 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionsWithState []
 				ScopeId:           sv.ScopeId,
 				Certificate:       sv.Certificate,
 				ExpirationTime:    sv.ExpirationTime,
+				MaxExpirationTime: sv.MaxExpirationTime,
 				CtTofuToken:       sv.CtTofuToken,
 				TofuToken:         sv.TofuToken, // will always be nil since it's not stored in the database.
 				TerminationReason: sv.TerminationReason,
@@ -67,6 +67,56 @@ func (r *Repository) ListConnectionsBySessionId(ctx context.Context, sessionId s
 	return connections, nil
 }
 
+// CloseSessionConnection closes the connection of the session on behalf of an
+// admin, which records ConnectionClosedByAdmin as the connection's closed
+// reason. The worker proxying the connection tears it down after its next
+// status update. Closing a connection which is already closed has no effect. It
+// returns the connection with its states.
+func (r *Repository) CloseSessionConnection(ctx context.Context, sessionId, connectionId string, _ ...Option) (*Connection, []*ConnectionState, error) {
+	const op = "session.(Repository).CloseSessionConnection"
+	if sessionId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if connectionId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+	connection := AllocConnection()
+	connection.PublicId = connectionId
+	var states []*ConnectionState
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			rowsAffected, err := w.Exec(ctx, closeSessionConnection, []interface{}{
+				sql.Named("closed_reason", ConnectionClosedByAdmin.String()),
+				sql.Named("connection_id", connectionId),
+				sql.Named("session_id", sessionId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to close connection %s", connectionId)))
+			}
+			if rowsAffected > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d would have been updated for connection %s", rowsAffected, connectionId))
+			}
+			if err := read.LookupById(ctx, &connection); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", connectionId)))
+			}
+			if connection.SessionId != sessionId {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("connection %s not found for session %s", connectionId, sessionId))
+			}
+			if states, err = fetchConnectionStates(ctx, read, connectionId, db.WithOrder("start_time desc")); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return &connection, states, nil
+}
+
 // DeleteConnection will delete a connection from the repository.
 func (r *Repository) DeleteConnection(ctx context.Context, publicId string, _ ...Option) (int, error) {
 	const op = "session.(Repository).DeleteConnection"
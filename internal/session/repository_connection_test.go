@@ -212,6 +212,83 @@ func TestRepository_DeleteConnection(t *testing.T) {
 	}
 }
 
+func TestRepository_CloseSessionConnection(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	session := TestDefaultSession(t, conn, wrapper, iamRepo)
+	otherSession := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	tests := []struct {
+		name         string
+		sessionId    string
+		connectionId string
+		wantErrMsg   string
+	}{
+		{
+			name:         "valid",
+			sessionId:    session.PublicId,
+			connectionId: TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222).PublicId,
+		},
+		{
+			name:         "other-session",
+			sessionId:    otherSession.PublicId,
+			connectionId: TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222).PublicId,
+			wantErrMsg:   "not found for session",
+		},
+		{
+			name:         "not-found",
+			sessionId:    session.PublicId,
+			connectionId: "sc_1234567890",
+			wantErrMsg:   "record not found",
+		},
+		{
+			name:         "missing-session-id",
+			connectionId: "sc_1234567890",
+			wantErrMsg:   "session.(Repository).CloseSessionConnection: missing session id: parameter violation: error #100",
+		},
+		{
+			name:       "missing-connection-id",
+			sessionId:  session.PublicId,
+			wantErrMsg: "session.(Repository).CloseSessionConnection: missing connection id: parameter violation: error #100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			c, states, err := repo.CloseSessionConnection(ctx, tt.sessionId, tt.connectionId)
+			if tt.wantErrMsg != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrMsg)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(ConnectionClosedByAdmin.String(), c.ClosedReason)
+			require.NotEmpty(states)
+			assert.Equal(StatusClosed, states[0].Status)
+
+			// Closing it again has no effect.
+			c, states, err = repo.CloseSessionConnection(ctx, tt.sessionId, tt.connectionId)
+			require.NoError(err)
+			assert.Equal(ConnectionClosedByAdmin.String(), c.ClosedReason)
+			assert.Equal(StatusClosed, states[0].Status)
+
+			// The worker reporting the connection closed keeps the reason.
+			resp, err := repo.CloseConnections(ctx, []CloseWith{{ConnectionId: tt.connectionId, BytesUp: 10, BytesDown: 20, ClosedReason: UnknownReason}})
+			require.NoError(err)
+			require.Len(resp, 1)
+			assert.Equal(ConnectionClosedByAdmin.String(), resp[0].Connection.ClosedReason)
+			assert.Equal(uint64(10), resp[0].Connection.BytesUp)
+		})
+	}
+}
+
 func TestRepository_CloseDeadConnectionsOnWorker(t *testing.T) {
 	t.Parallel()
 	require, assert := require.New(t), assert.New(t)
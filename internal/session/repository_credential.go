@@ -0,0 +1,100 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// AddSessionCredentials encrypts the credData and adds a credential
+// representation to the database for the specified session id. The
+// credentials are the egress credentials a worker injects into the
+// connection between the worker and the endpoint, and are deleted when the
+// session enters the canceling or terminated state.
+//
+// All options are ignored.
+func (r *Repository) AddSessionCredentials(ctx context.Context, sessScopeId, sessionId string, credData []Credential, _ ...Option) error {
+	const op = "session.(Repository).AddSessionCredentials"
+	if sessScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session scope id")
+	}
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if len(credData) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing credentials")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, sessScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	addCreds := make([]interface{}, 0, len(credData))
+	for _, cred := range credData {
+		c, err := newSessionCredential(ctx, sessionId, cred)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		addCreds = append(addCreds, c)
+	}
+
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.CreateItems(ctx, addCreds); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for session %s", sessionId)))
+	}
+	return nil
+}
+
+// ListSessionCredentials returns the decrypted egress credentials for the
+// specified session id. If the session has no credentials, or they have been
+// deleted because the session is canceling or terminated, no credentials and
+// no error are returned.
+//
+// All options are ignored.
+func (r *Repository) ListSessionCredentials(ctx context.Context, sessScopeId, sessionId string, _ ...Option) ([]Credential, error) {
+	const op = "session.(Repository).ListSessionCredentials"
+	if sessScopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session scope id")
+	}
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+
+	var creds []*sessionCredential
+	if err := r.reader.SearchWhere(ctx, &creds, "session_id = ?", []interface{}{sessionId}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) == 0 {
+		return nil, nil
+	}
+
+	ret := make([]Credential, 0, len(creds))
+	for _, c := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, sessScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.KeyId))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, c.Credential)
+	}
+	return ret, nil
+}
@@ -0,0 +1,127 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AddSessionCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	session := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	tests := []struct {
+		name        string
+		scopeId     string
+		sessionId   string
+		creds       []Credential
+		wantIsError errors.Code
+	}{
+		{
+			name:        "missing-scope-id",
+			sessionId:   session.PublicId,
+			creds:       []Credential{[]byte("cred")},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-session-id",
+			scopeId:     session.ScopeId,
+			creds:       []Credential{[]byte("cred")},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-credentials",
+			scopeId:     session.ScopeId,
+			sessionId:   session.PublicId,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "empty-credential",
+			scopeId:     session.ScopeId,
+			sessionId:   session.PublicId,
+			creds:       []Credential{nil},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:      "valid",
+			scopeId:   session.ScopeId,
+			sessionId: session.PublicId,
+			creds:     []Credential{[]byte("cred-one"), []byte("cred-two")},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			err := repo.AddSessionCredentials(context.Background(), tt.scopeId, tt.sessionId, tt.creds)
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+
+			got, err := repo.ListSessionCredentials(context.Background(), tt.scopeId, tt.sessionId)
+			require.NoError(err)
+			assert.ElementsMatch(tt.creds, got)
+		})
+	}
+}
+
+func TestRepository_ListSessionCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("missing-params", func(t *testing.T) {
+		_, err := repo.ListSessionCredentials(ctx, "", "s_1234567890")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+		_, err = repo.ListSessionCredentials(ctx, "p_1234567890", "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+
+	t.Run("no-credentials", func(t *testing.T) {
+		session := TestDefaultSession(t, conn, wrapper, iamRepo)
+		got, err := repo.ListSessionCredentials(ctx, session.ScopeId, session.PublicId)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("deleted-on-cancel", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		session := TestDefaultSession(t, conn, wrapper, iamRepo)
+		creds := []Credential{[]byte("cred-one")}
+		require.NoError(repo.AddSessionCredentials(ctx, session.ScopeId, session.PublicId, creds))
+
+		got, err := repo.ListSessionCredentials(ctx, session.ScopeId, session.PublicId)
+		require.NoError(err)
+		assert.Equal(creds, got)
+
+		_, err = repo.CancelSession(ctx, session.PublicId, session.Version)
+		require.NoError(err)
+
+		got, err = repo.ListSessionCredentials(ctx, session.ScopeId, session.PublicId)
+		require.NoError(err)
+		assert.Empty(got)
+	})
+}
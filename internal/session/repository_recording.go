@@ -0,0 +1,103 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// CreateRecording inserts into the repository a recording of one of the
+// session's connections and returns the new Recording. The scope, target and
+// user of the recording are copied from the session so they remain available
+// after the session has been deleted. No options are currently supported.
+func (r *Repository) CreateRecording(ctx context.Context, newRecording *Recording, _ ...Option) (*Recording, error) {
+	const op = "session.(Repository).CreateRecording"
+	if newRecording == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing recording")
+	}
+	if newRecording.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id is not empty")
+	}
+	if err := newRecording.validateNewRecording(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	id, err := newRecordingId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedRecording *Recording
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			sess := AllocSession()
+			sess.PublicId = newRecording.SessionId
+			if err := read.LookupById(ctx, &sess); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to look up session %s", newRecording.SessionId)))
+			}
+			returnedRecording = newRecording.Clone().(*Recording)
+			returnedRecording.PublicId = id
+			returnedRecording.ScopeId = sess.ScopeId
+			returnedRecording.TargetId = sess.TargetId
+			returnedRecording.UserId = sess.UserId
+			if err := w.Create(ctx, returnedRecording); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for session %s", newRecording.SessionId)))
+	}
+	return returnedRecording, nil
+}
+
+// LookupRecording will look up a recording in the repository. If the
+// recording is not found, it will return nil, nil. No options are currently
+// supported.
+func (r *Repository) LookupRecording(ctx context.Context, recordingId string, _ ...Option) (*Recording, error) {
+	const op = "session.(Repository).LookupRecording"
+	if recordingId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing recording id")
+	}
+	recording := AllocRecording()
+	recording.PublicId = recordingId
+	if err := r.reader.LookupById(ctx, &recording); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", recordingId)))
+	}
+	return &recording, nil
+}
+
+// ListRecordings will list recordings. Supports the WithLimit,
+// WithOrderByCreateTime, WithStartPageAfterItem, WithScopeIds and
+// WithSessionIds options.
+func (r *Repository) ListRecordings(ctx context.Context, opt ...Option) ([]*Recording, error) {
+	const op = "session.(Repository).ListRecordings"
+	opts := getOpts(opt...)
+	if len(opts.withScopeIds) == 0 && len(opts.withSessionIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids or session ids")
+	}
+	var where string
+	var args []interface{}
+	switch {
+	case len(opts.withScopeIds) > 0 && len(opts.withSessionIds) > 0:
+		where, args = "scope_id in (?) and session_id in (?)", []interface{}{opts.withScopeIds, opts.withSessionIds}
+	case len(opts.withScopeIds) > 0:
+		where, args = "scope_id in (?)", []interface{}{opts.withScopeIds}
+	default:
+		where, args = "session_id in (?)", []interface{}{opts.withSessionIds}
+	}
+	var recordings []*Recording
+	if err := r.list(ctx, &recordings, where, args, opt...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return recordings, nil
+}
@@ -0,0 +1,146 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateRecording(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	sess := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	start := time.Now().Truncate(time.Microsecond)
+
+	tests := []struct {
+		name        string
+		recording   func() *Recording
+		wantIsError errors.Code
+	}{
+		{
+			name:        "nil-recording",
+			recording:   func() *Recording { return nil },
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			recording: func() *Recording {
+				r, err := NewRecording(sess.PublicId, c.PublicId, "worker", "file:///tmp/public-id-set.cast", 10,
+					timestamp.New(start), timestamp.New(start))
+				require.NoError(t, err)
+				r.PublicId = "sr_1234567890"
+				return r
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "unknown-session",
+			recording: func() *Recording {
+				r, err := NewRecording("s_1234567890", c.PublicId, "worker", "file:///tmp/unknown-session.cast", 10,
+					timestamp.New(start), timestamp.New(start))
+				require.NoError(t, err)
+				return r
+			},
+			wantIsError: errors.RecordNotFound,
+		},
+		{
+			name: "valid",
+			recording: func() *Recording {
+				r, err := NewRecording(sess.PublicId, c.PublicId, "worker", "file:///tmp/valid.cast", 10,
+					timestamp.New(start), timestamp.New(start.Add(time.Second)))
+				require.NoError(t, err)
+				return r
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateRecording(context.Background(), tt.recording())
+			if tt.wantIsError != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "want err: %q got: %q", tt.wantIsError, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(sess.ScopeId, got.ScopeId)
+			assert.Equal(sess.TargetId, got.TargetId)
+			assert.Equal(sess.UserId, got.UserId)
+
+			found, err := repo.LookupRecording(context.Background(), got.PublicId)
+			require.NoError(err)
+			require.NotNil(found)
+			assert.Equal(got.StorageUri, found.StorageUri)
+			assert.Equal(got.Bytes, found.Bytes)
+			assert.True(got.StartTime.AsTime().Equal(found.StartTime.AsTime()))
+		})
+	}
+}
+
+func TestRepository_ListRecordings(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	sess := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	r1 := TestRecording(t, conn, sess, c.PublicId, "file:///tmp/list-1.cast")
+	r2 := TestRecording(t, conn, sess, c.PublicId, "file:///tmp/list-2.cast")
+
+	t.Run("missing-filters", func(t *testing.T) {
+		_, err := repo.ListRecordings(context.Background())
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+	t.Run("by-scope", func(t *testing.T) {
+		got, err := repo.ListRecordings(context.Background(), WithScopeIds([]string{sess.ScopeId}))
+		require.NoError(t, err)
+		var ids []string
+		for _, r := range got {
+			ids = append(ids, r.PublicId)
+		}
+		assert.ElementsMatch(t, []string{r1.PublicId, r2.PublicId}, ids)
+	})
+	t.Run("by-session", func(t *testing.T) {
+		got, err := repo.ListRecordings(context.Background(), WithSessionIds(sess.PublicId), WithLimit(1))
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+	t.Run("other-scope", func(t *testing.T) {
+		got, err := repo.ListRecordings(context.Background(), WithScopeIds([]string{"p_1234567890"}))
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("session-deleted", func(t *testing.T) {
+		_, err := repo.DeleteSession(context.Background(), sess.PublicId)
+		require.NoError(t, err)
+		found, err := repo.LookupRecording(context.Background(), r1.PublicId)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Empty(t, found.SessionId)
+		assert.Equal(t, sess.TargetId, found.TargetId)
+	})
+}
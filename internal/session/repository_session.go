@@ -19,8 +19,8 @@ import (
 
 // CreateSession inserts into the repository and returns the new Session with
 // its State of "Pending".  The following fields must be empty when creating a
-// session: ServerId, ServerType, and PublicId.  No options are
-// currently supported.
+// session: ServerId, ServerType, and PublicId. The MaxExpirationTime defaults to
+// the ExpirationTime. No options are currently supported.
 func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, _ ...Option) (*Session, ed25519.PrivateKey, error) {
 	const op = "session.(Repository).CreateSession"
 	if newSession == nil {
@@ -65,13 +65,21 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if newSession.ExpirationTime == nil || newSession.ExpirationTime.Timestamp.AsTime().IsZero() {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing expiration time")
 	}
+	if newSession.MaxExpirationTime == nil || newSession.MaxExpirationTime.Timestamp.AsTime().IsZero() {
+		newSession.MaxExpirationTime = newSession.ExpirationTime
+	}
+	if newSession.MaxExpirationTime.Timestamp.AsTime().Before(newSession.ExpirationTime.Timestamp.AsTime()) {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "max expiration time is before expiration time")
+	}
 
 	id, err := newId()
 	if err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
-	privKey, certBytes, err := newCert(ctx, sessionWrapper, newSession.UserId, id, newSession.ExpirationTime.Timestamp.AsTime())
+	// The certificate is valid until the max expiration time so that it's
+	// still valid after the session is extended.
+	privKey, certBytes, err := newCert(ctx, sessionWrapper, newSession.UserId, id, newSession.MaxExpirationTime.Timestamp.AsTime())
 	if err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
@@ -195,7 +203,8 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, _ ...O
 	return &session, authzSummary, nil
 }
 
-// ListSessions will sessions.  Supports the WithLimit, WithScopeId, WithSessionIds, and WithServerId options.
+// ListSessions will sessions.  Supports the WithLimit, WithScopeId, WithSessionIds,
+// WithServerId and WithStartPageAfterItem options.
 func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Session, error) {
 	const op = "session.(Repository).ListSessions"
 	opts := getOpts(opt...)
@@ -233,6 +242,11 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 		inClauseCnt += 1
 		where, args = append(where, fmt.Sprintf("server_id = @%d", inClauseCnt)), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), opts.withServerId))
 	}
+	if item := opts.withStartPageAfterItem; item != nil && item.PublicId != "" {
+		inClauseCnt += 2
+		where = append(where, fmt.Sprintf("(create_time, public_id) > (@%d, @%d)", inClauseCnt-1, inClauseCnt))
+		args = append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt-1), item.CreateTime), sql.Named(fmt.Sprintf("%d", inClauseCnt), item.PublicId))
+	}
 
 	var limit string
 	switch {
@@ -244,20 +258,27 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 		limit = fmt.Sprintf("limit %d", opts.withLimit)
 	}
 
-	var withOrder string
-	switch opts.withOrderByCreateTime {
-	case db.AscendingOrderBy:
+	// The sessions are filtered, ordered and limited before they are joined
+	// with their states, so the limit applies to the matching sessions.
+	var withOrder, withSessionOrder string
+	switch {
+	case opts.withStartPageAfterItem != nil:
+		withSessionOrder = "order by create_time asc, public_id asc"
+		withOrder = "order by ss.create_time asc, ss.public_id asc"
+	case opts.withOrderByCreateTime == db.AscendingOrderBy:
+		withSessionOrder = "order by create_time asc"
 		withOrder = "order by create_time asc"
-	case db.DescendingOrderBy:
+	case opts.withOrderByCreateTime == db.DescendingOrderBy:
+		withSessionOrder = "order by create_time"
 		withOrder = "order by create_time"
 	}
 
 	var whereClause string
 	if len(where) > 0 {
-		whereClause = " and " + strings.Join(where, " and ")
+		whereClause = "where " + strings.Join(where, " and ")
 	}
 	q := sessionList
-	query := fmt.Sprintf(q, limit, whereClause, withOrder)
+	query := fmt.Sprintf(q, whereClause, withSessionOrder, limit, withOrder)
 
 	rows, err := r.reader.Query(ctx, query, args)
 	if err != nil {
@@ -341,6 +362,58 @@ func (r *Repository) CancelSession(ctx context.Context, sessionId string, sessio
 	return s, nil
 }
 
+// ExtendSession extends the expiration time of a session in the repo by the
+// given number of seconds, up to the session's max expiration time. Only
+// sessions which are pending or active and haven't expired yet can be
+// extended. Workers learn the new expiration time during their next status
+// heartbeat.
+func (r *Repository) ExtendSession(ctx context.Context, sessionId string, sessionVersion uint32, seconds uint32) (*Session, error) {
+	const op = "session.(Repository).ExtendSession"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if sessionVersion == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session version")
+	}
+	if seconds == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing seconds")
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsAffected, err := w.Exec(ctx, extendSession, []interface{}{
+				sql.Named("seconds", seconds),
+				sql.Named("session_id", sessionId),
+				sql.Named("version", sessionVersion),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to extend session %s", sessionId)))
+			}
+			switch rowsAffected {
+			case 0:
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s can't be extended (version mismatch, not pending or active, expired or max expiration time reached)", sessionId))
+			case 1:
+				return nil
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("extended session %s and %d rows updated", sessionId, rowsAffected))
+			}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, _, err := r.LookupSession(ctx, sessionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found", sessionId))
+	}
+	return s, nil
+}
+
 // TerminateSession sets a session's termination reason and it's state to
 // "terminated" Sessions cannot be terminated which still have connections that
 // are not closed.
@@ -1257,6 +1257,133 @@ func TestRepository_CancelSession(t *testing.T) {
 	}
 }
 
+func TestRepository_ExtendSession(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	maxExp := exp.Add(time.Hour)
+	setupFn := func(max *time.Time) *Session {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ExpirationTime = &timestamp.Timestamp{Timestamp: timestamppb.New(exp)}
+		if max != nil {
+			composedOf.MaxExpirationTime = &timestamp.Timestamp{Timestamp: timestamppb.New(*max)}
+		}
+		return TestSession(t, conn, wrapper, composedOf)
+	}
+	tests := []struct {
+		name                   string
+		session                *Session
+		overrideSessionId      *string
+		overrideSessionVersion *uint32
+		seconds                uint32
+		wantExpiration         time.Time
+		wantIsError            errors.Code
+	}{
+		{
+			name:           "valid",
+			session:        setupFn(&maxExp),
+			seconds:        1800,
+			wantExpiration: exp.Add(30 * time.Minute),
+		},
+		{
+			name:           "capped-at-max-expiration",
+			session:        setupFn(&maxExp),
+			seconds:        3 * 3600,
+			wantExpiration: maxExp,
+		},
+		{
+			name:        "not-extendable",
+			session:     setupFn(&exp),
+			seconds:     1800,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name:        "no-max-expiration",
+			session:     setupFn(nil),
+			seconds:     1800,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name: "canceling",
+			session: func() *Session {
+				s := setupFn(&maxExp)
+				s, err := repo.CancelSession(context.Background(), s.PublicId, s.Version)
+				require.NoError(t, err)
+				return s
+			}(),
+			seconds:     1800,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name:    "bad-version-id",
+			session: setupFn(&maxExp),
+			overrideSessionVersion: func() *uint32 {
+				v := uint32(101)
+				return &v
+			}(),
+			seconds:     1800,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name:    "missing-session-id",
+			session: setupFn(&maxExp),
+			overrideSessionId: func() *string {
+				id := ""
+				return &id
+			}(),
+			seconds:     1800,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-version-id",
+			session: setupFn(&maxExp),
+			overrideSessionVersion: func() *uint32 {
+				v := uint32(0)
+				return &v
+			}(),
+			seconds:     1800,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-seconds",
+			session:     setupFn(&maxExp),
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			id := tt.session.PublicId
+			if tt.overrideSessionId != nil {
+				id = *tt.overrideSessionId
+			}
+			version := tt.session.Version
+			if tt.overrideSessionVersion != nil {
+				version = *tt.overrideSessionVersion
+			}
+			s, err := repo.ExtendSession(context.Background(), id, version, tt.seconds)
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			require.NotNil(s)
+			assert.True(tt.wantExpiration.Equal(s.ExpirationTime.GetTimestamp().AsTime()), "want %s, got %s", tt.wantExpiration, s.ExpirationTime.GetTimestamp().AsTime())
+			assert.True(maxExp.Equal(s.MaxExpirationTime.GetTimestamp().AsTime()))
+			assert.Equal(version+1, s.Version)
+			assert.Equal(tt.session.Certificate, s.Certificate)
+		})
+	}
+}
+
 func TestRepository_CancelSessionViaFKNull(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1436,6 +1563,48 @@ func TestRepository_CancelSessionViaFKNull(t *testing.T) {
 	}
 }
 
+func TestRepository_CancelSessionViaRevokeAuthTokens(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	atRepo, err := authtoken.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	_ = TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	other := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	tokenIds, sessionIds, err := atRepo.RevokeAuthTokens(ctx, s.UserId)
+	require.NoError(err)
+	assert.Equal([]string{s.AuthTokenId}, tokenIds)
+	assert.Equal([]string{s.PublicId}, sessionIds)
+
+	got, _, err := repo.LookupSession(ctx, s.PublicId)
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Empty(got.AuthTokenId)
+	assert.Equal(StatusCanceling, got.States[0].Status)
+
+	got, _, err = repo.LookupSession(ctx, other.PublicId)
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(other.AuthTokenId, got.AuthTokenId)
+	assert.Equal(StatusPending, got.States[0].Status)
+
+	// Revoking again finds no tokens and doesn't report the canceled session.
+	tokenIds, sessionIds, err = atRepo.RevokeAuthTokens(ctx, s.UserId)
+	require.NoError(err)
+	assert.Empty(tokenIds)
+	assert.Empty(sessionIds)
+}
+
 func TestRepository_ActivateSession(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("session_credential", sessionCredentialRewrapFn)
+}
+
+func sessionCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "session.sessionCredentialRewrapFn"
+	rows, err := reader.Query(ctx, listSessionCredentialsByKeyIdQuery, []interface{}{dataKeyVersionId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list session credentials"))
+	}
+	var creds []*sessionCredential
+	for rows.Next() {
+		var c sessionCredential
+		if err := rows.Scan(&c.SessionId, &c.CtCredential, &c.CredentialSha256); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, &c)
+	}
+	rows.Close()
+	if len(creds) == 0 {
+		return nil
+	}
+	old, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(dataKeyVersionId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get previous database wrapper"))
+	}
+	current, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get current database wrapper"))
+	}
+	_, err = writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range creds {
+				if err := c.decrypt(ctx, old); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := c.encrypt(ctx, current); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, rewrapSessionCredentialQuery, []interface{}{c.CtCredential, c.KeyId, c.SessionId, c.CredentialSha256})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update session credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -42,6 +42,10 @@ type ComposedOf struct {
 	Endpoint string
 	// Expiration time for the session
 	ExpirationTime *timestamp.Timestamp
+	// MaxExpirationTime is the latest time the expiration time of the session
+	// can be extended to. It defaults to the ExpirationTime, which means the
+	// session can't be extended.
+	MaxExpirationTime *timestamp.Timestamp
 	// Max connections for the session
 	ConnectionLimit int32
 	// Worker filter. Active filter when the session was created, used to
@@ -80,6 +84,9 @@ type Session struct {
 	Certificate []byte `json:"certificate,omitempty" gorm:"default:null"`
 	// ExpirationTime - after this time the connection will be expired, e.g. forcefully terminated
 	ExpirationTime *timestamp.Timestamp `json:"expiration_time,omitempty" gorm:"default:null"`
+	// MaxExpirationTime - the latest time the expiration time can be extended
+	// to, which is also when the certificate expires
+	MaxExpirationTime *timestamp.Timestamp `json:"max_expiration_time,omitempty" gorm:"default:null"`
 	// CtTofuToken is the ciphertext Tofutoken value stored in the database
 	CtTofuToken []byte `json:"ct_tofu_token,omitempty" gorm:"column:tofu_token;default:null" wrapping:"ct,tofu_token"`
 	// TofuToken - plain text of the "trust on first use" token for session
@@ -136,6 +143,7 @@ func New(c ComposedOf, _ ...Option) (*Session, error) {
 		ScopeId:            c.ScopeId,
 		Endpoint:           c.Endpoint,
 		ExpirationTime:     c.ExpirationTime,
+		MaxExpirationTime:  c.MaxExpirationTime,
 		ConnectionLimit:    c.ConnectionLimit,
 		WorkerFilter:       c.WorkerFilter,
 		DynamicCredentials: c.DynamicCredentials,
@@ -204,6 +212,14 @@ func (s *Session) Clone() interface{} {
 			},
 		}
 	}
+	if s.MaxExpirationTime != nil {
+		clone.MaxExpirationTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.MaxExpirationTime.Timestamp.Seconds,
+				Nanos:   s.MaxExpirationTime.Timestamp.Nanos,
+			},
+		}
+	}
 	if s.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -261,8 +277,8 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "update time is immutable")
 		case contains(opts.WithFieldMaskPaths, "Endpoint"):
 			return errors.New(ctx, errors.InvalidParameter, op, "endpoint is immutable")
-		case contains(opts.WithFieldMaskPaths, "ExpirationTime"):
-			return errors.New(ctx, errors.InvalidParameter, op, "expiration time is immutable")
+		case contains(opts.WithFieldMaskPaths, "MaxExpirationTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "max expiration time is immutable")
 		case contains(opts.WithFieldMaskPaths, "ConnectionLimit"):
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
@@ -320,6 +336,9 @@ func (s *Session) validateNewSession() error {
 	if s.ExpirationTime.GetTimestamp().AsTime().IsZero() {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "missing expiration time")
 	}
+	if s.MaxExpirationTime != nil && s.MaxExpirationTime.GetTimestamp().AsTime().Before(s.ExpirationTime.GetTimestamp().AsTime()) {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "max expiration time is before expiration time")
+	}
 	if s.TerminationReason != "" {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "termination reason must be empty")
 	}
@@ -414,6 +433,7 @@ type sessionView struct {
 	ScopeId           string               `json:"scope_id,omitempty" gorm:"default:null"`
 	Certificate       []byte               `json:"certificate,omitempty" gorm:"default:null"`
 	ExpirationTime    *timestamp.Timestamp `json:"expiration_time,omitempty" gorm:"default:null"`
+	MaxExpirationTime *timestamp.Timestamp `json:"max_expiration_time,omitempty" gorm:"default:null"`
 	CtTofuToken       []byte               `json:"ct_tofu_token,omitempty" gorm:"column:tofu_token;default:null" wrapping:"ct,tofu_token"`
 	TofuToken         []byte               `json:"tofu_token,omitempty" gorm:"-" wrapping:"pt,tofu_token"`
 	TerminationReason string               `json:"termination_reason,omitempty" gorm:"default:null"`
@@ -43,6 +43,27 @@ func TestConnection(t *testing.T, conn *db.DB, sessionId, clientTcpAddr string,
 	return c
 }
 
+// TestRecording creates a test recording of the connectionId of the session
+// in the repository.
+func TestRecording(t *testing.T, conn *db.DB, s *Session, connectionId, storageUri string) *Recording {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	start := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+	r, err := NewRecording(s.PublicId, connectionId, "test-worker", storageUri, 1024,
+		timestamp.New(start), timestamp.New(start.Add(30*time.Second)))
+	require.NoError(err)
+	id, err := newRecordingId()
+	require.NoError(err)
+	r.PublicId = id
+	r.ScopeId = s.ScopeId
+	r.TargetId = s.TargetId
+	r.UserId = s.UserId
+	err = rw.Create(context.Background(), r)
+	require.NoError(err)
+	return r
+}
+
 // TestConnectionState creates a test connection state for the connectionId in the repository.
 func TestConnectionState(t *testing.T, conn *db.DB, connectionId string, state ConnectionStatus) *ConnectionState {
 	t.Helper()
@@ -84,7 +105,11 @@ func TestSession(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, c Composed
 	id, err := newId()
 	require.NoError(err)
 	s.PublicId = id
-	_, certBytes, err := newCert(ctx, wrapper, c.UserId, id, c.ExpirationTime.Timestamp.AsTime())
+	certExp := c.ExpirationTime
+	if c.MaxExpirationTime != nil {
+		certExp = c.MaxExpirationTime
+	}
+	_, certBytes, err := newCert(ctx, wrapper, c.UserId, id, certExp.Timestamp.AsTime())
 	require.NoError(err)
 	s.Certificate = certBytes
 	s.ServerId = opts.withServerId
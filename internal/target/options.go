@@ -3,6 +3,7 @@ package target
 import (
 	"time"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 )
 
@@ -20,40 +21,49 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithLimit                  int
-	WithScopeId                string
-	WithScopeIds               []string
-	WithScopeName              string
-	WithUserId                 string
-	WithType                   subtypes.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPublicId               string
-	WithWorkerFilter           string
+	WithName                       string
+	WithDescription                string
+	WithDefaultPort                uint32
+	WithLimit                      int
+	WithStartPageAfterItem         *db.PageItem
+	WithScopeId                    string
+	WithScopeIds                   []string
+	WithScopeName                  string
+	WithUserId                     string
+	WithType                       subtypes.Subtype
+	WithHostSources                []string
+	WithCredentialLibraries        []*CredentialLibrary
+	WithStaticCredentials          []*StaticCredential
+	WithSessionMaxSeconds          uint32
+	WithSessionConnectionLimit     int32
+	WithPublicId                   string
+	WithWorkerFilter               string
+	WithInjectionProtocol          string
+	WithSessionMaxExtensionSeconds uint32
+	WithSshHostKeys                string
 }
 
 func getDefaultOptions() options {
 	return options{
-		WithName:                   "",
-		WithDescription:            "",
-		WithLimit:                  0,
-		WithDefaultPort:            0,
-		WithScopeId:                "",
-		WithScopeIds:               nil,
-		WithScopeName:              "",
-		WithUserId:                 "",
-		WithType:                   "",
-		WithHostSources:            nil,
-		WithCredentialLibraries:    nil,
-		WithSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
-		WithSessionConnectionLimit: 1,
-		WithPublicId:               "",
-		WithWorkerFilter:           "",
+		WithName:                       "",
+		WithDescription:                "",
+		WithLimit:                      0,
+		WithDefaultPort:                0,
+		WithScopeId:                    "",
+		WithScopeIds:                   nil,
+		WithScopeName:                  "",
+		WithUserId:                     "",
+		WithType:                       "",
+		WithHostSources:                nil,
+		WithCredentialLibraries:        nil,
+		WithStaticCredentials:          nil,
+		WithSessionMaxSeconds:          uint32((8 * time.Hour).Seconds()),
+		WithSessionConnectionLimit:     1,
+		WithPublicId:                   "",
+		WithWorkerFilter:               "",
+		WithInjectionProtocol:          "",
+		WithSessionMaxExtensionSeconds: 0,
+		WithSshHostKeys:                "",
 	}
 }
 
@@ -80,6 +90,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterItem provides an option to list items ordered by their
+// create time and then their id, starting with the first item which comes after
+// item. The zero PageItem starts with the first item.
+func WithStartPageAfterItem(item *db.PageItem) Option {
+	return func(o *options) {
+		o.WithStartPageAfterItem = item
+	}
+}
+
 // WithDefaultPort provides an option to specify the default target port.
 func WithDefaultPort(p uint32) Option {
 	return func(o *options) {
@@ -136,6 +155,13 @@ func WithCredentialLibraries(cl []*CredentialLibrary) Option {
 	}
 }
 
+// WithStaticCredentials provides an option for providing a list of static credentials.
+func WithStaticCredentials(sc []*StaticCredential) Option {
+	return func(o *options) {
+		o.WithStaticCredentials = sc
+	}
+}
+
 func WithSessionMaxSeconds(dur uint32) Option {
 	return func(o *options) {
 		o.WithSessionMaxSeconds = dur
@@ -148,6 +174,14 @@ func WithSessionConnectionLimit(limit int32) Option {
 	}
 }
 
+// WithSessionMaxExtensionSeconds provides an optional maximum total time that
+// the expiration of a session can be extended by.
+func WithSessionMaxExtensionSeconds(dur uint32) Option {
+	return func(o *options) {
+		o.WithSessionMaxExtensionSeconds = dur
+	}
+}
+
 // WithPublicId provides an optional public id
 func WithPublicId(id string) Option {
 	return func(o *options) {
@@ -161,3 +195,19 @@ func WithWorkerFilter(filter string) Option {
 		o.WithWorkerFilter = filter
 	}
 }
+
+// WithInjectionProtocol provides an optional protocol the worker uses to
+// inject egress credentials into the connection to the endpoint.
+func WithInjectionProtocol(protocol string) Option {
+	return func(o *options) {
+		o.WithInjectionProtocol = protocol
+	}
+}
+
+// WithSshHostKeys provides optional public keys, in authorized_keys format,
+// that the endpoint must present when the worker terminates ssh connections.
+func WithSshHostKeys(keys string) Option {
+	return func(o *options) {
+		o.WithSshHostKeys = keys
+	}
+}
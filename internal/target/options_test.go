@@ -108,6 +108,27 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithInjectionProtocol", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithInjectionProtocol("ssh"))
+		testOpts := getDefaultOptions()
+		testOpts.WithInjectionProtocol = "ssh"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionMaxExtensionSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionMaxExtensionSeconds(3600))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionMaxExtensionSeconds = 3600
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSshHostKeys", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSshHostKeys("ssh-ed25519 AAAA"))
+		testOpts := getDefaultOptions()
+		testOpts.WithSshHostKeys = "ssh-ed25519 AAAA"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithCredentialLibraries", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithCredentialLibraries([]*CredentialLibrary{
@@ -141,4 +162,25 @@ func Test_GetOpts(t *testing.T) {
 		}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStaticCredentials", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithStaticCredentials([]*StaticCredential{
+			{
+				StaticCredential: &store.StaticCredential{
+					CredentialStaticId: "alice",
+					CredentialPurpose:  string(credential.ApplicationPurpose),
+				},
+			},
+		}))
+		testOpts := getDefaultOptions()
+		testOpts.WithStaticCredentials = []*StaticCredential{
+			{
+				StaticCredential: &store.StaticCredential{
+					CredentialStaticId: "alice",
+					CredentialPurpose:  string(credential.ApplicationPurpose),
+				},
+			},
+		}
+		assert.Equal(opts, testOpts)
+	})
 }
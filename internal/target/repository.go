@@ -138,7 +138,8 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	return subtype, hostSources, credSources, nil
 }
 
-// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithType options.
+// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithType
+// and WithStartPageAfterItem options.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	const op = "target.(Repository).ListTargets"
 	opts := GetOpts(opt...)
@@ -174,7 +175,7 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 }
 
 // list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// repo defaultLimit, and the WithStartPageAfterItem option
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	const op = "target.(Repository).list"
 	opts := GetOpts(opt...)
@@ -185,6 +186,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		limit = opts.WithLimit
 	}
 	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithStartPageAfterItem(opts.WithStartPageAfterItem))
 	if err := r.reader.SearchWhere(ctx, resources, where, args, dbOpts...); err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -407,8 +409,9 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // UpdateTarget will update a target in the repository and return the written
 // target. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
-// included in fieldMask. Name, Description, and WorkerFilter are the only
-// updatable fields. If no updatable fields are included in the fieldMaskPaths,
+// included in fieldMask. Name, Description, DefaultPort, SessionMaxSeconds,
+// SessionConnectionLimit, WorkerFilter, InjectionProtocol,
+// SessionMaxExtensionSeconds and SshHostKeys are the only updatable fields. If no updatable fields are included in the fieldMaskPaths,
 // then an error is returned.
 func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).UpdateTarget"
@@ -435,6 +438,9 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("sessionmaxseconds", f):
 		case strings.EqualFold("sessionconnectionlimit", f):
 		case strings.EqualFold("workerfilter", f):
+		case strings.EqualFold("injectionprotocol", f):
+		case strings.EqualFold("sessionmaxextensionseconds", f):
+		case strings.EqualFold("sshhostkeys", f):
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -442,15 +448,18 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
+			"Name":                       target.GetName(),
+			"Description":                target.GetDescription(),
+			"DefaultPort":                target.GetDefaultPort(),
+			"SessionMaxSeconds":          target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":     target.GetSessionConnectionLimit(),
+			"WorkerFilter":               target.GetWorkerFilter(),
+			"InjectionProtocol":          target.GetInjectionProtocol(),
+			"SessionMaxExtensionSeconds": target.GetSessionMaxExtensionSeconds(),
+			"SshHostKeys":                target.GetSshHostKeys(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "SessionMaxExtensionSeconds"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -41,11 +41,30 @@ func newCredentialLibrariesPurposeMap(cls []*CredentialLibrary) credentialLibrar
 	return clpm
 }
 
+// staticCredentialsPurposeMap is used to group StaticCredentials by purpose.
+type staticCredentialsPurposeMap map[string][]*StaticCredential
+
+func (c staticCredentialsPurposeMap) add(sc *StaticCredential) {
+	c[sc.CredentialPurpose] = append(c[sc.CredentialPurpose], sc)
+}
+
+func newStaticCredentialsPurposeMap(creds []*StaticCredential) staticCredentialsPurposeMap {
+	scpm := make(staticCredentialsPurposeMap)
+	for _, p := range credential.ValidPurposes {
+		scpm[p.String()] = make([]*StaticCredential, 0)
+	}
+	for _, c := range creds {
+		scpm.add(c)
+	}
+	return scpm
+}
+
 // AddTargetCredentialSources adds the cIds to the targetId in the repository. The target
 // and the list of credential sources attached to the target, after cIds are added,
-// will be returned on success.
+// will be returned on success. Static credentials can be added by passing
+// WithStaticCredentials, in which case cls may be empty.
 // The targetVersion must match the current version of the targetId in the repository.
-func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) (Target, []HostSource, []CredentialSource, error) {
+func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).AddTargetCredentialSources"
 	if targetId == "" {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
@@ -53,14 +72,20 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 	if targetVersion == 0 {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
-	if len(cls) == 0 {
-		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential libraries")
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
+	if len(cls) == 0 && len(creds) == 0 {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential sources")
 	}
 
 	addCredLibs := make([]interface{}, 0, len(cls))
 	for _, cl := range cls {
 		addCredLibs = append(addCredLibs, cl)
 	}
+	addCreds := make([]interface{}, 0, len(creds))
+	for _, c := range creds {
+		addCreds = append(addCreds, c)
+	}
 
 	t := allocTargetView()
 	t.PublicId = targetId
@@ -74,11 +99,11 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
 
-	vetCredentialLibraries, ok := subtypeRegistry.vetCredentialLibrariesFunc(t.Subtype())
+	vetCredentialSources, ok := subtypeRegistry.vetCredentialSourcesFunc(t.Subtype())
 	if !ok {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
-	if err := vetCredentialLibraries(ctx, cls); err != nil {
+	if err := vetCredentialSources(ctx, cls, creds); err != nil {
 		return nil, nil, nil, err
 	}
 
@@ -120,11 +145,20 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 			}
 			msgs = append(msgs, &targetOplogMsg)
 
-			credLibsOplogMsgs := make([]*oplog.Message, 0, len(addCredLibs))
-			if err := w.CreateItems(ctx, addCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs)); err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target credential sources"))
+			if len(addCredLibs) > 0 {
+				credLibsOplogMsgs := make([]*oplog.Message, 0, len(addCredLibs))
+				if err := w.CreateItems(ctx, addCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target credential sources"))
+				}
+				msgs = append(msgs, credLibsOplogMsgs...)
+			}
+			if len(addCreds) > 0 {
+				credsOplogMsgs := make([]*oplog.Message, 0, len(addCreds))
+				if err := w.CreateItems(ctx, addCreds, db.NewOplogMsgs(&credsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target static credential sources"))
+				}
+				msgs = append(msgs, credsOplogMsgs...)
 			}
-			msgs = append(msgs, credLibsOplogMsgs...)
 
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -147,8 +181,10 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 }
 
 // DeleteTargetCredentialSources deletes credential sources from a target in the repository.
+// Static credentials can be deleted by passing WithStaticCredentials, in which case cls
+// may be empty.
 // The target's current db version must match the targetVersion or an error will be returned.
-func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) (int, error) {
+func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) (int, error) {
 	const op = "target.(Repository).DeleteTargetCredentialSources"
 	if targetId == "" {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
@@ -156,7 +192,9 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 	if targetVersion == 0 {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
-	if len(cls) == 0 {
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
+	if len(cls) == 0 && len(creds) == 0 {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential source ids")
 	}
 
@@ -164,6 +202,10 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 	for _, cl := range cls {
 		deleteCredLibs = append(deleteCredLibs, cl)
 	}
+	deleteCreds := make([]interface{}, 0, len(creds))
+	for _, c := range creds {
+		deleteCreds = append(deleteCreds, c)
+	}
 
 	t := allocTargetView()
 	t.PublicId = targetId
@@ -193,6 +235,7 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
+			rowsDeleted = 0
 			msgs := make([]*oplog.Message, 0, 2)
 			targetTicket, err := w.GetTicket(target)
 			if err != nil {
@@ -212,15 +255,30 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 			}
 			msgs = append(msgs, &targetOplogMsg)
 
-			credLibsOplogMsgs := make([]*oplog.Message, 0, len(deleteCredLibs))
-			rowsDeleted, err = w.DeleteItems(ctx, deleteCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs))
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target credential sources"))
+			if len(deleteCredLibs) > 0 {
+				credLibsOplogMsgs := make([]*oplog.Message, 0, len(deleteCredLibs))
+				libsDeleted, err := w.DeleteItems(ctx, deleteCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target credential sources"))
+				}
+				if libsDeleted != len(deleteCredLibs) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("credential sources deleted %d did not match request for %d", libsDeleted, len(deleteCredLibs)))
+				}
+				rowsDeleted += libsDeleted
+				msgs = append(msgs, credLibsOplogMsgs...)
 			}
-			if rowsDeleted != len(deleteCredLibs) {
-				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("credential sources deleted %d did not match request for %d", rowsDeleted, len(deleteCredLibs)))
+			if len(deleteCreds) > 0 {
+				credsOplogMsgs := make([]*oplog.Message, 0, len(deleteCreds))
+				credsDeleted, err := w.DeleteItems(ctx, deleteCreds, db.NewOplogMsgs(&credsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target static credential sources"))
+				}
+				if credsDeleted != len(deleteCreds) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("static credential sources deleted %d did not match request for %d", credsDeleted, len(deleteCreds)))
+				}
+				rowsDeleted += credsDeleted
+				msgs = append(msgs, credsOplogMsgs...)
 			}
-			msgs = append(msgs, credLibsOplogMsgs...)
 
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -236,8 +294,9 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 
 // SetTargetCredentialSources will set the target's credential sources. Set will add
 // and/or delete credential sources as need to reconcile the existing credential sources
-// with the request. If clIds is empty, all the credential sources will be cleared from the target.
-func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) ([]HostSource, []CredentialSource, int, error) {
+// with the request. Static credentials are set by passing WithStaticCredentials. If clIds
+// and the static credentials are empty, all the credential sources will be cleared from the target.
+func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) ([]HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).SetTargetCredentialSources"
 	if targetId == "" {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
@@ -246,7 +305,11 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
 
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
+
 	clpm := newCredentialLibrariesPurposeMap(cls)
+	scpm := newStaticCredentialsPurposeMap(creds)
 
 	var changes []*change
 
@@ -257,6 +320,13 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		}
 		changes = append(changes, c...)
 	}
+	for purpose, creds := range scpm {
+		c, err := r.staticCredentialChanges(ctx, targetId, creds, purpose)
+		if err != nil {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		changes = append(changes, c...)
+	}
 
 	if len(changes) == 0 {
 		// Nothing needs to be changed, return early
@@ -271,13 +341,17 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		return hostSets, credSources, db.NoRowsAffected, nil
 	}
 
-	var deleteCredLibs, addCredLibs []interface{}
+	var deleteCredLibs, addCredLibs, deleteCreds, addCreds []interface{}
 	for _, c := range changes {
-		switch c.action {
-		case "delete":
+		switch {
+		case c.action == "delete" && c.credentialLibrary != nil:
 			deleteCredLibs = append(deleteCredLibs, c.credentialLibrary)
-		case "add":
+		case c.action == "add" && c.credentialLibrary != nil:
 			addCredLibs = append(addCredLibs, c.credentialLibrary)
+		case c.action == "delete" && c.staticCredential != nil:
+			deleteCreds = append(deleteCreds, c.staticCredential)
+		case c.action == "add" && c.staticCredential != nil:
+			addCreds = append(addCreds, c.staticCredential)
 		}
 	}
 
@@ -293,11 +367,11 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
 
-	vetCredentialLibraries, ok := subtypeRegistry.vetCredentialLibrariesFunc(t.Subtype())
+	vetCredentialSources, ok := subtypeRegistry.vetCredentialSourcesFunc(t.Subtype())
 	if !ok {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
-	if err := vetCredentialLibraries(ctx, cls); err != nil {
+	if err := vetCredentialSources(ctx, cls, creds); err != nil {
 		return nil, nil, db.NoRowsAffected, err
 	}
 
@@ -363,6 +437,32 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 				msgs = append(msgs, delMsgs...)
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
 			}
+
+			// add new static credentials
+			if len(addCreds) > 0 {
+				addMsgs := make([]*oplog.Message, 0, len(addCreds))
+				if err := w.CreateItems(ctx, addCreds, db.NewOplogMsgs(&addMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add target static credentials"))
+				}
+				rowsAffected += len(addMsgs)
+				msgs = append(msgs, addMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+			}
+
+			// delete existing static credentials not part of set
+			if len(deleteCreds) > 0 {
+				delMsgs := make([]*oplog.Message, 0, len(deleteCreds))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteCreds, db.NewOplogMsgs(&delMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target static credentials"))
+				}
+				if rowsDeleted != len(delMsgs) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("target static credentials deleted %d did not match request for %d", rowsDeleted, len(deleteCreds)))
+				}
+				rowsAffected += rowsDeleted
+				msgs = append(msgs, delMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+			}
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -387,6 +487,7 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 type change struct {
 	action            string
 	credentialLibrary *CredentialLibrary
+	staticCredential  *StaticCredential
 }
 
 type changeQueryResult struct {
@@ -438,21 +539,72 @@ func (r *Repository) changes(ctx context.Context, targetId string, cls []*Creden
 	return changes, nil
 }
 
+type staticCredentialChangeQueryResult struct {
+	Action       string
+	CredentialId string
+}
+
+func (r *Repository) staticCredentialChanges(ctx context.Context, targetId string, creds []*StaticCredential, purpose string) ([]*change, error) {
+	const op = "target.(Repository).staticCredentialChanges"
+
+	var inClauseSpots []string
+	var params []interface{}
+	params = append(params, sql.Named("target_id", targetId), sql.Named("purpose", purpose))
+	for idx, c := range creds {
+		params = append(params, sql.Named(fmt.Sprintf("%d", idx+1), c.CredentialStaticId))
+		inClauseSpots = append(inClauseSpots, fmt.Sprintf("@%d", idx+1))
+	}
+	inClause := strings.Join(inClauseSpots, ",")
+	if inClause == "" {
+		inClause = "''"
+	}
+
+	query := fmt.Sprintf(setStaticCredentialChangesQuery, inClause)
+	rows, err := r.reader.Query(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("query failed"))
+	}
+	defer rows.Close()
+
+	var changes []*change
+	for rows.Next() {
+		var chg staticCredentialChangeQueryResult
+		if err := r.reader.ScanRows(rows, &chg); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		changes = append(changes, &change{
+			action: chg.Action,
+			staticCredential: &StaticCredential{
+				StaticCredential: &store.StaticCredential{
+					TargetId:           targetId,
+					CredentialStaticId: chg.CredentialId,
+					CredentialPurpose:  purpose,
+				},
+			},
+		})
+	}
+	return changes, nil
+}
+
 func fetchCredentialSources(ctx context.Context, r db.Reader, targetId string) ([]CredentialSource, error) {
 	const op = "target.fetchCredentialSources"
 	var libraries []*TargetLibrary
 	if err := r.SearchWhere(ctx, &libraries, "target_id = ?", []interface{}{targetId}); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	// FIXME: When we have static creds, there will need to be an updated view
-	// that unions between libs and creds, at which point the type above will
-	// change. For now we just take the libraries and wrap them.
-	if len(libraries) == 0 {
+	var creds []*TargetStaticCredential
+	if err := r.SearchWhere(ctx, &creds, "target_id = ?", []interface{}{targetId}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(libraries) == 0 && len(creds) == 0 {
 		return nil, nil
 	}
-	ret := make([]CredentialSource, len(libraries))
-	for i, lib := range libraries {
-		ret[i] = lib
+	ret := make([]CredentialSource, 0, len(libraries)+len(creds))
+	for _, lib := range libraries {
+		ret = append(ret, lib)
+	}
+	for _, c := range creds {
+		ret = append(ret, c)
 	}
 	return ret, nil
 }
@@ -2,9 +2,11 @@ package target_test
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -16,9 +18,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestRepository_SetTargetCredentialSources(t *testing.T) {
-	target.Register(targettest.Subtype, targettest.Alloc, targettest.Vet, targettest.VetCredentialLibraries, targettest.TargetPrefix)
+// TestMain registers the test target subtype once for the package, since
+// registering a subtype twice panics.
+func TestMain(m *testing.M) {
+	target.Register(targettest.Subtype, targettest.Alloc, targettest.Vet, targettest.VetCredentialSources, targettest.TargetPrefix)
+	os.Exit(m.Run())
+}
 
+func TestRepository_SetTargetCredentialSources(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -256,3 +263,73 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %s", err.Error())
 	})
 }
+
+func TestRepository_StaticCredentialSources(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	vcs := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, vcs.GetPublicId(), 1)[0]
+
+	scs := static.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	creds := static.TestUsernamePasswordCredentials(t, conn, wrapper, "user", "pass", scs.GetPublicId(), proj.GetPublicId(), 3)
+	cred1, cred2, cred3 := creds[0], creds[1], creds[2]
+
+	assert, require := assert.New(t), require.New(t)
+	tar := targettest.TestNewTestTarget(t, conn, proj.PublicId, "static-credential-sources")
+
+	// Add a library and a static credential at the same time
+	_, _, got, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), 1,
+		[]*target.CredentialLibrary{target.TestNewCredentialLibrary(tar.GetPublicId(), lib.GetPublicId(), credential.ApplicationPurpose)},
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), cred1.GetPublicId(), credential.ApplicationPurpose)}))
+	require.NoError(err)
+	require.Len(got, 2)
+	gotIds := make(map[string]string, len(got))
+	for _, cs := range got {
+		gotIds[cs.Id()] = cs.CredentialStoreId()
+	}
+	assert.Equal(map[string]string{lib.GetPublicId(): vcs.GetPublicId(), cred1.GetPublicId(): scs.GetPublicId()}, gotIds)
+
+	// Only static credentials
+	_, _, got, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), 2, nil,
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), cred2.GetPublicId(), credential.EgressPurpose)}))
+	require.NoError(err)
+	assert.Len(got, 3)
+
+	// No sources at all
+	_, _, _, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), 3, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	// Set replaces the static credentials and keeps the library
+	_, got, affectedRows, err := repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), 3,
+		[]*target.CredentialLibrary{target.TestNewCredentialLibrary(tar.GetPublicId(), lib.GetPublicId(), credential.ApplicationPurpose)},
+		target.WithStaticCredentials([]*target.StaticCredential{
+			target.TestNewStaticCredential(tar.GetPublicId(), cred1.GetPublicId(), credential.ApplicationPurpose),
+			target.TestNewStaticCredential(tar.GetPublicId(), cred3.GetPublicId(), credential.ApplicationPurpose),
+		}))
+	require.NoError(err)
+	assert.Equal(2, affectedRows)
+	require.Len(got, 3)
+	for _, cs := range got {
+		assert.NotEqual(cred2.GetPublicId(), cs.Id())
+	}
+
+	// Delete a static credential
+	deleted, err := repo.DeleteTargetCredentialSources(ctx, tar.GetPublicId(), 4, nil,
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), cred3.GetPublicId(), credential.ApplicationPurpose)}))
+	require.NoError(err)
+	assert.Equal(1, deleted)
+
+	_, _, got, err = repo.LookupTarget(ctx, tar.GetPublicId())
+	require.NoError(err)
+	assert.Len(got, 2)
+}
@@ -0,0 +1,59 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+func init() {
+	target.Register(Subtype, allocTarget, vet, vetCredentialSources, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of an ssh.Target.
+	TargetPrefix = "tssh"
+)
+
+// vet validates that the given target.Target is an ssh.Target and that it
+// has a Target store.
+func vet(ctx context.Context, t target.Target) error {
+	const op = "ssh.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an ssh.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	return nil
+}
+
+// vetCredentialSources checks that all of the provided credential libraries and static
+// credentials have a CredentialPurpose of EgressPurpose. The worker injects these
+// credentials into the ssh connection to the host, so any other CredentialPurpose
+// will result in an error.
+func vetCredentialSources(ctx context.Context, cls []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "ssh.vetCredentialSources"
+
+	for _, cl := range cls {
+		if cl.CredentialPurpose != string(credential.EgressPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purpose: %q", credential.EgressPurpose))
+		}
+	}
+	for _, c := range creds {
+		if c.CredentialPurpose != string(credential.EgressPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purpose: %q", credential.EgressPurpose))
+		}
+	}
+	return nil
+}
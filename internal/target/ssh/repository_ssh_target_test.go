@@ -0,0 +1,163 @@
+package ssh_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRepository_CreateSshTarget(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := ssh.New(proj.PublicId, target.WithName("valid"), target.WithDefaultPort(22))
+		require.NoError(err)
+		got, _, _, err := repo.CreateTarget(ctx, tar)
+		require.NoError(err)
+		assert.Equal(ssh.Subtype, got.GetType())
+		assert.True(strings.HasPrefix(got.GetPublicId(), ssh.TargetPrefix+"_"))
+
+		found, _, _, err := repo.LookupTarget(ctx, got.GetPublicId())
+		require.NoError(err)
+		assert.True(proto.Equal(got.(*ssh.Target), found.(*ssh.Target)))
+		assert.Equal(uint32(22), found.GetDefaultPort())
+		assert.Empty(found.GetInjectionProtocol())
+	})
+	t.Run("wrong-subtype", func(t *testing.T) {
+		assert := assert.New(t)
+		tar, err := tcp.New(proj.PublicId, target.WithName("wrong-subtype"))
+		require.NoError(t, err)
+		id, err := db.NewPublicId(tcp.TargetPrefix)
+		require.NoError(t, err)
+		tar.PublicId = id
+		err = ssh.TestVet(ctx, tar)
+		assert.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func TestRepository_ListTargets_MixedSubtypes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	sshTarget := ssh.TestTarget(t, conn, proj.PublicId, "ssh")
+	tcpTarget := tcp.TestTarget(t, conn, proj.PublicId, "tcp")
+
+	got, err := repo.ListTargets(ctx, target.WithScopeIds([]string{proj.PublicId}))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	byId := map[string]target.Target{}
+	for _, tar := range got {
+		byId[tar.GetPublicId()] = tar
+	}
+	assert.Equal(t, ssh.Subtype, byId[sshTarget.PublicId].GetType())
+	assert.Equal(t, tcp.Subtype, byId[tcpTarget.PublicId].GetType())
+}
+
+func TestRepository_UpdateSshTarget(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	assert, require := assert.New(t), require.New(t)
+	tar := ssh.TestTarget(t, conn, proj.PublicId, "update")
+	updated := tar.Clone().(*ssh.Target)
+	updated.Name = "updated"
+	updated.DefaultPort = 2222
+	got, _, _, rows, err := repo.UpdateTarget(ctx, updated, tar.Version, []string{"Name", "DefaultPort"})
+	require.NoError(err)
+	assert.Equal(1, rows)
+	assert.Equal("updated", got.GetName())
+	assert.Equal(uint32(2222), got.GetDefaultPort())
+}
+
+func TestRepository_SshTargetCredentialSources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	cs := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 1)[0]
+
+	tests := []struct {
+		name        string
+		purpose     credential.Purpose
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name:    "egress",
+			purpose: credential.EgressPurpose,
+		},
+		{
+			name:        "application",
+			purpose:     credential.ApplicationPurpose,
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "ingress",
+			purpose:     credential.IngressPurpose,
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tar := ssh.TestTarget(t, conn, proj.PublicId, tt.name)
+			cl := target.TestNewCredentialLibrary(tar.PublicId, lib.PublicId, tt.purpose)
+			_, _, got, err := repo.AddTargetCredentialSources(ctx, tar.PublicId, tar.Version, []*target.CredentialLibrary{cl})
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			require.Len(got, 1)
+			assert.Equal(lib.PublicId, got[0].Id())
+			assert.Equal(tt.purpose, got[0].CredentialPurpose())
+		})
+	}
+}
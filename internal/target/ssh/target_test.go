@@ -0,0 +1,141 @@
+package ssh_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	type args struct {
+		scopeId string
+		opt     []target.Option
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *ssh.Target
+		wantErr   bool
+		wantIsErr errors.Code
+		create    bool
+	}{
+		{
+			name:      "empty-scopeId",
+			args:      args{},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-proj-scope",
+			args: args{
+				scopeId: prj.PublicId,
+				opt:     []target.Option{target.WithName("valid-proj-scope"), target.WithDefaultPort(22)},
+			},
+			want: func() *ssh.Target {
+				t, _ := ssh.New(
+					prj.PublicId,
+					target.WithName("valid-proj-scope"),
+					target.WithDefaultPort(22),
+					target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+					target.WithSessionConnectionLimit(1),
+				)
+				return t
+			}(),
+			create: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ssh.New(tt.args.scopeId, tt.args.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			if tt.create {
+				id, err := db.NewPublicId(ssh.TargetPrefix)
+				require.NoError(err)
+				got.PublicId = id
+				require.NoError(db.New(conn).Create(context.Background(), got))
+
+				found := ssh.NewTestTarget("")
+				found.PublicId = id
+				require.NoError(db.New(conn).LookupByPublicId(context.Background(), found))
+				tt.want.PublicId = id
+				tt.want.CreateTime, tt.want.UpdateTime, tt.want.Version = found.CreateTime, found.UpdateTime, found.Version
+				assert.True(proto.Equal(tt.want.Target, found.Target))
+			}
+		})
+	}
+}
+
+func TestTarget_Delete(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tar := ssh.TestTarget(t, conn, proj.PublicId, ssh.TestTargetName(t, proj.PublicId))
+	deleteTarget := ssh.NewTestTarget("")
+	deleteTarget.PublicId = tar.PublicId
+	deletedRows, err := rw.Delete(context.Background(), deleteTarget)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deletedRows)
+
+	foundTarget := ssh.NewTestTarget("")
+	foundTarget.PublicId = tar.PublicId
+	err = rw.LookupById(context.Background(), foundTarget)
+	require.Error(t, err)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	tar := ssh.TestTarget(t, conn, proj.PublicId, ssh.TestTargetName(t, proj.PublicId))
+	cp := tar.Clone()
+	assert.True(t, proto.Equal(cp.(*ssh.Target).Target, tar.Target))
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	s, _ := ssh.New("testScope")
+	assert.Equal(ssh.DefaultTableName, s.TableName())
+	s.SetTableName("new-name")
+	assert.Equal("new-name", s.TableName())
+	s.SetTableName("")
+	assert.Equal(ssh.DefaultTableName, s.TableName())
+}
+
+func TestTarget_oplog(t *testing.T) {
+	id := ssh.TestId(t)
+	tar, _ := ssh.New(id)
+	tar.PublicId = id
+	got := tar.Oplog(oplog.OpType_OP_TYPE_CREATE)
+	assert.Equal(t, oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"ssh target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"scope-id":           []string{id},
+	}, got)
+}
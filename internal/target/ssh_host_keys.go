@@ -0,0 +1,36 @@
+package target
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// ParseSshHostKeys parses keys, which contains public keys in authorized_keys
+// format, one per line. Empty lines and lines starting with # are ignored.
+// The keys are returned in authorized_keys format without their options and
+// comments. An error is returned if a line can't be parsed or if keys
+// contains no key.
+func ParseSshHostKeys(ctx context.Context, keys string) ([]string, error) {
+	const op = "target.ParseSshHostKeys"
+	var ret []string
+	for i, line := range strings.Split(keys, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse ssh host key on line %d", i+1), errors.WithWrap(err))
+		}
+		ret = append(ret, string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(k))))
+	}
+	if len(ret) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no ssh host keys")
+	}
+	return ret, nil
+}
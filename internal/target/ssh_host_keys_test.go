@@ -0,0 +1,61 @@
+package target_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSshHostKeys(t *testing.T) {
+	t.Parallel()
+	const (
+		ed25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+		ecdsaKey   = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBEmKSENjQEezOmxkZMy7opKgwFB9nkt5YRrYMjNuG5N87uRgg6CLrbo5wAdT/y6v0mKV0U2w0WZ2YB/++Tpockg="
+	)
+	tests := []struct {
+		name    string
+		keys    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "single",
+			keys: ed25519Key,
+			want: []string{ed25519Key},
+		},
+		{
+			name: "comments-and-empty-lines",
+			keys: "# host keys\n\n" + ed25519Key + " root@host\n  " + ecdsaKey + "\n",
+			want: []string{ed25519Key, ecdsaKey},
+		},
+		{
+			name:    "empty",
+			keys:    "\n# no keys\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			keys:    ed25519Key + "\nssh-ed25519 invalid",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.ParseSshHostKeys(context.Background(), tt.keys)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
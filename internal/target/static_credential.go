@@ -0,0 +1,111 @@
+package target
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ CredentialSource = (*TargetStaticCredential)(nil)
+
+// A StaticCredential is a CredentialSource that represents the relationship
+// between a target and a static credential.
+type StaticCredential struct {
+	*store.StaticCredential
+	tableName string `gorm:"-"`
+}
+
+// NewStaticCredential creates a new in memory StaticCredential
+// representing the relationship between targetId and credentialId.
+func NewStaticCredential(targetId, credentialId string, purpose credential.Purpose) (*StaticCredential, error) {
+	const op = "target.NewStaticCredential"
+	if targetId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "no target id")
+	}
+	if credentialId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "no credential id")
+	}
+
+	t := &StaticCredential{
+		StaticCredential: &store.StaticCredential{
+			TargetId:           targetId,
+			CredentialStaticId: credentialId,
+			CredentialPurpose:  string(purpose),
+		},
+	}
+	return t, nil
+}
+
+func allocStaticCredential() *StaticCredential {
+	return &StaticCredential{
+		StaticCredential: &store.StaticCredential{},
+	}
+}
+
+func (t *StaticCredential) clone() *StaticCredential {
+	cp := proto.Clone(t.StaticCredential)
+	return &StaticCredential{
+		StaticCredential: cp.(*store.StaticCredential),
+	}
+}
+
+// TableName returns the table name.
+func (t *StaticCredential) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return "target_static_credential"
+}
+
+// SetTableName sets the table name.
+func (t *StaticCredential) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *StaticCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{fmt.Sprintf("%s:%s:%s", t.TargetId, t.CredentialStaticId, t.CredentialPurpose)},
+		"resource-type":      []string{"target-static-credential"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+// A TargetStaticCredential represents the relationship between a target
+// and a static credential and includes the id of the credential store that
+// the credential is a part of.
+//
+// It implements the target.CredentialSource interface.
+type TargetStaticCredential struct {
+	*store.StaticCredential
+	StoreId string
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (ts *TargetStaticCredential) TableName() string {
+	return "target_static_credential_view"
+}
+
+// Id returns the ID of the static credential
+func (ts *TargetStaticCredential) Id() string {
+	return ts.CredentialStaticId
+}
+
+// CredentialStoreId returns the ID of the store containing the credential
+func (ts *TargetStaticCredential) CredentialStoreId() string {
+	return ts.StoreId
+}
+
+// CredentialPurpose returns the purpose of the credential
+func (ts *TargetStaticCredential) CredentialPurpose() credential.Purpose {
+	return credential.Purpose(ts.GetCredentialPurpose())
+}
+
+// TargetId returns the target linked to this credential source
+func (ts *TargetStaticCredential) TargetId() string {
+	return ts.GetTargetId()
+}
@@ -25,6 +25,9 @@ type Target interface {
 	GetSessionMaxSeconds() uint32
 	GetSessionConnectionLimit() int32
 	GetWorkerFilter() string
+	GetInjectionProtocol() string
+	GetSessionMaxExtensionSeconds() uint32
+	GetSshHostKeys() string
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetScopeId(string)
@@ -37,6 +40,9 @@ type Target interface {
 	SetSessionMaxSeconds(uint32)
 	SetSessionConnectionLimit(int32)
 	SetWorkerFilter(string)
+	SetInjectionProtocol(string)
+	SetSessionMaxExtensionSeconds(uint32)
+	SetSshHostKeys(string)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
@@ -104,5 +110,8 @@ func (t *targetView) targetSubtype(ctx context.Context) (Target, error) {
 	tt.SetSessionMaxSeconds(t.SessionMaxSeconds)
 	tt.SetSessionConnectionLimit(t.SessionConnectionLimit)
 	tt.SetWorkerFilter(t.WorkerFilter)
+	tt.SetInjectionProtocol(t.InjectionProtocol)
+	tt.SetSessionMaxExtensionSeconds(t.SessionMaxExtensionSeconds)
+	tt.SetSshHostKeys(t.SshHostKeys)
 	return tt, nil
 }
@@ -116,6 +116,14 @@ func (t *Target) GetWorkerFilter() string {
 	return t.WorkerFilter
 }
 
+func (t *Target) GetSessionMaxExtensionSeconds() uint32 {
+	return t.SessionMaxExtensionSeconds
+}
+
+func (t *Target) GetSshHostKeys() string {
+	return t.SshHostKeys
+}
+
 func (t *Target) Clone() target.Target {
 	cp := proto.Clone(t.Target)
 	return &Target{
@@ -168,6 +176,18 @@ func (t *Target) SetWorkerFilter(f string) {
 	t.WorkerFilter = f
 }
 
+func (t *Target) SetInjectionProtocol(p string) {
+	t.InjectionProtocol = p
+}
+
+func (t *Target) SetSessionMaxExtensionSeconds(s uint32) {
+	t.SessionMaxExtensionSeconds = s
+}
+
+func (t *Target) SetSshHostKeys(keys string) {
+	t.SshHostKeys = keys
+}
+
 func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
 	return oplog.Metadata{
 		"resource-public-id": []string{t.PublicId},
@@ -203,8 +223,8 @@ func Vet(ctx context.Context, t target.Target) error {
 	return nil
 }
 
-// VetCredentialLibraries allows for any CredentialLibraries.
-func VetCredentialLibraries(_ context.Context, _ []*target.CredentialLibrary) error {
+// VetCredentialSources allows for any CredentialLibraries and StaticCredentials.
+func VetCredentialSources(_ context.Context, _ []*target.CredentialLibrary, _ []*target.StaticCredential) error {
 	return nil
 }
 
@@ -217,13 +237,16 @@ func New(scopeId string, opt ...target.Option) (*Target, error) {
 	}
 	t := &Target{
 		Target: &store.Target{
-			ScopeId:                scopeId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
+			ScopeId:                    scopeId,
+			Name:                       opts.WithName,
+			Description:                opts.WithDescription,
+			DefaultPort:                opts.WithDefaultPort,
+			SessionConnectionLimit:     opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:          opts.WithSessionMaxSeconds,
+			WorkerFilter:               opts.WithWorkerFilter,
+			SessionMaxExtensionSeconds: opts.WithSessionMaxExtensionSeconds,
+			SshHostKeys:                opts.WithSshHostKeys,
+			InjectionProtocol:          opts.WithInjectionProtocol,
 		},
 	}
 	return t, nil
@@ -263,5 +286,14 @@ func TestNewTestTarget(t *testing.T, conn *db.DB, scopeId, name string, opt ...t
 		err := rw.CreateItems(context.Background(), newCredLibs)
 		require.NoError(err)
 	}
+	if len(opts.WithStaticCredentials) > 0 {
+		newCreds := make([]interface{}, 0, len(opts.WithStaticCredentials))
+		for _, c := range opts.WithStaticCredentials {
+			c.TargetId = tar.PublicId
+			newCreds = append(newCreds, c)
+		}
+		err := rw.CreateItems(context.Background(), newCreds)
+		require.NoError(err)
+	}
 	return tar
 }
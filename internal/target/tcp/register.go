@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	target.Register(Subtype, allocTarget, vet, vetCredentialLibraries, TargetPrefix)
+	target.Register(Subtype, allocTarget, vet, vetCredentialSources, TargetPrefix)
 }
 
 const (
@@ -38,15 +38,30 @@ func vet(ctx context.Context, t target.Target) error {
 	return nil
 }
 
-// vetCredentialLibraries checks that all of the provided credential libriaries have a CredentialPurpose
-// of ApplicationPurpose. Any other CredentialPurpose will result in an error.
-func vetCredentialLibraries(ctx context.Context, cls []*target.CredentialLibrary) error {
-	const op = "tcp.vetCredentialLibraries"
+// vetCredentialSources checks that all of the provided credential libraries and static
+// credentials have a CredentialPurpose of ApplicationPurpose or EgressPurpose. Any other
+// CredentialPurpose will result in an error.
+func vetCredentialSources(ctx context.Context, cls []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "tcp.vetCredentialSources"
 
 	for _, cl := range cls {
-		if cl.CredentialPurpose != string(credential.ApplicationPurpose) {
-			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purpose: %q", credential.ApplicationPurpose))
+		if !validPurpose(cl.CredentialPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purposes: %q, %q", credential.ApplicationPurpose, credential.EgressPurpose))
+		}
+	}
+	for _, c := range creds {
+		if !validPurpose(c.CredentialPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purposes: %q, %q", credential.ApplicationPurpose, credential.EgressPurpose))
 		}
 	}
 	return nil
 }
+
+func validPurpose(p string) bool {
+	switch credential.Purpose(p) {
+	case credential.ApplicationPurpose, credential.EgressPurpose:
+		return true
+	default:
+		return false
+	}
+}
@@ -137,8 +137,15 @@ func TestRepository_AddTargetCredentialSources(t *testing.T) {
 					target.TestNewCredentialLibrary("", lib1.PublicId, credential.EgressPurpose),
 				},
 			},
-			wantErr:     true,
-			wantErrCode: errors.InvalidParameter,
+			wantCredSources: map[string]target.CredentialSource{
+				lib1.PublicId + "_" + string(credential.EgressPurpose): &target.TargetLibrary{
+					CredentialLibrary: &store.CredentialLibrary{
+						CredentialLibraryId: lib1.PublicId,
+						CredentialPurpose:   string(credential.EgressPurpose),
+					},
+				},
+			},
+			wantErr: false,
 		},
 		{
 			name: "ingress-credential-purpose",
@@ -559,13 +566,13 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 			name:  "egress-credential-purpose",
 			setup: setupFn,
 			args: args{
-				targetVersion: 1,
+				targetVersion: 2,
 				cls: []*target.CredentialLibrary{
 					target.TestNewCredentialLibrary("", lib1.PublicId, credential.EgressPurpose),
 				},
 			},
-			wantErr:     true,
-			wantErrCode: errors.InvalidParameter,
+			wantErr:          false,
+			wantAffectedRows: 11,
 		},
 		{
 			name:  "ingress-credential-purpose",
@@ -199,13 +199,15 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 	pubId := func(s string) *string { return &s }
 
 	type args struct {
-		name           string
-		description    string
-		port           uint32
-		fieldMaskPaths []string
-		opt            []target.Option
-		ScopeId        string
-		PublicId       *string
+		name              string
+		description       string
+		port              uint32
+		injectionProtocol string
+		sshHostKeys       string
+		fieldMaskPaths    []string
+		opt               []target.Option
+		ScopeId           string
+		PublicId          *string
 	}
 	tests := []struct {
 		name           string
@@ -230,6 +232,31 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 			wantErr:        false,
 			wantRowsUpdate: 1,
 		},
+		{
+			name: "valid-injection-protocol",
+			args: args{
+				name:              "valid-injection-protocol" + id,
+				injectionProtocol: "ssh",
+				fieldMaskPaths:    []string{"Name", "InjectionProtocol"},
+				ScopeId:           proj.PublicId,
+			},
+			newScopeId:     proj.PublicId,
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
+		{
+			name: "valid-ssh-host-keys",
+			args: args{
+				name:              "valid-ssh-host-keys" + id,
+				injectionProtocol: "ssh",
+				sshHostKeys:       "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+				fieldMaskPaths:    []string{"Name", "InjectionProtocol", "SshHostKeys"},
+				ScopeId:           proj.PublicId,
+			},
+			newScopeId:     proj.PublicId,
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
 		{
 			name: "valid-no-op",
 			args: args{
@@ -427,6 +454,8 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 				target.WithName(tt.args.name),
 				target.WithDescription(tt.args.description),
 				target.WithDefaultPort(tt.args.port),
+				target.WithInjectionProtocol(tt.args.injectionProtocol),
+				target.WithSshHostKeys(tt.args.sshHostKeys),
 			)
 			updateTarget.PublicId = tar.PublicId
 			if tt.args.PublicId != nil {
@@ -476,6 +505,8 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 				assert.Equal(foundTarget.GetDescription(), "")
 				dbassert.IsNull(foundTarget, "description")
 			}
+			assert.Equal(tt.args.injectionProtocol, foundTarget.GetInjectionProtocol())
+			assert.Equal(tt.args.sshHostKeys, foundTarget.GetSshHostKeys())
 			err = db.TestVerifyOplog(t, rw, tar.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second))
 			assert.NoError(err)
 		})
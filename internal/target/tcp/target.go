@@ -23,6 +23,23 @@ const (
 	Subtype          = subtypes.Subtype("tcp")
 )
 
+// Injection protocols a worker can use to inject egress credentials into
+// the connection to the endpoint of a tcp Target.
+const (
+	InjectionProtocolSsh      = "ssh"
+	InjectionProtocolPostgres = "postgres"
+)
+
+// ValidInjectionProtocol returns true if p is a supported injection protocol.
+func ValidInjectionProtocol(p string) bool {
+	switch p {
+	case InjectionProtocolSsh, InjectionProtocolPostgres:
+		return true
+	default:
+		return false
+	}
+}
+
 // Target is a resources that represets a networked service
 // that can be accessed via TCP. It is a subtype of target.Target.
 type Target struct {
@@ -47,13 +64,16 @@ func New(scopeId string, opt ...target.Option) (*Target, error) {
 	}
 	t := &Target{
 		Target: &store.Target{
-			ScopeId:                scopeId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
+			ScopeId:                    scopeId,
+			Name:                       opts.WithName,
+			Description:                opts.WithDescription,
+			DefaultPort:                opts.WithDefaultPort,
+			SessionConnectionLimit:     opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:          opts.WithSessionMaxSeconds,
+			WorkerFilter:               opts.WithWorkerFilter,
+			SessionMaxExtensionSeconds: opts.WithSessionMaxExtensionSeconds,
+			SshHostKeys:                opts.WithSshHostKeys,
+			InjectionProtocol:          opts.WithInjectionProtocol,
 		},
 	}
 	return t, nil
@@ -171,3 +191,15 @@ func (t *Target) SetSessionConnectionLimit(limit int32) {
 func (t *Target) SetWorkerFilter(filter string) {
 	t.WorkerFilter = filter
 }
+
+func (t *Target) SetInjectionProtocol(protocol string) {
+	t.InjectionProtocol = protocol
+}
+
+func (t *Target) SetSessionMaxExtensionSeconds(s uint32) {
+	t.SessionMaxExtensionSeconds = s
+}
+
+func (t *Target) SetSshHostKeys(keys string) {
+	t.SshHostKeys = keys
+}
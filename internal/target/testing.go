@@ -23,6 +23,19 @@ func TestNewCredentialLibrary(targetId, credentialLibraryId string, purpose cred
 	}
 }
 
+// TestNewStaticCredential creates a new in memory StaticCredential
+// representing the relationship between targetId and credentialId with the
+// given purpose.
+func TestNewStaticCredential(targetId, credentialId string, purpose credential.Purpose) *StaticCredential {
+	return &StaticCredential{
+		StaticCredential: &store.StaticCredential{
+			TargetId:           targetId,
+			CredentialStaticId: credentialId,
+			CredentialPurpose:  string(purpose),
+		},
+	}
+}
+
 // TestCredentialLibrary creates a CredentialLibrary for targetId and
 // libraryId with the credential purpose of application.
 func TestCredentialLibrary(t *testing.T, conn *db.DB, targetId, libraryId string) *CredentialLibrary {
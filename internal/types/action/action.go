@@ -53,6 +53,24 @@ const (
 	AddHostSources            Type = 42
 	SetHostSources            Type = 43
 	RemoveHostSources         Type = 44
+	AddWorkerTags             Type = 45
+	SetWorkerTags             Type = 46
+	RemoveWorkerTags          Type = 47
+	ListKeys                  Type = 48
+	RotateKeys                Type = 49
+	DestroyKeyVersion         Type = 50
+	Extend                    Type = 51
+	ExtendSelf                Type = 52
+	CloseConnection           Type = 53
+	Request                   Type = 54
+	Approve                   Type = 55
+	Deny                      Type = 56
+	ExplainPermissions        Type = 57
+	Unlock                    Type = 58
+	EnrollTotp                Type = 59
+	ConfirmTotp               Type = 60
+	RemoveTotp                Type = 61
+	RevokeTokens              Type = 62
 )
 
 var Map = map[string]Type{
@@ -100,6 +118,24 @@ var Map = map[string]Type{
 	AddHostSources.String():            AddHostSources,
 	SetHostSources.String():            SetHostSources,
 	RemoveHostSources.String():         RemoveHostSources,
+	AddWorkerTags.String():             AddWorkerTags,
+	SetWorkerTags.String():             SetWorkerTags,
+	RemoveWorkerTags.String():          RemoveWorkerTags,
+	ListKeys.String():                  ListKeys,
+	RotateKeys.String():                RotateKeys,
+	DestroyKeyVersion.String():         DestroyKeyVersion,
+	Extend.String():                    Extend,
+	ExtendSelf.String():                ExtendSelf,
+	CloseConnection.String():           CloseConnection,
+	Request.String():                   Request,
+	Approve.String():                   Approve,
+	Deny.String():                      Deny,
+	ExplainPermissions.String():        ExplainPermissions,
+	Unlock.String():                    Unlock,
+	EnrollTotp.String():                EnrollTotp,
+	ConfirmTotp.String():               ConfirmTotp,
+	RemoveTotp.String():                RemoveTotp,
+	RevokeTokens.String():              RevokeTokens,
 }
 
 func (a Type) String() string {
@@ -149,6 +185,24 @@ func (a Type) String() string {
 		"add-host-sources",
 		"set-host-sources",
 		"remove-host-sources",
+		"add-worker-tags",
+		"set-worker-tags",
+		"remove-worker-tags",
+		"list-keys",
+		"rotate-keys",
+		"destroy-key-version",
+		"extend",
+		"extend:self",
+		"close-connection",
+		"request",
+		"approve",
+		"deny",
+		"explain-permissions",
+		"unlock",
+		"enroll-totp",
+		"confirm-totp",
+		"remove-totp",
+		"revoke-tokens",
 	}[a]
 }
 
@@ -95,6 +95,78 @@ func TestAction(t *testing.T) {
 			action: NoOp,
 			want:   "no-op",
 		},
+		{
+			action: AddWorkerTags,
+			want:   "add-worker-tags",
+		},
+		{
+			action: SetWorkerTags,
+			want:   "set-worker-tags",
+		},
+		{
+			action: RemoveWorkerTags,
+			want:   "remove-worker-tags",
+		},
+		{
+			action: ListKeys,
+			want:   "list-keys",
+		},
+		{
+			action: RotateKeys,
+			want:   "rotate-keys",
+		},
+		{
+			action: DestroyKeyVersion,
+			want:   "destroy-key-version",
+		},
+		{
+			action: Extend,
+			want:   "extend",
+		},
+		{
+			action: ExtendSelf,
+			want:   "extend:self",
+		},
+		{
+			action: CloseConnection,
+			want:   "close-connection",
+		},
+		{
+			action: Request,
+			want:   "request",
+		},
+		{
+			action: Approve,
+			want:   "approve",
+		},
+		{
+			action: Deny,
+			want:   "deny",
+		},
+		{
+			action: ExplainPermissions,
+			want:   "explain-permissions",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: ConfirmTotp,
+			want:   "confirm-totp",
+		},
+		{
+			action: RemoveTotp,
+			want:   "remove-totp",
+		},
+		{
+			action: RevokeTokens,
+			want:   "revoke-tokens",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -25,6 +25,9 @@ const (
 	ManagedGroup
 	CredentialStore
 	CredentialLibrary
+	Credential
+	SessionRecording
+	AccessRequest
 	// NOTE: When adding a new type, be sure to update:
 	//
 	// * The Grant.validateType function and test
@@ -57,6 +60,9 @@ func (r Type) String() string {
 		"managed-group",
 		"credential-store",
 		"credential-library",
+		"credential",
+		"session-recording",
+		"access-request",
 	}[r]
 }
 
@@ -89,4 +95,7 @@ var Map = map[string]Type{
 	ManagedGroup.String():      ManagedGroup,
 	CredentialStore.String():   CredentialStore,
 	CredentialLibrary.String(): CredentialLibrary,
+	Credential.String():        Credential,
+	SessionRecording.String():  SessionRecording,
+	AccessRequest.String():     AccessRequest,
 }
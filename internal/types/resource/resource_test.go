@@ -87,6 +87,18 @@ func Test_Resource(t *testing.T) {
 			typeString: "credential-library",
 			want:       CredentialLibrary,
 		},
+		{
+			typeString: "credential",
+			want:       Credential,
+		},
+		{
+			typeString: "session-recording",
+			want:       SessionRecording,
+		},
+		{
+			typeString: "access-request",
+			want:       AccessRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.typeString, func(t *testing.T) {
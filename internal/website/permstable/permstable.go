@@ -63,6 +63,7 @@ var table = &Table{
 
 func main() {
 	table.Body.Resources = append(table.Body.Resources,
+		accessRequest,
 		account,
 		authMethod,
 		authToken,
@@ -306,6 +307,72 @@ func rudActions(typ string, pin bool) []*Action {
 	return ret
 }
 
+var accessRequest = &Resource{
+	Type:   "Access Request",
+	Scopes: infraScope,
+	Endpoints: []*Endpoint{
+		{
+			Path: "/access-requests",
+			Params: map[string]string{
+				"Type": "access-request",
+			},
+			Actions: []*Action{
+				{
+					Name:        "list",
+					Description: "List access requests",
+					Examples: []string{
+						"type=<type>;actions=list",
+					},
+				},
+				{
+					Name:        "request",
+					Description: "Request access to a target in the scope for the calling user",
+					Examples: []string{
+						"type=<type>;actions=request",
+					},
+				},
+			},
+		},
+		{
+			Path: "/access-requests/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "access-request",
+			},
+			Actions: []*Action{
+				{
+					Name:        "read",
+					Description: "Read an access request",
+					Examples: []string{
+						"id=<id>;actions=read",
+					},
+				},
+				{
+					Name:        "read:self",
+					Description: "Read an access request, which must have been made by the calling user",
+					Examples: []string{
+						"id=*;type=access-request;actions=read:self",
+					},
+				},
+				{
+					Name:        "approve",
+					Description: "Approve an access request, which must not have been made by the calling user",
+					Examples: []string{
+						"id=<id>;actions=approve",
+					},
+				},
+				{
+					Name:        "deny",
+					Description: "Deny an access request, which must not have been made by the calling user",
+					Examples: []string{
+						"id=<id>;actions=deny",
+					},
+				},
+			},
+		},
+	},
+}
+
 var account = &Resource{
 	Type:   "Account",
 	Scopes: iamScopes,
@@ -342,6 +409,38 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "unlock",
+					Description: "Clear the failed authentication attempts of an account, unlocking it",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Generate a TOTP secret and recovery codes for an account, replacing any previous enrollment",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "confirm-totp",
+					Description: "Confirm the TOTP enrollment of an account with a TOTP code",
+					Examples: []string{
+						"id=<id>;actions=confirm-totp",
+						"id=<pin>;type=<type>;actions=confirm-totp",
+					},
+				},
+				&Action{
+					Name:        "remove-totp",
+					Description: "Remove the TOTP secret and recovery codes of an account",
+					Examples: []string{
+						"id=<id>;actions=remove-totp",
+						"id=<pin>;type=<type>;actions=remove-totp",
+					},
+				},
 			),
 		},
 	},
@@ -668,7 +767,16 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "explain-permissions",
+					Description: "Explain how the grants of a user apply to resources in a scope",
+					Examples: []string{
+						"id=<id>;actions=explain-permissions",
+					},
+				},
+			),
 		},
 	},
 }
@@ -727,6 +835,27 @@ var session = &Resource{
 						"id=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "extend",
+					Description: "Extend the expiration time of a session",
+					Examples: []string{
+						"id=<id>;actions=extend",
+					},
+				},
+				{
+					Name:        "extend:self",
+					Description: "Extend the expiration time of a session, which must be associated with the calling user",
+					Examples: []string{
+						"id=*;type=session;actions=extend:self",
+					},
+				},
+				{
+					Name:        "close-connection",
+					Description: "Close a single connection of a session",
+					Examples: []string{
+						"id=<id>;actions=close-connection",
+					},
+				},
 			},
 		},
 	},
@@ -824,6 +953,13 @@ var user = &Resource{
 						"id=<id>;actions=remove-accounts",
 					},
 				},
+				&Action{
+					Name:        "revoke-tokens",
+					Description: "Revoke the auth tokens of a user and cancel their sessions",
+					Examples: []string{
+						"id=<id>;actions=revoke-tokens",
+					},
+				},
 			),
 		},
 	},